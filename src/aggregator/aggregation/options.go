@@ -31,6 +31,10 @@ type Options struct {
 	// HasExpensiveAggregations means expensive (multiplication／division)
 	// aggregation types are enabled.
 	HasExpensiveAggregations bool
+
+	// HistogramBuckets are the upper bounds of the histogram buckets kept
+	// by timers, if any.
+	HistogramBuckets []float64
 }
 
 // NewOptions creates a new aggregation options.
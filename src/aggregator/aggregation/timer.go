@@ -29,19 +29,35 @@ import (
 type Timer struct {
 	Options
 
-	count  int64     // Number of values received.
-	sum    float64   // Sum of the values.
-	sumSq  float64   // Sum of squared values.
-	stream cm.Stream // Stream of values received.
+	count     int64             // Number of values received.
+	sum       float64           // Sum of the values.
+	sumSq     float64           // Sum of squared values.
+	stream    cm.Stream         // Stream of values received.
+	histogram []HistogramBucket // Cumulative histogram buckets, if any.
+}
+
+// HistogramBucket is a cumulative histogram bucket counting the values
+// less than or equal to its upper bound.
+type HistogramBucket struct {
+	UpperBound float64
+	Count      int64
 }
 
 // NewTimer creates a new timer
 func NewTimer(quantiles []float64, streamOpts cm.Options, opts Options) Timer {
 	stream := streamOpts.StreamPool().Get()
 	stream.ResetSetData(quantiles)
+	var histogram []HistogramBucket
+	if len(opts.HistogramBuckets) > 0 {
+		histogram = make([]HistogramBucket, len(opts.HistogramBuckets))
+		for i, upperBound := range opts.HistogramBuckets {
+			histogram[i].UpperBound = upperBound
+		}
+	}
 	return Timer{
-		Options: opts,
-		stream:  stream,
+		Options:   opts,
+		stream:    stream,
+		histogram: histogram,
 	}
 }
 
@@ -54,6 +70,10 @@ func (t *Timer) Add(value float64) {
 	if t.HasExpensiveAggregations {
 		t.sumSq += value * value
 	}
+
+	if len(t.histogram) > 0 {
+		t.addToHistogram(value)
+	}
 }
 
 // AddBatch adds a batch of timer values.
@@ -69,6 +89,10 @@ func (t *Timer) Quantile(q float64) float64 {
 	return t.stream.Quantile(q)
 }
 
+// HistogramBuckets returns the cumulative histogram buckets excluding the
+// +Inf bucket, whose count is the number of values received.
+func (t *Timer) HistogramBuckets() []HistogramBucket { return t.histogram }
+
 // Count returns the number of values received.
 func (t *Timer) Count() int64 { return t.count }
 
@@ -128,5 +152,22 @@ func (t *Timer) ValueOf(aggType aggregation.Type) float64 {
 	return 0
 }
 
+func (t *Timer) addToHistogram(value float64) {
+	// Binary search for the first bucket the value falls into. We intentionally
+	// do not use the sort.Search() function because it requires passing in a closure.
+	left, right := 0, len(t.histogram)
+	for left < right {
+		mid := left + (right-left)/2 // avoid overflow
+		if t.histogram[mid].UpperBound < value {
+			left = mid + 1
+		} else {
+			right = mid
+		}
+	}
+	for i := left; i < len(t.histogram); i++ {
+		t.histogram[i].Count++
+	}
+}
+
 // Close closes the timer.
 func (t *Timer) Close() { t.stream.Close() }
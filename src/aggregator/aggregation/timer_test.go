@@ -169,3 +169,32 @@ func TestTimerAggregationsNotExpensive(t *testing.T) {
 	// Closing the timer a second time should be a no op.
 	timer.Close()
 }
+
+func TestTimerHistogram(t *testing.T) {
+	opts := NewOptions()
+	opts.ResetSetData(aggregation.Types{aggregation.Histogram})
+	opts.HistogramBuckets = []float64{1, 5, 10}
+
+	timer := NewTimer(testQuantiles, cm.NewOptions(), opts)
+	require.Equal(t, []HistogramBucket{
+		{UpperBound: 1},
+		{UpperBound: 5},
+		{UpperBound: 10},
+	}, timer.HistogramBuckets())
+
+	timer.AddBatch([]float64{0.5, 1, 3, 5, 7, 42})
+	require.Equal(t, []HistogramBucket{
+		{UpperBound: 1, Count: 2},
+		{UpperBound: 5, Count: 4},
+		{UpperBound: 10, Count: 5},
+	}, timer.HistogramBuckets())
+	require.Equal(t, int64(6), timer.Count())
+	timer.Close()
+}
+
+func TestTimerNoHistogram(t *testing.T) {
+	timer := NewTimer(testQuantiles, cm.NewOptions(), NewOptions())
+	timer.Add(1.0)
+	require.Nil(t, timer.HistogramBuckets())
+	timer.Close()
+}
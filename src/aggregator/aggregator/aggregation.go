@@ -34,8 +34,9 @@ func newCounterAggregation(c aggregation.Counter) counterAggregation {
 	return counterAggregation{Counter: c}
 }
 
-func (c *counterAggregation) Add(value float64)                    { c.Counter.Update(int64(value)) }
-func (c *counterAggregation) AddUnion(mu unaggregated.MetricUnion) { c.Counter.Update(mu.CounterVal) }
+func (c *counterAggregation) Add(value float64)                               { c.Counter.Update(int64(value)) }
+func (c *counterAggregation) AddUnion(mu unaggregated.MetricUnion)            { c.Counter.Update(mu.CounterVal) }
+func (c *counterAggregation) HistogramBuckets() []aggregation.HistogramBucket { return nil }
 
 // timerAggregation is a timer aggregation.
 type timerAggregation struct {
@@ -51,6 +52,7 @@ type gaugeAggregation struct {
 	aggregation.Gauge
 }
 
-func newGaugeAggregation(g aggregation.Gauge) gaugeAggregation   { return gaugeAggregation{Gauge: g} }
-func (g *gaugeAggregation) Add(value float64)                    { g.Gauge.Update(value) }
-func (g *gaugeAggregation) AddUnion(mu unaggregated.MetricUnion) { g.Gauge.Update(mu.GaugeVal) }
+func newGaugeAggregation(g aggregation.Gauge) gaugeAggregation              { return gaugeAggregation{Gauge: g} }
+func (g *gaugeAggregation) Add(value float64)                               { g.Gauge.Update(value) }
+func (g *gaugeAggregation) AddUnion(mu unaggregated.MetricUnion)            { g.Gauge.Update(mu.GaugeVal) }
+func (g *gaugeAggregation) HistogramBuckets() []aggregation.HistogramBucket { return nil }
@@ -282,8 +282,9 @@ func (e *counterElemBase) ResetSetData(
 func (e *counterElemBase) Close() {}
 
 type timerElemBase struct {
-	quantiles     []float64
-	quantilesPool pool.FloatsPool
+	quantiles        []float64
+	quantilesPool    pool.FloatsPool
+	histogramBuckets []float64
 }
 
 func (e timerElemBase) Type() metric.Type { return metric.TimerType }
@@ -301,6 +302,7 @@ func (e timerElemBase) TypeStringFor(aggTypesOpts maggregation.TypesOptions, agg
 func (e timerElemBase) ElemPool(opts Options) TimerElemPool { return opts.TimerElemPool() }
 
 func (e timerElemBase) NewAggregation(opts Options, aggOpts raggregation.Options) timerAggregation {
+	aggOpts.HistogramBuckets = e.histogramBuckets
 	newTimer := raggregation.NewTimer(e.quantiles, opts.StreamOptions(), aggOpts)
 	return newTimerAggregation(newTimer)
 }
@@ -313,6 +315,10 @@ func (e *timerElemBase) ResetSetData(
 	if !aggTypes.IsValidForTimer() {
 		return fmt.Errorf("invalid aggregation types %s for timer", aggTypes.String())
 	}
+	e.histogramBuckets = nil
+	if aggTypes.Contains(maggregation.Histogram) {
+		e.histogramBuckets = aggTypesOpts.HistogramBuckets()
+	}
 	if useDefaultAggregation {
 		e.quantiles = aggTypesOpts.Quantiles()
 		e.quantilesPool = nil
@@ -338,6 +344,7 @@ func (e *timerElemBase) Close() {
 	}
 	e.quantiles = nil
 	e.quantilesPool = nil
+	e.histogramBuckets = nil
 }
 
 type gaugeElemBase struct{}
@@ -1082,6 +1082,87 @@ func TestTimerElemConsumeCustomAggregationCustomPipeline(t *testing.T) {
 	require.Equal(t, 0, len(e.values))
 }
 
+func TestTimerElemConsumeHistogram(t *testing.T) {
+	aggTypesOpts := maggregation.NewTypesOptions().SetHistogramBuckets([]float64{2.0, 5.0})
+	opts := NewOptions().SetAggregationTypesOptions(aggTypesOpts)
+	aggregationTypes := maggregation.Types{maggregation.Max, maggregation.Histogram}
+	e := MustNewTimerElem(testBatchTimerID, testStoragePolicy, aggregationTypes, applied.DefaultPipeline, testNumForwardedTimes, WithPrefixWithSuffix, opts)
+	timer := &lockedTimerAggregation{aggregation: e.NewAggregation(opts, e.aggOpts)}
+	timer.aggregation.AddBatch(testBatchTimer.BatchTimerVal)
+	e.values = append(e.values, timedTimer{
+		startAtNanos: testAlignedStarts[0],
+		lockedAgg:    timer,
+	})
+
+	var (
+		prefix    = opts.FullTimerPrefix()
+		timeNanos = testAlignedStarts[1]
+	)
+	expectedLocalRes := []testLocalMetricWithMetadata{
+		{
+			idPrefix:  prefix,
+			id:        testBatchTimerID,
+			idSuffix:  aggTypesOpts.TypeStringForTimer(maggregation.Max),
+			timeNanos: timeNanos,
+			value:     6.5,
+			sp:        testStoragePolicy,
+		},
+		{
+			idPrefix:  prefix,
+			id:        testBatchTimerID,
+			idSuffix:  []byte(`_bucket{le="2"}`),
+			timeNanos: timeNanos,
+			value:     1.0,
+			sp:        testStoragePolicy,
+		},
+		{
+			idPrefix:  prefix,
+			id:        testBatchTimerID,
+			idSuffix:  []byte(`_bucket{le="5"}`),
+			timeNanos: timeNanos,
+			value:     4.0,
+			sp:        testStoragePolicy,
+		},
+		{
+			idPrefix:  prefix,
+			id:        testBatchTimerID,
+			idSuffix:  []byte(`_bucket{le="+Inf"}`),
+			timeNanos: timeNanos,
+			value:     5.0,
+			sp:        testStoragePolicy,
+		},
+		{
+			idPrefix:  prefix,
+			id:        testBatchTimerID,
+			idSuffix:  maggregation.HistogramSumTypeString,
+			timeNanos: timeNanos,
+			value:     18.0,
+			sp:        testStoragePolicy,
+		},
+		{
+			idPrefix:  prefix,
+			id:        testBatchTimerID,
+			idSuffix:  maggregation.HistogramCountTypeString,
+			timeNanos: timeNanos,
+			value:     5.0,
+			sp:        testStoragePolicy,
+		},
+	}
+	localFn, localRes := testFlushLocalMetricFn()
+	forwardFn, forwardRes := testFlushForwardedMetricFn()
+	onForwardedFlushedFn, _ := testOnForwardedFlushedFn()
+	require.False(t, e.Consume(timeNanos, isStandardMetricEarlierThan, standardMetricTimestampNanos, localFn, forwardFn, onForwardedFlushedFn))
+	require.Equal(t, expectedLocalRes, *localRes)
+	require.Equal(t, 0, len(*forwardRes))
+}
+
+func TestTimerResetSetDataHistogramWithRollup(t *testing.T) {
+	opts := NewOptions()
+	te := MustNewTimerElem(nil, policy.EmptyStoragePolicy, maggregation.DefaultTypes, applied.DefaultPipeline, testNumForwardedTimes, NoPrefixNoSuffix, opts)
+	err := te.ResetSetData(testBatchTimerID, testStoragePolicy, maggregation.Types{maggregation.Histogram}, testPipeline, 0, NoPrefixNoSuffix)
+	require.Error(t, err)
+}
+
 func TestTimerElemClose(t *testing.T) {
 	// Set up stream options.
 	streamOpts, p, numAlloc := testStreamOptions(t, len(testAlignedStarts)-1)
@@ -1625,6 +1706,120 @@ func TestGaugeElemConsumeCustomAggregationCustomPipeline(t *testing.T) {
 	require.Equal(t, 0, len(e.values))
 }
 
+func TestGaugeElemConsumeIncreaseAddPipeline(t *testing.T) {
+	alignedstartAtNanos := []int64{
+		time.Unix(210, 0).UnixNano(),
+		time.Unix(220, 0).UnixNano(),
+		time.Unix(230, 0).UnixNano(),
+		time.Unix(240, 0).UnixNano(),
+	}
+	gaugeVals := []float64{10.0, 15.0, 5.0}
+	aggregationTypes := maggregation.Types{maggregation.Last}
+	isEarlierThanFn := isStandardMetricEarlierThan
+	timestampNanosFn := standardMetricTimestampNanos
+	opts := NewOptions().SetDiscardNaNAggregatedValues(false)
+	increasePipeline := applied.NewPipeline([]applied.OpUnion{
+		{
+			Type:           pipeline.TransformationOpType,
+			Transformation: pipeline.TransformationOp{Type: transformation.Increase},
+		},
+		{
+			Type:           pipeline.TransformationOpType,
+			Transformation: pipeline.TransformationOp{Type: transformation.Add},
+		},
+		{
+			Type: pipeline.RollupOpType,
+			Rollup: applied.RollupOp{
+				ID:            []byte("foo.bar"),
+				AggregationID: maggregation.MustCompressTypes(maggregation.Sum),
+			},
+		},
+	})
+	e := testGaugeElem(alignedstartAtNanos[:3], gaugeVals, aggregationTypes, increasePipeline, opts)
+	require.Equal(t, 1, len(e.transformations))
+	require.Equal(t, 2, len(e.transformations[0]))
+
+	aggKey := aggregationKey{
+		aggregationID:     maggregation.MustCompressTypes(maggregation.Sum),
+		storagePolicy:     testStoragePolicy,
+		numForwardedTimes: testNumForwardedTimes + 1,
+	}
+
+	// The first value has no previous value to compute the increase from, the
+	// third value is smaller than the second and is treated as a counter reset.
+	expectedForwardedRes := []testForwardedMetricWithMetadata{
+		{
+			aggregationKey: aggKey,
+			timeNanos:      time.Unix(220, 0).UnixNano(),
+			value:          0.0,
+		},
+		{
+			aggregationKey: aggKey,
+			timeNanos:      time.Unix(230, 0).UnixNano(),
+			value:          5.0,
+		},
+		{
+			aggregationKey: aggKey,
+			timeNanos:      time.Unix(240, 0).UnixNano(),
+			value:          10.0,
+		},
+	}
+	localFn, localRes := testFlushLocalMetricFn()
+	forwardFn, forwardRes := testFlushForwardedMetricFn()
+	onForwardedFlushedFn, _ := testOnForwardedFlushedFn()
+	require.False(t, e.Consume(alignedstartAtNanos[3], isEarlierThanFn, timestampNanosFn, localFn, forwardFn, onForwardedFlushedFn))
+	verifyForwardedMetrics(t, expectedForwardedRes, *forwardRes)
+	require.Equal(t, 0, len(*localRes))
+	require.Equal(t, []float64{5.0}, e.lastConsumedValues)
+
+	// Closing the element releases the transformations.
+	e.Close()
+	require.Equal(t, 0, len(e.transformations))
+}
+
+func TestGaugeElemConsumeResetTransformation(t *testing.T) {
+	alignedstartAtNanos := []int64{
+		time.Unix(210, 0).UnixNano(),
+		time.Unix(220, 0).UnixNano(),
+	}
+	gaugeVals := []float64{123.0}
+	aggregationTypes := maggregation.Types{maggregation.Last}
+	isEarlierThanFn := isStandardMetricEarlierThan
+	timestampNanosFn := standardMetricTimestampNanos
+	e := testGaugeElem(alignedstartAtNanos[:1], gaugeVals, aggregationTypes, applied.DefaultPipeline, NewOptions())
+
+	// Transformations are only applied locally when the element has no rollup
+	// operations, in which case the reset datapoint is flushed as well.
+	resetOp, err := transformation.Reset.NewOp()
+	require.NoError(t, err)
+	e.transformations = [][]transformation.Op{{resetOp}}
+
+	expectedLocalRes := []testLocalMetricWithMetadata{
+		{
+			idPrefix:  []byte("stats.gauges."),
+			id:        testGaugeID,
+			idSuffix:  expectGaugeSuffix(maggregation.Last),
+			timeNanos: time.Unix(220, 0).UnixNano(),
+			value:     123.0,
+			sp:        testStoragePolicy,
+		},
+		{
+			idPrefix:  []byte("stats.gauges."),
+			id:        testGaugeID,
+			idSuffix:  expectGaugeSuffix(maggregation.Last),
+			timeNanos: time.Unix(225, 0).UnixNano(),
+			value:     0.0,
+			sp:        testStoragePolicy,
+		},
+	}
+	localFn, localRes := testFlushLocalMetricFn()
+	forwardFn, forwardRes := testFlushForwardedMetricFn()
+	onForwardedFlushedFn, _ := testOnForwardedFlushedFn()
+	require.False(t, e.Consume(alignedstartAtNanos[1], isEarlierThanFn, timestampNanosFn, localFn, forwardFn, onForwardedFlushedFn))
+	require.Equal(t, expectedLocalRes, *localRes)
+	require.Equal(t, 0, len(*forwardRes))
+}
+
 func TestGaugeElemClose(t *testing.T) {
 	e := testGaugeElem(testAlignedStarts[:len(testAlignedStarts)-1], testGaugeVals, maggregation.DefaultTypes, applied.DefaultPipeline, NewOptions())
 	require.False(t, e.closed)
@@ -63,10 +63,11 @@ type GaugeElem struct {
 	elemBase
 	gaugeElemBase
 
-	values              []timedGauge // metric aggregations sorted by time in ascending order
-	toConsume           []timedGauge // small buffer to avoid memory allocations during consumption
-	lastConsumedAtNanos int64        // last consumed at in Unix nanoseconds
-	lastConsumedValues  []float64    // last consumed values
+	values              []timedGauge          // metric aggregations sorted by time in ascending order
+	toConsume           []timedGauge          // small buffer to avoid memory allocations during consumption
+	lastConsumedAtNanos int64                 // last consumed at in Unix nanoseconds
+	lastConsumedValues  []float64             // last consumed values
+	transformations     [][]transformation.Op // transformation operations per aggregation type
 }
 
 // NewGaugeElem creates a new element for the given metric type.
@@ -125,12 +126,37 @@ func (e *GaugeElem) ResetSetData(
 	if err := e.gaugeElemBase.ResetSetData(e.aggTypesOpts, aggTypes, useDefaultAggregation); err != nil {
 		return err
 	}
+	// Histograms are flushed as multiple series and as such can neither be
+	// transformed nor forwarded.
+	if e.aggTypes.Contains(maggregation.Histogram) &&
+		(e.parsedPipeline.HasRollup || !e.parsedPipeline.Transformations.IsEmpty()) {
+		return fmt.Errorf("histogram aggregation is not supported with pipeline %v", pipeline)
+	}
+	// Transformations may keep state across consumptions, as such each aggregation
+	// type needs its own transformation operations.
+	var (
+		numAggTypes        = len(e.aggTypes)
+		numTransformations = e.parsedPipeline.Transformations.Len()
+	)
+	e.transformations = e.transformations[:0]
+	if numTransformations > 0 {
+		for i := 0; i < numAggTypes; i++ {
+			ops := make([]transformation.Op, 0, numTransformations)
+			for j := 0; j < numTransformations; j++ {
+				op, err := e.parsedPipeline.Transformations.At(j).Transformation.Type.NewOp()
+				if err != nil {
+					return err
+				}
+				ops = append(ops, op)
+			}
+			e.transformations = append(e.transformations, ops)
+		}
+	}
 	// If the pipeline contains derivative transformations, we need to store past
 	// values in order to compute the derivatives.
 	if !e.parsedPipeline.HasDerivativeTransform {
 		return nil
 	}
-	numAggTypes := len(e.aggTypes)
 	if cap(e.lastConsumedValues) < numAggTypes {
 		e.lastConsumedValues = make([]float64, numAggTypes)
 	}
@@ -299,6 +325,10 @@ func (e *GaugeElem) Close() {
 	e.values = e.values[:0]
 	e.toConsume = e.toConsume[:0]
 	e.lastConsumedValues = e.lastConsumedValues[:0]
+	for idx := range e.transformations {
+		e.transformations[idx] = nil
+	}
+	e.transformations = e.transformations[:0]
 	e.gaugeElemBase.Close()
 	aggTypesPool := e.aggTypesOpts.TypesPool()
 	pool := e.ElemPool(e.opts)
@@ -407,39 +437,52 @@ func (e *GaugeElem) processValueWithAggregationLock(
 	flushForwardedFn flushForwardedMetricFn,
 ) {
 	var (
-		transformations  = e.parsedPipeline.Transformations
+		resolution       = e.sp.Resolution().Window
 		discardNaNValues = e.opts.DiscardNaNAggregatedValues()
 	)
 	for aggTypeIdx, aggType := range e.aggTypes {
-		value := lockedAgg.aggregation.ValueOf(aggType)
-		for i := 0; i < transformations.Len(); i++ {
-			transformType := transformations.At(i).Transformation.Type
-			if transformType.IsUnaryTransform() {
-				fn := transformType.MustUnaryTransform()
-				res := fn(transformation.Datapoint{TimeNanos: timeNanos, Value: value})
+		if aggType == maggregation.Histogram {
+			e.flushHistogramWithLock(flushLocalFn, lockedAgg, timeNanos)
+			continue
+		}
+		var (
+			value      = lockedAgg.aggregation.ValueOf(aggType)
+			extraDp    transformation.Datapoint
+			hasExtraDp bool
+		)
+		var transformOps []transformation.Op
+		if len(e.transformations) > 0 {
+			transformOps = e.transformations[aggTypeIdx]
+		}
+		for _, transformOp := range transformOps {
+			curr := transformation.Datapoint{TimeNanos: timeNanos, Value: value}
+			if unaryOp, ok := transformOp.UnaryTransform(); ok {
+				res := unaryOp.Evaluate(curr)
 				value = res.Value
-			} else {
-				fn := transformType.MustBinaryTransform()
+			} else if binaryOp, ok := transformOp.BinaryTransform(); ok {
 				prev := transformation.Datapoint{TimeNanos: e.lastConsumedAtNanos, Value: e.lastConsumedValues[aggTypeIdx]}
-				curr := transformation.Datapoint{TimeNanos: timeNanos, Value: value}
-				res := fn(prev, curr)
+				res := binaryOp.Evaluate(prev, curr)
 				// NB: we only need to record the value needed for derivative transformations.
 				// We currently only support first-order derivative transformations so we only
 				// need to keep one value. In the future if we need to support higher-order
 				// derivative transformations, we need to store an array of values here.
 				e.lastConsumedValues[aggTypeIdx] = value
 				value = res.Value
+			} else if unaryMultiOp, ok := transformOp.UnaryMultiOutputTransform(); ok {
+				res, extra := unaryMultiOp.Evaluate(curr, resolution)
+				value = res.Value
+				extraDp, hasExtraDp = extra, true
 			}
 		}
 		if discardNaNValues && math.IsNaN(value) {
 			continue
 		}
 		if !e.parsedPipeline.HasRollup {
-			switch e.idPrefixSuffixType {
-			case NoPrefixNoSuffix:
-				flushLocalFn(nil, e.id, nil, timeNanos, value, e.sp)
-			case WithPrefixWithSuffix:
-				flushLocalFn(e.FullPrefix(e.opts), e.id, e.TypeStringFor(e.aggTypesOpts, aggType), timeNanos, value, e.sp)
+			e.flushLocalWithLock(flushLocalFn, aggType, timeNanos, value)
+			// NB: the additional datapoint is only flushed for local metrics since
+			// forwarding it would aggregate it into the same window as the value.
+			if hasExtraDp && !(discardNaNValues && extraDp.IsEmpty()) {
+				e.flushLocalWithLock(flushLocalFn, aggType, extraDp.TimeNanos, extraDp.Value)
 			}
 		} else {
 			forwardedAggregationKey, _ := e.ForwardedAggregationKey()
@@ -448,3 +491,43 @@ func (e *GaugeElem) processValueWithAggregationLock(
 	}
 	e.lastConsumedAtNanos = timeNanos
 }
+
+func (e *GaugeElem) flushLocalWithLock(
+	flushLocalFn flushLocalMetricFn,
+	aggType maggregation.Type,
+	timeNanos int64,
+	value float64,
+) {
+	switch e.idPrefixSuffixType {
+	case NoPrefixNoSuffix:
+		flushLocalFn(nil, e.id, nil, timeNanos, value, e.sp)
+	case WithPrefixWithSuffix:
+		flushLocalFn(e.FullPrefix(e.opts), e.id, e.TypeStringFor(e.aggTypesOpts, aggType), timeNanos, value, e.sp)
+	}
+}
+
+// flushHistogramWithLock flushes the histogram bucket series followed by the
+// sum and count series. Since the series are only distinguishable by their
+// suffixes, the suffixes are added regardless of the id prefix suffix type.
+func (e *GaugeElem) flushHistogramWithLock(
+	flushLocalFn flushLocalMetricFn,
+	lockedAgg *lockedGaugeAggregation,
+	timeNanos int64,
+) {
+	var (
+		prefix      []byte
+		typeStrings = e.aggTypesOpts.HistogramBucketTypeStrings()
+		buckets     = lockedAgg.aggregation.HistogramBuckets()
+		count       = lockedAgg.aggregation.ValueOf(maggregation.Count)
+		sum         = lockedAgg.aggregation.ValueOf(maggregation.Sum)
+	)
+	if e.idPrefixSuffixType == WithPrefixWithSuffix {
+		prefix = e.FullPrefix(e.opts)
+	}
+	for i, bucket := range buckets {
+		flushLocalFn(prefix, e.id, typeStrings[i], timeNanos, float64(bucket.Count), e.sp)
+	}
+	flushLocalFn(prefix, e.id, typeStrings[len(buckets)], timeNanos, count, e.sp)
+	flushLocalFn(prefix, e.id, maggregation.HistogramSumTypeString, timeNanos, sum, e.sp)
+	flushLocalFn(prefix, e.id, maggregation.HistogramCountTypeString, timeNanos, count, e.sp)
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/m3db/m3/src/aggregator/aggregator/handler"
 	"github.com/m3db/m3/src/aggregator/aggregator/handler/writer"
+	"github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
@@ -155,7 +156,7 @@ func (w *downsamplerFlushHandlerWriter) Write(
 		}
 
 		if len(chunkSuffix) != 0 {
-			tags = tags.AddTag(models.Tag{Name: aggregationSuffixTag, Value: chunkSuffix}.Clone())
+			tags = addSuffixTags(tags, chunkSuffix)
 		}
 
 		err := iter.Err()
@@ -202,3 +203,23 @@ func (w *downsamplerFlushHandlerWriter) Close() error {
 	// NB(r): This is a no-op since this flush handler isn't connection based.
 	return nil
 }
+
+// addSuffixTags adds the aggregation suffix of a flushed metric to its tags.
+// Histogram series are suffixed the Prometheus way, that is the metric name
+// is appended with the series suffix and bucket series are tagged with their
+// upper bound, all other suffixes are added as an aggregation tag.
+func addSuffixTags(tags models.Tags, suffix []byte) models.Tags {
+	nameSuffix, upperBound, ok := aggregation.ParseHistogramTypeString(suffix)
+	if !ok {
+		return tags.AddTag(models.Tag{Name: aggregationSuffixTag, Value: suffix}.Clone())
+	}
+	if name, exists := tags.Name(); exists {
+		newName := make([]byte, 0, len(name)+len(nameSuffix))
+		newName = append(append(newName, name...), nameSuffix...)
+		tags = tags.SetName(newName)
+	}
+	if len(upperBound) != 0 {
+		tags = tags.SetBucket(append([]byte(nil), upperBound...))
+	}
+	return tags
+}
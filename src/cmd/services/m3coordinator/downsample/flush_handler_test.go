@@ -22,8 +22,10 @@ package downsample
 
 import (
 	"bytes"
+	"math"
 	"testing"
 
+	"github.com/m3db/m3/src/metrics/aggregation"
 	"github.com/m3db/m3/src/metrics/metric/aggregated"
 	"github.com/m3db/m3/src/metrics/metric/id"
 	"github.com/m3db/m3/src/metrics/policy"
@@ -104,3 +106,58 @@ func TestDownsamplerFlushHandlerCopiesTags(t *testing.T) {
 	assert.False(t, xtest.ByteSlicesBackedBySameData(tagName, tag.Name))
 	assert.False(t, xtest.ByteSlicesBackedBySameData(tagValue, tag.Value))
 }
+
+func TestAddSuffixTags(t *testing.T) {
+	tests := []struct {
+		suffix   []byte
+		expected []models.Tag
+	}{
+		{
+			suffix: []byte("p99"),
+			expected: []models.Tag{
+				{Name: []byte("__name__"), Value: []byte("foo")},
+				{Name: []byte("agg"), Value: []byte("p99")},
+				{Name: []byte("bar"), Value: []byte("baz")},
+			},
+		},
+		{
+			suffix: aggregation.HistogramBucketTypeString(0.25),
+			expected: []models.Tag{
+				{Name: []byte("__name__"), Value: []byte("foo_bucket")},
+				{Name: []byte("bar"), Value: []byte("baz")},
+				{Name: []byte("le"), Value: []byte("0.25")},
+			},
+		},
+		{
+			suffix: aggregation.HistogramBucketTypeString(math.Inf(1)),
+			expected: []models.Tag{
+				{Name: []byte("__name__"), Value: []byte("foo_bucket")},
+				{Name: []byte("bar"), Value: []byte("baz")},
+				{Name: []byte("le"), Value: []byte("+Inf")},
+			},
+		},
+		{
+			suffix: aggregation.HistogramSumTypeString,
+			expected: []models.Tag{
+				{Name: []byte("__name__"), Value: []byte("foo_sum")},
+				{Name: []byte("bar"), Value: []byte("baz")},
+			},
+		},
+		{
+			suffix: aggregation.HistogramCountTypeString,
+			expected: []models.Tag{
+				{Name: []byte("__name__"), Value: []byte("foo_count")},
+				{Name: []byte("bar"), Value: []byte("baz")},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		tags := models.NewTags(2, models.NewTagOptions()).AddTags([]models.Tag{
+			{Name: []byte("__name__"), Value: []byte("foo")},
+			{Name: []byte("bar"), Value: []byte("baz")},
+		})
+		tags = addSuffixTags(tags, test.suffix)
+		require.Equal(t, test.expected, tags.Tags, string(test.suffix))
+	}
+}
@@ -53,6 +53,7 @@ const (
 	maxResourcePoolNameSize = 1024
 	maxPooledTagsSize       = 16
 	defaultResourcePoolSize = 4096
+	maxUDPPacketSize        = 65535
 )
 
 var (
@@ -60,6 +61,11 @@ var (
 	carbonSeparatorByte  = byte('.')
 	carbonSeparatorBytes = []byte{carbonSeparatorByte}
 
+	// Used for parsing carbon tagged names, i.e. foo.bar;dc=east;host=a, into tags.
+	carbonTaggedSeparatorByte      = graphite.TaggedSeparator[0]
+	carbonTaggedValueSeparatorByte = graphite.TaggedValueSeparator[0]
+	carbonTaggedNameTag            = []byte(graphite.TaggedNameTag)
+
 	errCannotGenerateTagsFromEmptyName = errors.New("cannot generate tags from empty name")
 	errIOptsMustBeSet                  = errors.New("carbon ingester options: instrument options must be st")
 	errWorkerPoolMustBeSet             = errors.New("carbon ingester options: worker pool must be set")
@@ -72,6 +78,18 @@ type Options struct {
 	WorkerPool        xsync.PooledWorkerPool
 }
 
+// Ingester ingests carbon metrics. As a server.Handler it handles connections
+// using the carbon plaintext protocol.
+type Ingester interface {
+	m3xserver.Handler
+
+	// PickleHandler returns a handler for connections using the carbon pickle protocol.
+	PickleHandler() m3xserver.Handler
+
+	// HandlePackets reads carbon plaintext packets from conn until it is closed.
+	HandlePackets(conn net.PacketConn)
+}
+
 // CarbonIngesterRules contains the carbon ingestion rules.
 type CarbonIngesterRules struct {
 	Rules []config.CarbonIngesterRuleConfiguration
@@ -95,7 +113,7 @@ func NewIngester(
 	downsamplerAndWriter ingest.DownsamplerAndWriter,
 	rules CarbonIngesterRules,
 	opts Options,
-) (m3xserver.Handler, error) {
+) (Ingester, error) {
 	err := opts.Validate()
 	if err != nil {
 		return nil, err
@@ -154,38 +172,47 @@ type ingester struct {
 }
 
 func (i *ingester) Handle(conn net.Conn) {
+	s := carbon.NewScanner(conn, i.opts.InstrumentOptions)
+	i.handleScanner(s, &s.MalformedCount)
+}
+
+// PickleHandler returns a handler for connections using the carbon pickle protocol.
+func (i *ingester) PickleHandler() m3xserver.Handler {
+	return pickleHandler{ingester: i}
+}
+
+type pickleHandler struct {
+	*ingester
+}
+
+func (h pickleHandler) Handle(conn net.Conn) {
+	s := carbon.NewPickleScanner(conn, h.opts.InstrumentOptions)
+	h.handleScanner(s, &s.MalformedCount)
+}
+
+type scanner interface {
+	Scan() bool
+	Metric() ([]byte, time.Time, float64)
+	Err() error
+}
+
+func (i *ingester) handleScanner(s scanner, malformedCount *int) {
 	var (
 		// Interfaces require a context be passed, but M3DB client already has timeouts
 		// built in and allocating a new context each time is expensive so we just pass
 		// the same context always and rely on M3DB client timeouts.
 		ctx    = context.Background()
 		wg     = sync.WaitGroup{}
-		s      = carbon.NewScanner(conn, i.opts.InstrumentOptions)
 		logger = i.opts.InstrumentOptions.Logger()
 	)
 
 	logger.Debug("handling new carbon ingestion connection")
 	for s.Scan() {
 		name, timestamp, value := s.Metric()
+		i.writeAsync(ctx, &wg, name, timestamp, value)
 
-		resources := i.getLineResources()
-		// Copy name since scanner bytes are recycled.
-		resources.name = append(resources.name[:0], name...)
-
-		wg.Add(1)
-		i.opts.WorkerPool.Go(func() {
-			ok := i.write(ctx, resources, timestamp, value)
-			if ok {
-				i.metrics.success.Inc(1)
-			}
-			// The contract is that after the DownsamplerAndWriter returns, any resources
-			// that it needed to hold onto have already been copied.
-			i.putLineResources(resources)
-			wg.Done()
-		})
-
-		i.metrics.malformed.Inc(int64(s.MalformedCount))
-		s.MalformedCount = 0
+		i.metrics.malformed.Inc(int64(*malformedCount))
+		*malformedCount = 0
 	}
 
 	if err := s.Err(); err != nil {
@@ -199,6 +226,66 @@ func (i *ingester) Handle(conn net.Conn) {
 	// Don't close the connection, that is the server's responsibility.
 }
 
+// HandlePackets reads carbon plaintext packets from conn until it is closed.
+func (i *ingester) HandlePackets(conn net.PacketConn) {
+	var (
+		ctx     = context.Background()
+		wg      = sync.WaitGroup{}
+		logger  = i.opts.InstrumentOptions.Logger()
+		buf     = make([]byte, maxUDPPacketSize)
+		metrics []carbon.Metric
+	)
+
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Temporary() {
+				logger.Errorf("temporary error during carbon ingestion when reading packet: %s", err)
+				continue
+			}
+			logger.Debugf("stopped reading carbon ingestion packets: %s", err)
+			break
+		}
+
+		var malformed int
+		metrics, malformed = carbon.ParseAndAppendPacket(metrics[:0], buf[:n])
+		for _, m := range metrics {
+			// Names reference the packet buffer, they are copied before it is reused.
+			i.writeAsync(ctx, &wg, m.Name, m.Time, m.Val)
+		}
+		i.metrics.malformed.Inc(int64(malformed))
+	}
+
+	logger.Debugf("waiting for outstanding carbon ingestion writes to complete")
+	wg.Wait()
+
+	// Don't close the connection, that is the caller's responsibility.
+}
+
+func (i *ingester) writeAsync(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	name []byte,
+	timestamp time.Time,
+	value float64,
+) {
+	resources := i.getLineResources()
+	// Copy name since scanner bytes are recycled.
+	resources.name = append(resources.name[:0], name...)
+
+	wg.Add(1)
+	i.opts.WorkerPool.Go(func() {
+		ok := i.write(ctx, resources, timestamp, value)
+		if ok {
+			i.metrics.success.Inc(1)
+		}
+		// The contract is that after the DownsamplerAndWriter returns, any resources
+		// that it needed to hold onto have already been copied.
+		i.putLineResources(resources)
+		wg.Done()
+	})
+}
+
 func (i *ingester) write(
 	ctx context.Context,
 	resources *lineResources,
@@ -317,6 +404,10 @@ func generateTagsFromName(
 		return models.EmptyTags(), errCannotGenerateTagsFromEmptyName
 	}
 
+	if bytes.IndexByte(name, carbonTaggedSeparatorByte) >= 0 {
+		return generateTagsFromTaggedName(name, opts, tags)
+	}
+
 	numTags := bytes.Count(name, carbonSeparatorBytes) + 1
 
 	if cap(tags) >= numTags {
@@ -362,6 +453,67 @@ func generateTagsFromName(
 	return models.Tags{Opts: opts, Tags: tags}, nil
 }
 
+// generateTagsFromTaggedName accepts a carbon tagged metric name and blows it
+// up into a list of key-value pair tags such that an input like:
+//      foo.bar;dc=east;host=a
+// becomes
+//      name:foo.bar
+//      dc:east
+//      host:a
+func generateTagsFromTaggedName(
+	name []byte,
+	opts models.TagOptions,
+	tags []models.Tag,
+) (models.Tags, error) {
+	numTags := bytes.Count(name, []byte{carbonTaggedSeparatorByte}) + 1
+	if cap(tags) >= numTags {
+		tags = tags[:0]
+	} else {
+		tags = make([]models.Tag, 0, numTags)
+	}
+
+	nameEndIdx := bytes.IndexByte(name, carbonTaggedSeparatorByte)
+	if nameEndIdx == 0 {
+		return models.EmptyTags(),
+			fmt.Errorf("carbon metric: %s has empty name", string(name))
+	}
+
+	tags = append(tags, models.Tag{
+		Name:  carbonTaggedNameTag,
+		Value: name[:nameEndIdx],
+	})
+
+	remaining := name[nameEndIdx+1:]
+	for len(remaining) > 0 {
+		tag := remaining
+		remaining = nil
+		if idx := bytes.IndexByte(tag, carbonTaggedSeparatorByte); idx >= 0 {
+			tag, remaining = tag[:idx], tag[idx+1:]
+		}
+
+		valueIdx := bytes.IndexByte(tag, carbonTaggedValueSeparatorByte)
+		if valueIdx <= 0 || valueIdx == len(tag)-1 {
+			return models.EmptyTags(),
+				fmt.Errorf("carbon metric: %s has invalid tag: %s", string(name), string(tag))
+		}
+
+		tagName := tag[:valueIdx]
+		for _, existing := range tags {
+			if bytes.Equal(existing.Name, tagName) {
+				return models.EmptyTags(),
+					fmt.Errorf("carbon metric: %s has duplicate tag: %s", string(name), string(tagName))
+			}
+		}
+
+		tags = append(tags, models.Tag{
+			Name:  tagName,
+			Value: tag[valueIdx+1:],
+		})
+	}
+
+	return models.Tags{Opts: opts, Tags: tags}, nil
+}
+
 // Compile all the carbon ingestion rules into regexp so that we can
 // perform matching. Also, generate all the mapping rules and storage
 // policies that we will need to pass to the DownsamplerAndWriter upfront
@@ -23,6 +23,7 @@ package ingestcarbon
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
@@ -47,6 +48,7 @@ import (
 	xtime "github.com/m3db/m3x/time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/hydrogen18/stalecucumber"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -229,6 +231,121 @@ func TestIngesterHandleConn(t *testing.T) {
 	assertTestMetricsAreEqual(t, testMetrics, found)
 }
 
+func TestIngesterHandlePickleConn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+
+	var (
+		lock  = sync.Mutex{}
+		found = []testMetric{}
+	)
+	mockDownsamplerAndWriter.EXPECT().
+		Write(gomock.Any(), gomock.Any(), gomock.Any(), xtime.Second, gomock.Any()).DoAndReturn(func(
+		_ context.Context,
+		tags models.Tags,
+		dp ts.Datapoints,
+		unit xtime.Unit,
+		overrides ingest.WriteOptions,
+	) interface{} {
+		lock.Lock()
+		// Clone tags because they (and their underlying bytes) are pooled.
+		found = append(found, testMetric{
+			tags: tags.Clone(), timestamp: int(dp[0].Timestamp.Unix()), value: dp[0].Value})
+		lock.Unlock()
+		return nil
+	}).AnyTimes()
+
+	var (
+		payload  bytes.Buffer
+		header   [4]byte
+		expected []testMetric
+		metrics  []interface{}
+	)
+	for i := 0; i < 100; i++ {
+		name := fmt.Sprintf("test.pickle.%d", i)
+		metrics = append(metrics, []interface{}{name, []interface{}{int64(i), float64(i)}})
+		expected = append(expected, testMetric{
+			tags:      mustGenerateTagsFromName(t, []byte(name)),
+			timestamp: i,
+			value:     float64(i),
+		})
+	}
+	_, err := stalecucumber.NewPickler(&payload).Pickle(metrics)
+	require.NoError(t, err)
+	binary.BigEndian.PutUint32(header[:], uint32(payload.Len()))
+
+	byteConn := &byteConn{b: io.MultiReader(bytes.NewReader(header[:]), &payload)}
+	ingester, err := NewIngester(mockDownsamplerAndWriter, testRulesMatchAll, testOptions)
+	require.NoError(t, err)
+	ingester.PickleHandler().Handle(byteConn)
+
+	assertTestMetricsAreEqual(t, expected, found)
+}
+
+func TestIngesterHandlePackets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+
+	var (
+		lock  = sync.Mutex{}
+		found = []testMetric{}
+		wg    sync.WaitGroup
+	)
+	wg.Add(2)
+	mockDownsamplerAndWriter.EXPECT().
+		Write(gomock.Any(), gomock.Any(), gomock.Any(), xtime.Second, gomock.Any()).DoAndReturn(func(
+		_ context.Context,
+		tags models.Tags,
+		dp ts.Datapoints,
+		unit xtime.Unit,
+		overrides ingest.WriteOptions,
+	) interface{} {
+		lock.Lock()
+		// Clone tags because they (and their underlying bytes) are pooled.
+		found = append(found, testMetric{
+			tags: tags.Clone(), timestamp: int(dp[0].Timestamp.Unix()), value: dp[0].Value})
+		lock.Unlock()
+		wg.Done()
+		return nil
+	}).Times(2)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ingester, err := NewIngester(mockDownsamplerAndWriter, testRulesMatchAll, testOptions)
+	require.NoError(t, err)
+
+	doneCh := make(chan struct{})
+	go func() {
+		ingester.HandlePackets(conn)
+		close(doneCh)
+	}()
+
+	client, err := net.Dial("udp", conn.LocalAddr().String())
+	require.NoError(t, err)
+	defer client.Close()
+
+	_, err = client.Write([]byte("foo.bar.baz 1 1\ngarbage\nfoo.bar.qux 2 2\n"))
+	require.NoError(t, err)
+
+	wg.Wait()
+	require.NoError(t, conn.Close())
+	<-doneCh
+
+	assertTestMetricsAreEqual(t, []testMetric{
+		{
+			tags:      mustGenerateTagsFromName(t, []byte("foo.bar.baz")),
+			timestamp: 1,
+			value:     1,
+		},
+		{
+			tags:      mustGenerateTagsFromName(t, []byte("foo.bar.qux")),
+			timestamp: 2,
+			value:     2,
+		},
+	}, found)
+}
+
 func TestIngesterHonorsPatterns(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
@@ -342,6 +459,44 @@ func TestGenerateTagsFromName(t *testing.T) {
 			expectedErr:  fmt.Errorf("carbon metric: foo.bar.baz.. has duplicate separator"),
 			expectedTags: []models.Tag{},
 		},
+		{
+			name: "foo.bar;dc=east;host=a",
+			id:   "foo.bar;dc=east;host=a",
+			expectedTags: []models.Tag{
+				{Name: []byte("name"), Value: []byte("foo.bar")},
+				{Name: []byte("dc"), Value: []byte("east")},
+				{Name: []byte("host"), Value: []byte("a")},
+			},
+		},
+		{
+			name: "foo.bar;host=a;dc=east=1;",
+			id:   "foo.bar;dc=east=1;host=a",
+			expectedTags: []models.Tag{
+				{Name: []byte("name"), Value: []byte("foo.bar")},
+				{Name: []byte("host"), Value: []byte("a")},
+				{Name: []byte("dc"), Value: []byte("east=1")},
+			},
+		},
+		{
+			name:         ";dc=east",
+			expectedErr:  fmt.Errorf("carbon metric: ;dc=east has empty name"),
+			expectedTags: []models.Tag{},
+		},
+		{
+			name:         "foo.bar;dc=",
+			expectedErr:  fmt.Errorf("carbon metric: foo.bar;dc= has invalid tag: dc="),
+			expectedTags: []models.Tag{},
+		},
+		{
+			name:         "foo.bar;dc=east;dc=west",
+			expectedErr:  fmt.Errorf("carbon metric: foo.bar;dc=east;dc=west has duplicate tag: dc"),
+			expectedTags: []models.Tag{},
+		},
+		{
+			name:         "foo.bar;name=baz",
+			expectedErr:  fmt.Errorf("carbon metric: foo.bar;name=baz has duplicate tag: name"),
+			expectedTags: []models.Tag{},
+		},
 	}
 
 	opts := models.NewTagOptions().SetIDSchemeType(models.TypeGraphite)
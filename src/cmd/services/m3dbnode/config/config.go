@@ -32,6 +32,7 @@ import (
 	coordinatorcfg "github.com/m3db/m3/src/cmd/services/m3query/config"
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/environment"
+	xtls "github.com/m3db/m3/src/x/tls"
 	"github.com/m3db/m3x/config/hostid"
 	"github.com/m3db/m3x/instrument"
 	xlog "github.com/m3db/m3x/log"
@@ -90,6 +91,10 @@ type DBConfiguration struct {
 	// The host and port on which to listen for debug endpoints.
 	DebugListenAddress string `yaml:"debugListenAddress"`
 
+	// TLS configuration for the node and cluster listeners, client
+	// certificates are required from peers when mutual TLS is enabled.
+	TLS *xtls.Configuration `yaml:"tls"`
+
 	// HostID is the local host ID configuration.
 	HostID hostid.Configuration `yaml:"hostID"`
 
@@ -154,6 +159,12 @@ func (c *DBConfiguration) InitDefaultsAndValidate() error {
 		return err
 	}
 
+	if c.TLS != nil {
+		if err := c.TLS.ValidateServer(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -339,6 +339,7 @@ func TestConfiguration(t *testing.T) {
   httpNodeListenAddress: 0.0.0.0:9002
   httpClusterListenAddress: 0.0.0.0:9003
   debugListenAddress: 0.0.0.0:9004
+  tls: null
   hostID:
     resolver: config
     value: host1
@@ -370,6 +371,7 @@ func TestConfiguration(t *testing.T) {
     backgroundHealthCheckFailThrottleFactor: 0.5
     hashing:
       seed: 42
+    tls: null
   gcPercentage: 100
   writeNewSeriesLimitPerSecond: 1048576
   writeNewSeriesBackoffDuration: 2ms
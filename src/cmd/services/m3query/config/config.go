@@ -30,6 +30,8 @@ import (
 	ingestm3msg "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/m3msg"
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/server/m3msg"
 	"github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/query/cache"
+	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
@@ -155,6 +157,52 @@ type FilterConfiguration struct {
 type CacheConfiguration struct {
 	// QueryConversion cache policy.
 	QueryConversion *QueryConversionCacheConfiguration `yaml:"queryConversion"`
+
+	// Results cache policy, if not provided query results are not cached.
+	Results *ResultsCacheConfiguration `yaml:"results"`
+}
+
+// ResultsCacheConfiguration is the query results cache configuration.
+type ResultsCacheConfiguration struct {
+	// Size is the number of query intervals held in memory.
+	Size int `yaml:"size" validate:"min=1"`
+
+	// SplitInterval is the interval range queries are split by, intervals
+	// are cached independently of one another.
+	SplitInterval *time.Duration `yaml:"splitInterval"`
+
+	// BufferPast is the horizon before now after which intervals are always
+	// recomputed since writes may still arrive for them.
+	BufferPast *time.Duration `yaml:"bufferPast"`
+
+	// FetchConcurrency is the number of intervals of a query which are
+	// computed concurrently.
+	FetchConcurrency *int `yaml:"fetchConcurrency"`
+}
+
+// NewResultsCache creates a new results cache from the configuration.
+func (c ResultsCacheConfiguration) NewResultsCache(
+	instrumentOpts instrument.Options,
+) (cache.ResultsCache, error) {
+	store, err := cache.NewLRUStore(c.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := cache.NewOptions().
+		SetStore(store).
+		SetInstrumentOptions(instrumentOpts)
+	if c.SplitInterval != nil {
+		opts = opts.SetSplitInterval(*c.SplitInterval)
+	}
+	if c.BufferPast != nil {
+		opts = opts.SetBufferPast(*c.BufferPast)
+	}
+	if c.FetchConcurrency != nil {
+		opts = opts.SetFetchConcurrency(*c.FetchConcurrency)
+	}
+
+	return cache.NewResultsCache(opts)
 }
 
 // QueryConversionCacheConfiguration is the query conversion cache configuration.
@@ -194,6 +242,37 @@ func (q *QueryConversionCacheConfiguration) Validate() error {
 // LimitsConfiguration represents limitations on per-query resource usage. Zero or negative values imply no limit.
 type LimitsConfiguration struct {
 	MaxComputedDatapoints int64 `yaml:"maxComputedDatapoints"`
+
+	// Global limits the resources used by all in-flight queries combined.
+	Global CostLimitsConfiguration `yaml:"global"`
+
+	// PerQuery limits the resources used by each individual query.
+	PerQuery CostLimitsConfiguration `yaml:"perQuery"`
+}
+
+// CostLimitsConfiguration represents the default limits on the resources used
+// by queries, which can be overridden at runtime through KV. Zero or negative
+// values imply no limit.
+type CostLimitsConfiguration struct {
+	// MaxFetchedSeries limits the number of series fetched from storage.
+	MaxFetchedSeries int64 `yaml:"maxFetchedSeries"`
+
+	// MaxDecodedDatapoints limits the number of datapoints decoded from
+	// the fetched series.
+	MaxDecodedDatapoints int64 `yaml:"maxDecodedDatapoints"`
+
+	// MaxBlockBytes limits the number of bytes of values materialized
+	// into blocks.
+	MaxBlockBytes int64 `yaml:"maxBlockBytes"`
+}
+
+// Thresholds returns the thresholds of each resource.
+func (c CostLimitsConfiguration) Thresholds() cost.Thresholds {
+	return cost.Thresholds{
+		cost.FetchedSeries:     c.MaxFetchedSeries,
+		cost.DecodedDatapoints: c.MaxDecodedDatapoints,
+		cost.BlockBytes:        c.MaxBlockBytes,
+	}
 }
 
 // IngestConfiguration is the configuration for ingestion server.
@@ -212,10 +291,12 @@ type CarbonConfiguration struct {
 
 // CarbonIngesterConfiguration is the configuration struct for carbon ingestion.
 type CarbonIngesterConfiguration struct {
-	Debug          bool                              `yaml:"debug"`
-	ListenAddress  string                            `yaml:"listenAddress"`
-	MaxConcurrency int                               `yaml:"maxConcurrency"`
-	Rules          []CarbonIngesterRuleConfiguration `yaml:"rules"`
+	Debug               bool                              `yaml:"debug"`
+	ListenAddress       string                            `yaml:"listenAddress"`
+	PickleListenAddress string                            `yaml:"pickleListenAddress"`
+	UDPListenAddress    string                            `yaml:"udpListenAddress"`
+	MaxConcurrency      int                               `yaml:"maxConcurrency"`
+	Rules               []CarbonIngesterRuleConfiguration `yaml:"rules"`
 }
 
 // LookbackDurationOrDefault validates the LookbackDuration
@@ -28,6 +28,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/m3db/m3/src/metrics/rules/view"
 	"github.com/m3db/m3/src/metrics/rules/view/changes"
 
 	validator "gopkg.in/go-playground/validator.v9"
@@ -88,3 +89,12 @@ type updateRuleSetRequest struct {
 	RuleSetChanges changes.RuleSetChanges `json:"rulesetChanges"`
 	RuleSetVersion int                    `json:"rulesetVersion"`
 }
+
+type evaluateRuleSetRequest struct {
+	MetricID   string            `json:"metricID"`
+	Name       string            `json:"name"`
+	Tags       map[string]string `json:"tags"`
+	FromMillis int64             `json:"fromMillis"`
+	ToMillis   int64             `json:"toMillis"`
+	RuleSet    *view.RuleSet     `json:"ruleSet"`
+}
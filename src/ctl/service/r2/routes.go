@@ -23,7 +23,10 @@ package r2
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/m3db/m3/src/ctl/service/r2/store"
 	"github.com/m3db/m3/src/metrics/rules/view"
 
 	"github.com/gorilla/mux"
@@ -93,6 +96,59 @@ func updateRuleSet(s *service, r *http.Request) (data interface{}, err error) {
 	return s.store.UpdateRuleSet(req.RuleSetChanges, req.RuleSetVersion, uOpts)
 }
 
+func evaluateRuleSet(s *service, r *http.Request) (data interface{}, err error) {
+	namespaceID := mux.Vars(r)[namespaceIDVar]
+	var req evaluateRuleSetRequest
+	if err := parseRequest(&req, r.Body); err != nil {
+		return nil, err
+	}
+	if req.MetricID != "" && (req.Name != "" || len(req.Tags) > 0) {
+		return nil, NewBadInputError(
+			"invalid request: metricID cannot be combined with name and tags",
+		)
+	}
+	if req.RuleSet != nil && req.RuleSet.Namespace != namespaceID {
+		return nil, NewBadInputError(fmt.Sprintf(
+			"namespaceID param %s and ruleset namespaceID %s do not match",
+			namespaceID,
+			req.RuleSet.Namespace,
+		))
+	}
+
+	// The match time range defaults to the current time.
+	fromNanos := s.nowFn().UnixNano()
+	if req.FromMillis != 0 {
+		fromNanos = req.FromMillis * int64(time.Millisecond)
+	}
+	toNanos := fromNanos + 1
+	if req.ToMillis != 0 {
+		toNanos = req.ToMillis * int64(time.Millisecond)
+	}
+
+	return s.store.EvaluateRuleSet(namespaceID, store.EvaluateRequest{
+		MetricID:  []byte(req.MetricID),
+		Name:      []byte(req.Name),
+		Tags:      req.Tags,
+		FromNanos: fromNanos,
+		ToNanos:   toNanos,
+		RuleSet:   req.RuleSet,
+	})
+}
+
+func rollbackRuleSet(s *service, r *http.Request) (data interface{}, err error) {
+	version, err := parseVersion(r)
+	if err != nil {
+		return nil, err
+	}
+
+	uOpts, err := s.newUpdateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.RollbackRuleSet(mux.Vars(r)[namespaceIDVar], version, uOpts)
+}
+
 func deleteNamespace(s *service, r *http.Request) (data interface{}, err error) {
 	vars := mux.Vars(r)
 	namespaceID := vars[namespaceIDVar]
@@ -170,6 +226,21 @@ func fetchMappingRuleHistory(s *service, r *http.Request) (data interface{}, err
 	return view.MappingRuleSnapshots{MappingRules: snapshots}, nil
 }
 
+func rollbackMappingRule(s *service, r *http.Request) (data interface{}, err error) {
+	vars := mux.Vars(r)
+	version, err := parseVersion(r)
+	if err != nil {
+		return nil, err
+	}
+
+	uOpts, err := s.newUpdateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.RollbackMappingRule(vars[namespaceIDVar], vars[ruleIDVar], version, uOpts)
+}
+
 func fetchRollupRule(s *service, r *http.Request) (data interface{}, err error) {
 	vars := mux.Vars(r)
 	return s.store.FetchRollupRule(vars[namespaceIDVar], vars[ruleIDVar])
@@ -232,3 +303,30 @@ func fetchRollupRuleHistory(s *service, r *http.Request) (data interface{}, err
 	}
 	return view.RollupRuleSnapshots{RollupRules: snapshots}, nil
 }
+
+func rollbackRollupRule(s *service, r *http.Request) (data interface{}, err error) {
+	vars := mux.Vars(r)
+	version, err := parseVersion(r)
+	if err != nil {
+		return nil, err
+	}
+
+	uOpts, err := s.newUpdateOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.RollbackRollupRule(vars[namespaceIDVar], vars[ruleIDVar], version, uOpts)
+}
+
+func parseVersion(r *http.Request) (int, error) {
+	value := r.URL.Query().Get(versionParam)
+	if value == "" {
+		return 0, NewBadInputError("invalid request: version is required")
+	}
+	version, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, NewBadInputError(fmt.Sprintf("invalid request: invalid version %s", value))
+	}
+	return version, nil
+}
@@ -78,6 +78,84 @@ func TestValidateRuleSetSuccess(t *testing.T) {
 	require.Equal(t, expected, actual)
 }
 
+func TestEvaluateRuleSetSuccess(t *testing.T) {
+	expected := store.RuleSetEvaluation{}
+	actual, err := evaluateRuleSet(newTestService(nil), newTestPostRequest(
+		[]byte(`{"name": "requests", "tags": {"app": "foo"}}`),
+	))
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestEvaluateRuleSetRequest(t *testing.T) {
+	namespaceID := "testNamespace"
+	req, err := http.NewRequest(
+		http.MethodPost,
+		fmt.Sprintf("/namespaces/%s/ruleset/evaluate", namespaceID),
+		bytes.NewBuffer([]byte(`{"metricID": "m3+requests+app=foo", "fromMillis": 1000, "toMillis": 2000}`)),
+	)
+	require.NoError(t, err)
+	req = mux.SetURLVars(
+		req,
+		map[string]string{
+			"namespaceID": namespaceID,
+		},
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	storeMock := store.NewMockStore(ctrl)
+	storeMock.EXPECT().EvaluateRuleSet(namespaceID, store.EvaluateRequest{
+		MetricID:  []byte("m3+requests+app=foo"),
+		Name:      []byte(""),
+		FromNanos: int64(time.Second),
+		ToNanos:   int64(2 * time.Second),
+	}).Return(store.RuleSetEvaluation{Version: 2}, nil)
+
+	service := newTestService(storeMock)
+	resp, err := evaluateRuleSet(service, req)
+	require.NoError(t, err)
+	require.Equal(t, 2, resp.(store.RuleSetEvaluation).Version)
+}
+
+func TestEvaluateRuleSetInvalidRequest(t *testing.T) {
+	bodies := []string{
+		`invalid json`,
+		`{"metricID": "m3+requests+app=foo", "name": "requests"}`,
+		`{"name": "requests", "ruleSet": {"namespace": "otherNamespace"}}`,
+	}
+	for _, body := range bodies {
+		req := mux.SetURLVars(
+			newTestPostRequest([]byte(body)),
+			map[string]string{"namespaceID": "testNamespace"},
+		)
+		resp, err := evaluateRuleSet(newTestService(nil), req)
+		require.Nil(t, resp)
+		require.Error(t, err)
+		require.IsType(t, NewBadInputError(""), err)
+	}
+}
+
+func TestRollbackRuleSetSuccess(t *testing.T) {
+	req := newTestPostRequest(nil)
+	req.URL.RawQuery = "version=2"
+	expected := view.RuleSet{}
+	actual, err := rollbackRuleSet(newTestService(nil), req)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestRollbackRuleSetInvalidVersion(t *testing.T) {
+	for _, query := range []string{"", "version=", "version=abc"} {
+		req := newTestPostRequest(nil)
+		req.URL.RawQuery = query
+		actual, err := rollbackRuleSet(newTestService(nil), req)
+		require.Nil(t, actual)
+		require.Error(t, err)
+		require.IsType(t, NewBadInputError(""), err)
+	}
+}
+
 func TestCreateNamespaceSuccess(t *testing.T) {
 	expected := view.Namespace{}
 	actual, err := createNamespace(newTestService(nil), newTestPostRequest([]byte(`{"id": "id"}`)))
@@ -131,6 +209,36 @@ func TestFetchMappingRuleHistorySuccess(t *testing.T) {
 	require.Equal(t, expected, actual)
 }
 
+func TestRollbackMappingRule(t *testing.T) {
+	namespaceID, ruleID := "testNamespace", "mappingRule1"
+	req, err := http.NewRequest(
+		http.MethodPost,
+		fmt.Sprintf("/namespaces/%s/mapping-rules/%s/rollback?version=3", namespaceID, ruleID),
+		nil,
+	)
+	require.NoError(t, err)
+	req = mux.SetURLVars(
+		req,
+		map[string]string{
+			"namespaceID": namespaceID,
+			"ruleID":      ruleID,
+		},
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	storeMock := store.NewMockStore(ctrl)
+	storeMock.EXPECT().RollbackMappingRule(namespaceID, ruleID, 3, gomock.Any()).Return(
+		view.MappingRule{ID: ruleID},
+		nil,
+	)
+
+	service := newTestService(storeMock)
+	resp, err := rollbackMappingRule(service, req)
+	require.NoError(t, err)
+	require.Equal(t, view.MappingRule{ID: ruleID}, resp)
+}
+
 func TestFetchRollupRuleSuccess(t *testing.T) {
 	expected := view.RollupRule{}
 	actual, err := fetchRollupRule(newTestService(nil), newTestGetRequest())
@@ -170,6 +278,36 @@ func TestFetchRollupRuleHistorySuccess(t *testing.T) {
 	require.Equal(t, expected, actual)
 }
 
+func TestRollbackRollupRule(t *testing.T) {
+	namespaceID, ruleID := "testNamespace", "rollupRule1"
+	req, err := http.NewRequest(
+		http.MethodPost,
+		fmt.Sprintf("/namespaces/%s/rollup-rules/%s/rollback?version=1", namespaceID, ruleID),
+		nil,
+	)
+	require.NoError(t, err)
+	req = mux.SetURLVars(
+		req,
+		map[string]string{
+			"namespaceID": namespaceID,
+			"ruleID":      ruleID,
+		},
+	)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	storeMock := store.NewMockStore(ctrl)
+	storeMock.EXPECT().RollbackRollupRule(namespaceID, ruleID, 1, gomock.Any()).Return(
+		view.RollupRule{},
+		NewBadInputError("invalid version"),
+	)
+
+	service := newTestService(storeMock)
+	_, err = rollbackRollupRule(service, req)
+	require.Error(t, err)
+	require.IsType(t, NewBadInputError(""), err)
+}
+
 func TestRulesetUpdateRuleSet(t *testing.T) {
 	namespaceID := "testNamespace"
 	bulkReqBody := newTestBulkReqBody()
@@ -412,6 +550,14 @@ func (s mockStore) UpdateRuleSet(rsChanges changes.RuleSetChanges, version int,
 	return view.RuleSet{}, nil
 }
 
+func (s mockStore) EvaluateRuleSet(namespaceID string, req store.EvaluateRequest) (store.RuleSetEvaluation, error) {
+	return store.RuleSetEvaluation{}, nil
+}
+
+func (s mockStore) RollbackRuleSet(namespaceID string, version int, uOpts store.UpdateOptions) (view.RuleSet, error) {
+	return view.RuleSet{}, nil
+}
+
 func (s mockStore) CreateNamespace(namespaceID string, uOpts store.UpdateOptions) (view.Namespace, error) {
 	return view.Namespace{}, nil
 }
@@ -449,6 +595,10 @@ func (s mockStore) FetchMappingRuleHistory(namespaceID, mappingRuleID string) ([
 	return make([]view.MappingRule, 0), nil
 }
 
+func (s mockStore) RollbackMappingRule(namespaceID, mappingRuleID string, version int, uOpts store.UpdateOptions) (view.MappingRule, error) {
+	return view.MappingRule{}, nil
+}
+
 func (s mockStore) FetchRollupRule(namespaceID, rollupRuleID string) (view.RollupRule, error) {
 	return view.RollupRule{}, nil
 }
@@ -469,4 +619,8 @@ func (s mockStore) FetchRollupRuleHistory(namespaceID, rollupRuleID string) ([]v
 	return make([]view.RollupRule, 0), nil
 }
 
+func (s mockStore) RollbackRollupRule(namespaceID, rollupRuleID string, version int, uOpts store.UpdateOptions) (view.RollupRule, error) {
+	return view.RollupRule{}, nil
+}
+
 func (s mockStore) Close() {}
@@ -43,20 +43,25 @@ const (
 	rollupRulePrefix  = "rollup-rules"
 	namespaceIDVar    = "namespaceID"
 	ruleIDVar         = "ruleID"
+	versionParam      = "version"
 )
 
 var (
 	namespacePrefix     = fmt.Sprintf("%s/{%s}", namespacePath, namespaceIDVar)
 	validateRuleSetPath = fmt.Sprintf("%s/{%s}/ruleset/validate", namespacePath, namespaceIDVar)
 	updateRuleSetPath   = fmt.Sprintf("%s/{%s}/ruleset/update", namespacePath, namespaceIDVar)
+	evaluateRuleSetPath = fmt.Sprintf("%s/{%s}/ruleset/evaluate", namespacePath, namespaceIDVar)
+	rollbackRuleSetPath = fmt.Sprintf("%s/{%s}/ruleset/rollback", namespacePath, namespaceIDVar)
 
-	mappingRuleRoot        = fmt.Sprintf("%s/%s", namespacePrefix, mappingRulePrefix)
-	mappingRuleWithIDPath  = fmt.Sprintf("%s/{%s}", mappingRuleRoot, ruleIDVar)
-	mappingRuleHistoryPath = fmt.Sprintf("%s/history", mappingRuleWithIDPath)
+	mappingRuleRoot         = fmt.Sprintf("%s/%s", namespacePrefix, mappingRulePrefix)
+	mappingRuleWithIDPath   = fmt.Sprintf("%s/{%s}", mappingRuleRoot, ruleIDVar)
+	mappingRuleHistoryPath  = fmt.Sprintf("%s/history", mappingRuleWithIDPath)
+	mappingRuleRollbackPath = fmt.Sprintf("%s/rollback", mappingRuleWithIDPath)
 
-	rollupRuleRoot        = fmt.Sprintf("%s/%s", namespacePrefix, rollupRulePrefix)
-	rollupRuleWithIDPath  = fmt.Sprintf("%s/{%s}", rollupRuleRoot, ruleIDVar)
-	rollupRuleHistoryPath = fmt.Sprintf("%s/history", rollupRuleWithIDPath)
+	rollupRuleRoot         = fmt.Sprintf("%s/%s", namespacePrefix, rollupRulePrefix)
+	rollupRuleWithIDPath   = fmt.Sprintf("%s/{%s}", rollupRuleRoot, ruleIDVar)
+	rollupRuleHistoryPath  = fmt.Sprintf("%s/history", rollupRuleWithIDPath)
+	rollupRuleRollbackPath = fmt.Sprintf("%s/rollback", rollupRuleWithIDPath)
 
 	errNilRequest = errors.New("Nil request")
 )
@@ -78,6 +83,10 @@ type serviceMetrics struct {
 	deleteRollupRule        instrument.MethodMetrics
 	fetchRollupRuleHistory  instrument.MethodMetrics
 	updateRuleSet           instrument.MethodMetrics
+	evaluateRuleSet         instrument.MethodMetrics
+	rollbackRuleSet         instrument.MethodMetrics
+	rollbackMappingRule     instrument.MethodMetrics
+	rollbackRollupRule      instrument.MethodMetrics
 }
 
 func newServiceMetrics(scope tally.Scope, samplingRate float64) serviceMetrics {
@@ -98,12 +107,18 @@ func newServiceMetrics(scope tally.Scope, samplingRate float64) serviceMetrics {
 		deleteRollupRule:        instrument.NewMethodMetrics(scope, "deleteRollupRule", samplingRate),
 		fetchRollupRuleHistory:  instrument.NewMethodMetrics(scope, "fetchRollupRuleHistory", samplingRate),
 		updateRuleSet:           instrument.NewMethodMetrics(scope, "updateRuleSet", samplingRate),
+		evaluateRuleSet:         instrument.NewMethodMetrics(scope, "evaluateRuleSet", samplingRate),
+		rollbackRuleSet:         instrument.NewMethodMetrics(scope, "rollbackRuleSet", samplingRate),
+		rollbackMappingRule:     instrument.NewMethodMetrics(scope, "rollbackMappingRule", samplingRate),
+		rollbackRollupRule:      instrument.NewMethodMetrics(scope, "rollbackRollupRule", samplingRate),
 	}
 }
 
 var authorizationRegistry = map[route]auth.AuthorizationType{
 	// This validation route should only require read access.
 	{path: validateRuleSetPath, method: http.MethodPost}: auth.ReadOnlyAuthorization,
+	// Evaluating a ruleset does not modify it so should only require read access.
+	{path: evaluateRuleSetPath, method: http.MethodPost}: auth.ReadOnlyAuthorization,
 }
 
 func defaultAuthorizationTypeForHTTPMethod(method string) (auth.AuthorizationType, error) {
@@ -174,6 +189,8 @@ func (s *service) RegisterHandlers(router *mux.Router) error {
 		{route: route{path: namespacePrefix, method: http.MethodDelete}, handler: s.deleteNamespace},
 		{route: route{path: validateRuleSetPath, method: http.MethodPost}, handler: s.validateRuleSet},
 		{route: route{path: updateRuleSetPath, method: http.MethodPost}, handler: s.updateRuleSet},
+		{route: route{path: evaluateRuleSetPath, method: http.MethodPost}, handler: s.evaluateRuleSet},
+		{route: route{path: rollbackRuleSetPath, method: http.MethodPost}, handler: s.rollbackRuleSet},
 
 		// Mapping Rule actions.
 		{route: route{path: mappingRuleRoot, method: http.MethodPost}, handler: s.createMappingRule},
@@ -184,6 +201,7 @@ func (s *service) RegisterHandlers(router *mux.Router) error {
 
 		// Mapping Rule history.
 		{route: route{path: mappingRuleHistoryPath, method: http.MethodGet}, handler: s.fetchMappingRuleHistory},
+		{route: route{path: mappingRuleRollbackPath, method: http.MethodPost}, handler: s.rollbackMappingRule},
 
 		// Rollup Rule actions.
 		{route: route{path: rollupRuleRoot, method: http.MethodPost}, handler: s.createRollupRule},
@@ -194,6 +212,7 @@ func (s *service) RegisterHandlers(router *mux.Router) error {
 
 		// Rollup Rule history.
 		{route: route{path: rollupRuleHistoryPath, method: http.MethodGet}, handler: s.fetchRollupRuleHistory},
+		{route: route{path: rollupRuleRollbackPath, method: http.MethodPost}, handler: s.rollbackRollupRule},
 	}
 
 	h := r2Handler{s.logger, s.authService}
@@ -284,6 +303,22 @@ func (s *service) updateRuleSet(w http.ResponseWriter, r *http.Request) error {
 	return s.sendResponse(w, http.StatusOK, data)
 }
 
+func (s *service) evaluateRuleSet(w http.ResponseWriter, r *http.Request) error {
+	data, err := s.handleRoute(evaluateRuleSet, r, s.metrics.evaluateRuleSet)
+	if err != nil {
+		return err
+	}
+	return s.sendResponse(w, http.StatusOK, data)
+}
+
+func (s *service) rollbackRuleSet(w http.ResponseWriter, r *http.Request) error {
+	data, err := s.handleRoute(rollbackRuleSet, r, s.metrics.rollbackRuleSet)
+	if err != nil {
+		return err
+	}
+	return s.sendResponse(w, http.StatusOK, data)
+}
+
 func (s *service) deleteNamespace(w http.ResponseWriter, r *http.Request) error {
 	data, err := s.handleRoute(deleteNamespace, r, s.metrics.deleteNamespace)
 	if err != nil {
@@ -332,6 +367,14 @@ func (s *service) fetchMappingRuleHistory(w http.ResponseWriter, r *http.Request
 	return s.sendResponse(w, http.StatusOK, data)
 }
 
+func (s *service) rollbackMappingRule(w http.ResponseWriter, r *http.Request) error {
+	data, err := s.handleRoute(rollbackMappingRule, r, s.metrics.rollbackMappingRule)
+	if err != nil {
+		return err
+	}
+	return s.sendResponse(w, http.StatusOK, data)
+}
+
 func (s *service) fetchRollupRule(w http.ResponseWriter, r *http.Request) error {
 	data, err := s.handleRoute(fetchRollupRule, r, s.metrics.fetchRollupRule)
 	if err != nil {
@@ -372,6 +415,14 @@ func (s *service) fetchRollupRuleHistory(w http.ResponseWriter, r *http.Request)
 	return s.sendResponse(w, http.StatusOK, data)
 }
 
+func (s *service) rollbackRollupRule(w http.ResponseWriter, r *http.Request) error {
+	data, err := s.handleRoute(rollbackRollupRule, r, s.metrics.rollbackRollupRule)
+	if err != nil {
+		return err
+	}
+	return s.sendResponse(w, http.StatusOK, data)
+}
+
 type route struct {
 	path   string
 	method string
@@ -0,0 +1,86 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE
+
+package store
+
+import (
+	"github.com/m3db/m3/src/metrics/aggregation"
+	"github.com/m3db/m3/src/metrics/policy"
+	"github.com/m3db/m3/src/metrics/rules/view"
+)
+
+// EvaluateRequest describes a metric to match against a ruleset and the
+// time range to match it in.
+type EvaluateRequest struct {
+	// MetricID is the metric ID to match, if empty the ID is generated from
+	// the name and tags.
+	MetricID []byte
+
+	// Name is the metric name used to generate the metric ID.
+	Name []byte
+
+	// Tags are the metric tags used to generate the metric ID.
+	Tags map[string]string
+
+	// FromNanos is the start of the match time range (inclusive).
+	FromNanos int64
+
+	// ToNanos is the end of the match time range (exclusive).
+	ToNanos int64
+
+	// RuleSet is a proposed ruleset to match against instead of the
+	// current ruleset of the namespace, if set.
+	RuleSet *view.RuleSet
+}
+
+// RuleSetEvaluation is the result of matching a metric against a ruleset.
+type RuleSetEvaluation struct {
+	Namespace       string               `json:"namespace"`
+	Version         int                  `json:"version"`
+	MetricID        string               `json:"metricID"`
+	FromMillis      int64                `json:"fromMillis"`
+	ToMillis        int64                `json:"toMillis"`
+	MappingRules    []view.MappingRule   `json:"mappingRules"`
+	RollupRules     []view.RollupRule    `json:"rollupRules"`
+	ForExistingID   []StagedEvaluation   `json:"forExistingID"`
+	ForNewRollupIDs []RollupIDEvaluation `json:"forNewRollupIDs"`
+}
+
+// RollupIDEvaluation is a new rollup metric ID generated by rule matching
+// alongside the metadatas applied to it.
+type RollupIDEvaluation struct {
+	ID        string             `json:"id"`
+	Metadatas []StagedEvaluation `json:"metadatas"`
+}
+
+// StagedEvaluation is the set of pipelines in effect starting at a cutover time.
+type StagedEvaluation struct {
+	CutoverMillis int64                `json:"cutoverMillis"`
+	Tombstoned    bool                 `json:"tombstoned"`
+	Pipelines     []PipelineEvaluation `json:"pipelines"`
+}
+
+// PipelineEvaluation is a single pipeline of a match result.
+type PipelineEvaluation struct {
+	AggregationID   aggregation.ID         `json:"aggregation"`
+	StoragePolicies policy.StoragePolicies `json:"storagePolicies"`
+	Pipeline        string                 `json:"pipeline,omitempty"`
+	DropPolicy      string                 `json:"dropPolicy,omitempty"`
+}
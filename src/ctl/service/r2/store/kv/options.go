@@ -23,6 +23,9 @@ package kv
 import (
 	"time"
 
+	"github.com/m3db/m3/src/metrics/filters"
+	"github.com/m3db/m3/src/metrics/metric/id"
+	"github.com/m3db/m3/src/metrics/metric/id/m3"
 	"github.com/m3db/m3/src/metrics/rules"
 	"github.com/m3db/m3x/clock"
 	"github.com/m3db/m3x/instrument"
@@ -30,6 +33,7 @@ import (
 
 const (
 	defaultRuleUpdatePropagationDelay = time.Minute
+	defaultNameTagKey                 = "name"
 )
 
 // StoreOptions is a set of options for a kv backed store.
@@ -57,6 +61,18 @@ type StoreOptions interface {
 
 	// ValidatprOptions returns the validator for the store.
 	Validator() rules.Validator
+
+	// SetRuleSetOptions sets the ruleset options used for matching metrics against rules.
+	SetRuleSetOptions(value rules.Options) StoreOptions
+
+	// RuleSetOptions returns the ruleset options used for matching metrics against rules.
+	RuleSetOptions() rules.Options
+
+	// SetNewMetricIDFn sets the function that generates a metric id from a name and tags.
+	SetNewMetricIDFn(value id.NewIDFn) StoreOptions
+
+	// NewMetricIDFn returns the function that generates a metric id from a name and tags.
+	NewMetricIDFn() id.NewIDFn
 }
 
 type storeOptions struct {
@@ -64,6 +80,8 @@ type storeOptions struct {
 	instrumentOpts             instrument.Options
 	ruleUpdatePropagationDelay time.Duration
 	validator                  rules.Validator
+	ruleSetOpts                rules.Options
+	newMetricIDFn              id.NewIDFn
 }
 
 // NewStoreOptions creates a new set of store options.
@@ -72,6 +90,8 @@ func NewStoreOptions() StoreOptions {
 		clockOpts:                  clock.NewOptions(),
 		instrumentOpts:             instrument.NewOptions(),
 		ruleUpdatePropagationDelay: defaultRuleUpdatePropagationDelay,
+		ruleSetOpts:                defaultRuleSetOptions(),
+		newMetricIDFn:              m3.NewMetricID,
 	}
 }
 
@@ -114,3 +134,38 @@ func (o *storeOptions) SetValidator(value rules.Validator) StoreOptions {
 func (o *storeOptions) Validator() rules.Validator {
 	return o.validator
 }
+
+func (o *storeOptions) SetRuleSetOptions(value rules.Options) StoreOptions {
+	opts := *o
+	opts.ruleSetOpts = value
+	return &opts
+}
+
+func (o *storeOptions) RuleSetOptions() rules.Options {
+	return o.ruleSetOpts
+}
+
+func (o *storeOptions) SetNewMetricIDFn(value id.NewIDFn) StoreOptions {
+	opts := *o
+	opts.newMetricIDFn = value
+	return &opts
+}
+
+func (o *storeOptions) NewMetricIDFn() id.NewIDFn {
+	return o.newMetricIDFn
+}
+
+func defaultRuleSetOptions() rules.Options {
+	tagsFilterOpts := filters.TagsFilterOptions{
+		NameTagKey:          []byte(defaultNameTagKey),
+		NameAndTagsFn:       m3.NameAndTags,
+		SortedTagIteratorFn: m3.NewSortedTagIterator,
+	}
+	isRollupIDFn := func(name []byte, tags []byte) bool {
+		return m3.IsRollupID(name, tags, nil)
+	}
+	return rules.NewOptions().
+		SetTagsFilterOptions(tagsFilterOpts).
+		SetNewRollupIDFn(m3.NewRollupID).
+		SetIsRollupIDFn(isRollupIDFn)
+}
@@ -23,10 +23,17 @@ package kv
 import (
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"time"
 
 	"github.com/m3db/m3/src/ctl/service/r2"
 	r2store "github.com/m3db/m3/src/ctl/service/r2/store"
 	merrors "github.com/m3db/m3/src/metrics/errors"
+	"github.com/m3db/m3/src/metrics/filters"
+	"github.com/m3db/m3/src/metrics/metadata"
+	"github.com/m3db/m3/src/metrics/metric/id"
+	"github.com/m3db/m3/src/metrics/policy"
 	"github.com/m3db/m3/src/metrics/rules"
 	"github.com/m3db/m3/src/metrics/rules/view"
 	"github.com/m3db/m3/src/metrics/rules/view/changes"
@@ -41,6 +48,10 @@ type store struct {
 	updateHelper rules.RuleSetUpdateHelper
 }
 
+const (
+	nanosPerMilli = int64(time.Millisecond / time.Nanosecond)
+)
+
 var errNilValidator = errors.New("no validator set on StoreOptions so validation is not applicable")
 
 // NewStore returns a new service that knows how to talk to a kv backed r2 store.
@@ -123,6 +134,51 @@ func (s *store) UpdateRuleSet(
 	return s.FetchRuleSetSnapshot(rsChanges.Namespace)
 }
 
+func (s *store) RollbackRuleSet(
+	namespaceID string,
+	version int,
+	uOpts r2store.UpdateOptions,
+) (view.RuleSet, error) {
+	rs, err := s.ruleStore.ReadRuleSet(namespaceID)
+	if err != nil {
+		return view.RuleSet{}, handleUpstreamError(err)
+	}
+	if version <= 0 || version >= rs.Version() {
+		return view.RuleSet{}, r2.NewBadInputError(fmt.Sprintf(
+			"invalid ruleset version %d: must be between 1 and %d",
+			version,
+			rs.Version()-1,
+		))
+	}
+	target, err := s.ruleStore.ReadRuleSetVersion(namespaceID, version)
+	if err != nil {
+		return view.RuleSet{}, handleUpstreamError(err)
+	}
+
+	rsChanges, err := newRollbackRuleSetChanges(rs, target)
+	if err != nil {
+		return view.RuleSet{}, handleUpstreamError(err)
+	}
+	if len(rsChanges.MappingRuleChanges) == 0 && len(rsChanges.RollupRuleChanges) == 0 {
+		return view.RuleSet{}, r2.NewBadInputError(fmt.Sprintf(
+			"ruleset version %d has the same rules as the current ruleset",
+			version,
+		))
+	}
+
+	mutable := rs.ToMutableRuleSet().Clone()
+	err = mutable.ApplyRuleSetChanges(rsChanges, s.newUpdateMeta(uOpts))
+	if err != nil {
+		return view.RuleSet{}, handleUpstreamError(err)
+	}
+	err = s.ruleStore.WriteRuleSet(mutable)
+	if err != nil {
+		return view.RuleSet{}, handleUpstreamError(err)
+	}
+
+	return s.FetchRuleSetSnapshot(namespaceID)
+}
+
 func (s *store) CreateNamespace(
 	namespaceID string,
 	uOpts r2store.UpdateOptions,
@@ -211,6 +267,90 @@ func (s *store) FetchRuleSetSnapshot(namespaceID string) (view.RuleSet, error) {
 	return rs.Latest()
 }
 
+func (s *store) EvaluateRuleSet(
+	namespaceID string,
+	req r2store.EvaluateRequest,
+) (r2store.RuleSetEvaluation, error) {
+	if req.FromNanos >= req.ToNanos {
+		return r2store.RuleSetEvaluation{}, r2.NewBadInputError(fmt.Sprintf(
+			"invalid time range: from=%d must be before to=%d",
+			req.FromNanos,
+			req.ToNanos,
+		))
+	}
+	metricID, err := s.newMetricID(req)
+	if err != nil {
+		return r2store.RuleSetEvaluation{}, err
+	}
+	tagsFilterOpts := s.opts.RuleSetOptions().TagsFilterOptions()
+	if _, _, err := tagsFilterOpts.NameAndTagsFn(metricID); err != nil {
+		return r2store.RuleSetEvaluation{}, r2.NewBadInputError(
+			fmt.Sprintf("invalid metric ID %s: %v", metricID, err),
+		)
+	}
+
+	var rs rules.RuleSet
+	if req.RuleSet != nil {
+		rs, err = s.newProposedRuleSet(*req.RuleSet)
+	} else {
+		rs, err = s.readMatchableRuleSet(namespaceID)
+	}
+	if err != nil {
+		return r2store.RuleSetEvaluation{}, err
+	}
+
+	mappingRules, err := rs.MappingRules()
+	if err != nil {
+		return r2store.RuleSetEvaluation{}, handleUpstreamError(err)
+	}
+	matchedMappingRules, err := matchMappingRules(
+		mappingRules,
+		metricID,
+		req.FromNanos,
+		req.ToNanos,
+		tagsFilterOpts,
+	)
+	if err != nil {
+		return r2store.RuleSetEvaluation{}, err
+	}
+	rollupRules, err := rs.RollupRules()
+	if err != nil {
+		return r2store.RuleSetEvaluation{}, handleUpstreamError(err)
+	}
+	matchedRollupRules, err := matchRollupRules(
+		rollupRules,
+		metricID,
+		req.FromNanos,
+		req.ToNanos,
+		tagsFilterOpts,
+	)
+	if err != nil {
+		return r2store.RuleSetEvaluation{}, err
+	}
+
+	res := rs.ActiveSet(req.FromNanos).ForwardMatch(metricID, req.FromNanos, req.ToNanos)
+	forNewRollupIDs := make([]r2store.RollupIDEvaluation, 0, res.NumNewRollupIDs())
+	for i := 0; i < res.NumNewRollupIDs(); i++ {
+		idWithMetadatas := res.ForNewRollupIDsAt(i, req.FromNanos)
+		forNewRollupIDs = append(forNewRollupIDs, r2store.RollupIDEvaluation{
+			ID:        string(idWithMetadatas.ID),
+			Metadatas: newStagedEvaluations(idWithMetadatas.Metadatas),
+		})
+	}
+
+	return r2store.RuleSetEvaluation{
+		Namespace:       string(rs.Namespace()),
+		Version:         rs.Version(),
+		MetricID:        string(metricID),
+		FromMillis:      req.FromNanos / nanosPerMilli,
+		ToMillis:        req.ToNanos / nanosPerMilli,
+		MappingRules:    matchedMappingRules,
+		RollupRules:     matchedRollupRules,
+		ForExistingID:   newStagedEvaluations(res.ForExistingIDAt(req.FromNanos)),
+		ForNewRollupIDs: forNewRollupIDs,
+	}, nil
+}
+
 func (s *store) FetchMappingRule(
 	namespaceID string,
 	mappingRuleID string,
@@ -325,6 +465,59 @@ func (s *store) FetchMappingRuleHistory(
 	return nil, mappingRuleNotFoundError(namespaceID, mappingRuleID)
 }
 
+func (s *store) RollbackMappingRule(
+	namespaceID string,
+	mappingRuleID string,
+	version int,
+	uOpts r2store.UpdateOptions,
+) (view.MappingRule, error) {
+	rs, err := s.ruleStore.ReadRuleSet(namespaceID)
+	if err != nil {
+		return view.MappingRule{}, handleUpstreamError(err)
+	}
+
+	mrs, err := rs.MappingRules()
+	if err != nil {
+		return view.MappingRule{}, handleUpstreamError(err)
+	}
+	history, exists := mrs[mappingRuleID]
+	if !exists {
+		return view.MappingRule{}, mappingRuleNotFoundError(namespaceID, mappingRuleID)
+	}
+	if version <= 0 || version > len(history) {
+		return view.MappingRule{}, r2.NewBadInputError(fmt.Sprintf(
+			"invalid version %d for mapping rule %s: must be between 1 and %d",
+			version,
+			mappingRuleID,
+			len(history),
+		))
+	}
+
+	// History is ordered from the newest snapshot to the oldest.
+	snapshot := history[len(history)-version]
+	mutable := rs.ToMutableRuleSet().Clone()
+	meta := s.newUpdateMeta(uOpts)
+	if snapshot.Tombstoned {
+		err = mutable.DeleteMappingRule(mappingRuleID, meta)
+	} else {
+		err = mutable.UpdateMappingRule(snapshot, meta)
+	}
+	if err != nil {
+		return view.MappingRule{}, handleUpstreamError(err)
+	}
+
+	err = s.ruleStore.WriteRuleSet(mutable)
+	if err != nil {
+		return view.MappingRule{}, handleUpstreamError(err)
+	}
+
+	snapshots, err := s.FetchMappingRuleHistory(namespaceID, mappingRuleID)
+	if err != nil {
+		return view.MappingRule{}, err
+	}
+	return snapshots[0], nil
+}
+
 func (s *store) FetchRollupRule(
 	namespaceID string,
 	rollupRuleID string,
@@ -439,12 +632,308 @@ func (s *store) FetchRollupRuleHistory(
 	return nil, rollupRuleNotFoundError(namespaceID, rollupRuleID)
 }
 
+func (s *store) RollbackRollupRule(
+	namespaceID string,
+	rollupRuleID string,
+	version int,
+	uOpts r2store.UpdateOptions,
+) (view.RollupRule, error) {
+	rs, err := s.ruleStore.ReadRuleSet(namespaceID)
+	if err != nil {
+		return view.RollupRule{}, handleUpstreamError(err)
+	}
+
+	rrs, err := rs.RollupRules()
+	if err != nil {
+		return view.RollupRule{}, handleUpstreamError(err)
+	}
+	history, exists := rrs[rollupRuleID]
+	if !exists {
+		return view.RollupRule{}, rollupRuleNotFoundError(namespaceID, rollupRuleID)
+	}
+	if version <= 0 || version > len(history) {
+		return view.RollupRule{}, r2.NewBadInputError(fmt.Sprintf(
+			"invalid version %d for rollup rule %s: must be between 1 and %d",
+			version,
+			rollupRuleID,
+			len(history),
+		))
+	}
+
+	// History is ordered from the newest snapshot to the oldest.
+	snapshot := history[len(history)-version]
+	mutable := rs.ToMutableRuleSet().Clone()
+	meta := s.newUpdateMeta(uOpts)
+	if snapshot.Tombstoned {
+		err = mutable.DeleteRollupRule(rollupRuleID, meta)
+	} else {
+		err = mutable.UpdateRollupRule(snapshot, meta)
+	}
+	if err != nil {
+		return view.RollupRule{}, handleUpstreamError(err)
+	}
+
+	err = s.ruleStore.WriteRuleSet(mutable)
+	if err != nil {
+		return view.RollupRule{}, handleUpstreamError(err)
+	}
+
+	snapshots, err := s.FetchRollupRuleHistory(namespaceID, rollupRuleID)
+	if err != nil {
+		return view.RollupRule{}, err
+	}
+	return snapshots[0], nil
+}
+
 func (s *store) Close() { s.ruleStore.Close() }
 
 func (s *store) newUpdateMeta(uOpts r2store.UpdateOptions) rules.UpdateMetadata {
 	return s.updateHelper.NewUpdateMetadata(s.nowFn().UnixNano(), uOpts.Author())
 }
 
+func (s *store) newMetricID(req r2store.EvaluateRequest) ([]byte, error) {
+	if len(req.MetricID) > 0 {
+		return req.MetricID, nil
+	}
+	if len(req.Name) == 0 {
+		return nil, r2.NewBadInputError("either a metric ID or a metric name is required")
+	}
+	tagPairs := make([]id.TagPair, 0, len(req.Tags))
+	for name, value := range req.Tags {
+		tagPairs = append(tagPairs, id.TagPair{Name: []byte(name), Value: []byte(value)})
+	}
+	return s.opts.NewMetricIDFn()(req.Name, tagPairs), nil
+}
+
+// readMatchableRuleSet reads the current ruleset for the given namespace and
+// rebuilds it with the ruleset options so its filters can match metric IDs.
+func (s *store) readMatchableRuleSet(namespaceID string) (rules.RuleSet, error) {
+	rs, err := s.ruleStore.ReadRuleSet(namespaceID)
+	if err != nil {
+		return nil, handleUpstreamError(err)
+	}
+	return s.newMatchableRuleSet(rs.Version(), rs)
+}
+
+// newProposedRuleSet builds a ruleset from a proposed ruleset snapshot, with
+// every rule in the snapshot in effect for all time.
+func (s *store) newProposedRuleSet(rsv view.RuleSet) (rules.RuleSet, error) {
+	if validator := s.opts.Validator(); validator != nil {
+		if err := validator.ValidateSnapshot(rsv); err != nil {
+			return nil, handleUpstreamError(err)
+		}
+	}
+
+	meta := rules.NewRuleSetUpdateHelper(0).NewUpdateMetadata(0, "")
+	mutable := rules.NewEmptyRuleSet(rsv.Namespace, meta)
+	for _, mr := range rsv.MappingRules {
+		if mr.Tombstoned {
+			continue
+		}
+		if _, err := mutable.AddMappingRule(mr, meta); err != nil {
+			return nil, r2.NewBadInputError(err.Error())
+		}
+	}
+	for _, rr := range rsv.RollupRules {
+		if rr.Tombstoned {
+			continue
+		}
+		if _, err := mutable.AddRollupRule(rr, meta); err != nil {
+			return nil, r2.NewBadInputError(err.Error())
+		}
+	}
+	return s.newMatchableRuleSet(rsv.Version, mutable)
+}
+
+func (s *store) newMatchableRuleSet(version int, rs rules.RuleSet) (rules.RuleSet, error) {
+	pb, err := rs.Proto()
+	if err != nil {
+		return nil, handleUpstreamError(err)
+	}
+	matchable, err := rules.NewRuleSetFromProto(version, pb, s.opts.RuleSetOptions())
+	if err != nil {
+		return nil, r2.NewBadInputError(err.Error())
+	}
+	return matchable, nil
+}
+
+// matchMappingRules returns the mapping rule snapshots in effect within
+// [fromNanos, toNanos) whose filters match the metric ID.
+func matchMappingRules(
+	mappingRules view.MappingRules,
+	metricID []byte,
+	fromNanos, toNanos int64,
+	opts filters.TagsFilterOptions,
+) ([]view.MappingRule, error) {
+	var matched []view.MappingRule
+	for _, history := range mappingRules {
+		// History is ordered from the newest snapshot to the oldest.
+		endNanos := int64(math.MaxInt64)
+		for _, snapshot := range history {
+			startNanos := snapshot.CutoverMillis * nanosPerMilli
+			active := startNanos < toNanos && endNanos > fromNanos
+			endNanos = startNanos
+			if !active || snapshot.Tombstoned {
+				continue
+			}
+			ok, err := filterMatches(snapshot.Filter, metricID, opts)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				matched = append(matched, snapshot)
+			}
+		}
+	}
+	sort.Sort(view.MappingRulesByNameAsc(matched))
+	return matched, nil
+}
+
+// matchRollupRules returns the rollup rule snapshots in effect within
+// [fromNanos, toNanos) whose filters match the metric ID.
+func matchRollupRules(
+	rollupRules view.RollupRules,
+	metricID []byte,
+	fromNanos, toNanos int64,
+	opts filters.TagsFilterOptions,
+) ([]view.RollupRule, error) {
+	var matched []view.RollupRule
+	for _, history := range rollupRules {
+		// History is ordered from the newest snapshot to the oldest.
+		endNanos := int64(math.MaxInt64)
+		for _, snapshot := range history {
+			startNanos := snapshot.CutoverMillis * nanosPerMilli
+			active := startNanos < toNanos && endNanos > fromNanos
+			endNanos = startNanos
+			if !active || snapshot.Tombstoned {
+				continue
+			}
+			ok, err := filterMatches(snapshot.Filter, metricID, opts)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				matched = append(matched, snapshot)
+			}
+		}
+	}
+	sort.Sort(view.RollupRulesByNameAsc(matched))
+	return matched, nil
+}
+
+func filterMatches(filter string, metricID []byte, opts filters.TagsFilterOptions) (bool, error) {
+	filterValues, err := filters.ParseTagFilterValueMap(filter)
+	if err != nil {
+		return false, r2.NewBadInputError(err.Error())
+	}
+	f, err := filters.NewTagsFilter(filterValues, filters.Conjunction, opts)
+	if err != nil {
+		return false, r2.NewBadInputError(err.Error())
+	}
+	return f.Matches(metricID), nil
+}
+
+func newStagedEvaluations(metadatas metadata.StagedMetadatas) []r2store.StagedEvaluation {
+	res := make([]r2store.StagedEvaluation, 0, len(metadatas))
+	for _, sm := range metadatas {
+		pipelines := make([]r2store.PipelineEvaluation, 0, len(sm.Pipelines))
+		for _, pm := range sm.Pipelines {
+			pe := r2store.PipelineEvaluation{
+				AggregationID:   pm.AggregationID,
+				StoragePolicies: pm.StoragePolicies,
+			}
+			if !pm.Pipeline.IsEmpty() {
+				pe.Pipeline = pm.Pipeline.String()
+			}
+			if pm.DropPolicy != policy.DropNone {
+				pe.DropPolicy = pm.DropPolicy.String()
+			}
+			pipelines = append(pipelines, pe)
+		}
+		res = append(res, r2store.StagedEvaluation{
+			CutoverMillis: sm.CutoverNanos / nanosPerMilli,
+			Tombstoned:    sm.Tombstoned,
+			Pipelines:     pipelines,
+		})
+	}
+	return res
+}
+
+// newRollbackRuleSetChanges returns the changes that restore the rules of the
+// current ruleset to their latest state in the target ruleset. Rules that are
+// not active in the target ruleset are deleted.
+func newRollbackRuleSetChanges(current, target rules.RuleSet) (changes.RuleSetChanges, error) {
+	currentLatest, err := current.Latest()
+	if err != nil {
+		return changes.RuleSetChanges{}, err
+	}
+	targetLatest, err := target.Latest()
+	if err != nil {
+		return changes.RuleSetChanges{}, err
+	}
+
+	rsChanges := changes.RuleSetChanges{Namespace: string(current.Namespace())}
+	currentMappingRules := make(map[string]view.MappingRule, len(currentLatest.MappingRules))
+	for _, mr := range currentLatest.MappingRules {
+		currentMappingRules[mr.ID] = mr
+	}
+	for _, mr := range targetLatest.MappingRules {
+		mr := mr
+		if curr, exists := currentMappingRules[mr.ID]; exists {
+			delete(currentMappingRules, mr.ID)
+			if curr.Equal(&mr) {
+				continue
+			}
+		}
+		rsChanges.MappingRuleChanges = append(rsChanges.MappingRuleChanges, changes.MappingRuleChange{
+			Op:       changes.ChangeOp,
+			RuleID:   &mr.ID,
+			RuleData: &mr,
+		})
+	}
+	for _, mr := range currentLatest.MappingRules {
+		if _, exists := currentMappingRules[mr.ID]; !exists {
+			continue
+		}
+		id := mr.ID
+		rsChanges.MappingRuleChanges = append(rsChanges.MappingRuleChanges, changes.MappingRuleChange{
+			Op:     changes.DeleteOp,
+			RuleID: &id,
+		})
+	}
+
+	currentRollupRules := make(map[string]view.RollupRule, len(currentLatest.RollupRules))
+	for _, rr := range currentLatest.RollupRules {
+		currentRollupRules[rr.ID] = rr
+	}
+	for _, rr := range targetLatest.RollupRules {
+		rr := rr
+		if curr, exists := currentRollupRules[rr.ID]; exists {
+			delete(currentRollupRules, rr.ID)
+			if curr.Equal(&rr) {
+				continue
+			}
+		}
+		rsChanges.RollupRuleChanges = append(rsChanges.RollupRuleChanges, changes.RollupRuleChange{
+			Op:       changes.ChangeOp,
+			RuleID:   &rr.ID,
+			RuleData: &rr,
+		})
+	}
+	for _, rr := range currentLatest.RollupRules {
+		if _, exists := currentRollupRules[rr.ID]; !exists {
+			continue
+		}
+		id := rr.ID
+		rsChanges.RollupRuleChanges = append(rsChanges.RollupRuleChanges, changes.RollupRuleChange{
+			Op:     changes.DeleteOp,
+			RuleID: &id,
+		})
+	}
+
+	return rsChanges, nil
+}
+
 func mappingRuleNotFoundError(namespaceID, mappingRuleID string) error {
 	return r2.NewNotFoundError(
 		fmt.Sprintf("mapping rule: %s doesn't exist in Namespace: %s",
@@ -250,6 +250,325 @@ func TestUpdateRuleSetWriteFailure(t *testing.T) {
 	require.IsType(t, r2.NewConflictError(""), err)
 }
 
+func TestEvaluateRuleSet(t *testing.T) {
+	helper := rules.NewRuleSetUpdateHelper(time.Minute)
+	rs, err := testEvaluateRuleSet(3, helper.NewUpdateMetadata(0, "validUser"))
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockedStore := rules.NewMockStore(ctrl)
+	mockedStore.EXPECT().ReadRuleSet("testNamespace").Return(rs, nil)
+
+	rulesStore := NewStore(mockedStore, NewStoreOptions())
+	res, err := rulesStore.EvaluateRuleSet("testNamespace", r2store.EvaluateRequest{
+		Name:      []byte("requests"),
+		Tags:      map[string]string{"app": "foo", "host": "h1"},
+		FromNanos: int64(time.Hour),
+		ToNanos:   int64(2 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "testNamespace", res.Namespace)
+	require.Equal(t, 3, res.Version)
+	require.Equal(t, "m3+requests+app=foo,host=h1", res.MetricID)
+	require.Equal(t, int64(time.Hour/time.Millisecond), res.FromMillis)
+	require.Equal(t, int64(2*time.Hour/time.Millisecond), res.ToMillis)
+	require.Len(t, res.MappingRules, 1)
+	require.Equal(t, "fooMappingRule", res.MappingRules[0].Name)
+	require.Len(t, res.RollupRules, 1)
+	require.Equal(t, "fooRollupRule", res.RollupRules[0].Name)
+
+	require.Len(t, res.ForExistingID, 1)
+	require.Equal(t, []r2store.PipelineEvaluation{
+		{
+			AggregationID: aggregation.DefaultID,
+			StoragePolicies: policy.StoragePolicies{
+				policy.MustParseStoragePolicy("1m:10d"),
+			},
+		},
+	}, res.ForExistingID[0].Pipelines)
+
+	require.Len(t, res.ForNewRollupIDs, 1)
+	require.Equal(t, "m3+rolled+app=foo,m3_rollup=true", res.ForNewRollupIDs[0].ID)
+	require.Len(t, res.ForNewRollupIDs[0].Metadatas, 1)
+	require.Equal(t, []r2store.PipelineEvaluation{
+		{
+			AggregationID: aggregation.MustCompressTypes(aggregation.Sum),
+			StoragePolicies: policy.StoragePolicies{
+				policy.MustParseStoragePolicy("1m:30d"),
+			},
+		},
+	}, res.ForNewRollupIDs[0].Metadatas[0].Pipelines)
+}
+
+func TestEvaluateRuleSetProposedRuleSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockedStore := rules.NewMockStore(ctrl)
+
+	rulesStore := NewStore(mockedStore, NewStoreOptions())
+	res, err := rulesStore.EvaluateRuleSet("testNamespace", r2store.EvaluateRequest{
+		MetricID:  []byte("m3+requests+app=bar"),
+		FromNanos: int64(time.Hour),
+		ToNanos:   int64(2 * time.Hour),
+		RuleSet: &view.RuleSet{
+			Namespace: "testNamespace",
+			Version:   5,
+			MappingRules: []view.MappingRule{
+				{
+					Name:       "dropBar",
+					Filter:     "app:bar",
+					DropPolicy: policy.DropMust,
+				},
+				{
+					Name:       "tombstoned",
+					Filter:     "app:bar",
+					Tombstoned: true,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 5, res.Version)
+	require.Equal(t, "m3+requests+app=bar", res.MetricID)
+	require.Len(t, res.MappingRules, 1)
+	require.Equal(t, "dropBar", res.MappingRules[0].Name)
+	require.Empty(t, res.RollupRules)
+	require.Empty(t, res.ForNewRollupIDs)
+	require.Len(t, res.ForExistingID, 1)
+	require.Len(t, res.ForExistingID[0].Pipelines, 1)
+	require.Equal(t, policy.DropMust.String(), res.ForExistingID[0].Pipelines[0].DropPolicy)
+}
+
+func TestEvaluateRuleSetInvalidRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockedStore := rules.NewMockStore(ctrl)
+	rulesStore := NewStore(mockedStore, NewStoreOptions())
+
+	reqs := []r2store.EvaluateRequest{
+		// Empty time range.
+		{MetricID: []byte("m3+requests+app=bar"), FromNanos: 10, ToNanos: 10},
+		// No metric ID or name.
+		{FromNanos: 10, ToNanos: 20},
+		// Metric ID in an unknown format.
+		{MetricID: []byte("requests"), FromNanos: 10, ToNanos: 20},
+	}
+	for _, req := range reqs {
+		_, err := rulesStore.EvaluateRuleSet("testNamespace", req)
+		require.Error(t, err)
+		require.IsType(t, r2.NewBadInputError(""), err)
+	}
+}
+
+func TestRollbackMappingRule(t *testing.T) {
+	helper := rules.NewRuleSetUpdateHelper(time.Minute)
+	mutable := rules.NewEmptyRuleSet("testNamespace", helper.NewUpdateMetadata(0, "originalUser"))
+	ruleID, err := mutable.AddMappingRule(view.MappingRule{
+		Name:            "mappingRule",
+		Filter:          "app:foo",
+		StoragePolicies: policy.StoragePolicies{policy.MustParseStoragePolicy("1m:10d")},
+	}, helper.NewUpdateMetadata(0, "originalUser"))
+	require.NoError(t, err)
+	require.NoError(t, mutable.UpdateMappingRule(view.MappingRule{
+		ID:              ruleID,
+		Name:            "mappingRule",
+		Filter:          "app:bar",
+		StoragePolicies: policy.StoragePolicies{policy.MustParseStoragePolicy("10s:2d")},
+	}, helper.NewUpdateMetadata(100, "updateUser")))
+	proto, err := mutable.Proto()
+	require.NoError(t, err)
+	initialRuleSet, err := rules.NewRuleSetFromProto(1, proto, rules.NewOptions())
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockedStore := rules.NewMockStore(ctrl)
+	var written rules.RuleSet
+	gomock.InOrder(
+		mockedStore.EXPECT().ReadRuleSet("testNamespace").Return(initialRuleSet, nil),
+		mockedStore.EXPECT().WriteRuleSet(gomock.Any()).Do(func(rs rules.MutableRuleSet) {
+			written = rs
+		}).Return(nil),
+		mockedStore.EXPECT().ReadRuleSet("testNamespace").DoAndReturn(func(string) (rules.RuleSet, error) {
+			return written, nil
+		}),
+	)
+
+	storeOpts := NewStoreOptions().SetClockOptions(
+		clock.NewOptions().SetNowFn(func() time.Time {
+			return time.Unix(0, 200)
+		}),
+	)
+	rulesStore := NewStore(mockedStore, storeOpts)
+	uOpts := r2store.NewUpdateOptions().SetAuthor("rollbackUser")
+	res, err := rulesStore.RollbackMappingRule("testNamespace", ruleID, 1, uOpts)
+	require.NoError(t, err)
+	require.Equal(t, ruleID, res.ID)
+	require.Equal(t, "app:foo", res.Filter)
+	require.Equal(t, policy.StoragePolicies{policy.MustParseStoragePolicy("1m:10d")}, res.StoragePolicies)
+	require.Equal(t, "rollbackUser", res.LastUpdatedBy)
+	require.Equal(t, int64(200+time.Minute)/nanosPerMilli, res.CutoverMillis)
+
+	history, err := written.MappingRules()
+	require.NoError(t, err)
+	require.Len(t, history[ruleID], 3)
+	require.Equal(t, "updateUser", history[ruleID][1].LastUpdatedBy)
+}
+
+func TestRollbackMappingRuleInvalidVersion(t *testing.T) {
+	helper := rules.NewRuleSetUpdateHelper(time.Minute)
+	rs, err := testRuleSet(1, helper.NewUpdateMetadata(100, "validUser"))
+	require.NoError(t, err)
+	mrs, err := rs.MappingRules()
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockedStore := rules.NewMockStore(ctrl)
+	mockedStore.EXPECT().ReadRuleSet("testNamespace").Return(rs, nil).Times(3)
+
+	rulesStore := NewStore(mockedStore, NewStoreOptions())
+	uOpts := r2store.NewUpdateOptions()
+	for ruleID := range mrs {
+		for _, version := range []int{0, 2} {
+			_, err = rulesStore.RollbackMappingRule("testNamespace", ruleID, version, uOpts)
+			require.Error(t, err)
+			require.IsType(t, r2.NewBadInputError(""), err)
+		}
+	}
+	_, err = rulesStore.RollbackMappingRule("testNamespace", "nonexistent", 1, uOpts)
+	require.Error(t, err)
+	require.IsType(t, r2.NewNotFoundError(""), err)
+}
+
+func TestRollbackRollupRuleToTombstonedSnapshot(t *testing.T) {
+	helper := rules.NewRuleSetUpdateHelper(time.Minute)
+	rs, err := testRuleSet(1, helper.NewUpdateMetadata(100, "validUser"))
+	require.NoError(t, err)
+	rrs, err := rs.RollupRules()
+	require.NoError(t, err)
+	var ruleID string
+	for id := range rrs {
+		ruleID = id
+	}
+	mutable := rs.ToMutableRuleSet().Clone()
+	require.NoError(t, mutable.DeleteRollupRule(ruleID, helper.NewUpdateMetadata(200, "validUser")))
+	require.NoError(t, mutable.UpdateRollupRule(rrs[ruleID][0], helper.NewUpdateMetadata(300, "validUser")))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockedStore := rules.NewMockStore(ctrl)
+	var written rules.RuleSet
+	gomock.InOrder(
+		mockedStore.EXPECT().ReadRuleSet("testNamespace").Return(mutable, nil),
+		mockedStore.EXPECT().WriteRuleSet(gomock.Any()).Do(func(rs rules.MutableRuleSet) {
+			written = rs
+		}).Return(nil),
+		mockedStore.EXPECT().ReadRuleSet("testNamespace").DoAndReturn(func(string) (rules.RuleSet, error) {
+			return written, nil
+		}),
+	)
+
+	rulesStore := NewStore(mockedStore, NewStoreOptions())
+	res, err := rulesStore.RollbackRollupRule("testNamespace", ruleID, 2, r2store.NewUpdateOptions())
+	require.NoError(t, err)
+	require.Equal(t, ruleID, res.ID)
+	require.True(t, res.Tombstoned)
+}
+
+func TestRollbackRuleSet(t *testing.T) {
+	helper := rules.NewRuleSetUpdateHelper(time.Minute)
+	mutable := rules.NewEmptyRuleSet("testNamespace", helper.NewUpdateMetadata(0, "originalUser"))
+	ruleID, err := mutable.AddMappingRule(view.MappingRule{
+		Name:            "mappingRule1",
+		Filter:          "app:foo",
+		StoragePolicies: policy.StoragePolicies{policy.MustParseStoragePolicy("1m:10d")},
+	}, helper.NewUpdateMetadata(0, "originalUser"))
+	require.NoError(t, err)
+	proto, err := mutable.Proto()
+	require.NoError(t, err)
+	targetRuleSet, err := rules.NewRuleSetFromProto(1, proto, rules.NewOptions())
+	require.NoError(t, err)
+
+	updateMeta := helper.NewUpdateMetadata(100, "updateUser")
+	require.NoError(t, mutable.UpdateMappingRule(view.MappingRule{
+		ID:              ruleID,
+		Name:            "mappingRule1",
+		Filter:          "app:bar",
+		StoragePolicies: policy.StoragePolicies{policy.MustParseStoragePolicy("10s:2d")},
+	}, updateMeta))
+	_, err = mutable.AddMappingRule(view.MappingRule{
+		Name:            "mappingRule2",
+		Filter:          "app:baz",
+		StoragePolicies: policy.StoragePolicies{policy.MustParseStoragePolicy("1m:10d")},
+	}, updateMeta)
+	require.NoError(t, err)
+	proto, err = mutable.Proto()
+	require.NoError(t, err)
+	currentRuleSet, err := rules.NewRuleSetFromProto(2, proto, rules.NewOptions())
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockedStore := rules.NewMockStore(ctrl)
+	var written rules.RuleSet
+	gomock.InOrder(
+		mockedStore.EXPECT().ReadRuleSet("testNamespace").Return(currentRuleSet, nil),
+		mockedStore.EXPECT().ReadRuleSetVersion("testNamespace", 1).Return(targetRuleSet, nil),
+		mockedStore.EXPECT().WriteRuleSet(gomock.Any()).Do(func(rs rules.MutableRuleSet) {
+			written = rs
+		}).Return(nil),
+		mockedStore.EXPECT().ReadRuleSet("testNamespace").DoAndReturn(func(string) (rules.RuleSet, error) {
+			return written, nil
+		}),
+	)
+
+	storeOpts := NewStoreOptions().SetClockOptions(
+		clock.NewOptions().SetNowFn(func() time.Time {
+			return time.Unix(0, 200)
+		}),
+	)
+	rulesStore := NewStore(mockedStore, storeOpts)
+	uOpts := r2store.NewUpdateOptions().SetAuthor("rollbackUser")
+	res, err := rulesStore.RollbackRuleSet("testNamespace", 1, uOpts)
+	require.NoError(t, err)
+	require.Len(t, res.MappingRules, 1)
+	require.Equal(t, ruleID, res.MappingRules[0].ID)
+	require.Equal(t, "app:foo", res.MappingRules[0].Filter)
+	require.Equal(t, "rollbackUser", res.MappingRules[0].LastUpdatedBy)
+
+	history, err := written.MappingRules()
+	require.NoError(t, err)
+	for id, snapshots := range history {
+		if id == ruleID {
+			continue
+		}
+		require.True(t, snapshots[0].Tombstoned)
+		require.Equal(t, "rollbackUser", snapshots[0].LastUpdatedBy)
+	}
+}
+
+func TestRollbackRuleSetInvalidVersion(t *testing.T) {
+	helper := rules.NewRuleSetUpdateHelper(time.Minute)
+	rs, err := testRuleSet(2, helper.NewUpdateMetadata(100, "validUser"))
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockedStore := rules.NewMockStore(ctrl)
+	mockedStore.EXPECT().ReadRuleSet("testNamespace").Return(rs, nil).Times(2)
+
+	rulesStore := NewStore(mockedStore, NewStoreOptions())
+	for _, version := range []int{0, 2} {
+		_, err = rulesStore.RollbackRuleSet("testNamespace", version, r2store.NewUpdateOptions())
+		require.Error(t, err)
+		require.IsType(t, r2.NewBadInputError(""), err)
+	}
+}
+
 func newTestRuleSetChanges(mrs view.MappingRules, rrs view.RollupRules) changes.RuleSetChanges {
 	mrChanges := make([]changes.MappingRuleChange, 0, len(mrs))
 	for uuid := range mrs {
@@ -348,6 +667,59 @@ func testRuleSet(version int, meta rules.UpdateMetadata) (rules.RuleSet, error)
 	return ruleSet, nil
 }
 
+func testEvaluateRuleSet(version int, meta rules.UpdateMetadata) (rules.RuleSet, error) {
+	mutable := rules.NewEmptyRuleSet("testNamespace", meta)
+	for _, mr := range []view.MappingRule{
+		{
+			Name:   "fooMappingRule",
+			Filter: "app:foo",
+			StoragePolicies: policy.StoragePolicies{
+				policy.MustParseStoragePolicy("1m:10d"),
+			},
+		},
+		{
+			Name:   "barMappingRule",
+			Filter: "app:bar",
+			StoragePolicies: policy.StoragePolicies{
+				policy.MustParseStoragePolicy("10s:2d"),
+			},
+		},
+	} {
+		if _, err := mutable.AddMappingRule(mr, meta); err != nil {
+			return nil, err
+		}
+	}
+	_, err := mutable.AddRollupRule(view.RollupRule{
+		Name:   "fooRollupRule",
+		Filter: "app:foo",
+		Targets: []view.RollupTarget{
+			{
+				Pipeline: pipeline.NewPipeline([]pipeline.OpUnion{
+					{
+						Type: pipeline.RollupOpType,
+						Rollup: pipeline.RollupOp{
+							NewName:       []byte("rolled"),
+							Tags:          [][]byte{[]byte("app")},
+							AggregationID: aggregation.MustCompressTypes(aggregation.Sum),
+						},
+					},
+				}),
+				StoragePolicies: policy.StoragePolicies{
+					policy.MustParseStoragePolicy("1m:30d"),
+				},
+			},
+		},
+	}, meta)
+	if err != nil {
+		return nil, err
+	}
+	proto, err := mutable.Proto()
+	if err != nil {
+		return nil, err
+	}
+	return rules.NewRuleSetFromProto(version, proto, rules.NewOptions())
+}
+
 func newEmptyTestRuleSet(version int, meta rules.UpdateMetadata) (rules.RuleSet, error) {
 	proto, err := rules.NewEmptyRuleSet("testNamespace", meta).Proto()
 	if err != nil {
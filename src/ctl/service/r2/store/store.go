@@ -45,6 +45,14 @@ type Store interface {
 	// UpdateRuleSet updates a ruleset with a given namespace.
 	UpdateRuleSet(rsChanges changes.RuleSetChanges, version int, uOpts UpdateOptions) (view.RuleSet, error)
 
+	// RollbackRuleSet restores every rule of the ruleset for the given namespace ID
+	// to its state in the given ruleset version.
+	RollbackRuleSet(namespaceID string, version int, uOpts UpdateOptions) (view.RuleSet, error)
+
+	// EvaluateRuleSet matches a metric against the current ruleset of the given
+	// namespace ID, or against the proposed ruleset in the request if one is given.
+	EvaluateRuleSet(namespaceID string, req EvaluateRequest) (RuleSetEvaluation, error)
+
 	// FetchMappingRule fetches the mapping rule for the given namespace ID and rule ID.
 	FetchMappingRule(namespaceID, mappingRuleID string) (view.MappingRule, error)
 
@@ -61,6 +69,10 @@ type Store interface {
 	// and rule ID.
 	FetchMappingRuleHistory(namespaceID, mappingRuleID string) ([]view.MappingRule, error)
 
+	// RollbackMappingRule restores the mapping rule for the given namespace ID and rule ID
+	// to the given snapshot version, where version 1 is the oldest snapshot in its history.
+	RollbackMappingRule(namespaceID, mappingRuleID string, version int, uOpts UpdateOptions) (view.MappingRule, error)
+
 	// FetchRollupRule fetches the rollup rule for the given namespace ID and rule ID.
 	FetchRollupRule(namespaceID, rollupRuleID string) (view.RollupRule, error)
 
@@ -77,6 +89,10 @@ type Store interface {
 	// and rule ID.
 	FetchRollupRuleHistory(namespaceID, rollupRuleID string) ([]view.RollupRule, error)
 
+	// RollbackRollupRule restores the rollup rule for the given namespace ID and rule ID
+	// to the given snapshot version, where version 1 is the oldest snapshot in its history.
+	RollbackRollupRule(namespaceID, rollupRuleID string, version int, uOpts UpdateOptions) (view.RollupRule, error)
+
 	// Close closes the store.
 	Close()
 }
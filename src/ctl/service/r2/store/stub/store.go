@@ -394,6 +394,43 @@ func (s *store) UpdateRuleSet(
 	return view.RuleSet{}, errNotImplemented
 }
 
+// This function is not supported. Use mocks package.
+func (s *store) RollbackRuleSet(
+	namespaceID string,
+	version int,
+	uOpts r2store.UpdateOptions,
+) (view.RuleSet, error) {
+	return view.RuleSet{}, errNotImplemented
+}
+
+// This function is not supported. Use mocks package.
+func (s *store) RollbackMappingRule(
+	namespaceID string,
+	mappingRuleID string,
+	version int,
+	uOpts r2store.UpdateOptions,
+) (view.MappingRule, error) {
+	return view.MappingRule{}, errNotImplemented
+}
+
+// This function is not supported. Use mocks package.
+func (s *store) RollbackRollupRule(
+	namespaceID string,
+	rollupRuleID string,
+	version int,
+	uOpts r2store.UpdateOptions,
+) (view.RollupRule, error) {
+	return view.RollupRule{}, errNotImplemented
+}
+
+// This function is not supported. Use mocks package.
+func (s *store) EvaluateRuleSet(
+	namespaceID string,
+	req r2store.EvaluateRequest,
+) (r2store.RuleSetEvaluation, error) {
+	return r2store.RuleSetEvaluation{}, errNotImplemented
+}
+
 func (s *store) DeleteNamespace(namespaceID string, uOpts r2store.UpdateOptions) error {
 	switch namespaceID {
 	case s.data.ErrorNamespace:
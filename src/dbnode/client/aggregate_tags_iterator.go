@@ -0,0 +1,105 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"github.com/m3db/m3x/ident"
+)
+
+type aggregateTagsIterator struct {
+	currentIdx int
+	err        error
+
+	current struct {
+		tagName   ident.ID
+		tagValues ident.Iterator
+	}
+
+	backing []aggregatedTag
+}
+
+type aggregatedTag struct {
+	name   []byte
+	values [][]byte
+}
+
+// make the compiler ensure the concrete type `&aggregateTagsIterator{}` implements
+// the `AggregatedTagsIterator` interface.
+var _ AggregatedTagsIterator = &aggregateTagsIterator{}
+
+func newAggregateTagsIterator() *aggregateTagsIterator {
+	return &aggregateTagsIterator{
+		currentIdx: -1,
+	}
+}
+
+func (i *aggregateTagsIterator) Next() bool {
+	if i.err != nil || i.currentIdx >= len(i.backing) {
+		return false
+	}
+	i.release()
+	i.currentIdx++
+	if i.currentIdx >= len(i.backing) {
+		return false
+	}
+
+	tag := i.backing[i.currentIdx]
+	values := make([]ident.ID, 0, len(tag.values))
+	for _, value := range tag.values {
+		values = append(values, ident.BytesID(value))
+	}
+
+	i.current.tagName = ident.BytesID(tag.name)
+	i.current.tagValues = ident.NewIDSliceIterator(values)
+	return true
+}
+
+func (i *aggregateTagsIterator) addBacking(name []byte, values [][]byte) {
+	i.backing = append(i.backing, aggregatedTag{name: name, values: values})
+}
+
+func (i *aggregateTagsIterator) Remaining() int {
+	if remaining := len(i.backing) - (i.currentIdx + 1); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+func (i *aggregateTagsIterator) Current() (ident.ID, ident.Iterator) {
+	return i.current.tagName, i.current.tagValues
+}
+
+func (i *aggregateTagsIterator) Err() error {
+	return i.err
+}
+
+func (i *aggregateTagsIterator) Finalize() {
+	i.release()
+	i.backing = nil
+}
+
+func (i *aggregateTagsIterator) release() {
+	i.current.tagName = nil
+	if iter := i.current.tagValues; iter != nil {
+		iter.Close()
+		i.current.tagValues = nil
+	}
+}
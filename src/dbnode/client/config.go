@@ -31,6 +31,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/environment"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/dbnode/x/tchannel"
+	xtls "github.com/m3db/m3/src/x/tls"
 	"github.com/m3db/m3x/instrument"
 	"github.com/m3db/m3x/retry"
 )
@@ -79,6 +80,9 @@ type Configuration struct {
 
 	// HashingConfiguration is the configuration for hashing of IDs to shards.
 	HashingConfiguration *HashingConfiguration `yaml:"hashing"`
+
+	// TLS is the TLS configuration used to connect to M3DB nodes.
+	TLS *xtls.Configuration `yaml:"tls"`
 }
 
 // Validate validates the configuration.
@@ -109,6 +113,12 @@ func (c *Configuration) Validate() error {
 			*c.BackgroundHealthCheckFailThrottleFactor)
 	}
 
+	if c.TLS != nil {
+		if err := c.TLS.Validate(); err != nil {
+			return fmt.Errorf("m3db client tls config invalid: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -248,6 +258,9 @@ func (c Configuration) NewAdminClient(
 	if c.FetchRetry != nil {
 		v = v.SetFetchRetrier(c.FetchRetry.NewRetrier(fetchRequestScope))
 	}
+	if c.TLS != nil {
+		v = v.SetTLSConfiguration(c.TLS)
+	}
 
 	encodingOpts := params.EncodingOptions
 	if encodingOpts == nil {
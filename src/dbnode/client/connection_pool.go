@@ -296,7 +296,18 @@ func (p *connPool) healthCheckEvery(interval time.Duration, stutter time.Duratio
 }
 
 func newConn(channelName string, address string, opts Options) (xclose.SimpleCloser, rpc.TChanNode, error) {
-	channel, err := tchannel.NewChannel(channelName, opts.ChannelOptions())
+	channelOpts := opts.ChannelOptions()
+	if provider := opts.TLSConfigProvider(); provider != nil {
+		// Copy the channel options so the shared options are not mutated
+		// when dialing over TLS.
+		var tlsChannelOpts tchannel.ChannelOptions
+		if channelOpts != nil {
+			tlsChannelOpts = *channelOpts
+		}
+		tlsChannelOpts.Dialer = provider.DialContext
+		channelOpts = &tlsChannelOpts
+	}
+	channel, err := tchannel.NewChannel(channelName, channelOpts)
 	if err != nil {
 		return nil, nil, err
 	}
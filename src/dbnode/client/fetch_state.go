@@ -42,13 +42,22 @@ var (
 	errFetchStateStillProcessing = errors.New("[invariant violated] fetch state is still processing, unable to create response")
 )
 
+// fetchStateOp is the subset of an op tracked by a fetchState, satisfied by
+// both fetchTaggedOp and aggregateOp.
+type fetchStateOp interface {
+	op
+	incRef()
+	decRef()
+	requestLimit(defaultValue int) int
+}
+
 type fetchState struct {
 	sync.Cond
 	sync.Mutex
 	refCounter
 
 	nsID                 ident.ID
-	op                   *fetchTaggedOp
+	op                   fetchStateOp
 	tagResultAccumulator fetchTaggedResultAccumulator
 	err                  error
 	done                 bool
@@ -88,7 +97,7 @@ func (f *fetchState) close() {
 func (f *fetchState) Reset(
 	startTime time.Time,
 	endTime time.Time,
-	op *fetchTaggedOp, topoMap topology.Map,
+	op fetchStateOp, topoMap topology.Map,
 	majority int,
 	consistencyLevel topology.ReadConsistencyLevel,
 ) {
@@ -113,15 +122,22 @@ func (f *fetchState) completionFn(
 		return
 	}
 
-	opts, ok := result.(fetchTaggedResultAccumulatorOpts)
-	if !ok {
+	var (
+		done bool
+		err  error
+	)
+	switch opts := result.(type) {
+	case fetchTaggedResultAccumulatorOpts:
+		done, err = f.tagResultAccumulator.Add(opts, resultErr)
+	case aggregateResultAccumulatorOpts:
+		done, err = f.tagResultAccumulator.AddAggregate(opts, resultErr)
+	default:
 		// should never happen
-		f.markDoneWithLock(fmt.Errorf(
-			"[invariant violated] expected result to be of type fetchTaggedResultAccumulatorOpts, received: %v", result))
-		return
+		done, err = true, fmt.Errorf(
+			"[invariant violated] expected result to be one of %v, received: %v",
+			[]string{"fetchTaggedResultAccumulatorOpts", "aggregateResultAccumulatorOpts"},
+			result)
 	}
-
-	done, err := f.tagResultAccumulator.Add(opts, resultErr)
 	if done {
 		f.markDoneWithLock(err)
 	}
@@ -149,6 +165,22 @@ func (f *fetchState) asTaggedIDsIterator(pools fetchTaggedPools) (TaggedIDsItera
 	return f.tagResultAccumulator.AsTaggedIDsIterator(limit, pools)
 }
 
+func (f *fetchState) asAggregatedTagsIterator() (AggregatedTagsIterator, bool, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if !f.done {
+		return nil, false, errFetchStateStillProcessing
+	}
+
+	if err := f.err; err != nil {
+		return nil, false, err
+	}
+
+	limit := f.op.requestLimit(maxInt)
+	return f.tagResultAccumulator.AsAggregatedTagsIterator(limit)
+}
+
 func (f *fetchState) asEncodingSeriesIterators(pools fetchTaggedPools) (encoding.SeriesIterators, bool, error) {
 	f.Lock()
 	defer f.Unlock()
@@ -38,6 +38,11 @@ type fetchTaggedResultAccumulatorOpts struct {
 	response *rpc.FetchTaggedResult_
 }
 
+type aggregateResultAccumulatorOpts struct {
+	host     topology.Host
+	response *rpc.AggregateQueryResult_
+}
+
 func newFetchTaggedResultAccumulator() fetchTaggedResultAccumulator {
 	accum := fetchTaggedResultAccumulator{}
 	accum.Clear()
@@ -52,9 +57,10 @@ type fetchTaggedResultAccumulator struct {
 	numHostsPending         int32
 	numShardsPending        int32
 
-	errors     xerrors.Errors
-	responses  fetchTaggedIDResults
-	exhaustive bool
+	errors       xerrors.Errors
+	responses    fetchTaggedIDResults
+	aggResponses aggregateResults
+	exhaustive   bool
 
 	startTime        time.Time
 	endTime          time.Time
@@ -78,35 +84,74 @@ func (accum *fetchTaggedResultAccumulator) Add(
 	opts fetchTaggedResultAccumulatorOpts,
 	resultErr error,
 ) (bool, error) {
-	host := opts.host
-	response := opts.response
+	hostShardSet, err := accum.hostShardSet(opts.host)
+	if err != nil {
+		doneAccumulating := true
+		return doneAccumulating, err
+	}
+
+	if resultErr == nil {
+		accum.exhaustive = accum.exhaustive && opts.response.Exhaustive
+		for _, elem := range opts.response.Elements {
+			accum.responses = append(accum.responses, elem)
+		}
+	}
+
+	return accum.accumulate(opts.host, hostShardSet, resultErr)
+}
+
+func (accum *fetchTaggedResultAccumulator) AddAggregate(
+	opts aggregateResultAccumulatorOpts,
+	resultErr error,
+) (bool, error) {
+	hostShardSet, err := accum.hostShardSet(opts.host)
+	if err != nil {
+		doneAccumulating := true
+		return doneAccumulating, err
+	}
 
+	if resultErr == nil {
+		accum.exhaustive = accum.exhaustive && opts.response.Exhaustive
+		for _, elem := range opts.response.Results {
+			accum.aggResponses = append(accum.aggResponses, elem)
+		}
+	}
+
+	return accum.accumulate(opts.host, hostShardSet, resultErr)
+}
+
+func (accum *fetchTaggedResultAccumulator) hostShardSet(
+	host topology.Host,
+) (topology.HostShardSet, error) {
 	if host == nil {
 		// should never happen, guarding against incompatible changes to the `client` package.
-		doneAccumulating := true
 		err := fmt.Errorf("[invariant violated] nil host in fetchState completionFn")
-		return doneAccumulating, xerrors.NewNonRetryableError(err)
+		return nil, xerrors.NewNonRetryableError(err)
 	}
 
 	hostShardSet, ok := accum.topoMap.LookupHostShardSet(host.ID())
 	if !ok {
 		// should never happen, as we've taken a reference to the
 		// topology when beginning the request, and the var is immutable.
-		doneAccumulating := true
 		err := fmt.Errorf(
 			"[invariant violated] missing host shard in fetchState completionFn: %s", host.ID())
-		return doneAccumulating, xerrors.NewNonRetryableError(err)
+		return nil, xerrors.NewNonRetryableError(err)
 	}
 
+	return hostShardSet, nil
+}
+
+// accumulate tracks the response consistency of each shard owned by the
+// responding host, shared by both the fetchTagged and aggregate code-paths.
+func (accum *fetchTaggedResultAccumulator) accumulate(
+	host topology.Host,
+	hostShardSet topology.HostShardSet,
+	resultErr error,
+) (bool, error) {
 	accum.numHostsPending--
 	if resultErr != nil {
 		accum.errors = append(accum.errors, xerrors.NewRenamedError(resultErr,
 			fmt.Errorf("error fetching tagged from host %s: %v", host.ID(), resultErr)))
-	} else {
-		accum.exhaustive = accum.exhaustive && response.Exhaustive
-		for _, elem := range response.Elements {
-			accum.responses = append(accum.responses, elem)
-		}
 	}
 
 	// FOLLOWUP(prateek): once we transmit the shards successfully satisfied by a response, the
@@ -171,6 +216,10 @@ func (accum *fetchTaggedResultAccumulator) Clear() {
 		accum.responses[i] = nil
 	}
 	accum.responses = accum.responses[:0]
+	for i := range accum.aggResponses {
+		accum.aggResponses[i] = nil
+	}
+	accum.aggResponses = accum.aggResponses[:0]
 	for i := range accum.errors {
 		accum.errors[i] = nil
 	}
@@ -302,6 +351,35 @@ func (accum *fetchTaggedResultAccumulator) AsTaggedIDsIterator(
 	return iter, exhaustive, nil
 }
 
+func (accum *fetchTaggedResultAccumulator) AsAggregatedTagsIterator(
+	limit int,
+) (AggregatedTagsIterator, bool, error) {
+	var (
+		iter      = newAggregateTagsIterator()
+		size      = 0
+		moreElems = false
+	)
+	results := aggregateResultsSortedByTagName(accum.aggResponses)
+	sort.Sort(results)
+	accum.aggResponses = aggregateResults(results)
+	accum.aggResponses.forEachTag(func(elems aggregateResults, hasMore bool) bool {
+		values, truncated := elems.distinctValues(limit - size)
+		iter.addBacking(elems[0].TagName, values)
+		// NB: mirror the server side limit which counts tag names when only
+		// aggregating names, and tag values otherwise.
+		if len(values) == 0 {
+			size++
+		} else {
+			size += len(values)
+		}
+		moreElems = hasMore || truncated
+		return size < limit
+	})
+
+	exhaustive := accum.exhaustive && size <= limit && !moreElems
+	return iter, exhaustive, nil
+}
+
 type fetchTaggedShardConsistencyResults []fetchTaggedShardConsistencyResult
 
 func (res fetchTaggedShardConsistencyResults) initialize(length int) fetchTaggedShardConsistencyResults {
@@ -365,3 +443,73 @@ func (a fetchTaggedIDResultsSortedByID) Swap(i, j int) { a[i], a[j] = a[j], a[i]
 func (a fetchTaggedIDResultsSortedByID) Less(i, j int) bool {
 	return bytes.Compare(a[i].ID, a[j].ID) < 0
 }
+
+type aggregateResults []*rpc.AggregateQueryResultTagNameElement
+
+// forEachTag iterates over the provided results, and calls `fn` on each
+// group of responses with the same tag name.
+// NB: assumes the results array being operated upon has been sorted.
+func (results aggregateResults) forEachTag(fn func(responsesForSingleTag aggregateResults, hasMore bool) bool) {
+	var (
+		startIdx = 0
+		lastTag  []byte
+	)
+	for i := 0; i < len(results); i++ {
+		elem := results[i]
+		if !bytes.Equal(elem.TagName, lastTag) {
+			lastTag = elem.TagName
+			// NB: see fetchTaggedIDResults.forEachID for why the first element is skipped.
+			if i == 0 {
+				continue
+			}
+			continueIterating := fn(results[startIdx:i], i < len(results))
+			if !continueIterating {
+				return
+			}
+			startIdx = i
+		}
+	}
+	// spill over
+	if startIdx < len(results) {
+		fn(results[startIdx:], false)
+	}
+}
+
+// distinctValues returns the sorted distinct tag values across the results,
+// returning at most limit values, and whether any values were left out.
+func (results aggregateResults) distinctValues(limit int) ([][]byte, bool) {
+	var values [][]byte
+	for _, elem := range results {
+		for _, value := range elem.TagValues {
+			values = append(values, value.TagValue)
+		}
+	}
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return bytes.Compare(values[i], values[j]) < 0
+	})
+	distinct := values[:1]
+	for _, value := range values[1:] {
+		if bytes.Equal(value, distinct[len(distinct)-1]) {
+			continue
+		}
+		if len(distinct) >= limit {
+			return distinct, true
+		}
+		distinct = append(distinct, value)
+	}
+	return distinct, false
+}
+
+// aggregateResultsSortedByTagName implements sort.Interface for aggregateResults
+// based on the TagName field.
+type aggregateResultsSortedByTagName aggregateResults
+
+func (a aggregateResultsSortedByTagName) Len() int      { return len(a) }
+func (a aggregateResultsSortedByTagName) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a aggregateResultsSortedByTagName) Less(i, j int) bool {
+	return bytes.Compare(a[i].TagName, a[j].TagName) < 0
+}
@@ -59,6 +59,57 @@ func TestFetchTaggedResultsAccumulatorClearResetsState(t *testing.T) {
 	require.NoError(t, resultsIter.Err())
 }
 
+func TestFetchTaggedResultsAccumulatorAggregateMergesResults(t *testing.T) {
+	accum := newFetchTaggedResultAccumulator()
+	accum.aggResponses = aggregateResults{
+		&rpc.AggregateQueryResultTagNameElement{
+			TagName: []byte("foo"),
+			TagValues: []*rpc.AggregateQueryResultTagValueElement{
+				{TagValue: []byte("b")},
+				{TagValue: []byte("a")},
+			},
+		},
+		&rpc.AggregateQueryResultTagNameElement{
+			TagName: []byte("bar"),
+			TagValues: []*rpc.AggregateQueryResultTagValueElement{
+				{TagValue: []byte("a")},
+			},
+		},
+		&rpc.AggregateQueryResultTagNameElement{
+			TagName: []byte("foo"),
+			TagValues: []*rpc.AggregateQueryResultTagValueElement{
+				{TagValue: []byte("c")},
+				{TagValue: []byte("b")},
+			},
+		},
+	}
+
+	iter, exhaustive, err := accum.AsAggregatedTagsIterator(100)
+	require.NoError(t, err)
+	require.True(t, exhaustive)
+	require.Equal(t, 2, iter.Remaining())
+
+	expected := map[string][]string{
+		"bar": {"a"},
+		"foo": {"a", "b", "c"},
+	}
+	actual := make(map[string][]string)
+	for iter.Next() {
+		name, values := iter.Current()
+		for values.Next() {
+			actual[name.String()] = append(actual[name.String()], values.Current().String())
+		}
+	}
+	require.NoError(t, iter.Err())
+	require.Equal(t, expected, actual)
+	iter.Finalize()
+
+	iter, exhaustive, err = accum.AsAggregatedTagsIterator(2)
+	require.NoError(t, err)
+	require.False(t, exhaustive)
+	require.Equal(t, 2, iter.Remaining())
+}
+
 func TestFetchTaggedShardConsistencyResultsInitializeLength(t *testing.T) {
 	var results fetchTaggedShardConsistencyResults
 	require.Len(t, results, 0)
@@ -257,6 +257,8 @@ func (q *queue) drain() {
 				q.asyncFetch(v)
 			case *fetchTaggedOp:
 				q.asyncFetchTagged(v)
+			case *aggregateOp:
+				q.asyncAggregate(v)
 			case *truncateOp:
 				q.asyncTruncate(v)
 			default:
@@ -505,6 +507,38 @@ func (q *queue) asyncFetchTagged(op *fetchTaggedOp) {
 	})
 }
 
+func (q *queue) asyncAggregate(op *aggregateOp) {
+	q.Add(1)
+	q.workerPool.Go(func() {
+		cleanup := func() {
+			op.decRef()
+			q.Done()
+		}
+
+		client, err := q.connPool.NextClient()
+		if err != nil {
+			// No client available
+			op.CompletionFn()(aggregateResultAccumulatorOpts{host: q.host}, err)
+			cleanup()
+			return
+		}
+
+		ctx, _ := thrift.NewContext(q.opts.FetchRequestTimeout())
+		result, err := client.AggregateQuery(ctx, &op.request)
+		if err != nil {
+			op.CompletionFn()(aggregateResultAccumulatorOpts{host: q.host}, err)
+			cleanup()
+			return
+		}
+
+		op.CompletionFn()(aggregateResultAccumulatorOpts{
+			host:     q.host,
+			response: result,
+		}, err)
+		cleanup()
+	})
+}
+
 func (q *queue) asyncTruncate(op *truncateOp) {
 	q.Add(1)
 
@@ -545,6 +579,9 @@ func (q *queue) Enqueue(o op) error {
 	case *fetchTaggedOp:
 		// Need to take ownership if its a fetch tagged op
 		sOp.incRef()
+	case *aggregateOp:
+		// Need to take ownership if its an aggregate op
+		sOp.incRef()
 	}
 
 	var needsDrain []op
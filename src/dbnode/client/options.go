@@ -33,6 +33,7 @@ import (
 	m3dbruntime "github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/x/serialize"
+	xtls "github.com/m3db/m3/src/x/tls"
 	"github.com/m3db/m3x/context"
 	"github.com/m3db/m3x/ident"
 	"github.com/m3db/m3x/instrument"
@@ -201,6 +202,8 @@ type options struct {
 	writeConsistencyLevel                   topology.ConsistencyLevel
 	bootstrapConsistencyLevel               topology.ReadConsistencyLevel
 	channelOptions                          *tchannel.ChannelOptions
+	tlsConfigProvider                       xtls.ConfigProvider
+	tlsConfiguration                        *xtls.Configuration
 	maxConnectionCount                      int
 	minConnectionCount                      int
 	hostConnectTimeout                      time.Duration
@@ -439,6 +442,26 @@ func (o *options) ChannelOptions() *tchannel.ChannelOptions {
 	return o.channelOptions
 }
 
+func (o *options) SetTLSConfigProvider(value xtls.ConfigProvider) Options {
+	opts := *o
+	opts.tlsConfigProvider = value
+	return &opts
+}
+
+func (o *options) TLSConfigProvider() xtls.ConfigProvider {
+	return o.tlsConfigProvider
+}
+
+func (o *options) SetTLSConfiguration(value *xtls.Configuration) Options {
+	opts := *o
+	opts.tlsConfiguration = value
+	return &opts
+}
+
+func (o *options) TLSConfiguration() *xtls.Configuration {
+	return o.tlsConfiguration
+}
+
 func (o *options) SetMaxConnectionCount(value int) Options {
 	opts := *o
 	opts.maxConnectionCount = value
@@ -48,6 +48,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/x/xio"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
 	"github.com/m3db/m3/src/x/serialize"
+	xtls "github.com/m3db/m3/src/x/tls"
 	"github.com/m3db/m3x/checked"
 	xclose "github.com/m3db/m3x/close"
 	"github.com/m3db/m3x/context"
@@ -131,6 +132,7 @@ type session struct {
 	state                            sessionState
 	opts                             Options
 	runtimeOptsListenerCloser        xclose.Closer
+	tlsConfigProvider                xtls.ConfigProvider
 	scope                            tally.Scope
 	nowFn                            clock.NowFn
 	log                              xlog.Logger
@@ -272,6 +274,14 @@ func newSession(opts Options) (clientSession, error) {
 	s.pools.fetchTaggedAttempt = newFetchTaggedAttemptPool(s, fetchTaggedAttemptPoolImplOpts)
 	s.pools.fetchTaggedAttempt.Init()
 
+	aggregateAttemptPoolImplOpts := pool.NewObjectPoolOptions().
+		SetSize(opts.FetchBatchOpPoolSize()).
+		SetInstrumentOptions(opts.InstrumentOptions().SetMetricsScope(
+			scope.SubScope("aggregate-attempt-pool"),
+		))
+	s.pools.aggregateAttempt = newAggregateAttemptPool(s, aggregateAttemptPoolImplOpts)
+	s.pools.aggregateAttempt.Init()
+
 	tagEncoderPoolOpts := pool.NewObjectPoolOptions().
 		SetSize(opts.TagEncoderPoolSize()).
 		SetInstrumentOptions(opts.InstrumentOptions().SetMetricsScope(
@@ -450,8 +460,14 @@ func (s *session) Open() error {
 
 	topoMap := watch.Get()
 
+	if err := s.openTLSConfigProviderWithLock(); err != nil {
+		s.state.Unlock()
+		return err
+	}
+
 	queues, replicas, majority, err := s.hostQueues(topoMap, nil)
 	if err != nil {
+		s.closeTLSConfigProviderWithLock()
 		s.state.Unlock()
 		return err
 	}
@@ -504,6 +520,14 @@ func (s *session) Open() error {
 	s.pools.fetchTaggedOp = newFetchTaggedOpPool(fetchTaggedOpPoolOpts)
 	s.pools.fetchTaggedOp.Init()
 
+	aggregateOpPoolOpts := pool.NewObjectPoolOptions().
+		SetSize(s.opts.FetchBatchOpPoolSize()).
+		SetInstrumentOptions(s.opts.InstrumentOptions().SetMetricsScope(
+			s.scope.SubScope("aggregate-op-pool"),
+		))
+	s.pools.aggregateOp = newAggregateOpPool(aggregateOpPoolOpts)
+	s.pools.aggregateOp.Init()
+
 	fetchStatePoolOpts := pool.NewObjectPoolOptions().
 		SetSize(s.opts.FetchBatchOpPoolSize()).
 		SetInstrumentOptions(s.opts.InstrumentOptions().SetMetricsScope(
@@ -1204,7 +1228,6 @@ func (s *session) fetchTaggedAttemptWithRLock(
 	}
 
 	var (
-		topoMap    = s.state.topoMap
 		op         = s.pools.fetchTaggedOp.Get()
 		fetchState = s.pools.fetchState.Get()
 	)
@@ -1214,7 +1237,96 @@ func (s *session) fetchTaggedAttemptWithRLock(
 	op.incRef()               // indicate current go-routine has a reference to the op
 	op.update(req, fetchState.completionFn)
 
-	fetchState.Reset(opts.StartInclusive, opts.EndExclusive, op, topoMap, s.state.majority, s.state.readLevel)
+	return s.enqueueFetchStateWithRLock(fetchState, op, opts.StartInclusive, opts.EndExclusive)
+}
+
+func (s *session) Aggregate(
+	ns ident.ID, q index.Query, opts index.AggregationOptions,
+) (AggregatedTagsIterator, bool, error) {
+	f := s.pools.aggregateAttempt.Get()
+	f.args.ns = ns
+	f.args.query = q
+	f.args.opts = opts
+	err := s.fetchRetrier.Attempt(f.attemptFn)
+	iter, exhaustive := f.resultIter, f.resultExhaustive
+	s.pools.aggregateAttempt.Put(f)
+	return iter, exhaustive, err
+}
+
+func (s *session) aggregateAttempt(
+	ns ident.ID, q index.Query, opts index.AggregationOptions,
+) (AggregatedTagsIterator, bool, error) {
+	s.state.RLock()
+	if s.state.status != statusOpen {
+		s.state.RUnlock()
+		return nil, false, errSessionStatusNotOpen
+	}
+
+	fetchState, err := s.aggregateAttemptWithRLock(ns, q, opts)
+	s.state.RUnlock()
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	// it's safe to Wait() here, as we still hold the lock on fetchState, after it's
+	// returned from aggregateAttemptWithRLock.
+	fetchState.Wait()
+
+	// must Unlock before calling `asAggregatedTagsIterator` as the latter needs to acquire
+	// the fetchState Lock
+	fetchState.Unlock()
+	iter, exhaustive, err := fetchState.asAggregatedTagsIterator()
+
+	// must Unlock() before decRef'ing, as the latter releases the fetchState back into a
+	// pool if ref count == 0.
+	fetchState.decRef()
+
+	return iter, exhaustive, err
+}
+
+// NB: the returned fetchState, if valid, still holds the lock. Its ownership
+// is transferred to the calling function, and is expected to manage the lifecycle of
+// of the object (including releasing the lock/decRef'ing it).
+func (s *session) aggregateAttemptWithRLock(
+	ns ident.ID,
+	q index.Query,
+	opts index.AggregationOptions,
+) (*fetchState, error) {
+	// NB: we have to clone the namespace, as we cannot guarantee the lifecycle
+	// of the hostQueues responding is less than the lifecycle of the current method.
+	nsClone := s.pools.id.Clone(ns)
+
+	req, err := convert.ToRPCAggregateQueryRequest(nsClone, q, opts)
+	if err != nil {
+		nsClone.Finalize()
+		return nil, xerrors.NewNonRetryableError(err)
+	}
+
+	var (
+		op         = s.pools.aggregateOp.Get()
+		fetchState = s.pools.fetchState.Get()
+	)
+
+	fetchState.nsID = nsClone // transfer ownership to `fetchState`
+	fetchState.incRef()       // indicate current go-routine has a reference to the fetchState
+	op.incRef()               // indicate current go-routine has a reference to the op
+	op.update(req, fetchState.completionFn)
+
+	return s.enqueueFetchStateWithRLock(fetchState, op, opts.StartInclusive, opts.EndExclusive)
+}
+
+// NB: expects the caller to hold a ref on both the fetchState and the op, the ref on
+// the op is released before returning. On success the returned fetchState still holds
+// the lock and the caller's ref.
+func (s *session) enqueueFetchStateWithRLock(
+	fetchState *fetchState,
+	op fetchStateOp,
+	startInclusive time.Time,
+	endExclusive time.Time,
+) (*fetchState, error) {
+	topoMap := s.state.topoMap
+	fetchState.Reset(startInclusive, endExclusive, op, topoMap, s.state.majority, s.state.readLevel)
 	fetchState.Lock()
 	for _, hq := range s.state.queues {
 		// inc to indicate the hostQueue has a reference to `op` which has a ref to the fetchState
@@ -1227,7 +1339,7 @@ func (s *session) fetchTaggedAttemptWithRLock(
 
 			// NB: if this happens we have a bug, once we are in the read
 			// lock the current queues should never be closed
-			wrappedErr := fmt.Errorf("[invariant violated] failed to enqueue fetchTagged: %v", err)
+			wrappedErr := fmt.Errorf("[invariant violated] failed to enqueue fetch: %v", err)
 			s.log.Errorf(wrappedErr.Error())
 			return nil, wrappedErr
 		}
@@ -1549,6 +1661,36 @@ func (s *session) IteratorPools() (encoding.IteratorPools, error) {
 	return s.pools, nil
 }
 
+// openTLSConfigProviderWithLock creates a TLS config provider owned by the
+// session from the TLS configuration, unless a provider was already set.
+func (s *session) openTLSConfigProviderWithLock() error {
+	if s.opts.TLSConfigProvider() != nil {
+		return nil
+	}
+
+	provider, err := s.opts.TLSConfiguration().NewConfigProvider(
+		s.opts.InstrumentOptions())
+	if err != nil {
+		return err
+	}
+	if provider == nil {
+		return nil
+	}
+
+	s.tlsConfigProvider = provider
+	s.opts = s.opts.SetTLSConfigProvider(provider)
+	return nil
+}
+
+func (s *session) closeTLSConfigProviderWithLock() {
+	if s.tlsConfigProvider == nil {
+		return
+	}
+
+	s.tlsConfigProvider.Close()
+	s.tlsConfigProvider = nil
+}
+
 func (s *session) Close() error {
 	s.state.Lock()
 	if s.state.status != statusOpen {
@@ -1559,6 +1701,8 @@ func (s *session) Close() error {
 	queues := s.state.queues
 	topoWatch := s.state.topoWatch
 	topo := s.state.topo
+	tlsConfigProvider := s.tlsConfigProvider
+	s.tlsConfigProvider = nil
 	s.state.Unlock()
 
 	for _, q := range queues {
@@ -1572,6 +1716,12 @@ func (s *session) Close() error {
 		closer.Close()
 	}
 
+	// Close the TLS config provider after the host queues so that no
+	// connections are dialed after it stops reloading certificates.
+	if tlsConfigProvider != nil {
+		tlsConfigProvider.Close()
+	}
+
 	return nil
 }
 
@@ -32,6 +32,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/dbnode/x/xpool"
 	"github.com/m3db/m3/src/x/serialize"
+	xtls "github.com/m3db/m3/src/x/tls"
 	"github.com/m3db/m3x/ident"
 	"github.com/m3db/m3x/pool"
 	xretry "github.com/m3db/m3x/retry"
@@ -170,6 +171,41 @@ func TestSessionShardID(t *testing.T) {
 	assert.NoError(t, s.Close())
 }
 
+func TestSessionOpenCloseTLSConfigProvider(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions().
+		SetTLSConfiguration(&xtls.Configuration{Enabled: true})
+	s, err := newSession(opts)
+	require.NoError(t, err)
+	session := s.(*session)
+	assert.Nil(t, session.opts.TLSConfigProvider())
+
+	mockHostQueues(ctrl, session, sessionTestReplicas, nil)
+
+	require.NoError(t, s.Open())
+	provider := session.tlsConfigProvider
+	require.NotNil(t, provider)
+	assert.Equal(t, provider, session.opts.TLSConfigProvider())
+
+	require.NoError(t, s.Close())
+	assert.Nil(t, session.tlsConfigProvider)
+}
+
+func TestSessionOpenTLSConfigProviderError(t *testing.T) {
+	opts := newSessionTestOptions().
+		SetTLSConfiguration(&xtls.Configuration{
+			Enabled: true,
+			CAFile:  "/path/does/not/exist",
+		})
+	s, err := newSession(opts)
+	require.NoError(t, err)
+
+	require.Error(t, s.Open())
+	assert.Nil(t, s.(*session).tlsConfigProvider)
+}
+
 func TestSessionClusterConnectConsistencyLevelAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -33,6 +33,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/namespace"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/x/serialize"
+	xtls "github.com/m3db/m3/src/x/tls"
 	"github.com/m3db/m3x/context"
 	"github.com/m3db/m3x/ident"
 	"github.com/m3db/m3x/instrument"
@@ -78,6 +79,11 @@ type Session interface {
 	// FetchTaggedIDs resolves the provided query to known IDs.
 	FetchTaggedIDs(namespace ident.ID, q index.Query, opts index.QueryOptions) (iter TaggedIDsIterator, exhaustive bool, err error)
 
+	// Aggregate resolves the provided query to the distinct tag names, and
+	// optionally tag values, of the series it matches. Tag names can be
+	// restricted by exact match, tag values are only restricted by the query.
+	Aggregate(namespace ident.ID, q index.Query, opts index.AggregationOptions) (iter AggregatedTagsIterator, exhaustive bool, err error)
+
 	// ShardID returns the given shard for an ID for callers
 	// to easily discern what shard is failing when operations
 	// for given IDs begin failing
@@ -106,6 +112,26 @@ type TaggedIDsIterator interface {
 	Finalize()
 }
 
+// AggregatedTagsIterator iterates over a collection of tag names with optionally
+// associated tag values.
+type AggregatedTagsIterator interface {
+	// Next returns whether there are more items in the collection.
+	Next() bool
+
+	// Remaining returns the number of elements remaining to be iterated over.
+	Remaining() int
+
+	// Current returns the current tag name, and associated tag value iterator.
+	// These remain valid until Next() is called again.
+	Current() (tagName ident.ID, tagValues ident.Iterator)
+
+	// Err returns any error encountered.
+	Err() error
+
+	// Finalize releases any held resources.
+	Finalize()
+}
+
 // AdminClient can create administration sessions
 type AdminClient interface {
 	Client
@@ -252,6 +278,24 @@ type Options interface {
 	// ChannelOptions returns the channelOptions
 	ChannelOptions() *tchannel.ChannelOptions
 
+	// SetTLSConfigProvider sets the provider of the TLS configs used to connect
+	// to hosts, connections are made in plaintext if it is nil
+	SetTLSConfigProvider(value xtls.ConfigProvider) Options
+
+	// TLSConfigProvider returns the provider of the TLS configs used to connect
+	// to hosts
+	TLSConfigProvider() xtls.ConfigProvider
+
+	// SetTLSConfiguration sets the TLS configuration used to create a TLS
+	// config provider for each session, the provider is closed when the
+	// session is closed and the configuration is ignored if a TLS config
+	// provider is set
+	SetTLSConfiguration(value *xtls.Configuration) Options
+
+	// TLSConfiguration returns the TLS configuration used to create a TLS
+	// config provider for each session
+	TLSConfiguration() *xtls.Configuration
+
 	// SetMaxConnectionCount sets the maxConnectionCount
 	SetMaxConnectionCount(value int) Options
 
@@ -32,6 +32,11 @@ enum ErrorType {
 	BAD_REQUEST
 }
 
+enum AggregateQueryType {
+	AGGREGATE_BY_TAG_NAME,
+	AGGREGATE_BY_TAG_NAME_VALUE
+}
+
 exception Error {
 	1: required ErrorType type = ErrorType.INTERNAL_ERROR
 	2: required string message
@@ -46,6 +51,7 @@ service Node {
 	QueryResult query(1: QueryRequest req) throws (1: Error err)
 	FetchResult fetch(1: FetchRequest req) throws (1: Error err)
 	FetchTaggedResult fetchTagged(1: FetchTaggedRequest req) throws (1: Error err)
+	AggregateQueryResult aggregateQuery(1: AggregateQueryRequest req) throws (1: Error err)
 	void write(1: WriteRequest req) throws (1: Error err)
 	void writeTagged(1: WriteTaggedRequest req) throws (1: Error err)
 
@@ -157,6 +163,31 @@ struct FetchTaggedIDResult {
 	5: optional Error err
 }
 
+struct AggregateQueryRequest {
+	1: required binary nameSpace
+	2: required binary query
+	3: required i64 rangeStart
+	4: required i64 rangeEnd
+	5: optional i64 limit
+	6: optional list<binary> tagNameFilter
+	7: optional AggregateQueryType aggregateQueryType = AggregateQueryType.AGGREGATE_BY_TAG_NAME_VALUE
+	8: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+}
+
+struct AggregateQueryResult {
+	1: required list<AggregateQueryResultTagNameElement> results
+	2: required bool exhaustive
+}
+
+struct AggregateQueryResultTagNameElement {
+	1: required binary tagName
+	2: optional list<AggregateQueryResultTagValueElement> tagValues
+}
+
+struct AggregateQueryResultTagValueElement {
+	1: required binary tagValue
+}
+
 struct FetchBlocksRawRequest {
 	1: required binary nameSpace
 	2: required i32 shard
@@ -0,0 +1,178 @@
+// +build integration
+
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package integration
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/integration/generate"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/storage/namespace"
+	"github.com/m3db/m3x/ident"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskColdFlushRestart(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow() // Just skip if we're doing a short run
+	}
+	// Test setup
+	var (
+		blockSize = time.Hour
+		rOpts     = retention.NewOptions().
+				SetRetentionPeriod(6 * time.Hour).
+				SetBlockSize(blockSize).
+				SetBufferPast(10 * time.Minute).
+				SetBufferFuture(10 * time.Minute)
+		nsID = testNamespaces[0]
+	)
+
+	nsOpts := namespace.NewOptions().
+		SetRetentionOptions(rOpts).
+		SetColdWritesEnabled(true)
+	ns, err := namespace.NewMetadata(nsID, nsOpts)
+	require.NoError(t, err)
+	opts := newTestOptions(t).
+		SetNamespaces([]namespace.Metadata{ns})
+
+	setup := newTestSetupWithCommitLogAndFilesystemBootstrapper(t, opts)
+	defer setup.close()
+
+	log := setup.storageOpts.InstrumentOptions().Logger()
+	log.Info("cold writes restart test")
+
+	// setting time to 2017/02/13 15:30:10
+	fakeStart := time.Date(2017, time.February, 13, 15, 30, 10, 0, time.Local)
+	blockStart := fakeStart.Truncate(blockSize)
+	setup.setNowFn(fakeStart)
+
+	// startup server
+	log.Debug("starting server")
+	startServerWithNewInspection(t, opts, setup)
+	log.Debug("server is now up")
+	setup.mustSetTickMinimumInterval(100 * time.Millisecond)
+
+	// Stop the server
+	defer func() {
+		log.Debug("stopping server")
+		require.NoError(t, setup.stopServer())
+		log.Debug("server is now down")
+	}()
+
+	// Write to the block and wait for it to be flushed once it is no longer
+	// within buffer past.
+	warm := generate.Block(generate.BlockConfig{
+		IDs: []string{"foo", "bar"}, NumPoints: 10, Start: fakeStart,
+	})
+	require.NoError(t, setup.writeBatch(nsID, warm))
+
+	setup.setNowFn(blockStart.Add(blockSize).Add(rOpts.BufferPast()).Add(time.Minute))
+
+	var (
+		waitTimeout    = time.Minute
+		filePathPrefix = setup.storageOpts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
+	)
+	log.Info("waiting till block has been flushed")
+	require.NoError(t, waitUntilDataFilesFlushed(filePathPrefix, setup.shardSet, nsID,
+		map[xtime.UnixNano]generate.SeriesBlock{xtime.ToUnixNano(blockStart): warm}, waitTimeout))
+	log.Info("block has been flushed")
+
+	// Hang the next tick so that the cold writes are not cold flushed
+	// before the restart and have to be bootstrapped from the commit log.
+	tickMinimumInterval := setup.storageOpts.RuntimeOptionsManager().Get().TickMinimumInterval()
+	setup.mustSetTickMinimumInterval(2 * time.Hour)
+	time.Sleep(tickMinimumInterval * 10)
+
+	// Cold writes to the flushed block, including to a series that was not
+	// written to before.
+	cold := generate.Block(generate.BlockConfig{
+		IDs: []string{"foo", "baz"}, NumPoints: 5, Start: blockStart.Add(45 * time.Minute),
+	})
+	require.NoError(t, setup.writeBatch(nsID, cold))
+
+	expectedSeriesMap := map[xtime.UnixNano]generate.SeriesBlock{
+		xtime.ToUnixNano(blockStart): mergeSeriesBlocks(warm, cold),
+	}
+	log.Info("verifying data in database equals expected data")
+	verifySeriesMaps(t, setup, nsID, expectedSeriesMap)
+	log.Info("verified data in database equals expected data")
+
+	log.Info("restarting database")
+	require.NoError(t, setup.stopServer())
+	startServerWithNewInspection(t, opts, setup)
+	log.Info("verifying data in database equals expected data after restart")
+	verifySeriesMaps(t, setup, nsID, expectedSeriesMap)
+	log.Info("verified data in database equals expected data after restart")
+
+	// Once cold flushed the cold writes are read from the new volume.
+	setup.mustSetTickMinimumInterval(tickMinimumInterval)
+	log.Info("waiting till cold writes have been cold flushed")
+	for _, id := range []string{"foo", "baz"} {
+		shard := setup.shardSet.Lookup(ident.StringID(id))
+		require.True(t, waitUntil(func() bool {
+			exists, err := fs.DataFileSetVolumeExistsAt(filePathPrefix, nsID,
+				shard, blockStart, 1)
+			require.NoError(t, err)
+			return exists
+		}, waitTimeout))
+	}
+	log.Info("cold writes have been cold flushed")
+
+	log.Info("restarting database")
+	require.NoError(t, setup.stopServer())
+	startServerWithNewInspection(t, opts, setup)
+	log.Info("verifying data in database equals expected data after cold flush")
+	verifySeriesMaps(t, setup, nsID, expectedSeriesMap)
+	log.Info("verified data in database equals expected data after cold flush")
+}
+
+// mergeSeriesBlocks merges the datapoints of series with the same ID.
+func mergeSeriesBlocks(blocks ...generate.SeriesBlock) generate.SeriesBlock {
+	var (
+		merged generate.SeriesBlock
+		byID   = make(map[string]int)
+	)
+	for _, block := range blocks {
+		for _, series := range block {
+			idx, ok := byID[series.ID.String()]
+			if !ok {
+				byID[series.ID.String()] = len(merged)
+				merged = append(merged, generate.Series{ID: series.ID, Tags: series.Tags})
+				idx = len(merged) - 1
+			}
+			merged[idx].Data = append(merged[idx].Data, series.Data...)
+		}
+	}
+	for _, series := range merged {
+		data := series.Data
+		sort.Slice(data, func(i, j int) bool {
+			return data[i].Timestamp.Before(data[j].Timestamp)
+		})
+	}
+	return merged
+}
@@ -103,7 +103,7 @@ func openAndServe(
 	contextPool := opts.ContextPool()
 	ttopts := tchannelthrift.NewOptions()
 	service := ttnode.NewService(db, ttopts)
-	nativeNodeClose, err := ttnode.NewServer(service, tchannelNodeAddr, contextPool, nil).ListenAndServe()
+	nativeNodeClose, err := ttnode.NewServer(service, tchannelNodeAddr, contextPool, nil, nil).ListenAndServe()
 	if err != nil {
 		return fmt.Errorf("could not open tchannelthrift interface %s: %v", tchannelNodeAddr, err)
 	}
@@ -117,7 +117,7 @@ func openAndServe(
 	defer httpjsonNodeClose()
 	logger.Infof("node httpjson: listening on %v", httpNodeAddr)
 
-	nativeClusterClose, err := ttcluster.NewServer(client, tchannelClusterAddr, contextPool, nil).ListenAndServe()
+	nativeClusterClose, err := ttcluster.NewServer(client, tchannelClusterAddr, contextPool, nil, nil).ListenAndServe()
 	if err != nil {
 		return fmt.Errorf("could not open tchannelthrift interface %s: %v", tchannelClusterAddr, err)
 	}
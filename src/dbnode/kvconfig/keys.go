@@ -33,6 +33,18 @@ const (
 	// configuration specifying a hard limit for a cluster new series insertions.
 	ClusterNewSeriesInsertLimitKey = "m3db.node.cluster-new-series-insert-limit"
 
+	// PersistRateLimitEnabledKey is the KV config key for the runtime
+	// configuration specifying whether the persist rate limit is enabled.
+	PersistRateLimitEnabledKey = "m3db.node.persist-rate-limit-enabled"
+
+	// PersistRateLimitMbpsKey is the KV config key for the runtime
+	// configuration specifying the persist rate limit in megabits per second.
+	PersistRateLimitMbpsKey = "m3db.node.persist-rate-limit-mbps"
+
+	// WriteNewSeriesAsyncKey is the KV config key for the runtime
+	// configuration specifying whether new series are inserted asynchronously.
+	WriteNewSeriesAsyncKey = "m3db.node.write-new-series-async"
+
 	// ClientBootstrapConsistencyLevel is the KV config key for the runtime
 	// configuration specifying the client bootstrap consistency level
 	ClientBootstrapConsistencyLevel = "m3db.client.bootstrap-consistency-level"
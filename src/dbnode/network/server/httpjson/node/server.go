@@ -27,6 +27,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	ns "github.com/m3db/m3/src/dbnode/network/server"
 	"github.com/m3db/m3/src/dbnode/network/server/httpjson"
+	xtls "github.com/m3db/m3/src/x/tls"
 	"github.com/m3db/m3x/context"
 )
 
@@ -66,6 +67,7 @@ func (s *server) ListenAndServe() (ns.Close, error) {
 	if err != nil {
 		return nil, err
 	}
+	listener = xtls.NewListener(listener, s.opts.TLSConfigProvider())
 
 	server := http.Server{
 		Handler:      mux,
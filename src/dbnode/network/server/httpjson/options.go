@@ -23,6 +23,8 @@ package httpjson
 import (
 	"time"
 
+	xtls "github.com/m3db/m3/src/x/tls"
+
 	apachethrift "github.com/apache/thrift/lib/go/thrift"
 	"github.com/uber/tchannel-go/thrift"
 	"golang.org/x/net/context"
@@ -72,6 +74,12 @@ type ServerOptions interface {
 
 	// PostResponseFn returns the post response fn
 	PostResponseFn() PostResponseFn
+
+	// SetTLSConfigProvider sets the TLS config provider and returns a new ServerOptions
+	SetTLSConfigProvider(value xtls.ConfigProvider) ServerOptions
+
+	// TLSConfigProvider returns the TLS config provider, nil if TLS is disabled
+	TLSConfigProvider() xtls.ConfigProvider
 }
 
 type serverOptions struct {
@@ -80,6 +88,7 @@ type serverOptions struct {
 	requestTimeout time.Duration
 	contextFn      ContextFn
 	postResponseFn PostResponseFn
+	tlsProvider    xtls.ConfigProvider
 }
 
 // NewServerOptions creates a new set of server options with defaults
@@ -140,3 +149,13 @@ func (o *serverOptions) SetPostResponseFn(value PostResponseFn) ServerOptions {
 func (o *serverOptions) PostResponseFn() PostResponseFn {
 	return o.postResponseFn
 }
+
+func (o *serverOptions) SetTLSConfigProvider(value xtls.ConfigProvider) ServerOptions {
+	opts := *o
+	opts.tlsProvider = value
+	return &opts
+}
+
+func (o *serverOptions) TLSConfigProvider() xtls.ConfigProvider {
+	return o.tlsProvider
+}
@@ -21,10 +21,13 @@
 package cluster
 
 import (
+	"net"
+
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	ns "github.com/m3db/m3/src/dbnode/network/server"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
+	xtls "github.com/m3db/m3/src/x/tls"
 	xclose "github.com/m3db/m3x/close"
 	"github.com/m3db/m3x/context"
 
@@ -41,6 +44,7 @@ type server struct {
 	address     string
 	contextPool context.Pool
 	opts        *tchannel.ChannelOptions
+	tlsProvider xtls.ConfigProvider
 }
 
 // NewServer creates a new cluster TChannel Thrift network service
@@ -49,6 +53,7 @@ func NewServer(
 	address string,
 	contextPool context.Pool,
 	opts *tchannel.ChannelOptions,
+	tlsProvider xtls.ConfigProvider,
 ) ns.NetworkService {
 	// Make the opts immutable on the way in
 	if opts != nil {
@@ -60,6 +65,7 @@ func NewServer(
 		client:      client,
 		contextPool: contextPool,
 		opts:        opts,
+		tlsProvider: tlsProvider,
 	}
 }
 
@@ -72,7 +78,14 @@ func (s *server) ListenAndServe() (ns.Close, error) {
 	service := NewService(s.client)
 	tchannelthrift.RegisterServer(channel, rpc.NewTChanClusterServer(service), s.contextPool)
 
-	channel.ListenAndServe(s.address)
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		channel.Close()
+		xclose.TryClose(service)
+		return nil, err
+	}
+
+	channel.Serve(xtls.NewListener(listener, s.tlsProvider))
 
 	return func() {
 		channel.Close()
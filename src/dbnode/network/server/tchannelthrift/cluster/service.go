@@ -26,11 +26,15 @@ import (
 	"sync"
 
 	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
 	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
 	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/x/serialize"
 	"github.com/m3db/m3x/checked"
 	xerrors "github.com/m3db/m3x/errors"
 	"github.com/m3db/m3x/ident"
@@ -40,8 +44,8 @@ import (
 )
 
 var (
-	// errNotImplemented raised when attempting to execute an un-implemented method
-	errNotImplemented = errors.New("method is not implemented")
+	// errUnableToEncodeTags raised when the tag encoder has no data after encoding
+	errUnableToEncodeTags = errors.New("unable to encode tags: unable to unwrap bytes")
 )
 
 type service struct {
@@ -274,8 +278,161 @@ func (s *service) Fetch(tctx thrift.Context, req *rpc.FetchRequest) (*rpc.FetchR
 	return result, nil
 }
 
-func (s *service) FetchTagged(ctx thrift.Context, req *rpc.FetchTaggedRequest) (*rpc.FetchTaggedResult_, error) {
-	return nil, tterrors.NewInternalError(errNotImplemented)
+func (s *service) FetchTagged(tctx thrift.Context, req *rpc.FetchTaggedRequest) (*rpc.FetchTaggedResult_, error) {
+	session, err := s.session()
+	if err != nil {
+		return nil, tterrors.NewInternalError(err)
+	}
+
+	ns, query, opts, fetchData, err := convert.FromRPCFetchTaggedRequest(req, nil)
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	pools, err := session.IteratorPools()
+	if err != nil {
+		return nil, tterrors.NewInternalError(err)
+	}
+
+	if !fetchData {
+		return s.fetchTaggedIDs(session, pools, ns, query, opts)
+	}
+
+	iters, exhaustive, err := session.FetchTagged(ns, query, opts)
+	if err != nil {
+		return nil, convert.ToRPCError(err)
+	}
+
+	defer iters.Close()
+
+	response := &rpc.FetchTaggedResult_{
+		Elements:   make([]*rpc.FetchTaggedIDResult_, 0, iters.Len()),
+		Exhaustive: exhaustive,
+	}
+	for _, iter := range iters.Iters() {
+		encodedTags, err := encodeTags(pools.TagEncoder(), iter.Tags())
+		if err != nil {
+			return nil, tterrors.NewInternalError(err)
+		}
+
+		// NB: copy the namespace and ID since the iterators are closed
+		// before the response is serialized.
+		elem := &rpc.FetchTaggedIDResult_{
+			NameSpace:   copyBytes(iter.Namespace().Bytes()),
+			ID:          copyBytes(iter.ID().Bytes()),
+			EncodedTags: encodedTags,
+		}
+		response.Elements = append(response.Elements, elem)
+
+		segments, err := encodeSegments(iter)
+		if err != nil {
+			elem.Err = convert.ToRPCError(err)
+			continue
+		}
+
+		elem.Segments = segments
+	}
+
+	return response, nil
+}
+
+func (s *service) fetchTaggedIDs(
+	session client.Session,
+	pools encoding.IteratorPools,
+	ns ident.ID,
+	query index.Query,
+	opts index.QueryOptions,
+) (*rpc.FetchTaggedResult_, error) {
+	results, exhaustive, err := session.FetchTaggedIDs(ns, query, opts)
+	if err != nil {
+		return nil, convert.ToRPCError(err)
+	}
+
+	defer results.Finalize()
+
+	response := &rpc.FetchTaggedResult_{
+		Exhaustive: exhaustive,
+	}
+	for results.Next() {
+		nsID, tsID, tags := results.Current()
+		encodedTags, err := encodeTags(pools.TagEncoder(), tags)
+		if err != nil {
+			return nil, tterrors.NewInternalError(err)
+		}
+
+		response.Elements = append(response.Elements, &rpc.FetchTaggedIDResult_{
+			NameSpace:   copyBytes(nsID.Bytes()),
+			ID:          copyBytes(tsID.Bytes()),
+			EncodedTags: encodedTags,
+		})
+	}
+
+	if err := results.Err(); err != nil {
+		return nil, convert.ToRPCError(err)
+	}
+
+	return response, nil
+}
+
+func encodeTags(
+	pool serialize.TagEncoderPool,
+	tags ident.TagIterator,
+) ([]byte, error) {
+	enc := pool.Get()
+	defer enc.Finalize()
+
+	if err := enc.Encode(tags); err != nil {
+		return nil, err
+	}
+
+	data, ok := enc.Data()
+	if !ok {
+		return nil, errUnableToEncodeTags
+	}
+
+	return copyBytes(data.Bytes()), nil
+}
+
+// encodeSegments re-encodes the datapoints of a series iterator, which have
+// already been merged across replicas, into a single segment.
+func encodeSegments(iter encoding.SeriesIterator) ([]*rpc.Segments, error) {
+	var (
+		start   = iter.Start()
+		encoder = m3tsz.NewEncoder(start, nil,
+			m3tsz.DefaultIntOptimizationEnabled, encoding.NewOptions())
+	)
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			encoder.Close()
+			return nil, err
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		encoder.Close()
+		return nil, err
+	}
+
+	segment := encoder.Discard()
+	converted, err := convert.ToSegments([]xio.BlockReader{{
+		SegmentReader: xio.NewSegmentReader(segment),
+		Start:         start,
+		BlockSize:     iter.End().Sub(start),
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	if converted.Segments == nil {
+		return nil, nil
+	}
+
+	return []*rpc.Segments{converted.Segments}, nil
+}
+
+func copyBytes(b []byte) []byte {
+	return append(make([]byte, 0, len(b)), b...)
 }
 
 func (s *service) Write(tctx thrift.Context, req *rpc.WriteRequest) error {
@@ -0,0 +1,258 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/m3ninx/idx"
+	"github.com/m3db/m3/src/x/serialize"
+	"github.com/m3db/m3x/checked"
+	"github.com/m3db/m3x/ident"
+	"github.com/m3db/m3x/pool"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testDatapoint struct {
+	t time.Time
+	v float64
+}
+
+func newTestService(
+	ctrl *gomock.Controller,
+) (rpc.TChanCluster, *client.MockSession) {
+	encoderPool := serialize.NewTagEncoderPool(serialize.NewTagEncoderOptions(),
+		pool.NewObjectPoolOptions().SetSize(1))
+	encoderPool.Init()
+
+	pools := encoding.NewMockIteratorPools(ctrl)
+	pools.EXPECT().TagEncoder().Return(encoderPool).AnyTimes()
+
+	session := client.NewMockSession(ctrl)
+	session.EXPECT().IteratorPools().Return(pools, nil).AnyTimes()
+
+	mockClient := client.NewMockClient(ctrl)
+	mockClient.EXPECT().Options().Return(client.NewOptions()).AnyTimes()
+	mockClient.EXPECT().DefaultSession().Return(session, nil).AnyTimes()
+
+	return NewService(mockClient), session
+}
+
+func newFetchTaggedRequest(
+	t *testing.T,
+	start, end time.Time,
+	fetchData bool,
+) (*rpc.FetchTaggedRequest, index.Query) {
+	q, err := idx.NewRegexpQuery([]byte("foo"), []byte("b.*"))
+	require.NoError(t, err)
+
+	data, err := idx.Marshal(q)
+	require.NoError(t, err)
+
+	startNanos, err := convert.ToValue(start, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	endNanos, err := convert.ToValue(end, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+
+	var limit int64 = 10
+	return &rpc.FetchTaggedRequest{
+		NameSpace:  []byte("metrics"),
+		Query:      data,
+		RangeStart: startNanos,
+		RangeEnd:   endNanos,
+		FetchData:  fetchData,
+		Limit:      &limit,
+	}, index.Query{Query: q}
+}
+
+func decodeTags(t *testing.T, encoded []byte) map[string]string {
+	decoder := serialize.NewTagDecoderPool(serialize.NewTagDecoderOptions(),
+		pool.NewObjectPoolOptions().SetSize(1))
+	decoder.Init()
+
+	dec := decoder.Get()
+	defer dec.Close()
+
+	dec.Reset(checked.NewBytes(encoded, nil))
+	tags := make(map[string]string)
+	for dec.Next() {
+		tag := dec.Current()
+		tags[tag.Name.String()] = tag.Value.String()
+	}
+
+	require.NoError(t, dec.Err())
+	return tags
+}
+
+func TestServiceFetchTagged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, session := newTestService(ctrl)
+
+	start := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+	end := start.Add(2 * time.Hour)
+	req, query := newFetchTaggedRequest(t, start, end, true)
+
+	datapoints := []testDatapoint{
+		{start.Add(10 * time.Second), 1.0},
+		{start.Add(20 * time.Second), 2.5},
+	}
+
+	iter := encoding.NewMockSeriesIterator(ctrl)
+	iter.EXPECT().ID().Return(ident.StringID("foo")).AnyTimes()
+	iter.EXPECT().Namespace().Return(ident.StringID("metrics")).AnyTimes()
+	iter.EXPECT().Start().Return(start).AnyTimes()
+	iter.EXPECT().End().Return(end).AnyTimes()
+	iter.EXPECT().Tags().Return(ident.NewTagsIterator(ident.NewTags(
+		ident.StringTag("foo", "bar"),
+		ident.StringTag("baz", "qux"),
+	)))
+	for _, dp := range datapoints {
+		iter.EXPECT().Next().Return(true)
+		iter.EXPECT().Current().Return(ts.Datapoint{
+			Timestamp: dp.t,
+			Value:     dp.v,
+		}, xtime.Second, nil)
+	}
+	iter.EXPECT().Next().Return(false)
+	iter.EXPECT().Err().Return(nil)
+	iter.EXPECT().Close()
+
+	iters := encoding.NewSeriesIterators([]encoding.SeriesIterator{iter}, nil)
+	session.EXPECT().FetchTagged(
+		ident.NewIDMatcher("metrics"),
+		index.NewQueryMatcher(query),
+		index.QueryOptions{
+			StartInclusive: start,
+			EndExclusive:   end,
+			Limit:          10,
+		}).Return(iters, true, nil)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	r, err := service.FetchTagged(tctx, req)
+	require.NoError(t, err)
+
+	assert.True(t, r.Exhaustive)
+	require.Len(t, r.Elements, 1)
+
+	elem := r.Elements[0]
+	assert.Nil(t, elem.Err)
+	assert.Equal(t, []byte("foo"), elem.ID)
+	assert.Equal(t, []byte("metrics"), elem.NameSpace)
+	assert.Equal(t, map[string]string{"foo": "bar", "baz": "qux"},
+		decodeTags(t, elem.EncodedTags))
+
+	require.Len(t, elem.Segments, 1)
+	merged := elem.Segments[0].Merged
+	require.NotNil(t, merged)
+
+	segment := ts.NewSegment(checked.NewBytes(merged.Head, nil),
+		checked.NewBytes(merged.Tail, nil), ts.FinalizeNone)
+	reader := m3tsz.NewReaderIterator(xio.NewSegmentReader(segment),
+		m3tsz.DefaultIntOptimizationEnabled, encoding.NewOptions())
+	defer reader.Close()
+
+	for _, expected := range datapoints {
+		require.True(t, reader.Next())
+		dp, _, _ := reader.Current()
+		assert.True(t, expected.t.Equal(dp.Timestamp))
+		assert.Equal(t, expected.v, dp.Value)
+	}
+
+	assert.False(t, reader.Next())
+	assert.NoError(t, reader.Err())
+}
+
+func TestServiceFetchTaggedNoData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, session := newTestService(ctrl)
+
+	start := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+	end := start.Add(2 * time.Hour)
+	req, query := newFetchTaggedRequest(t, start, end, false)
+
+	results := client.NewMockTaggedIDsIterator(ctrl)
+	gomock.InOrder(
+		results.EXPECT().Next().Return(true),
+		results.EXPECT().Current().Return(
+			ident.StringID("metrics"),
+			ident.StringID("foo"),
+			ident.NewTagsIterator(ident.NewTags(ident.StringTag("foo", "bar"))),
+		),
+		results.EXPECT().Next().Return(false),
+		results.EXPECT().Err().Return(nil),
+		results.EXPECT().Finalize(),
+	)
+
+	session.EXPECT().FetchTaggedIDs(
+		ident.NewIDMatcher("metrics"),
+		index.NewQueryMatcher(query),
+		index.QueryOptions{
+			StartInclusive: start,
+			EndExclusive:   end,
+			Limit:          10,
+		}).Return(results, false, nil)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	r, err := service.FetchTagged(tctx, req)
+	require.NoError(t, err)
+
+	assert.False(t, r.Exhaustive)
+	require.Len(t, r.Elements, 1)
+
+	elem := r.Elements[0]
+	assert.Equal(t, []byte("foo"), elem.ID)
+	assert.Equal(t, []byte("metrics"), elem.NameSpace)
+	assert.Equal(t, map[string]string{"foo": "bar"},
+		decodeTags(t, elem.EncodedTags))
+	assert.Nil(t, elem.Segments)
+}
+
+func TestServiceFetchTaggedBadRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, _ := newTestService(ctrl)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	_, err := service.FetchTagged(tctx, &rpc.FetchTaggedRequest{
+		NameSpace: []byte("metrics"),
+		Query:     []byte("invalid"),
+	})
+	require.Error(t, err)
+}
@@ -44,6 +44,8 @@ var (
 	errUnknownUnit      = errors.New("unknown unit")
 	errNilTaggedRequest = errors.New("nil write tagged request")
 
+	errUnknownAggregateQueryType = errors.New("unknown aggregate query type")
+
 	timeZero time.Time
 )
 
@@ -277,6 +279,102 @@ func ToRPCFetchTaggedRequest(
 	return request, nil
 }
 
+// FromRPCAggregateQueryRequest converts the rpc request type for AggregateQueryRequest into corresponding Go API types.
+func FromRPCAggregateQueryRequest(
+	req *rpc.AggregateQueryRequest, pools FetchTaggedConversionPools,
+) (ident.ID, index.Query, index.AggregationOptions, error) {
+	start, rangeStartErr := ToTime(req.RangeStart, req.RangeTimeType)
+	if rangeStartErr != nil {
+		return nil, index.Query{}, index.AggregationOptions{}, rangeStartErr
+	}
+
+	end, rangeEndErr := ToTime(req.RangeEnd, req.RangeTimeType)
+	if rangeEndErr != nil {
+		return nil, index.Query{}, index.AggregationOptions{}, rangeEndErr
+	}
+
+	opts := index.AggregationOptions{
+		QueryOptions: index.QueryOptions{
+			StartInclusive: start,
+			EndExclusive:   end,
+		},
+		FieldFilter: req.TagNameFilter,
+	}
+	if l := req.Limit; l != nil {
+		opts.Limit = int(*l)
+	}
+
+	switch req.AggregateQueryType {
+	case rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME:
+		opts.Type = index.AggregateTagNames
+	case rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME_VALUE:
+		opts.Type = index.AggregateTagNamesAndValues
+	default:
+		return nil, index.Query{}, index.AggregationOptions{}, errUnknownAggregateQueryType
+	}
+
+	q, err := idx.Unmarshal(req.Query)
+	if err != nil {
+		return nil, index.Query{}, index.AggregationOptions{}, err
+	}
+
+	var ns ident.ID
+	if pools != nil {
+		nsBytes := pools.CheckedBytesWrapper().Get(req.NameSpace)
+		ns = pools.ID().BinaryID(nsBytes)
+	} else {
+		ns = ident.StringID(string(req.NameSpace))
+	}
+	return ns, index.Query{Query: q}, opts, nil
+}
+
+// ToRPCAggregateQueryRequest converts the Go `client/` types into rpc request type for AggregateQueryRequest.
+func ToRPCAggregateQueryRequest(
+	ns ident.ID,
+	q index.Query,
+	opts index.AggregationOptions,
+) (rpc.AggregateQueryRequest, error) {
+	rangeStart, tsErr := ToValue(opts.StartInclusive, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.AggregateQueryRequest{}, tsErr
+	}
+
+	rangeEnd, tsErr := ToValue(opts.EndExclusive, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.AggregateQueryRequest{}, tsErr
+	}
+
+	query, queryErr := idx.Marshal(q.Query)
+	if queryErr != nil {
+		return rpc.AggregateQueryRequest{}, queryErr
+	}
+
+	request := rpc.AggregateQueryRequest{
+		NameSpace:     ns.Bytes(),
+		Query:         query,
+		RangeStart:    rangeStart,
+		RangeEnd:      rangeEnd,
+		TagNameFilter: opts.FieldFilter,
+		RangeTimeType: fetchTaggedTimeType,
+	}
+
+	switch opts.Type {
+	case index.AggregateTagNames:
+		request.AggregateQueryType = rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME
+	case index.AggregateTagNamesAndValues:
+		request.AggregateQueryType = rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME_VALUE
+	default:
+		return rpc.AggregateQueryRequest{}, errUnknownAggregateQueryType
+	}
+
+	if opts.Limit > 0 {
+		l := int64(opts.Limit)
+		request.Limit = &l
+	}
+
+	return request, nil
+}
+
 // ToTagsIter returns a tag iterator over the given request.
 func ToTagsIter(r *rpc.WriteTaggedRequest) (ident.TagIterator, error) {
 	if r == nil {
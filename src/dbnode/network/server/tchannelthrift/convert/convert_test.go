@@ -150,6 +150,72 @@ func TestConvertFetchTaggedRequest(t *testing.T) {
 	}
 }
 
+func TestConvertAggregateQueryRequest(t *testing.T) {
+	ns := ident.StringID("abc")
+	opts := index.AggregationOptions{
+		QueryOptions: index.QueryOptions{
+			StartInclusive: time.Now().Add(-900 * time.Hour),
+			EndExclusive:   time.Now(),
+			Limit:          10,
+		},
+		FieldFilter: [][]byte{[]byte("foo"), []byte("dat")},
+		Type:        index.AggregateTagNames,
+	}
+	var limit int64 = 10
+	requestSkeleton := &rpc.AggregateQueryRequest{
+		NameSpace:          ns.Bytes(),
+		RangeStart:         mustToRpcTime(t, opts.StartInclusive),
+		RangeEnd:           mustToRpcTime(t, opts.EndExclusive),
+		Limit:              &limit,
+		TagNameFilter:      [][]byte{[]byte("foo"), []byte("dat")},
+		AggregateQueryType: rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME,
+		RangeTimeType:      rpc.TimeType_UNIX_NANOSECONDS,
+	}
+	requireEqual := func(a, b interface{}) {
+		d := cmp.Diff(a, b)
+		assert.Equal(t, "", d, d)
+	}
+
+	type inputFn func(t *testing.T) (idx.Query, []byte)
+
+	for _, pools := range []struct {
+		name string
+		pool convert.FetchTaggedConversionPools
+	}{
+		{"nil pools", nil},
+		{"valid pools", newTestPools()},
+	} {
+		testCases := []struct {
+			name string
+			fn   inputFn
+		}{
+			{"Term Query", termQueryTestCase},
+			{"Regexp Query", regexpQueryTestCase},
+			{"Conjunction Query A", conjunctionQueryATestCase},
+		}
+		for _, tc := range testCases {
+			t.Run(fmt.Sprintf("(%s pools) Forward %s", pools.name, tc.name), func(t *testing.T) {
+				q, rpcQ := tc.fn(t)
+				expectedReq := &(*requestSkeleton)
+				expectedReq.Query = rpcQ
+				observedReq, err := convert.ToRPCAggregateQueryRequest(ns, index.Query{Query: q}, opts)
+				require.NoError(t, err)
+				requireEqual(expectedReq, &observedReq)
+			})
+			t.Run(fmt.Sprintf("(%s pools) Backward %s", pools.name, tc.name), func(t *testing.T) {
+				expectedQuery, rpcQ := tc.fn(t)
+				rpcRequest := &(*requestSkeleton)
+				rpcRequest.Query = rpcQ
+				id, observedQuery, observedOpts, err := convert.FromRPCAggregateQueryRequest(rpcRequest, pools.pool)
+				require.NoError(t, err)
+				require.Equal(t, ns.String(), id.String())
+				require.True(t, index.NewQueryMatcher(index.Query{Query: expectedQuery}).Matches(observedQuery))
+				requireEqual(opts, observedOpts)
+			})
+		}
+	}
+}
+
 type testPools struct {
 	id      ident.Pool
 	wrapper xpool.CheckedBytesWrapperPool
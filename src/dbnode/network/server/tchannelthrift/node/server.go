@@ -21,10 +21,13 @@
 package node
 
 import (
+	"net"
+
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
 	ns "github.com/m3db/m3/src/dbnode/network/server"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/node/channel"
+	xtls "github.com/m3db/m3/src/x/tls"
 	"github.com/m3db/m3x/context"
 
 	"github.com/uber/tchannel-go"
@@ -35,6 +38,7 @@ type server struct {
 	address     string
 	contextPool context.Pool
 	opts        *tchannel.ChannelOptions
+	tlsProvider xtls.ConfigProvider
 }
 
 // NewServer creates a new node TChannel Thrift network service
@@ -43,6 +47,7 @@ func NewServer(
 	address string,
 	contextPool context.Pool,
 	opts *tchannel.ChannelOptions,
+	tlsProvider xtls.ConfigProvider,
 ) ns.NetworkService {
 	// Make the opts immutable on the way in
 	if opts != nil {
@@ -54,6 +59,7 @@ func NewServer(
 		address:     address,
 		contextPool: contextPool,
 		opts:        opts,
+		tlsProvider: tlsProvider,
 	}
 }
 
@@ -65,7 +71,13 @@ func (s *server) ListenAndServe() (ns.Close, error) {
 
 	tchannelthrift.RegisterServer(channel, rpc.NewTChanNodeServer(s.service), s.contextPool)
 
-	channel.ListenAndServe(s.address)
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		channel.Close()
+		return nil, err
+	}
+
+	channel.Serve(xtls.NewListener(listener, s.tlsProvider))
 
 	return channel.Close, nil
 }
@@ -85,6 +85,7 @@ var (
 type serviceMetrics struct {
 	fetch               instrument.MethodMetrics
 	fetchTagged         instrument.MethodMetrics
+	aggregateQuery      instrument.MethodMetrics
 	write               instrument.MethodMetrics
 	writeTagged         instrument.MethodMetrics
 	fetchBlocks         instrument.MethodMetrics
@@ -101,6 +102,7 @@ func newServiceMetrics(scope tally.Scope, samplingRate float64) serviceMetrics {
 	return serviceMetrics{
 		fetch:               instrument.NewMethodMetrics(scope, "fetch", samplingRate),
 		fetchTagged:         instrument.NewMethodMetrics(scope, "fetchTagged", samplingRate),
+		aggregateQuery:      instrument.NewMethodMetrics(scope, "aggregateQuery", samplingRate),
 		write:               instrument.NewMethodMetrics(scope, "write", samplingRate),
 		writeTagged:         instrument.NewMethodMetrics(scope, "writeTagged", samplingRate),
 		fetchBlocks:         instrument.NewMethodMetrics(scope, "fetchBlocks", samplingRate),
@@ -452,6 +454,50 @@ func (s *service) FetchTagged(tctx thrift.Context, req *rpc.FetchTaggedRequest)
 	return response, nil
 }
 
+func (s *service) AggregateQuery(tctx thrift.Context, req *rpc.AggregateQueryRequest) (*rpc.AggregateQueryResult_, error) {
+	if s.isOverloaded() {
+		s.metrics.overloadRejected.Inc(1)
+		return nil, tterrors.NewInternalError(errServerIsOverloaded)
+	}
+
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+	ns, query, opts, err := convert.FromRPCAggregateQueryRequest(req, s.pools)
+	if err != nil {
+		s.metrics.aggregateQuery.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	queryResult, err := s.db.AggregateQuery(ctx, ns, query, opts)
+	if err != nil {
+		s.metrics.aggregateQuery.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	fields := queryResult.Results.Fields()
+	response := &rpc.AggregateQueryResult_{
+		Results:    make([]*rpc.AggregateQueryResultTagNameElement, 0, len(fields)),
+		Exhaustive: queryResult.Exhaustive,
+	}
+	for _, field := range fields {
+		elem := &rpc.AggregateQueryResultTagNameElement{
+			TagName: field.Name,
+		}
+		if opts.Type == index.AggregateTagNamesAndValues {
+			elem.TagValues = make([]*rpc.AggregateQueryResultTagValueElement, 0, len(field.Values))
+			for _, value := range field.Values {
+				elem.TagValues = append(elem.TagValues, &rpc.AggregateQueryResultTagValueElement{
+					TagValue: value,
+				})
+			}
+		}
+		response.Results = append(response.Results, elem)
+	}
+
+	s.metrics.aggregateQuery.ReportSuccess(s.nowFn().Sub(callStart))
+	return response, nil
+}
+
 func (s *service) encodeTags(
 	enc serialize.TagEncoder,
 	tags ident.TagIterator,
@@ -1311,6 +1311,131 @@ func TestServiceFetchTaggedErrs(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestServiceAggregateQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	start := time.Now().Add(-2 * time.Hour)
+	end := start.Add(2 * time.Hour)
+
+	start, end = start.Truncate(time.Second), end.Truncate(time.Second)
+	nsID := "metrics"
+
+	startNanos, err := convert.ToValue(start, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	endNanos, err := convert.ToValue(end, rpc.TimeType_UNIX_NANOSECONDS)
+	require.NoError(t, err)
+	var limit int64 = 10
+
+	req, err := idx.NewRegexpQuery([]byte("foo"), []byte("b.*"))
+	require.NoError(t, err)
+	data, err := idx.Marshal(req)
+	require.NoError(t, err)
+	qry := index.Query{Query: req}
+
+	results := index.NewAggregateResults(ident.StringID(nsID),
+		index.AggregateTagNamesAndValues)
+	results.AddFieldAndValue([]byte("foo"), []byte("baz"))
+	results.AddFieldAndValue([]byte("foo"), []byte("bar"))
+	results.AddFieldAndValue([]byte("bar"), []byte("qux"))
+
+	mockDB.EXPECT().AggregateQuery(
+		ctx,
+		ident.NewIDMatcher(nsID),
+		index.NewQueryMatcher(qry),
+		index.AggregationOptions{
+			QueryOptions: index.QueryOptions{
+				StartInclusive: start,
+				EndExclusive:   end,
+				Limit:          10,
+			},
+			FieldFilter: [][]byte{[]byte("foo"), []byte("bar")},
+			Type:        index.AggregateTagNamesAndValues,
+		}).Return(index.AggregateQueryResult{
+		Results:    results,
+		Exhaustive: true,
+	}, nil)
+
+	r, err := service.AggregateQuery(tctx, &rpc.AggregateQueryRequest{
+		NameSpace:          []byte(nsID),
+		Query:              data,
+		RangeStart:         startNanos,
+		RangeEnd:           endNanos,
+		Limit:              &limit,
+		TagNameFilter:      [][]byte{[]byte("foo"), []byte("bar")},
+		AggregateQueryType: rpc.AggregateQueryType_AGGREGATE_BY_TAG_NAME_VALUE,
+		RangeTimeType:      rpc.TimeType_UNIX_NANOSECONDS,
+	})
+	require.NoError(t, err)
+
+	require.True(t, r.Exhaustive)
+	require.Equal(t, []*rpc.AggregateQueryResultTagNameElement{
+		{
+			TagName: []byte("bar"),
+			TagValues: []*rpc.AggregateQueryResultTagValueElement{
+				{TagValue: []byte("qux")},
+			},
+		},
+		{
+			TagName: []byte("foo"),
+			TagValues: []*rpc.AggregateQueryResultTagValueElement{
+				{TagValue: []byte("bar")},
+				{TagValue: []byte("baz")},
+			},
+		},
+	}, r.Results)
+}
+
+func TestServiceAggregateQueryErrs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false).Times(2)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	req, err := idx.NewRegexpQuery([]byte("foo"), []byte("b.*"))
+	require.NoError(t, err)
+	data, err := idx.Marshal(req)
+	require.NoError(t, err)
+
+	// Unknown aggregate query types are rejected as bad requests.
+	_, err = service.AggregateQuery(tctx, &rpc.AggregateQueryRequest{
+		NameSpace:          []byte("metrics"),
+		Query:              data,
+		AggregateQueryType: rpc.AggregateQueryType(42),
+		RangeTimeType:      rpc.TimeType_UNIX_NANOSECONDS,
+	})
+	require.Error(t, err)
+	require.True(t, tterrors.IsBadRequestError(err.(*rpc.Error)))
+
+	mockDB.EXPECT().AggregateQuery(ctx, ident.NewIDMatcher("metrics"),
+		gomock.Any(), gomock.Any()).
+		Return(index.AggregateQueryResult{}, fmt.Errorf("random err"))
+	_, err = service.AggregateQuery(tctx, &rpc.AggregateQueryRequest{
+		NameSpace:     []byte("metrics"),
+		Query:         data,
+		RangeTimeType: rpc.TimeType_UNIX_NANOSECONDS,
+	})
+	require.Error(t, err)
+}
+
 func TestServiceWrite(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -42,6 +42,7 @@ import (
 	xerrors "github.com/m3db/m3x/errors"
 	"github.com/m3db/m3x/ident"
 	"github.com/m3db/m3x/instrument"
+	xtime "github.com/m3db/m3x/time"
 
 	"github.com/pborman/uuid"
 )
@@ -60,6 +61,9 @@ const (
 
 	commitLogComponentPosition    = 2
 	indexFileSetComponentPosition = 2
+	dataFileSetComponentPosition  = 2
+
+	numComponentsDataFileSetFirstVolume = 3
 
 	numComponentsSnapshotMetadataFile           = 4
 	numComponentsSnapshotMetadataCheckpointFile = 5
@@ -142,7 +146,7 @@ func (f FileSetFilesSlice) Filepaths() []string {
 }
 
 // LatestVolumeForBlock returns the latest (highest index) FileSetFile in the
-// slice for a given block start, only applicable for index and snapshot file set files.
+// slice for a given block start that has a checkpoint file.
 func (f FileSetFilesSlice) LatestVolumeForBlock(blockStart time.Time) (FileSetFile, bool) {
 	// Make sure we're already sorted
 	f.sortByTimeAndVolumeIndexAscending()
@@ -175,14 +179,6 @@ func (f FileSetFilesSlice) LatestVolumeForBlock(blockStart time.Time) (FileSetFi
 	return FileSetFile{}, false
 }
 
-// ignores the index in the FileSetFileIdentifier because fileset files should
-// always have index 0.
-func (f FileSetFilesSlice) sortByTimeAscending() {
-	sort.Slice(f, func(i, j int) bool {
-		return f[i].ID.BlockStart.Before(f[j].ID.BlockStart)
-	})
-}
-
 func (f FileSetFilesSlice) sortByTimeAndVolumeIndexAscending() {
 	sort.Slice(f, func(i, j int) bool {
 		if f[i].ID.BlockStart.Equal(f[j].ID.BlockStart) {
@@ -341,6 +337,21 @@ func (a fileSetFilesByTimeAndVolumeIndexAscending) Less(i, j int) bool {
 	return ti.Equal(tj) && ii < ij
 }
 
+// dataFileSetFilesByTimeAndVolumeIndexAscending sorts data fileset files where
+// the first volume of a block has no volume index in its file name.
+type dataFileSetFilesByTimeAndVolumeIndexAscending []string
+
+func (a dataFileSetFilesByTimeAndVolumeIndexAscending) Len() int      { return len(a) }
+func (a dataFileSetFilesByTimeAndVolumeIndexAscending) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a dataFileSetFilesByTimeAndVolumeIndexAscending) Less(i, j int) bool {
+	ti, ii, _ := TimeAndVolumeIndexFromDataFileSetFilename(a[i])
+	tj, ij, _ := TimeAndVolumeIndexFromDataFileSetFilename(a[j])
+	if ti.Before(tj) {
+		return true
+	}
+	return ti.Equal(tj) && ii < ij
+}
+
 func componentsAndTimeFromFileName(fname string) ([]string, time.Time, error) {
 	components := strings.Split(filepath.Base(fname), separator)
 	if len(components) < 3 {
@@ -370,6 +381,22 @@ func TimeAndVolumeIndexFromFileSetFilename(fname string) (time.Time, int, error)
 	return timeAndIndexFromFileName(fname, indexFileSetComponentPosition)
 }
 
+// TimeAndVolumeIndexFromDataFileSetFilename extracts the block start and volume
+// index from a data fileset file name, the first volume of a block is written
+// without a volume index in its file name.
+func TimeAndVolumeIndexFromDataFileSetFilename(fname string) (time.Time, int, error) {
+	components, t, err := componentsAndTimeFromFileName(fname)
+	if err != nil {
+		return timeZero, 0, err
+	}
+
+	if len(components) == numComponentsDataFileSetFirstVolume {
+		return t, 0, nil
+	}
+
+	return timeAndIndexFromFileName(fname, dataFileSetComponentPosition)
+}
+
 func timeAndIndexFromFileName(fname string, componentPosition int) (time.Time, int, error) {
 	components, t, err := componentsAndTimeFromFileName(fname)
 	if err != nil {
@@ -509,6 +536,25 @@ func forEachInfoFile(
 		return
 	}
 
+	// Only the latest complete volume of each data fileset is read as later
+	// volumes supersede the earlier volumes written for the same block.
+	var latestDataVolumes map[xtime.UnixNano]int
+	if args.fileSetType == persist.FileSetFlushType &&
+		args.contentType == persist.FileSetDataContentType {
+		latestDataVolumes = make(map[xtime.UnixNano]int, len(matched))
+		for i := range matched {
+			var (
+				id         = matched[i].ID
+				checkpoint = dataFilesetPathFromTimeAndIndex(dir, id.BlockStart,
+					id.VolumeIndex, checkpointFileSuffix)
+			)
+			if exists, err := CompleteCheckpointFileExists(checkpoint); err == nil && exists {
+				// Matched files are sorted by volume index ascending.
+				latestDataVolumes[xtime.ToUnixNano(id.BlockStart)] = id.VolumeIndex
+			}
+		}
+	}
+
 	var indexDigests index.IndexDigests
 	digestBuf := digest.NewBuffer()
 	for i := range matched {
@@ -524,9 +570,12 @@ func forEachInfoFile(
 		case persist.FileSetFlushType:
 			switch args.contentType {
 			case persist.FileSetDataContentType:
-				checkpointFilePath = filesetPathFromTime(dir, t, checkpointFileSuffix)
-				digestsFilePath = filesetPathFromTime(dir, t, digestFileSuffix)
-				infoFilePath = filesetPathFromTime(dir, t, infoFileSuffix)
+				if latest, ok := latestDataVolumes[xtime.ToUnixNano(t)]; !ok || latest != volume {
+					continue
+				}
+				checkpointFilePath = dataFilesetPathFromTimeAndIndex(dir, t, volume, checkpointFileSuffix)
+				digestsFilePath = dataFilesetPathFromTimeAndIndex(dir, t, volume, digestFileSuffix)
+				infoFilePath = dataFilesetPathFromTimeAndIndex(dir, t, volume, infoFileSuffix)
 			case persist.FileSetIndexContentType:
 				checkpointFilePath = filesetPathFromTimeAndIndex(dir, t, volume, checkpointFileSuffix)
 				digestsFilePath = filesetPathFromTimeAndIndex(dir, t, volume, digestFileSuffix)
@@ -589,6 +638,7 @@ func forEachInfoFile(
 
 // ReadInfoFileResult is the result of reading an info file
 type ReadInfoFileResult struct {
+	ID   FileSetFileIdentifier
 	Info schema.IndexInfo
 	Err  ReadInfoFileResultError
 }
@@ -639,6 +689,7 @@ func ReadInfoFiles(
 			decoder.Reset(msgpack.NewDecoderStream(data))
 			info, err := decoder.DecodeIndexInfo()
 			infoFileResults = append(infoFileResults, ReadInfoFileResult{
+				ID:   id,
 				Info: info,
 				Err: readInfoFileResultError{
 					err:      err,
@@ -786,7 +837,8 @@ func IndexSnapshotFiles(filePathPrefix string, namespace ident.ID) (FileSetFiles
 	})
 }
 
-// FileSetAt returns a FileSetFile for the given namespace/shard/blockStart combination if it exists.
+// FileSetAt returns the latest complete volume of the FileSetFile for the given
+// namespace/shard/blockStart combination if it exists.
 func FileSetAt(filePathPrefix string, namespace ident.ID, shard uint32, blockStart time.Time) (FileSetFile, bool, error) {
 	matched, err := filesetFiles(filesetFilesSelector{
 		fileSetType:    persist.FileSetFlushType,
@@ -794,33 +846,40 @@ func FileSetAt(filePathPrefix string, namespace ident.ID, shard uint32, blockSta
 		filePathPrefix: filePathPrefix,
 		namespace:      namespace,
 		shard:          shard,
-		pattern:        filesetFileForTime(blockStart, anyLowerCaseCharsPattern),
+		pattern:        filesetFileForTime(blockStart, anyLowerCaseCharsNumbersPattern),
 	})
 	if err != nil {
 		return FileSetFile{}, false, err
 	}
 
-	matched.sortByTimeAscending()
-	for i, fileset := range matched {
-		if fileset.ID.BlockStart.Equal(blockStart) {
-			nextIdx := i + 1
-			if nextIdx < len(matched) && matched[nextIdx].ID.BlockStart.Equal(blockStart) {
-				// Should never happen
-				return FileSetFile{}, false, fmt.Errorf(
-					"found multiple fileset files for blockStart: %d",
-					blockStart.Unix(),
-				)
-			}
+	fileset, ok := matched.LatestVolumeForBlock(blockStart)
+	return fileset, ok, nil
+}
 
-			if !fileset.HasCheckpointFile() {
-				continue
-			}
+// DataFileSetVolumesAt returns all the volumes of the FileSetFile for the given
+// namespace/shard/blockStart combination, including incomplete volumes.
+func DataFileSetVolumesAt(filePathPrefix string, namespace ident.ID, shard uint32, blockStart time.Time) (FileSetFilesSlice, error) {
+	matched, err := filesetFiles(filesetFilesSelector{
+		fileSetType:    persist.FileSetFlushType,
+		contentType:    persist.FileSetDataContentType,
+		filePathPrefix: filePathPrefix,
+		namespace:      namespace,
+		shard:          shard,
+		pattern:        filesetFileForTime(blockStart, anyLowerCaseCharsNumbersPattern),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-			return fileset, true, nil
+	volumes := make(FileSetFilesSlice, 0, len(matched))
+	for _, fileset := range matched {
+		if fileset.ID.BlockStart.Equal(blockStart) {
+			volumes = append(volumes, fileset)
 		}
 	}
 
-	return FileSetFile{}, false, nil
+	volumes.sortByTimeAndVolumeIndexAscending()
+	return volumes, nil
 }
 
 // IndexFileSetsAt returns all FileSetFile(s) for the given namespace/blockStart combination.
@@ -838,7 +897,7 @@ func IndexFileSetsAt(filePathPrefix string, namespace ident.ID, blockStart time.
 	}
 
 	filesets := make(FileSetFilesSlice, 0, len(matches))
-	matches.sortByTimeAscending()
+	matches.sortByTimeAndVolumeIndexAscending()
 	for _, fileset := range matches {
 		if fileset.ID.BlockStart.Equal(blockStart) {
 			if !fileset.HasCheckpointFile() {
@@ -861,7 +920,45 @@ func DeleteFileSetAt(filePathPrefix string, namespace ident.ID, shard uint32, t
 		return fmt.Errorf("fileset for blockStart: %d does not exist", t.Unix())
 	}
 
-	return DeleteFiles(fileset.AbsoluteFilepaths)
+	volumes, err := DataFileSetVolumesAt(filePathPrefix, namespace, shard, t)
+	if err != nil {
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, volume := range volumes {
+		if volume.ID.VolumeIndex == fileset.ID.VolumeIndex {
+			continue
+		}
+		multiErr = multiErr.Add(DeleteFiles(volume.AbsoluteFilepaths))
+	}
+	multiErr = multiErr.Add(DeleteFiles(fileset.AbsoluteFilepaths))
+	return multiErr.FinalError()
+}
+
+// DeleteDataFileSetVolumesBefore deletes all the volumes of the data fileset for
+// a given namespace/shard/blockStart combination with a volume index lower than
+// the given volume index.
+func DeleteDataFileSetVolumesBefore(
+	filePathPrefix string,
+	namespace ident.ID,
+	shard uint32,
+	blockStart time.Time,
+	volumeIndex int,
+) error {
+	volumes, err := DataFileSetVolumesAt(filePathPrefix, namespace, shard, blockStart)
+	if err != nil {
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, volume := range volumes {
+		if volume.ID.VolumeIndex >= volumeIndex {
+			continue
+		}
+		multiErr = multiErr.Add(DeleteFiles(volume.AbsoluteFilepaths))
+	}
+	return multiErr.FinalError()
 }
 
 // DataFileSetsBefore returns all the flush data fileset files whose timestamps are earlier than a given time.
@@ -979,7 +1076,7 @@ func filesetFiles(args filesetFilesSelector) (FileSetFilesSlice, error) {
 		case persist.FileSetDataContentType:
 			dir := ShardDataDirPath(args.filePathPrefix, args.namespace, args.shard)
 			byTimeAsc, err = findFiles(dir, args.pattern, func(files []string) sort.Interface {
-				return byTimeAscending(files)
+				return dataFileSetFilesByTimeAndVolumeIndexAscending(files)
 			})
 		case persist.FileSetIndexContentType:
 			dir := NamespaceIndexDataDirPath(args.filePathPrefix, args.namespace)
@@ -1029,7 +1126,7 @@ func filesetFiles(args filesetFilesSelector) (FileSetFilesSlice, error) {
 		case persist.FileSetFlushType:
 			switch args.contentType {
 			case persist.FileSetDataContentType:
-				currentFileBlockStart, err = TimeFromFileName(file)
+				currentFileBlockStart, volumeIndex, err = TimeAndVolumeIndexFromDataFileSetFilename(file)
 			case persist.FileSetIndexContentType:
 				currentFileBlockStart, volumeIndex, err = TimeAndVolumeIndexFromFileSetFilename(file)
 			default:
@@ -1181,8 +1278,21 @@ func CommitLogsDirPath(prefix string) string {
 
 // DataFileSetExistsAt determines whether data fileset files exist for the given namespace, shard, and block start.
 func DataFileSetExistsAt(filePathPrefix string, namespace ident.ID, shard uint32, blockStart time.Time) (bool, error) {
+	_, ok, err := FileSetAt(filePathPrefix, namespace, shard, blockStart)
+	return ok, err
+}
+
+// DataFileSetVolumeExistsAt determines whether a given volume of the data fileset files
+// exists for the given namespace, shard, and block start.
+func DataFileSetVolumeExistsAt(
+	filePathPrefix string,
+	namespace ident.ID,
+	shard uint32,
+	blockStart time.Time,
+	volumeIndex int,
+) (bool, error) {
 	shardDir := ShardDataDirPath(filePathPrefix, namespace, shard)
-	checkpointPath := filesetPathFromTime(shardDir, blockStart, checkpointFileSuffix)
+	checkpointPath := dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, checkpointFileSuffix)
 	return CompleteCheckpointFileExists(checkpointPath)
 }
 
@@ -1236,6 +1346,22 @@ func NextSnapshotFileSetVolumeIndex(filePathPrefix string, namespace ident.ID, s
 	return latestFile.ID.VolumeIndex + 1, nil
 }
 
+// NextDataFileSetVolumeIndex returns the next data file set volume index for a given
+// namespace/shard/blockStart combination.
+func NextDataFileSetVolumeIndex(filePathPrefix string, namespace ident.ID, shard uint32, blockStart time.Time) (int, error) {
+	volumes, err := DataFileSetVolumesAt(filePathPrefix, namespace, shard, blockStart)
+	if err != nil {
+		return -1, err
+	}
+
+	if len(volumes) == 0 {
+		return 0, nil
+	}
+
+	// Skip past any incomplete volumes so they are never overwritten.
+	return volumes[len(volumes)-1].ID.VolumeIndex + 1, nil
+}
+
 // NextIndexFileSetVolumeIndex returns the next index file set index for a given
 // namespace/blockStart combination.
 func NextIndexFileSetVolumeIndex(filePathPrefix string, namespace ident.ID, blockStart time.Time) (int, error) {
@@ -1351,6 +1477,33 @@ func filesetPathFromTimeAndIndex(prefix string, t time.Time, index int, suffix s
 	return path.Join(prefix, filesetFileForTime(t, fmt.Sprintf("%d%s%s", index, separator, suffix)))
 }
 
+// dataFilesetPathFromTimeAndIndex keeps the file names of the first volume of a
+// data fileset without a volume index so they match the files written before
+// data filesets had more than one volume.
+func dataFilesetPathFromTimeAndIndex(prefix string, t time.Time, index int, suffix string) string {
+	if index == 0 {
+		return filesetPathFromTime(prefix, t, suffix)
+	}
+	return filesetPathFromTimeAndIndex(prefix, t, index, suffix)
+}
+
+func dataFilesetPathsFromTimeAndIndex(prefix string, t time.Time, index int) []string {
+	suffixes := []string{
+		infoFileSuffix,
+		indexFileSuffix,
+		summariesFileSuffix,
+		bloomFilterFileSuffix,
+		dataFileSuffix,
+		digestFileSuffix,
+		checkpointFileSuffix,
+	}
+	paths := make([]string, 0, len(suffixes))
+	for _, suffix := range suffixes {
+		paths = append(paths, dataFilesetPathFromTimeAndIndex(prefix, t, index, suffix))
+	}
+	return paths
+}
+
 func filesetIndexSegmentFileSuffixFromTime(
 	t time.Time,
 	segmentIndex int,
@@ -212,6 +212,54 @@ func TestForEachInfoFile(t *testing.T) {
 	require.Equal(t, infoData, res)
 }
 
+func TestForEachInfoFileLatestDataVolume(t *testing.T) {
+	var (
+		shard      = uint32(0)
+		dir        = createTempDir(t)
+		shardDir   = ShardDataDirPath(dir, testNs1ID, shard)
+		blockStart = time.Now().Truncate(testBlockSize)
+		otherStart = blockStart.Add(testBlockSize)
+	)
+	defer os.RemoveAll(dir)
+
+	for volume := 0; volume < 3; volume++ {
+		writeOutTestDataVolume(t, dir, shard, blockStart, volume)
+	}
+	writeOutTestDataVolume(t, dir, shard, otherStart, 0)
+
+	// The latest volume is incomplete so the volume before it is read.
+	require.NoError(t, os.Remove(dataFilesetPathFromTimeAndIndex(
+		shardDir, blockStart, 2, checkpointFileSuffix)))
+
+	var (
+		fnames []string
+		ids    []FileSetFileIdentifier
+	)
+	forEachInfoFile(
+		forEachInfoFileSelector{
+			fileSetType:    persist.FileSetFlushType,
+			contentType:    persist.FileSetDataContentType,
+			filePathPrefix: dir,
+			namespace:      testNs1ID,
+			shard:          shard,
+		},
+		testReaderBufferSize,
+		func(fname string, id FileSetFileIdentifier, _ []byte) {
+			fnames = append(fnames, fname)
+			ids = append(ids, id)
+		})
+
+	require.Equal(t, []string{
+		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, 1, infoFileSuffix),
+		dataFilesetPathFromTimeAndIndex(shardDir, otherStart, 0, infoFileSuffix),
+	}, fnames)
+	require.Equal(t, 2, len(ids))
+	require.True(t, blockStart.Equal(ids[0].BlockStart))
+	require.Equal(t, 1, ids[0].VolumeIndex)
+	require.True(t, otherStart.Equal(ids[1].BlockStart))
+	require.Equal(t, 0, ids[1].VolumeIndex)
+}
+
 func TestTimeFromName(t *testing.T) {
 	_, err := TimeFromFileName("foo/bar")
 	require.Error(t, err)
@@ -283,6 +331,41 @@ func TestTimeAndVolumeIndexFromFileSetFilename(t *testing.T) {
 	require.Equal(t, filesetPathFromTimeAndIndex("foo/bar", exp.t, exp.i, "data"), validName)
 }
 
+func TestTimeAndVolumeIndexFromDataFileSetFilename(t *testing.T) {
+	tests := []struct {
+		fname       string
+		expectedT   time.Time
+		expectedIdx int
+		expectedErr bool
+	}{
+		{fname: "foo/bar", expectedErr: true},
+		{fname: "foo/fileset-1-bar-data.db", expectedErr: true},
+		{fname: "fileset-1-data.db", expectedT: time.Unix(0, 1), expectedIdx: 0},
+		{fname: "foo/bar/fileset-21234567890-2-data.db", expectedT: time.Unix(0, 21234567890), expectedIdx: 2},
+	}
+
+	for _, test := range tests {
+		ts, i, err := TimeAndVolumeIndexFromDataFileSetFilename(test.fname)
+		if test.expectedErr {
+			require.Error(t, err, test.fname)
+			continue
+		}
+		require.NoError(t, err, test.fname)
+		require.Equal(t, test.expectedT, ts, test.fname)
+		require.Equal(t, test.expectedIdx, i, test.fname)
+	}
+
+	// The first volume is written without a volume index in its file name.
+	blockStart := time.Unix(0, 21234567890)
+	for _, volume := range []int{0, 1, 2} {
+		fname := dataFilesetPathFromTimeAndIndex("foo/bar", blockStart, volume, infoFileSuffix)
+		ts, i, err := TimeAndVolumeIndexFromDataFileSetFilename(fname)
+		require.NoError(t, err)
+		require.Equal(t, blockStart, ts)
+		require.Equal(t, volume, i)
+	}
+}
+
 func TestSnapshotMetadataFilePathFromIdentifierRoundTrip(t *testing.T) {
 	idUUID := uuid.Parse("bf58eb3e-0582-42ee-83b2-d098c206260e")
 	require.NotNil(t, idUUID)
@@ -594,6 +677,105 @@ func TestNextSnapshotFileSetVolumeIndex(t *testing.T) {
 	}
 }
 
+func TestDataFileSetVolumesAt(t *testing.T) {
+	var (
+		shard      = uint32(0)
+		dir        = createTempDir(t)
+		blockStart = time.Now().Truncate(testBlockSize)
+		otherStart = blockStart.Add(testBlockSize)
+	)
+	defer os.RemoveAll(dir)
+
+	volumes, err := DataFileSetVolumesAt(dir, testNs1ID, shard, blockStart)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(volumes))
+
+	for _, volume := range []int{2, 0, 1} {
+		writeOutTestDataVolume(t, dir, shard, blockStart, volume)
+	}
+	writeOutTestDataVolume(t, dir, shard, otherStart, 0)
+
+	// Incomplete volumes are returned as well.
+	shardDir := ShardDataDirPath(dir, testNs1ID, shard)
+	require.NoError(t, os.Remove(dataFilesetPathFromTimeAndIndex(
+		shardDir, blockStart, 2, checkpointFileSuffix)))
+
+	volumes, err = DataFileSetVolumesAt(dir, testNs1ID, shard, blockStart)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(volumes))
+	for i, volume := range volumes {
+		require.True(t, blockStart.Equal(volume.ID.BlockStart))
+		require.Equal(t, i, volume.ID.VolumeIndex)
+		require.Equal(t, i != 2, volume.HasCheckpointFile())
+	}
+}
+
+func TestNextDataFileSetVolumeIndex(t *testing.T) {
+	var (
+		shard      = uint32(0)
+		dir        = createTempDir(t)
+		shardDir   = ShardDataDirPath(dir, testNs1ID, shard)
+		blockStart = time.Now().Truncate(testBlockSize)
+	)
+	require.NoError(t, os.MkdirAll(shardDir, 0755))
+	defer os.RemoveAll(dir)
+
+	index, err := NextDataFileSetVolumeIndex(dir, testNs1ID, shard, blockStart)
+	require.NoError(t, err)
+	require.Equal(t, 0, index)
+
+	// Check increments properly
+	curr := -1
+	for i := 0; i <= 3; i++ {
+		index, err := NextDataFileSetVolumeIndex(dir, testNs1ID, shard, blockStart)
+		require.NoError(t, err)
+		require.Equal(t, curr+1, index)
+		curr = index
+
+		writeOutTestDataVolume(t, dir, shard, blockStart, index)
+	}
+
+	// Incomplete volumes are skipped rather than overwritten.
+	require.NoError(t, os.Remove(dataFilesetPathFromTimeAndIndex(
+		shardDir, blockStart, curr, checkpointFileSuffix)))
+	index, err = NextDataFileSetVolumeIndex(dir, testNs1ID, shard, blockStart)
+	require.NoError(t, err)
+	require.Equal(t, curr+1, index)
+}
+
+func TestDeleteDataFileSetVolumesBefore(t *testing.T) {
+	var (
+		shard      = uint32(0)
+		dir        = createTempDir(t)
+		blockStart = time.Now().Truncate(testBlockSize)
+		otherStart = blockStart.Add(testBlockSize)
+	)
+	defer os.RemoveAll(dir)
+
+	for volume := 0; volume < 3; volume++ {
+		writeOutTestDataVolume(t, dir, shard, blockStart, volume)
+		writeOutTestDataVolume(t, dir, shard, otherStart, volume)
+	}
+
+	require.NoError(t, DeleteDataFileSetVolumesBefore(dir, testNs1ID, shard, blockStart, 2))
+
+	volumes, err := DataFileSetVolumesAt(dir, testNs1ID, shard, blockStart)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(volumes))
+	require.Equal(t, 2, volumes[0].ID.VolumeIndex)
+	require.True(t, volumes[0].HasCheckpointFile())
+
+	// Volumes of other blocks are not deleted.
+	volumes, err = DataFileSetVolumesAt(dir, testNs1ID, shard, otherStart)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(volumes))
+
+	fileset, ok, err := FileSetAt(dir, testNs1ID, shard, blockStart)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 2, fileset.ID.VolumeIndex)
+}
+
 // TestSortedSnapshotMetadataFiles tests the SortedSnapshotMetadataFiles function by writing out
 // a number of valid snapshot metadata files (along with their checkpoint files), as
 // well as one invalid / corrupt one, and then asserts that the correct number of valid
@@ -1208,6 +1390,22 @@ func writeOutTestSnapshot(
 		t, w, shard, blockStart, volume, entries, persist.FileSetSnapshotType)
 }
 
+func writeOutTestDataVolume(
+	t *testing.T, filePathPrefix string,
+	shard uint32, blockStart time.Time, volume int) {
+	var (
+		entries = []testEntry{
+			{"foo", nil, []byte{1, 2, 3}},
+			{"bar", nil, []byte{4, 5, 6}},
+		}
+		w = newTestWriter(t, filePathPrefix)
+	)
+	defer w.Close()
+
+	writeTestDataWithVolume(
+		t, w, shard, blockStart, volume, entries, persist.FileSetFlushType)
+}
+
 func mustFileExists(t *testing.T, path string) bool {
 	exists, err := FileExists(path)
 	require.NoError(t, err)
@@ -421,7 +421,7 @@ func (pm *persistManager) PrepareData(opts persist.DataPrepareOptions) (persist.
 		return prepared, err
 	}
 
-	var volumeIndex int
+	volumeIndex := opts.Volume.VolumeIndex
 	if opts.FileSetType == persist.FileSetSnapshotType {
 		// Need to work out the volume index for the next snapshot
 		volumeIndex, err = NextSnapshotFileSetVolumeIndex(pm.opts.FilePathPrefix(),
@@ -453,7 +453,14 @@ func (pm *persistManager) PrepareData(opts persist.DataPrepareOptions) (persist.
 	}
 
 	if exists && opts.DeleteIfExists {
-		err := DeleteFileSetAt(pm.opts.FilePathPrefix(), nsID, shard, blockStart)
+		var err error
+		if opts.FileSetType == persist.FileSetFlushType && volumeIndex > 0 {
+			// Only replace the volume being written and not the volumes it supersedes.
+			shardDir := ShardDataDirPath(pm.opts.FilePathPrefix(), nsID, shard)
+			err = DeleteFiles(dataFilesetPathsFromTimeAndIndex(shardDir, blockStart, volumeIndex))
+		} else {
+			err = DeleteFileSetAt(pm.opts.FilePathPrefix(), nsID, shard, blockStart)
+		}
 		if err != nil {
 			return prepared, err
 		}
@@ -611,6 +618,11 @@ func (pm *persistManager) dataFilesetExistsAt(prepareOpts persist.DataPrepareOpt
 		// already exist doesn't make much sense
 		return false, nil
 	case persist.FileSetFlushType:
+		if volumeIndex := prepareOpts.Volume.VolumeIndex; volumeIndex > 0 {
+			// Later volumes are written by cold flushes to supersede the
+			// existing volumes of the fileset.
+			return DataFileSetVolumeExistsAt(pm.filePathPrefix, nsID, shard, blockStart, volumeIndex)
+		}
 		return DataFileSetExistsAt(pm.filePathPrefix, nsID, shard, blockStart)
 	default:
 		return false, fmt.Errorf(
@@ -160,6 +160,16 @@ func (r *blockRetriever) CacheShardIndices(shards []uint32) error {
 	return r.seekerMgr.CacheShardIndices(shards)
 }
 
+func (r *blockRetriever) ReopenBlock(shard uint32, blockStart time.Time) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.status != blockRetrieverOpen {
+		return errBlockRetrieverNotOpen
+	}
+	return r.seekerMgr.Reopen(shard, blockStart)
+}
+
 func (r *blockRetriever) fetchLoop(seekerMgr DataFileSetSeekerManager) {
 	var (
 		inFlight      []*retrieveRequest
@@ -365,20 +375,6 @@ func (r *blockRetriever) Stream(
 	startTime time.Time,
 	onRetrieve block.OnRetrieveBlock,
 ) (xio.BlockReader, error) {
-	req := r.reqPool.Get()
-	req.shard = shard
-	// NB(r): Clone the ID as we're not positive it will stay valid throughout
-	// the lifecycle of the async request.
-	req.id = r.idPool.Clone(id)
-	req.start = startTime
-	req.blockSize = r.blockSize
-
-	req.onRetrieve = onRetrieve
-	req.resultWg.Add(1)
-
-	// Ensure to finalize at the end of request
-	ctx.RegisterFinalizer(req)
-
 	// Capture variable and RLock() because this slice can be modified in the
 	// Open() method
 	r.RLock()
@@ -395,12 +391,27 @@ func (r *blockRetriever) Stream(
 	}
 
 	// If the ID is not in the seeker's bloom filter, then it's definitely not on
-	// disk and we can return immediately
+	// disk and we can return immediately. An empty block reader is returned so
+	// that callers skip it rather than reading an empty stream, which would
+	// fail when merged with data for the block held in memory.
 	if !bloomFilter.Test(id.Bytes()) {
-		// No need to call req.onRetrieve.OnRetrieveBlock if there is no data
-		req.onRetrieved(ts.Segment{})
-		return req.toBlock(), nil
+		return xio.EmptyBlockReader, nil
 	}
+
+	req := r.reqPool.Get()
+	req.shard = shard
+	// NB(r): Clone the ID as we're not positive it will stay valid throughout
+	// the lifecycle of the async request.
+	req.id = r.idPool.Clone(id)
+	req.start = startTime
+	req.blockSize = r.blockSize
+
+	req.onRetrieve = onRetrieve
+	req.resultWg.Add(1)
+
+	// Ensure to finalize at the end of request
+	ctx.RegisterFinalizer(req)
+
 	reqs, err := r.shardRequests(shard)
 	if err != nil {
 		return xio.EmptyBlockReader, err
@@ -263,7 +263,7 @@ func testBlockRetrieverHighConcurrentSeeks(t *testing.T, shouldCacheShardIndices
 
 // TestBlockRetrieverIDDoesNotExist verifies the behavior of the Stream() method
 // on the retriever in the case where the requested ID does not exist. In that
-// case, Stream() should return an empty segment.
+// case, Stream() should return an empty block reader.
 func TestBlockRetrieverIDDoesNotExist(t *testing.T) {
 	// Make sure reader/writer are looking at the same test directory
 	dir, err := ioutil.TempDir("", "testdb")
@@ -300,9 +300,5 @@ func TestBlockRetrieverIDDoesNotExist(t *testing.T) {
 	segmentReader, err := retriever.Stream(ctx, shard,
 		ident.StringID("not-exists"), blockStart, nil)
 	assert.NoError(t, err)
-
-	segment, err := segmentReader.Segment()
-	assert.NoError(t, err)
-	assert.Equal(t, nil, segment.Head)
-	assert.Equal(t, nil, segment.Tail)
+	assert.True(t, segmentReader.IsEmpty())
 }
@@ -161,17 +161,28 @@ func (s *seeker) Open(namespace ident.ID, shard uint32, blockStart time.Time) er
 		return errClonesShouldNotBeOpened
 	}
 
+	// Open the latest complete volume as later volumes contain the data of
+	// earlier volumes merged with any cold writes for the block.
+	volumeIndex := 0
+	fileset, ok, err := FileSetAt(s.filePathPrefix, namespace, shard, blockStart)
+	if err != nil {
+		return err
+	}
+	if ok {
+		volumeIndex = fileset.ID.VolumeIndex
+	}
+
 	shardDir := ShardDataDirPath(s.filePathPrefix, namespace, shard)
 	var infoFd, indexFd, dataFd, digestFd, bloomFilterFd, summariesFd *os.File
 
 	// Open necessary files
 	if err := openFiles(os.Open, map[string]**os.File{
-		filesetPathFromTime(shardDir, blockStart, infoFileSuffix):        &infoFd,
-		filesetPathFromTime(shardDir, blockStart, indexFileSuffix):       &indexFd,
-		filesetPathFromTime(shardDir, blockStart, dataFileSuffix):        &dataFd,
-		filesetPathFromTime(shardDir, blockStart, digestFileSuffix):      &digestFd,
-		filesetPathFromTime(shardDir, blockStart, bloomFilterFileSuffix): &bloomFilterFd,
-		filesetPathFromTime(shardDir, blockStart, summariesFileSuffix):   &summariesFd,
+		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, infoFileSuffix):        &infoFd,
+		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, indexFileSuffix):       &indexFd,
+		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, dataFileSuffix):        &dataFd,
+		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, digestFileSuffix):      &digestFd,
+		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, bloomFilterFileSuffix): &bloomFilterFd,
+		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, summariesFileSuffix):   &summariesFd,
 	}); err != nil {
 		return err
 	}
@@ -208,12 +219,12 @@ func (s *seeker) Open(namespace ident.ID, shard uint32, blockStart time.Time) er
 		},
 	}
 	mmapResult, err := mmap.Files(os.Open, map[string]mmap.FileDesc{
-		filesetPathFromTime(shardDir, blockStart, indexFileSuffix): mmap.FileDesc{
+		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, indexFileSuffix): mmap.FileDesc{
 			File:    &indexFd,
 			Bytes:   &s.indexMmap,
 			Options: mmapOptions,
 		},
-		filesetPathFromTime(shardDir, blockStart, dataFileSuffix): mmap.FileDesc{
+		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, dataFileSuffix): mmap.FileDesc{
 			File:    &dataFd,
 			Bytes:   &s.dataMmap,
 			Options: mmapOptions,
@@ -250,7 +261,7 @@ func (s *seeker) Open(namespace ident.ID, shard uint32, blockStart time.Time) er
 		s.Close()
 		return fmt.Errorf(
 			"index file digest for file: %s does not match the expected digest",
-			filesetPathFromTime(shardDir, blockStart, indexFileSuffix),
+			dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, indexFileSuffix),
 		)
 	}
 
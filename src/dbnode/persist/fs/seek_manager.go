@@ -107,6 +107,10 @@ type seekersByTime struct {
 	shard    uint32
 	accessed bool
 	seekers  map[xtime.UnixNano]seekersAndBloom
+	// inactive contains seekers for volumes that have been superseded by a
+	// newer volume while some of the seekers were still borrowed, they are
+	// closed once all of them have been returned.
+	inactive map[xtime.UnixNano][]seekersAndBloom
 }
 
 type seekerManagerPendingClose struct {
@@ -234,6 +238,15 @@ func (m *seekerManager) Return(shard uint32, start time.Time, seeker ConcurrentD
 
 	startNano := xtime.ToUnixNano(start)
 	seekersAndBloom, ok := byTime.seekers[startNano]
+	if ok && seekersAndBloom.markReturned(seeker) {
+		return nil
+	}
+
+	// The seekers may have been superseded by a newer volume while borrowed.
+	if returned, err := m.returnInactiveSeekerWithLock(byTime, startNano, seeker); returned {
+		return err
+	}
+
 	// Should never happen - This either means that the caller (DataBlockRetriever) is trying to return seekers
 	// that it never requested, OR its trying to return seekers after the openCloseLoop has already
 	// determined that they were all no longer in use and safe to close. Either way it indicates there is
@@ -242,22 +255,105 @@ func (m *seekerManager) Return(shard uint32, start time.Time, seeker ConcurrentD
 		return errSeekersDontExist
 	}
 
-	found := false
-	for i, compareSeeker := range seekersAndBloom.seekers {
+	// Should never happen with a well behaved caller. Either they are trying to return a seeker
+	// that we're not managing, or they provided the wrong shard/start.
+	return errReturnedUnmanagedSeeker
+}
+
+func (m *seekerManager) returnInactiveSeekerWithLock(
+	byTime *seekersByTime,
+	start xtime.UnixNano,
+	seeker ConcurrentDataFileSetSeeker,
+) (bool, error) {
+	inactive := byTime.inactive[start]
+	for i, seekers := range inactive {
+		if !seekers.markReturned(seeker) {
+			continue
+		}
+
+		if seekers.anyBorrowed() {
+			return true, nil
+		}
+
+		// All seekers of the superseded volume have been returned, it is now
+		// safe to close them as none of the clones are in use.
+		inactive = append(inactive[:i], inactive[i+1:]...)
+		if len(inactive) == 0 {
+			delete(byTime.inactive, start)
+		} else {
+			byTime.inactive[start] = inactive
+		}
+		return true, seekers.close()
+	}
+
+	return false, nil
+}
+
+// Reopen closes the open seekers for a given shard and block start so that
+// subsequent borrows open the latest volume of the fileset, seekers that are
+// borrowed are closed once they have all been returned.
+func (m *seekerManager) Reopen(shard uint32, start time.Time) error {
+	byTime := m.seekersByTime(shard)
+
+	byTime.Lock()
+	defer byTime.Unlock()
+
+	startNano := xtime.ToUnixNano(start)
+	for {
+		seekers, ok := byTime.seekers[startNano]
+		if !ok {
+			// Nothing open yet, the next borrow will open the latest volume.
+			return nil
+		}
+
+		if seekers.wg != nil {
+			// Seekers are being opened, possibly against the previous volume,
+			// wait for that to complete and then close them.
+			byTime.Unlock()
+			seekers.wg.Wait()
+			byTime.Lock()
+			continue
+		}
+
+		delete(byTime.seekers, startNano)
+		if seekers.anyBorrowed() {
+			if byTime.inactive == nil {
+				byTime.inactive = make(map[xtime.UnixNano][]seekersAndBloom)
+			}
+			byTime.inactive[startNano] = append(byTime.inactive[startNano], seekers)
+			return nil
+		}
+
+		return seekers.close()
+	}
+}
+
+func (s seekersAndBloom) markReturned(seeker ConcurrentDataFileSetSeeker) bool {
+	for i, compareSeeker := range s.seekers {
 		if seeker == compareSeeker.seeker {
-			found = true
 			compareSeeker.isBorrowed = false
-			seekersAndBloom.seekers[i] = compareSeeker
-			break
+			s.seekers[i] = compareSeeker
+			return true
 		}
 	}
-	// Should never happen with a well behaved caller. Either they are trying to return a seeker
-	// that we're not managing, or they provided the wrong shard/start.
-	if !found {
-		return errReturnedUnmanagedSeeker
+	return false
+}
+
+func (s seekersAndBloom) anyBorrowed() bool {
+	for _, seeker := range s.seekers {
+		if seeker.isBorrowed {
+			return true
+		}
 	}
+	return false
+}
 
-	return nil
+func (s seekersAndBloom) close() error {
+	multiErr := xerrors.NewMultiError()
+	for _, seeker := range s.seekers {
+		multiErr = multiErr.Add(seeker.seeker.Close())
+	}
+	return multiErr.FinalError()
 }
 
 // getOrOpenSeekersWithLock checks if the seekers are already open / initialized. If they are, then it
@@ -459,6 +555,12 @@ func (m *seekerManager) Close() error {
 				}
 			}
 		}
+		if len(byTime.inactive) > 0 {
+			// Inactive seekers are only retained while some are borrowed.
+			byTime.Unlock()
+			m.Unlock()
+			return errCantCloseSeekerManagerWhileSeekersAreBorrowed
+		}
 		byTime.Unlock()
 	}
 
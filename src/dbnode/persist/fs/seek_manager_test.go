@@ -275,3 +275,78 @@ func TestSeekerManagerOpenCloseLoop(t *testing.T) {
 	// to prevent the test itself from interfering with the goroutine leak test
 	close(cleanupCh)
 }
+
+// TestSeekerManagerReopen tests that the Reopen() method closes the seekers
+// that are not borrowed and only closes the borrowed seekers once they have
+// all been returned.
+func TestSeekerManagerReopen(t *testing.T) {
+	defer leaktest.CheckTimeout(t, 1*time.Minute)()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		shard  = uint32(2)
+		start  = time.Now().Truncate(time.Hour)
+		opened int
+		closed int
+	)
+	m := NewSeekerManager(nil, testDefaultOpts, defaultFetchConcurrency).(*seekerManager)
+	m.newOpenSeekerFn = func(
+		shard uint32,
+		blockStart time.Time,
+	) (DataFileSetSeeker, error) {
+		newSeeker := func() *MockDataFileSetSeeker {
+			mock := NewMockDataFileSetSeeker(ctrl)
+			mock.EXPECT().Close().Do(func() { closed++ }).Return(nil)
+			return mock
+		}
+		opened++
+		mock := newSeeker()
+		for i := 0; i < defaultFetchConcurrency-1; i++ {
+			mock.EXPECT().ConcurrentClone().Return(newSeeker(), nil)
+		}
+		mock.EXPECT().ConcurrentIDBloomFilter().Return(nil)
+		return mock, nil
+	}
+
+	// Nothing is opened or closed if there are no seekers open.
+	require.NoError(t, m.Reopen(shard, start))
+	require.Equal(t, 0, opened)
+
+	// Seekers that are not borrowed are closed immediately.
+	seeker, err := m.Borrow(shard, start)
+	require.NoError(t, err)
+	require.NoError(t, m.Return(shard, start, seeker))
+	require.Equal(t, 1, opened)
+
+	require.NoError(t, m.Reopen(shard, start))
+	require.Equal(t, defaultFetchConcurrency, closed)
+	_, ok := m.seekersByTime(shard).seekers[xtime.ToUnixNano(start)]
+	require.False(t, ok)
+
+	// Borrowed seekers are kept inactive until they are returned while any
+	// subsequent borrows open the seekers again.
+	inactiveSeeker, err := m.Borrow(shard, start)
+	require.NoError(t, err)
+	require.Equal(t, 2, opened)
+
+	require.NoError(t, m.Reopen(shard, start))
+	require.Equal(t, defaultFetchConcurrency, closed)
+	require.Equal(t, 1, len(m.seekersByTime(shard).inactive[xtime.ToUnixNano(start)]))
+
+	seeker, err = m.Borrow(shard, start)
+	require.NoError(t, err)
+	require.Equal(t, 3, opened)
+	require.False(t, seeker == inactiveSeeker)
+
+	require.NoError(t, m.Return(shard, start, inactiveSeeker))
+	require.Equal(t, 2*defaultFetchConcurrency, closed)
+	require.Equal(t, 0, len(m.seekersByTime(shard).inactive))
+
+	require.NoError(t, m.Return(shard, start, seeker))
+	require.Equal(t, errReturnedUnmanagedSeeker, m.Return(shard, start, inactiveSeeker))
+
+	require.NoError(t, m.Reopen(shard, start))
+	require.Equal(t, 3*defaultFetchConcurrency, closed)
+}
@@ -104,8 +104,9 @@ type DataFileSetReaderStatus struct {
 	Namespace  ident.ID
 	BlockStart time.Time
 
-	Shard uint32
-	Open  bool
+	Shard       uint32
+	VolumeIndex int
+	Open        bool
 }
 
 // DataReaderOpenOptions is options struct for the reader open method.
@@ -239,6 +240,10 @@ type DataFileSetSeekerManager interface {
 	// Return returns an open seeker for a given shard and block start time.
 	Return(shard uint32, start time.Time, seeker ConcurrentDataFileSetSeeker) error
 
+	// Reopen closes the seekers for a given shard and block start time so that
+	// subsequent borrows open the latest volume of the fileset.
+	Reopen(shard uint32, start time.Time) error
+
 	// ConcurrentIDBloomFilter returns a concurrent ID bloom filter for a given
 	// shard and block start time
 	ConcurrentIDBloomFilter(shard uint32, start time.Time) (*ManagedConcurrentBloomFilter, error)
@@ -140,11 +140,14 @@ type DataPrepareOptions struct {
 	DeleteIfExists    bool
 	// Snapshot options are applicable to snapshots (index yes, data yes)
 	Snapshot DataPrepareSnapshotOptions
+	// Volume options are applicable to flushes (data yes), the volume index of
+	// snapshots is always the next available volume.
+	Volume DataPrepareVolumeOptions
 }
 
 // DataPrepareVolumeOptions is the options struct for the prepare method that contains
 // information specific to read/writing filesets that have multiple volumes (such as
-// snapshots and index file sets).
+// snapshots, index file sets and data file sets that include cold writes).
 type DataPrepareVolumeOptions struct {
 	VolumeIndex int
 }
@@ -45,6 +45,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/environment"
 	"github.com/m3db/m3/src/dbnode/kvconfig"
+	"github.com/m3db/m3/src/dbnode/network/server/httpjson"
 	hjcluster "github.com/m3db/m3/src/dbnode/network/server/httpjson/cluster"
 	hjnode "github.com/m3db/m3/src/dbnode/network/server/httpjson/node"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift"
@@ -81,6 +82,7 @@ import (
 
 	"github.com/coreos/etcd/embed"
 	"github.com/coreos/pkg/capnslog"
+	"github.com/golang/protobuf/proto"
 	"github.com/uber-go/tally"
 )
 
@@ -504,6 +506,7 @@ func Run(runOpts RunOptions) {
 	clientAdminOpts := m3dbClient.Options().(client.AdminOptions)
 	kvWatchClientConsistencyLevels(envCfg.KVStore, logger,
 		clientAdminOpts, runtimeOptsMgr)
+	kvWatchRuntimeOptions(envCfg.KVStore, logger, runtimeOptsMgr, runtimeOpts)
 
 	opts = opts.
 		// Feature currently not working.
@@ -564,11 +567,20 @@ func Run(runOpts RunOptions) {
 
 	contextPool := opts.ContextPool()
 
+	tlsProvider, err := cfg.TLS.NewConfigProvider(iopts)
+	if err != nil {
+		logger.Fatalf("could not create tls config provider: %v", err)
+	}
+	if tlsProvider != nil {
+		defer tlsProvider.Close()
+		logger.Info("tls enabled for node and cluster listeners")
+	}
+
 	tchannelOpts := xtchannel.NewDefaultChannelOptions()
 	service := ttnode.NewService(db, ttopts)
 
 	tchannelthriftNodeClose, err := ttnode.NewServer(service,
-		cfg.ListenAddress, contextPool, tchannelOpts).ListenAndServe()
+		cfg.ListenAddress, contextPool, tchannelOpts, tlsProvider).ListenAndServe()
 	if err != nil {
 		logger.Fatalf("could not open tchannelthrift interface on %s: %v",
 			cfg.ListenAddress, err)
@@ -577,7 +589,7 @@ func Run(runOpts RunOptions) {
 	logger.Infof("node tchannelthrift: listening on %v", cfg.ListenAddress)
 
 	tchannelthriftClusterClose, err := ttcluster.NewServer(m3dbClient,
-		cfg.ClusterListenAddress, contextPool, tchannelOpts).ListenAndServe()
+		cfg.ClusterListenAddress, contextPool, tchannelOpts, tlsProvider).ListenAndServe()
 	if err != nil {
 		logger.Fatalf("could not open tchannelthrift interface on %s: %v",
 			cfg.ClusterListenAddress, err)
@@ -585,8 +597,9 @@ func Run(runOpts RunOptions) {
 	defer tchannelthriftClusterClose()
 	logger.Infof("cluster tchannelthrift: listening on %v", cfg.ClusterListenAddress)
 
+	httpjsonOpts := httpjson.NewServerOptions().SetTLSConfigProvider(tlsProvider)
 	httpjsonNodeClose, err := hjnode.NewServer(service,
-		cfg.HTTPNodeListenAddress, contextPool, nil).ListenAndServe()
+		cfg.HTTPNodeListenAddress, contextPool, httpjsonOpts).ListenAndServe()
 	if err != nil {
 		logger.Fatalf("could not open httpjson interface on %s: %v",
 			cfg.HTTPNodeListenAddress, err)
@@ -595,7 +608,7 @@ func Run(runOpts RunOptions) {
 	logger.Infof("node httpjson: listening on %v", cfg.HTTPNodeListenAddress)
 
 	httpjsonClusterClose, err := hjcluster.NewServer(m3dbClient,
-		cfg.HTTPClusterListenAddress, contextPool, nil).ListenAndServe()
+		cfg.HTTPClusterListenAddress, contextPool, httpjsonOpts).ListenAndServe()
 	if err != nil {
 		logger.Fatalf("could not open httpjson interface on %s: %v",
 			cfg.HTTPClusterListenAddress, err)
@@ -832,6 +845,67 @@ func kvWatchClientConsistencyLevels(
 		})
 }
 
+func kvWatchRuntimeOptions(
+	store kv.Store,
+	logger xlog.Logger,
+	runtimeOptsMgr m3dbruntime.OptionsManager,
+	defaults m3dbruntime.Options,
+) {
+	setPersistRateLimitOptions := func(
+		applyFn func(ratelimit.Options) ratelimit.Options,
+	) error {
+		runtimeOpts := runtimeOptsMgr.Get()
+		rateLimitOpts := applyFn(runtimeOpts.PersistRateLimitOptions())
+		return runtimeOptsMgr.Update(runtimeOpts.SetPersistRateLimitOptions(rateLimitOpts))
+	}
+
+	persistRateLimitEnabled := &commonpb.BoolProto{}
+	kvWatchValue(store, logger,
+		kvconfig.PersistRateLimitEnabledKey,
+		persistRateLimitEnabled,
+		func() error {
+			return setPersistRateLimitOptions(func(opts ratelimit.Options) ratelimit.Options {
+				return opts.SetLimitEnabled(persistRateLimitEnabled.Value)
+			})
+		},
+		func() error {
+			return setPersistRateLimitOptions(func(opts ratelimit.Options) ratelimit.Options {
+				return opts.SetLimitEnabled(defaults.PersistRateLimitOptions().LimitEnabled())
+			})
+		})
+
+	persistRateLimitMbps := &commonpb.Float64Proto{}
+	kvWatchValue(store, logger,
+		kvconfig.PersistRateLimitMbpsKey,
+		persistRateLimitMbps,
+		func() error {
+			if persistRateLimitMbps.Value <= 0 {
+				return fmt.Errorf("invalid persist rate limit set: %v", persistRateLimitMbps.Value)
+			}
+			return setPersistRateLimitOptions(func(opts ratelimit.Options) ratelimit.Options {
+				return opts.SetLimitMbps(persistRateLimitMbps.Value)
+			})
+		},
+		func() error {
+			return setPersistRateLimitOptions(func(opts ratelimit.Options) ratelimit.Options {
+				return opts.SetLimitMbps(defaults.PersistRateLimitOptions().LimitMbps())
+			})
+		})
+
+	writeNewSeriesAsync := &commonpb.BoolProto{}
+	kvWatchValue(store, logger,
+		kvconfig.WriteNewSeriesAsyncKey,
+		writeNewSeriesAsync,
+		func() error {
+			return runtimeOptsMgr.Update(runtimeOptsMgr.Get().
+				SetWriteNewSeriesAsync(writeNewSeriesAsync.Value))
+		},
+		func() error {
+			return runtimeOptsMgr.Update(runtimeOptsMgr.Get().
+				SetWriteNewSeriesAsync(defaults.WriteNewSeriesAsync()))
+		})
+}
+
 func kvWatchStringValue(
 	store kv.Store,
 	logger xlog.Logger,
@@ -840,7 +914,23 @@ func kvWatchStringValue(
 	onDelete func() error,
 ) {
 	protoValue := &commonpb.StringProto{}
+	kvWatchValue(store, logger, key, protoValue,
+		func() error {
+			return onValue(protoValue.Value)
+		},
+		onDelete)
+}
 
+// kvWatchValue unmarshals each value of the key into protoValue before
+// calling onValue, and calls onDelete when the key is removed.
+func kvWatchValue(
+	store kv.Store,
+	logger xlog.Logger,
+	key string,
+	protoValue proto.Message,
+	onValue func() error,
+	onDelete func() error,
+) {
 	// First try to eagerly set the value so it doesn't flap if the
 	// watch returns but not immediately for an existing value
 	value, err := store.Get(key)
@@ -850,10 +940,10 @@ func kvWatchStringValue(
 	if err == nil {
 		if err := value.Unmarshal(protoValue); err != nil {
 			logger.Errorf("could not unmarshal KV key %s: %v", key, err)
-		} else if err := onValue(protoValue.Value); err != nil {
+		} else if err := onValue(); err != nil {
 			logger.Errorf("could not process value of KV key %s: %v", key, err)
 		} else {
-			logger.Infof("set KV key %s: %v", key, protoValue.Value)
+			logger.Infof("set KV key %s: %v", key, protoValue.String())
 		}
 	}
 
@@ -878,11 +968,11 @@ func kvWatchStringValue(
 				logger.Warnf("could not unmarshal KV key %s: %v", key, err)
 				continue
 			}
-			if err := onValue(protoValue.Value); err != nil {
+			if err := onValue(); err != nil {
 				logger.Warnf("could not process change for KV key %s: %v", key, err)
 				continue
 			}
-			logger.Infof("set KV key %s: %v", key, protoValue.Value)
+			logger.Infof("set KV key %s: %v", key, protoValue.String())
 		}
 	}()
 }
@@ -264,6 +264,10 @@ type DatabaseBlockRetriever interface {
 	// to improve times when streaming a block.
 	CacheShardIndices(shards []uint32) error
 
+	// ReopenBlock makes subsequent streams of a block for a given shard and
+	// start read from the latest volume of the block written to disk.
+	ReopenBlock(shard uint32, blockStart time.Time) error
+
 	// Stream will stream a block for a given shard, id and start.
 	Stream(
 		ctx context.Context,
@@ -32,6 +32,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
+	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
@@ -141,12 +142,13 @@ func (s *commitLogSource) ReadData(
 		encounteredCorruptData = false
 		fsOpts                 = s.opts.CommitLogOptions().FilesystemOptions()
 		filePathPrefix         = fsOpts.FilePathPrefix()
+		readTimeRanges         = s.dataReadTimeRanges(ns, shardsTimeRanges)
 	)
 	defer doneReadingData()
 
 	// Determine which snapshot files are available.
 	snapshotFilesByShard, err := s.snapshotFilesByShard(
-		ns.ID(), filePathPrefix, shardsTimeRanges)
+		ns.ID(), filePathPrefix, readTimeRanges)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +160,7 @@ func (s *commitLogSource) ReadData(
 	)
 
 	readCommitLogPred, mostRecentCompleteSnapshotByBlockShard, err := s.newReadCommitlogPredAndMostRecentSnapshotByBlockShard(
-		ns, shardsTimeRanges, snapshotFilesByShard)
+		ns, readTimeRanges, snapshotFilesByShard)
 	if err != nil {
 		return nil, err
 	}
@@ -211,11 +213,11 @@ func (s *commitLogSource) ReadData(
 	var (
 		// +1 so we can use the shard number as an index throughout without constantly
 		// remembering to subtract 1 to convert to zero-based indexing
-		numShards        = s.findHighestShard(shardsTimeRanges) + 1
+		numShards        = s.findHighestShard(readTimeRanges) + 1
 		numConc          = s.opts.EncodingConcurrency()
 		encoderPool      = blOpts.EncoderPool()
 		workerErrs       = make([]int, numConc)
-		shardDataByShard = s.newShardDataByShard(shardsTimeRanges, numShards)
+		shardDataByShard = s.newShardDataByShard(readTimeRanges, numShards)
 	)
 
 	encoderChans := make([]chan encoderArg, numConc)
@@ -287,6 +289,7 @@ func (s *commitLogSource) ReadData(
 	bootstrapResult, err := s.mergeAllShardsCommitLogEncodersAndSnapshots(
 		ns,
 		shardsTimeRanges,
+		readTimeRanges,
 		snapshotFilesByShard,
 		mostRecentCompleteSnapshotByBlockShard,
 		int(numShards),
@@ -311,6 +314,41 @@ func (s *commitLogSource) ReadData(
 	return bootstrapResult, nil
 }
 
+// dataReadTimeRanges returns the time ranges to read data for, which when cold
+// writes are enabled extends the ranges to bootstrap to the entire retention
+// period. Cold writes to blocks that have already been flushed are only held
+// in memory until they are cold flushed, however the blocks are fulfilled by
+// the filesystem bootstrapper so they would otherwise never be read from the
+// commit logs and snapshots. Only blocks with unflushed writes produce data
+// for the extended ranges, as snapshots are only taken of blocks with
+// buffered writes and commit logs are cleaned up once they are snapshotted.
+func (s *commitLogSource) dataReadTimeRanges(
+	ns namespace.Metadata,
+	shardsTimeRanges result.ShardTimeRanges,
+) result.ShardTimeRanges {
+	if !ns.Options().ColdWritesEnabled() {
+		return shardsTimeRanges
+	}
+
+	var (
+		ropts    = ns.Options().RetentionOptions()
+		now      = s.opts.ResultOptions().ClockOptions().NowFn()()
+		_, max   = shardsTimeRanges.MinMax()
+		retained = xtime.Range{
+			Start: retention.FlushTimeStart(ropts, now),
+			End:   max,
+		}
+		readTimeRanges = shardsTimeRanges.Copy()
+	)
+	if retained.IsEmpty() {
+		return readTimeRanges
+	}
+	for shard, ranges := range readTimeRanges {
+		readTimeRanges[shard] = ranges.AddRange(retained)
+	}
+	return readTimeRanges
+}
+
 func (s *commitLogSource) snapshotFilesByShard(
 	nsID ident.ID,
 	filePathPrefix string,
@@ -807,6 +845,7 @@ func (s *commitLogSource) shouldIncludeInIndex(
 func (s *commitLogSource) mergeAllShardsCommitLogEncodersAndSnapshots(
 	ns namespace.Metadata,
 	shardsTimeRanges result.ShardTimeRanges,
+	readTimeRanges result.ShardTimeRanges,
 	snapshotFiles map[uint32]fs.FileSetFilesSlice,
 	mostRecentCompleteSnapshotByBlockShard map[xtime.UnixNano]map[uint32]fs.FileSetFile,
 	numShards int,
@@ -834,7 +873,7 @@ func (s *commitLogSource) mergeAllShardsCommitLogEncodersAndSnapshots(
 			ns.ID(),
 			uint32(shard),
 			false,
-			shardsTimeRanges[uint32(shard)],
+			readTimeRanges[uint32(shard)],
 			blockSize,
 			snapshotFiles[uint32(shard)],
 			mostRecentCompleteSnapshotByBlockShard,
@@ -858,7 +897,7 @@ func (s *commitLogSource) mergeAllShardsCommitLogEncodersAndSnapshots(
 		mergeShardFunc := func() {
 			var shardResult result.ShardResult
 			shardResult, shardEmptyErrs[shard], shardErrs[shard] = s.mergeShardCommitLogEncodersAndSnapshots(
-				shard, snapshotData, unmergedShard, blockSize)
+				shard, shardsTimeRanges[uint32(shard)], snapshotData, unmergedShard, blockSize)
 
 			if shardResult != nil && shardResult.NumSeries() > 0 {
 				// Prevent race conditions while updating bootstrapResult from multiple go-routines
@@ -885,6 +924,7 @@ func (s *commitLogSource) mergeAllShardsCommitLogEncodersAndSnapshots(
 
 func (s *commitLogSource) mergeShardCommitLogEncodersAndSnapshots(
 	shard int,
+	bootstrapRanges xtime.Ranges,
 	snapshotData result.ShardResult,
 	unmergedShard shardData,
 	blockSize time.Duration,
@@ -927,7 +967,8 @@ func (s *commitLogSource) mergeShardCommitLogEncodersAndSnapshots(
 			)
 
 			if seriesBlocks != nil && seriesBlocks.Len() > 0 {
-				shardResult.AddSeries(val.id, val.tags, seriesBlocks)
+				addSeriesBlocks(shardResult, bootstrapRanges, val.id, val.tags,
+					seriesBlocks, blockSize)
 			}
 
 			numShardEmptyErrs += numSeriesEmptyErrs
@@ -950,11 +991,39 @@ func (s *commitLogSource) mergeShardCommitLogEncodersAndSnapshots(
 			continue
 		}
 
-		shardResult.AddSeries(id, blocks.Tags, blocks.Blocks)
+		addSeriesBlocks(shardResult, bootstrapRanges, id, blocks.Tags,
+			blocks.Blocks, blockSize)
 	}
 	return shardResult, numShardEmptyErrs, numErrs
 }
 
+// addSeriesBlocks adds the blocks of a series to a shard result, blocks outside
+// of the ranges to bootstrap have already been flushed and are only read when
+// cold writes are enabled, so they are added as cold blocks to be loaded as
+// cold writes rather than replacing the flushed data.
+func addSeriesBlocks(
+	shardResult result.ShardResult,
+	bootstrapRanges xtime.Ranges,
+	id ident.ID,
+	tags ident.Tags,
+	blocks block.DatabaseSeriesBlocks,
+	blockSize time.Duration,
+) {
+	if blocks == nil {
+		return
+	}
+
+	for _, b := range blocks.AllBlocks() {
+		start := b.StartTime()
+		blockRange := xtime.Range{Start: start, End: start.Add(blockSize)}
+		if bootstrapRanges.Overlaps(blockRange) {
+			shardResult.AddBlock(id, tags, b)
+			continue
+		}
+		shardResult.AddColdBlock(id, tags, b)
+	}
+}
+
 func (s *commitLogSource) mergeSeries(
 	snapshotData result.DatabaseSeriesBlocks,
 	unmergedCommitlogBlocks metadataAndEncodersByTime,
@@ -277,6 +277,69 @@ func TestReadTrimsToRanges(t *testing.T) {
 		values[1:3], blockSize, res.ShardResults(), opts))
 }
 
+func TestReadColdWritesForEntireRetention(t *testing.T) {
+	tests := []struct {
+		name              string
+		coldWritesEnabled bool
+	}{
+		{name: "cold writes disabled", coldWritesEnabled: false},
+		{name: "cold writes enabled", coldWritesEnabled: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := testDefaultOpts
+			md, err := namespace.NewMetadata(testNamespaceID,
+				namespace.NewOptions().SetColdWritesEnabled(test.coldWritesEnabled))
+			require.NoError(t, err)
+			src := newCommitLogSource(opts, fs.Inspection{}).(*commitLogSource)
+
+			blockSize := md.Options().RetentionOptions().BlockSize()
+			now := time.Now()
+			start := now.Truncate(blockSize)
+			end := start.Add(blockSize)
+
+			// Only the current block is requested as the blocks before it
+			// have been flushed and are fulfilled by the filesystem.
+			ranges := xtime.Ranges{}
+			ranges = ranges.AddRange(xtime.Range{
+				Start: start,
+				End:   end,
+			})
+
+			foo := ts.Series{Namespace: testNamespaceID, Shard: 0, ID: ident.StringID("foo")}
+
+			values := []testValue{
+				{foo, start.Add(-3 * blockSize), 1.0, xtime.Nanosecond, nil},
+				{foo, start, 2.0, xtime.Nanosecond, nil},
+			}
+			src.newIteratorFn = func(_ commitlog.IteratorOpts) (commitlog.Iterator, []commitlog.ErrorWithPath, error) {
+				return newTestCommitLogIterator(values, nil), nil, nil
+			}
+
+			targetRanges := result.ShardTimeRanges{0: ranges}
+			res, err := src.ReadData(md, targetRanges, testDefaultRunOpts)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			require.Equal(t, 0, len(res.Unfulfilled()))
+			require.NoError(t, verifyShardResultsAreCorrect(
+				values[1:], blockSize, res.ShardResults(), opts))
+
+			// Writes to blocks that have already been flushed are returned
+			// as cold blocks rather than replacing the flushed data.
+			series, ok := res.ShardResults()[0].AllSeries().Get(foo.ID)
+			require.True(t, ok)
+			if !test.coldWritesEnabled {
+				require.Nil(t, series.ColdBlocks)
+				return
+			}
+			require.Equal(t, 1, series.ColdBlocks.Len())
+			_, ok = series.ColdBlocks.BlockAt(values[0].t.Truncate(blockSize))
+			require.True(t, ok)
+		})
+	}
+}
+
 func TestItMergesSnapshotsAndCommitLogs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -339,11 +339,26 @@ func (s *fileSystemSource) newShardReaders(
 			continue
 		}
 
+		// Info files are only read for the latest volume of a block.
+		fileset, _, err := fs.FileSetAt(s.fsopts.FilePathPrefix(), ns.ID(), shard, blockStart)
+		if err != nil {
+			s.log.WithFields(
+				xlog.NewField("shard", shard),
+				xlog.NewField("blockStart", blockStart.String()),
+				xlog.NewField("error", err.Error()),
+			).Error("unable to find fileset volume")
+			readerPool.put(r)
+			// Errors are marked unfulfilled by markRunResultErrorsAndUnfulfilled
+			// and will be re-attempted by the next bootstrapper
+			continue
+		}
+
 		openOpts := fs.DataReaderOpenOptions{
 			Identifier: fs.FileSetFileIdentifier{
-				Namespace:  ns.ID(),
-				Shard:      shard,
-				BlockStart: blockStart,
+				Namespace:   ns.ID(),
+				Shard:       shard,
+				BlockStart:  blockStart,
+				VolumeIndex: fileset.ID.VolumeIndex,
 			},
 		}
 		if err := r.Open(openOpts); err != nil {
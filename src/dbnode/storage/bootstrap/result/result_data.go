@@ -126,6 +126,19 @@ func (sr *shardResult) AddSeries(id ident.ID, tags ident.Tags, rawSeries block.D
 	curSeries.Blocks.AddSeries(rawSeries)
 }
 
+// AddColdBlock adds a block of writes to a block that has already been flushed.
+func (sr *shardResult) AddColdBlock(id ident.ID, tags ident.Tags, b block.DatabaseBlock) {
+	curSeries, exists := sr.blocks.Get(id)
+	if !exists {
+		curSeries = sr.newBlocks(id, tags)
+	}
+	if curSeries.ColdBlocks == nil {
+		curSeries.ColdBlocks = block.NewDatabaseSeriesBlocks(sr.opts.NewBlocksLen())
+	}
+	curSeries.ColdBlocks.AddBlock(b)
+	sr.blocks.Set(id, curSeries)
+}
+
 func (sr *shardResult) newBlocks(id ident.ID, tags ident.Tags) DatabaseSeriesBlocks {
 	size := sr.opts.NewBlocksLen()
 	return DatabaseSeriesBlocks{
@@ -144,6 +157,12 @@ func (sr *shardResult) AddResult(other ShardResult) {
 	for _, entry := range otherSeries.Iter() {
 		series := entry.Value()
 		sr.AddSeries(series.ID, series.Tags, series.Blocks)
+		if series.ColdBlocks == nil {
+			continue
+		}
+		for _, b := range series.ColdBlocks.AllBlocks() {
+			sr.AddColdBlock(series.ID, series.Tags, b)
+		}
 	}
 }
 
@@ -154,7 +173,8 @@ func (sr *shardResult) RemoveBlockAt(id ident.ID, t time.Time) {
 		return
 	}
 	curSeries.Blocks.RemoveBlockAt(t)
-	if curSeries.Blocks.Len() == 0 {
+	if curSeries.Blocks.Len() == 0 &&
+		(curSeries.ColdBlocks == nil || curSeries.ColdBlocks.Len() == 0) {
 		sr.RemoveSeries(id)
 	}
 }
@@ -186,6 +206,9 @@ func (sr *shardResult) Close() {
 	for _, entry := range sr.blocks.Iter() {
 		series := entry.Value()
 		series.Blocks.Close()
+		if series.ColdBlocks != nil {
+			series.ColdBlocks.Close()
+		}
 	}
 }
 
@@ -267,6 +267,39 @@ func TestShardResultAddBlock(t *testing.T) {
 	require.Equal(t, 1, barBlocks.Blocks.Len())
 }
 
+func TestShardResultAddColdBlock(t *testing.T) {
+	opts := testResultOptions()
+	sr := NewShardResult(0, opts)
+	start := time.Now().Truncate(time.Hour)
+	id := ident.StringID("foo")
+	tags := ident.NewTags(ident.StringTag("foo", "foe"))
+
+	warm := opts.DatabaseBlockOptions().DatabaseBlockPool().Get()
+	warm.Reset(start, time.Hour, ts.Segment{})
+	sr.AddBlock(id, tags, warm)
+
+	// Cold blocks are held separately so they never replace a block for the
+	// same block start.
+	cold := opts.DatabaseBlockOptions().DatabaseBlockPool().Get()
+	cold.Reset(start, time.Hour, ts.Segment{})
+	sr.AddColdBlock(id, tags, cold)
+
+	other := NewShardResult(0, opts)
+	otherCold := opts.DatabaseBlockOptions().DatabaseBlockPool().Get()
+	otherCold.Reset(start.Add(-time.Hour), time.Hour, ts.Segment{})
+	other.AddColdBlock(id, tags, otherCold)
+	sr.AddResult(other)
+
+	series, ok := sr.AllSeries().Get(id)
+	require.True(t, ok)
+	require.Equal(t, 1, series.Blocks.Len())
+	require.Equal(t, 2, series.ColdBlocks.Len())
+
+	// The series is kept while it still has cold blocks.
+	sr.RemoveBlockAt(id, start)
+	require.Equal(t, int64(1), sr.NumSeries())
+}
+
 func TestShardResultAddSeries(t *testing.T) {
 	opts := testResultOptions()
 	sr := NewShardResult(0, opts)
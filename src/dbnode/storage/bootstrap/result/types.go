@@ -97,6 +97,10 @@ type ShardResult interface {
 	// AddSeries adds a single series of blocks.
 	AddSeries(id ident.ID, tags ident.Tags, rawSeries block.DatabaseSeriesBlocks)
 
+	// AddColdBlock adds a block of writes to a block that has already been
+	// flushed and which are not yet persisted.
+	AddColdBlock(id ident.ID, tags ident.Tags, block block.DatabaseBlock)
+
 	// AddResult adds a shard result.
 	AddResult(other ShardResult)
 
@@ -115,6 +119,10 @@ type DatabaseSeriesBlocks struct {
 	ID     ident.ID
 	Tags   ident.Tags
 	Blocks block.DatabaseSeriesBlocks
+	// ColdBlocks are writes to blocks that have already been flushed which
+	// are loaded as cold writes rather than merged with the flushed data,
+	// nil if there are none.
+	ColdBlocks block.DatabaseSeriesBlocks
 }
 
 // ShardResults is a map of shards to shard results.
@@ -118,6 +118,7 @@ type databaseMetrics struct {
 	unknownNamespaceFetchBlocks         tally.Counter
 	unknownNamespaceFetchBlocksMetadata tally.Counter
 	unknownNamespaceQueryIDs            tally.Counter
+	unknownNamespaceAggregateQuery      tally.Counter
 	errQueryIDsIndexDisabled            tally.Counter
 	errWriteTaggedIndexDisabled         tally.Counter
 }
@@ -135,6 +136,7 @@ func newDatabaseMetrics(scope tally.Scope) databaseMetrics {
 		unknownNamespaceFetchBlocks:         unknownNamespaceScope.Counter("fetch-blocks"),
 		unknownNamespaceFetchBlocksMetadata: unknownNamespaceScope.Counter("fetch-blocks-metadata"),
 		unknownNamespaceQueryIDs:            unknownNamespaceScope.Counter("query-ids"),
+		unknownNamespaceAggregateQuery:      unknownNamespaceScope.Counter("aggregate-query"),
 		errQueryIDsIndexDisabled:            indexDisabledScope.Counter("err-query-ids"),
 		errWriteTaggedIndexDisabled:         indexDisabledScope.Counter("err-write-tagged"),
 	}
@@ -238,7 +240,10 @@ func (d *db) UpdateOwnedNamespaces(newNamespaces namespace.Map) error {
 		return err
 	}
 
-	// log that updates and removals are skipped
+	// apply any updates that can be made to live namespaces in place
+	updates = d.updateNamespacesWithLock(updates)
+
+	// log that the remaining updates and removals are skipped
 	if len(removes) > 0 || len(updates) > 0 {
 		d.log.Warnf("skipping namespace removals and updates, restart process if you want changes to take effect.")
 	}
@@ -317,11 +322,35 @@ func (d *db) logNamespaceUpdate(removes []ident.ID, adds, updates []namespace.Me
 	).Infof("updating database namespaces")
 
 	// NB(prateek): as noted in `UpdateOwnedNamespaces()` above, the current implementation
-	// does not apply updates, and removals until the m3dbnode process is restarted.
+	// does not apply removals, and updates other than to the retention period, until the
+	// m3dbnode process is restarted.
 
 	return nil
 }
 
+// updateNamespacesWithLock applies the updates that can be made to live
+// namespaces in place and returns the updates that could not be applied.
+func (d *db) updateNamespacesWithLock(updates []namespace.Metadata) []namespace.Metadata {
+	var skipped []namespace.Metadata
+	for _, n := range updates {
+		ns, ok := d.namespaces.Get(n.ID())
+		if !ok {
+			skipped = append(skipped, n)
+			continue
+		}
+
+		if err := ns.SetMetadata(n); err != nil {
+			d.log.Warnf("unable to update namespace %s in place: %v", n.ID().String(), err)
+			skipped = append(skipped, n)
+			continue
+		}
+
+		d.log.Infof("updated namespace %s in place", n.ID().String())
+	}
+
+	return skipped
+}
+
 func (d *db) addNamespacesWithLock(namespaces []namespace.Metadata) error {
 	for _, n := range namespaces {
 		// ensure namespace doesn't exist
@@ -742,6 +771,21 @@ func (d *db) QueryIDs(
 	return n.QueryIDs(ctx, query, opts)
 }
 
+func (d *db) AggregateQuery(
+	ctx context.Context,
+	namespace ident.ID,
+	query index.Query,
+	opts index.AggregationOptions,
+) (index.AggregateQueryResult, error) {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		d.metrics.unknownNamespaceAggregateQuery.Inc(1)
+		return index.AggregateQueryResult{}, err
+	}
+
+	return n.AggregateQuery(ctx, query, opts)
+}
+
 func (d *db) ReadEncoded(
 	ctx context.Context,
 	namespace ident.ID,
@@ -628,17 +628,34 @@ func TestDatabaseUpdateNamespace(t *testing.T) {
 	// wait till the update has propagated
 	<-updateCh
 	<-updateCh
-	time.Sleep(10 * time.Millisecond)
 
-	// ensure the namespaces have old properties
-	nses = d.Namespaces()
-	require.Len(t, nses, 2)
+	// ensure the retention period update has been applied in place
 	ns1, ok := d.Namespace(defaultTestNs1ID)
 	require.True(t, ok)
-	require.Equal(t, defaultTestNs1Opts, ns1.Options())
+	require.True(t, xclock.WaitUntil(func() bool {
+		return ns1.Options().Equal(md1.Options())
+	}, 2*time.Second))
+	nses = d.Namespaces()
+	require.Len(t, nses, 2)
 	ns2, ok := d.Namespace(defaultTestNs2ID)
 	require.True(t, ok)
 	require.Equal(t, defaultTestNs2Opts, ns2.Options())
+
+	// construct a namespace Map changing options that cannot be updated in place
+	ropts = defaultTestNs2Opts.RetentionOptions().SetBufferPast(2 * time.Minute)
+	md2, err = namespace.NewMetadata(defaultTestNs2ID, defaultTestNs2Opts.SetRetentionOptions(ropts))
+	require.NoError(t, err)
+	nsMap, err = namespace.NewMap([]namespace.Metadata{md1, md2})
+	require.NoError(t, err)
+
+	mapCh <- nsMap
+	<-updateCh
+	time.Sleep(10 * time.Millisecond)
+
+	// ensure the namespace has old properties
+	ns2, ok = d.Namespace(defaultTestNs2ID)
+	require.True(t, ok)
+	require.Equal(t, defaultTestNs2Opts, ns2.Options())
 }
 
 func TestDatabaseNamespaceIndexFunctions(t *testing.T) {
@@ -30,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
 	"github.com/m3db/m3/src/dbnode/retention"
 	xerrors "github.com/m3db/m3x/errors"
+	xtime "github.com/m3db/m3x/time"
 
 	"github.com/pborman/uuid"
 	"github.com/uber-go/tally"
@@ -102,13 +103,16 @@ func (m *flushManager) Flush(
 
 	defer m.setState(flushManagerIdle)
 
-	// create flush-er
-	flushPersist, err := m.pm.StartFlushPersist()
+	// Resolve the namespaces before starting the persist so that a failure,
+	// such as the database being closed, does not leave the persist manager
+	// unable to start any subsequent persist.
+	namespaces, err := m.database.GetOwnedNamespaces()
 	if err != nil {
 		return err
 	}
 
-	namespaces, err := m.database.GetOwnedNamespaces()
+	// create flush-er
+	flushPersist, err := m.pm.StartFlushPersist()
 	if err != nil {
 		return err
 	}
@@ -142,6 +146,23 @@ func (m *flushManager) Flush(
 		}
 	}
 
+	// Merge any cold writes with the blocks that have been flushed, this is
+	// performed after all the flushes so that blocks flushed during this flush
+	// can include cold writes already received.
+	for _, ns := range namespaces {
+		if !ns.Options().ColdWritesEnabled() {
+			continue
+		}
+		shardBootstrapTimes, ok := dbBootstrapStateAtTickStart.NamespaceBootstrapStates[ns.ID().String()]
+		if !ok {
+			// Already reported by the flush loop above.
+			continue
+		}
+		if err := ns.ColdFlush(shardBootstrapTimes, flushPersist); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
 	err = flushPersist.DoneFlush()
 	if err != nil {
 		multiErr = multiErr.Add(err)
@@ -286,8 +307,22 @@ func (m *flushManager) namespaceSnapshotTimes(ns databaseNamespace, curr time.Ti
 		latest = curr.Add(rOpts.BufferFuture()).Truncate(blockSize)
 	)
 
+	// Flushed blocks can hold cold writes that have not been cold flushed
+	// yet, they need to be snapshotted as the commit logs they were written
+	// to are cleaned up once the snapshot completes.
+	var coldFlushTimes map[xtime.UnixNano]struct{}
+	if ns.Options().ColdWritesEnabled() {
+		coldFlushTimes = make(map[xtime.UnixNano]struct{})
+		for _, t := range ns.ColdFlushBlockStarts() {
+			coldFlushTimes[xtime.ToUnixNano(t)] = struct{}{}
+		}
+	}
+
 	candidateTimes := timesInRange(earliest, latest, blockSize)
 	return filterTimes(candidateTimes, func(t time.Time) bool {
+		if _, ok := coldFlushTimes[xtime.ToUnixNano(t)]; ok {
+			return true
+		}
 		// Snapshot anything that is unflushed.
 		return ns.NeedsFlush(t, t)
 	})
@@ -224,6 +224,32 @@ func TestFlushManagerFlushDoneSnapshotError(t *testing.T) {
 	require.EqualError(t, fakeErr, fm.Flush(now, DatabaseBootstrapState{}).Error())
 }
 
+// TestFlushManagerFlushGetOwnedNamespacesError makes sure that the persist
+// manager is not left persisting when the namespaces cannot be resolved.
+func TestFlushManagerFlushGetOwnedNamespacesError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		fakeErr            = errors.New("fake error while getting owned namespaces")
+		mockPersistManager = persist.NewMockManager(ctrl)
+	)
+
+	testOpts := testDatabaseOptions().SetPersistManager(mockPersistManager)
+	db := newMockdatabase(ctrl)
+	db.EXPECT().Options().Return(testOpts).AnyTimes()
+	db.EXPECT().GetOwnedNamespaces().Return(nil, fakeErr)
+
+	cl := commitlog.NewMockCommitLog(ctrl)
+
+	fm := newFlushManager(db, cl, tally.NoopScope).(*flushManager)
+	fm.pm = mockPersistManager
+
+	now := time.Unix(0, 0)
+	require.Equal(t, fakeErr, fm.Flush(now, DatabaseBootstrapState{}))
+	require.Equal(t, flushManagerIdle, fm.state)
+}
+
 func TestFlushManagerFlushDoneIndexError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -357,6 +383,61 @@ func TestFlushManagerNamespaceIndexingEnabled(t *testing.T) {
 	require.NoError(t, fm.Flush(now, bootstrapStates))
 }
 
+func TestFlushManagerNamespaceColdWritesEnabled(t *testing.T) {
+	ctrl := gomock.NewController(xtest.Reporter{T: t})
+	defer ctrl.Finish()
+
+	nsOpts := defaultTestNs1Opts.
+		SetIndexOptions(namespace.NewIndexOptions().SetEnabled(false)).
+		SetColdWritesEnabled(true)
+	ns := NewMockdatabaseNamespace(ctrl)
+	ns.EXPECT().Options().Return(nsOpts).AnyTimes()
+	ns.EXPECT().ID().Return(defaultTestNs1ID).AnyTimes()
+	ns.EXPECT().NeedsFlush(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+	ns.EXPECT().Flush(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ns.EXPECT().ColdFlushBlockStarts().Return(nil).AnyTimes()
+	ns.EXPECT().Snapshot(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	var (
+		mockFlushPersist    = persist.NewMockFlushPreparer(ctrl)
+		mockSnapshotPersist = persist.NewMockSnapshotPreparer(ctrl)
+		mockPersistManager  = persist.NewMockManager(ctrl)
+	)
+
+	// Cold flush must happen before the flush is marked as done.
+	gomock.InOrder(
+		ns.EXPECT().ColdFlush(ShardBootstrapStates{}, mockFlushPersist).Return(nil),
+		mockFlushPersist.EXPECT().DoneFlush().Return(nil),
+	)
+	mockPersistManager.EXPECT().StartFlushPersist().Return(mockFlushPersist, nil)
+
+	mockSnapshotPersist.EXPECT().DoneSnapshot(gomock.Any(), testCommitlogFile).Return(nil)
+	mockPersistManager.EXPECT().StartSnapshotPersist(gomock.Any()).Return(mockSnapshotPersist, nil)
+
+	mockIndexFlusher := persist.NewMockIndexFlush(ctrl)
+	mockIndexFlusher.EXPECT().DoneIndex().Return(nil)
+	mockPersistManager.EXPECT().StartIndexPersist().Return(mockIndexFlusher, nil)
+
+	testOpts := testDatabaseOptions().SetPersistManager(mockPersistManager)
+	db := newMockdatabase(ctrl)
+	db.EXPECT().Options().Return(testOpts).AnyTimes()
+	db.EXPECT().GetOwnedNamespaces().Return([]databaseNamespace{ns}, nil)
+
+	cl := commitlog.NewMockCommitLog(ctrl)
+	cl.EXPECT().RotateLogs().Return(testCommitlogFile, nil).AnyTimes()
+
+	fm := newFlushManager(db, cl, tally.NoopScope).(*flushManager)
+	fm.pm = mockPersistManager
+
+	now := time.Unix(0, 0)
+	bootstrapStates := DatabaseBootstrapState{
+		NamespaceBootstrapStates: map[string]ShardBootstrapStates{
+			ns.ID().String(): ShardBootstrapStates{},
+		},
+	}
+	require.NoError(t, fm.Flush(now, bootstrapStates))
+}
+
 func TestFlushManagerFlushTimeStart(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -462,6 +543,41 @@ func TestFlushManagerNamespaceFlushTimesSomeNeedFlush(t *testing.T) {
 	require.Equal(t, expectedTimes, times)
 }
 
+func TestFlushManagerNamespaceSnapshotTimesColdWritesEnabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fm, _, _, _ := newMultipleFlushManagerNeedsFlush(t, ctrl)
+	now := time.Now()
+
+	nsOpts := namespace.NewOptions().SetColdWritesEnabled(true)
+	ns := NewMockdatabaseNamespace(ctrl)
+	ns.EXPECT().Options().Return(nsOpts).AnyTimes()
+
+	rOpts := nsOpts.RetentionOptions()
+	blockSize := rOpts.BlockSize()
+	start := retention.FlushTimeStart(rOpts, now)
+	end := now.Add(rOpts.BufferFuture()).Truncate(blockSize)
+	num := numIntervals(start, end, blockSize)
+
+	// Flushed blocks are only snapshotted if they hold cold writes that have
+	// not been cold flushed yet.
+	coldStart := start.Add(blockSize)
+	unflushedStart := start.Add(time.Duration(num-1) * blockSize)
+	ns.EXPECT().ColdFlushBlockStarts().Return([]time.Time{coldStart})
+	for i := 0; i < num; i++ {
+		st := start.Add(time.Duration(i) * blockSize)
+		if st.Equal(coldStart) {
+			continue
+		}
+		ns.EXPECT().NeedsFlush(st, st).Return(st.Equal(unflushedStart))
+	}
+
+	times := fm.namespaceSnapshotTimes(ns, now)
+	sort.Sort(timesInOrder(times))
+	require.Equal(t, []time.Time{coldStart, unflushedStart}, times)
+}
+
 func TestFlushManagerFlushSnapshot(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
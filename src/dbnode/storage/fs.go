@@ -40,6 +40,9 @@ const (
 type fileOpState struct {
 	Status      fileOpStatus
 	NumFailures int
+	// VolumeIndex is the index of the latest volume of a flushed fileset,
+	// later volumes are written by cold flushes.
+	VolumeIndex int
 }
 
 type runType int
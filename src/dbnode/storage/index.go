@@ -77,11 +77,10 @@ type nsIndex struct {
 
 	// all the vars below this line are not modified past the ctor
 	// and don't require a lock when being accessed.
-	nowFn           clock.NowFn
-	blockSize       time.Duration
-	retentionPeriod time.Duration
-	bufferPast      time.Duration
-	bufferFuture    time.Duration
+	nowFn        clock.NowFn
+	blockSize    time.Duration
+	bufferPast   time.Duration
+	bufferFuture time.Duration
 
 	indexFilesetsBeforeFn indexFilesetsBeforeFn
 	deleteFilesFn         deleteFilesFn
@@ -115,6 +114,9 @@ type nsIndexState struct {
 
 	runtimeOpts nsIndexRuntimeOptions
 
+	// retentionPeriod may be updated when the namespace retention changes.
+	retentionPeriod time.Duration
+
 	insertQueue namespaceIndexInsertQueue
 
 	// NB: `latestBlock` v `blocksByTime`: blocksByTime contains all the blocks known to `nsIndex`.
@@ -230,14 +232,14 @@ func newNamespaceIndexWithOptions(
 				insertMode:            indexOpts.InsertMode(), // FOLLOWUP(prateek): wire to allow this to be tweaked at runtime
 				flushBlockNumSegments: runtime.DefaultFlushIndexBlockNumSegments,
 			},
-			blocksByTime: make(map[xtime.UnixNano]index.Block),
+			retentionPeriod: nsMD.Options().RetentionOptions().RetentionPeriod(),
+			blocksByTime:    make(map[xtime.UnixNano]index.Block),
 		},
 
-		nowFn:           nowFn,
-		blockSize:       nsMD.Options().IndexOptions().BlockSize(),
-		retentionPeriod: nsMD.Options().RetentionOptions().RetentionPeriod(),
-		bufferPast:      nsMD.Options().RetentionOptions().BufferPast(),
-		bufferFuture:    nsMD.Options().RetentionOptions().BufferFuture(),
+		nowFn:        nowFn,
+		blockSize:    nsMD.Options().IndexOptions().BlockSize(),
+		bufferPast:   nsMD.Options().RetentionOptions().BufferPast(),
+		bufferFuture: nsMD.Options().RetentionOptions().BufferFuture(),
 
 		indexFilesetsBeforeFn: fs.IndexFileSetsBefore,
 		deleteFilesFn:         fs.DeleteFiles,
@@ -593,11 +595,16 @@ func (i *nsIndex) BootstrapsDone() uint {
 	return result
 }
 
+func (i *nsIndex) SetRetentionPeriod(retentionPeriod time.Duration) {
+	i.state.Lock()
+	i.state.retentionPeriod = retentionPeriod
+	i.state.Unlock()
+}
+
 func (i *nsIndex) Tick(c context.Cancellable, tickStart time.Time) (namespaceIndexTickResult, error) {
 	var (
-		result                     = namespaceIndexTickResult{}
-		earliestBlockStartToRetain = retention.FlushTimeStartForRetentionPeriod(i.retentionPeriod, i.blockSize, tickStart)
-		lastSealableBlockStart     = retention.FlushTimeEndForBlockSize(i.blockSize, tickStart.Add(-i.bufferPast))
+		result                 = namespaceIndexTickResult{}
+		lastSealableBlockStart = retention.FlushTimeEndForBlockSize(i.blockSize, tickStart.Add(-i.bufferPast))
 	)
 
 	i.state.Lock()
@@ -606,6 +613,9 @@ func (i *nsIndex) Tick(c context.Cancellable, tickStart time.Time) (namespaceInd
 		i.state.Unlock()
 	}()
 
+	earliestBlockStartToRetain := retention.FlushTimeStartForRetentionPeriod(
+		i.state.retentionPeriod, i.blockSize, tickStart)
+
 	result.NumBlocks = int64(len(i.state.blocksByTime))
 
 	var multiErr xerrors.MultiError
@@ -1089,6 +1099,79 @@ func (i *nsIndex) Query(
 	}, nil
 }
 
+func (i *nsIndex) AggregateQuery(
+	ctx context.Context,
+	query index.Query,
+	opts index.AggregationOptions,
+) (index.AggregateQueryResult, error) {
+	// Capture start before needing to acquire lock.
+	start := i.nowFn()
+
+	i.state.RLock()
+	if !i.isOpenWithRLock() {
+		i.state.RUnlock()
+		return index.AggregateQueryResult{}, errDbIndexUnableToQueryClosed
+	}
+
+	// Track this as an inflight query that needs to finish
+	// when the index is closed.
+	i.queriesWg.Add(1)
+	defer i.queriesWg.Done()
+
+	// Enact overrides for query options
+	opts.QueryOptions = i.overriddenOptsForQueryWithRLock(opts.QueryOptions)
+	timeout := i.timeoutForQueryWithRLock(ctx)
+
+	// Retrieve blocks to query, then we can release lock.
+	blocks, err := i.blocksForQueryWithRLock(xtime.NewRanges(xtime.Range{
+		Start: opts.StartInclusive,
+		End:   opts.EndExclusive,
+	}))
+
+	i.state.RUnlock()
+
+	if err != nil {
+		return index.AggregateQueryResult{}, err
+	}
+
+	var (
+		deadline   = start.Add(timeout)
+		results    = index.NewAggregateResults(i.nsMetadata.ID(), opts.Type)
+		exhaustive = true
+	)
+	// NB: Aggregations only walk the terms dictionaries of each segment
+	// rather than materializing documents, so the blocks are aggregated
+	// sequentially into a single set of results.
+	for _, block := range blocks {
+		if timeout > 0 && !i.nowFn().Before(deadline) {
+			return index.AggregateQueryResult{}, fmt.Errorf("index query timed out: %s", timeout.String())
+		}
+
+		if opts.LimitExceeded(results.Size()) {
+			exhaustive = false
+			break
+		}
+
+		blockExhaustive, err := block.Aggregate(query, opts, results)
+		if err == index.ErrUnableToQueryBlockClosed {
+			// NB: Block slid out of retention while querying, see Query.
+			continue
+		}
+		if err != nil {
+			return index.AggregateQueryResult{}, err
+		}
+		if !blockExhaustive {
+			exhaustive = false
+			break
+		}
+	}
+
+	return index.AggregateQueryResult{
+		Results:    results,
+		Exhaustive: exhaustive,
+	}, nil
+}
+
 func (i *nsIndex) timeoutForQueryWithRLock(
 	ctx context.Context,
 ) time.Duration {
@@ -1244,7 +1327,7 @@ func (i *nsIndex) CleanupExpiredFileSets(t time.Time) error {
 	}
 
 	// earliest block to retain based on retention period
-	earliestBlockStartToRetain := retention.FlushTimeStartForRetentionPeriod(i.retentionPeriod, i.blockSize, t)
+	earliestBlockStartToRetain := retention.FlushTimeStartForRetentionPeriod(i.state.retentionPeriod, i.blockSize, t)
 
 	// now we loop through the blocks we hold, to ensure we don't delete any data for them.
 	for t := range i.state.blocksByTime {
@@ -0,0 +1,107 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/m3db/m3x/ident"
+)
+
+type aggregateResults struct {
+	nsID     ident.ID
+	aggType  AggregationType
+	fields   map[string]map[string]struct{}
+	numTerms int
+}
+
+// NewAggregateResults returns a new aggregate results object.
+func NewAggregateResults(
+	namespaceID ident.ID,
+	aggType AggregationType,
+) AggregateResults {
+	return &aggregateResults{
+		nsID:    namespaceID,
+		aggType: aggType,
+		fields:  make(map[string]map[string]struct{}),
+	}
+}
+
+func (r *aggregateResults) Namespace() ident.ID {
+	return r.nsID
+}
+
+func (r *aggregateResults) Type() AggregationType {
+	return r.aggType
+}
+
+func (r *aggregateResults) Size() int {
+	if r.aggType == AggregateTagNames {
+		return len(r.fields)
+	}
+	return r.numTerms
+}
+
+func (r *aggregateResults) AddField(field []byte) int {
+	r.valuesForField(field)
+	return r.Size()
+}
+
+func (r *aggregateResults) AddFieldAndValue(field, value []byte) int {
+	values := r.valuesForField(field)
+	if _, ok := values[string(value)]; !ok {
+		values[string(value)] = struct{}{}
+		r.numTerms++
+	}
+	return r.Size()
+}
+
+func (r *aggregateResults) valuesForField(field []byte) map[string]struct{} {
+	// NB: the compiler optimizes the string conversion away for lookups.
+	values, ok := r.fields[string(field)]
+	if !ok {
+		values = make(map[string]struct{})
+		r.fields[string(field)] = values
+	}
+	return values
+}
+
+func (r *aggregateResults) Fields() []AggregateField {
+	fields := make([]AggregateField, 0, len(r.fields))
+	for name, values := range r.fields {
+		field := AggregateField{Name: []byte(name)}
+		if len(values) > 0 {
+			field.Values = make([][]byte, 0, len(values))
+			for value := range values {
+				field.Values = append(field.Values, []byte(value))
+			}
+			sort.Slice(field.Values, func(i, j int) bool {
+				return bytes.Compare(field.Values[i], field.Values[j]) < 0
+			})
+		}
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return bytes.Compare(fields[i].Name, fields[j].Name) < 0
+	})
+	return fields
+}
@@ -21,6 +21,7 @@
 package index
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"sync"
@@ -35,6 +36,7 @@ import (
 	"github.com/m3db/m3/src/m3ninx/index/segment"
 	"github.com/m3db/m3/src/m3ninx/index/segment/builder"
 	"github.com/m3db/m3/src/m3ninx/index/segment/fst"
+	"github.com/m3db/m3/src/m3ninx/postings"
 	"github.com/m3db/m3/src/m3ninx/search"
 	"github.com/m3db/m3/src/m3ninx/search/executor"
 	"github.com/m3db/m3x/context"
@@ -810,6 +812,192 @@ func (b *block) Query(
 	return exhaustive, nil
 }
 
+func (b *block) Aggregate(
+	query Query,
+	opts AggregationOptions,
+	results AggregateResults,
+) (bool, error) {
+	b.RLock()
+	defer b.RUnlock()
+	if b.state == blockStateClosed {
+		return false, ErrUnableToQueryBlockClosed
+	}
+
+	searcher, err := query.Query.SearchQuery().Searcher()
+	if err != nil {
+		return false, err
+	}
+
+	for _, seg := range b.segmentsWithRLock() {
+		exhaustive, err := aggregateSegment(seg, searcher, opts, results)
+		if err != nil {
+			return false, err
+		}
+		if !exhaustive {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (b *block) segmentsWithRLock() []segment.Segment {
+	numSegments := len(b.foregroundSegments) + len(b.backgroundSegments)
+	for _, group := range b.shardRangesSegments {
+		numSegments += len(group.segments)
+	}
+
+	segments := make([]segment.Segment, 0, numSegments)
+	for _, seg := range b.foregroundSegments {
+		segments = append(segments, seg.Segment())
+	}
+	for _, seg := range b.backgroundSegments {
+		segments = append(segments, seg.Segment())
+	}
+	for _, group := range b.shardRangesSegments {
+		segments = append(segments, group.segments...)
+	}
+	return segments
+}
+
+// aggregateSegment walks the terms dictionaries of the given segment and adds
+// the fields, and optionally terms, that belong to at least one document
+// matched by the searcher to the results.
+func aggregateSegment(
+	seg segment.Segment,
+	searcher search.Searcher,
+	opts AggregationOptions,
+	results AggregateResults,
+) (bool, error) {
+	reader, err := seg.Reader()
+	if err != nil {
+		return false, err
+	}
+
+	readerCloser := safeCloser{closable: reader}
+	defer readerCloser.Close()
+
+	matched, err := searcher.Search(reader)
+	if err != nil {
+		return false, err
+	}
+
+	if matched.IsEmpty() {
+		return true, readerCloser.Close()
+	}
+
+	var (
+		// NB: when every document in the segment matches there is no need
+		// to check each term's postings list against the matched documents.
+		matchesAll = int64(matched.Len()) >= seg.Size()
+		terms      = seg.TermsIterable()
+	)
+	fieldsIter, err := seg.FieldsIterable().Fields()
+	if err != nil {
+		return false, err
+	}
+
+	fieldsIterCloser := safeCloser{closable: fieldsIter}
+	defer fieldsIterCloser.Close()
+
+	exhaustive := true
+	for fieldsIter.Next() {
+		field := fieldsIter.Current()
+		// NB: the reserved ID field is an implementation detail of the index
+		// and is never exposed as a tag.
+		if bytes.Equal(field, doc.IDReservedFieldName) {
+			continue
+		}
+		if !opts.matchesFieldFilter(field) {
+			continue
+		}
+
+		if opts.LimitExceeded(results.Size()) {
+			exhaustive = false
+			break
+		}
+
+		termsIter, err := terms.Terms(field)
+		if err != nil {
+			return false, err
+		}
+
+		fieldExhaustive, err := aggregateTerms(field, termsIter, matched,
+			matchesAll, opts, results)
+		if closeErr := termsIter.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return false, err
+		}
+		if !fieldExhaustive {
+			exhaustive = false
+			break
+		}
+	}
+
+	if err := fieldsIter.Err(); err != nil {
+		return false, err
+	}
+
+	if err := fieldsIterCloser.Close(); err != nil {
+		return false, err
+	}
+
+	if err := readerCloser.Close(); err != nil {
+		return false, err
+	}
+
+	return exhaustive, nil
+}
+
+func aggregateTerms(
+	field []byte,
+	termsIter segment.TermsIterator,
+	matched postings.List,
+	matchesAll bool,
+	opts AggregationOptions,
+	results AggregateResults,
+) (bool, error) {
+	for termsIter.Next() {
+		term, pl := termsIter.Current()
+		if !matchesAll && !postingsIntersect(matched, pl) {
+			continue
+		}
+
+		if opts.Type == AggregateTagNames {
+			// Only need a single matching term to include the field.
+			results.AddField(field)
+			return true, termsIter.Err()
+		}
+
+		if opts.LimitExceeded(results.Size()) {
+			return false, termsIter.Err()
+		}
+
+		results.AddFieldAndValue(field, term)
+	}
+
+	return true, termsIter.Err()
+}
+
+// postingsIntersect returns whether the two postings lists share at least
+// one postings ID without allocating their intersection.
+func postingsIntersect(a, b postings.List) bool {
+	if a.Len() > b.Len() {
+		a, b = b, a
+	}
+
+	iter := a.Iterator()
+	defer iter.Close()
+	for iter.Next() {
+		if b.Contains(iter.Current()) {
+			return true
+		}
+	}
+	return false
+}
+
 func (b *block) AddResults(
 	results result.IndexBlock,
 ) error {
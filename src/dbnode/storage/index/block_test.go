@@ -1157,6 +1157,91 @@ func TestBlockE2EInsertQuery(t *testing.T) {
 		ident.NewTagsIterator(t2)))
 }
 
+func TestBlockE2EInsertAggregate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blockSize := time.Hour
+
+	testMD := newTestNSMetadata(t)
+	now := time.Now()
+	blockStart := now.Truncate(blockSize)
+
+	nowNotBlockStartAligned := now.
+		Truncate(blockSize).
+		Add(time.Minute)
+
+	blk, err := NewBlock(blockStart, testMD, testOpts)
+	require.NoError(t, err)
+	b, ok := blk.(*block)
+	require.True(t, ok)
+
+	batch := NewWriteBatch(WriteBatchOptions{
+		IndexBlockSize: blockSize,
+	})
+	for _, d := range []doc.Document{testDoc1(), testDoc2(), testDoc3()} {
+		h := NewMockOnIndexSeries(ctrl)
+		h.EXPECT().OnIndexFinalize(xtime.ToUnixNano(blockStart))
+		h.EXPECT().OnIndexSuccess(xtime.ToUnixNano(blockStart))
+		batch.Append(WriteBatchEntry{
+			Timestamp:     nowNotBlockStartAligned,
+			OnIndexSeries: h,
+		}, d)
+	}
+
+	res, err := b.WriteBatch(batch)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.NumSuccess)
+	require.Equal(t, int64(0), res.NumError)
+
+	q, err := idx.NewRegexpQuery([]byte("bar"), []byte("b.*"))
+	require.NoError(t, err)
+
+	results := NewAggregateResults(ident.StringID("ns"), AggregateTagNamesAndValues)
+	exhaustive, err := b.Aggregate(Query{q}, AggregationOptions{
+		Type: AggregateTagNamesAndValues,
+	}, results)
+	require.NoError(t, err)
+	require.True(t, exhaustive)
+	require.Equal(t, 2, results.Size())
+	require.Equal(t, []AggregateField{
+		{Name: []byte("bar"), Values: [][]byte{[]byte("baz")}},
+		{Name: []byte("some"), Values: [][]byte{[]byte("more")}},
+	}, results.Fields())
+
+	results = NewAggregateResults(ident.StringID("ns"), AggregateTagNamesAndValues)
+	exhaustive, err = b.Aggregate(Query{q}, AggregationOptions{
+		FieldFilter: [][]byte{[]byte("some")},
+		Type:        AggregateTagNamesAndValues,
+	}, results)
+	require.NoError(t, err)
+	require.True(t, exhaustive)
+	require.Equal(t, []AggregateField{
+		{Name: []byte("some"), Values: [][]byte{[]byte("more")}},
+	}, results.Fields())
+
+	results = NewAggregateResults(ident.StringID("ns"), AggregateTagNames)
+	exhaustive, err = b.Aggregate(Query{q}, AggregationOptions{
+		QueryOptions: QueryOptions{Limit: 1},
+		Type:         AggregateTagNames,
+	}, results)
+	require.NoError(t, err)
+	require.False(t, exhaustive)
+	require.Equal(t, 1, results.Size())
+}
+
+func TestBlockAggregateAfterClose(t *testing.T) {
+	testMD := newTestNSMetadata(t)
+	start := time.Now().Truncate(time.Hour)
+	b, err := NewBlock(start, testMD, testOpts)
+	require.NoError(t, err)
+	require.NoError(t, b.Close())
+
+	results := NewAggregateResults(ident.StringID("ns"), AggregateTagNames)
+	_, err = b.Aggregate(Query{}, AggregationOptions{}, results)
+	require.Error(t, err)
+}
+
 func TestBlockE2EInsertQueryLimit(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
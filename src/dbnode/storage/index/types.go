@@ -21,6 +21,7 @@
 package index
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"time"
@@ -79,6 +80,82 @@ type QueryResults struct {
 	Exhaustive bool
 }
 
+// AggregationType specifies what an aggregate query should aggregate.
+type AggregationType uint
+
+const (
+	// AggregateTagNames aggregates only the distinct tag names.
+	AggregateTagNames AggregationType = iota
+	// AggregateTagNamesAndValues aggregates distinct tag names and the
+	// distinct values for each of them.
+	AggregateTagNamesAndValues
+)
+
+// AggregationOptions enables users to specify constraints on aggregations.
+type AggregationOptions struct {
+	QueryOptions
+
+	// FieldFilter restricts the aggregation to fields whose names exactly
+	// match one of the given names, all fields are aggregated if empty.
+	// Terms are not filtered, the query is used to restrict which terms
+	// are returned for each field.
+	FieldFilter [][]byte
+
+	// Type indicates what should be aggregated.
+	Type AggregationType
+}
+
+func (o AggregationOptions) matchesFieldFilter(field []byte) bool {
+	if len(o.FieldFilter) == 0 {
+		return true
+	}
+	for _, f := range o.FieldFilter {
+		if bytes.Equal(f, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// AggregateQueryResult is the collection of results for an aggregate query.
+type AggregateQueryResult struct {
+	Results    AggregateResults
+	Exhaustive bool
+}
+
+// AggregateResults is a collection of distinct field names and, depending
+// on the aggregation type, their distinct values. It is not safe for
+// concurrent use.
+type AggregateResults interface {
+	// Namespace returns the namespace associated with the result.
+	Namespace() ident.ID
+
+	// Type returns the aggregation type of the results.
+	Type() AggregationType
+
+	// Size returns the number of distinct field names tracked when
+	// aggregating tag names, otherwise the number of distinct field values.
+	Size() int
+
+	// AddField adds the provided field name to the results, a copy of
+	// the field is made so the original may be modified after the call.
+	AddField(field []byte) (size int)
+
+	// AddFieldAndValue adds the provided field name and value to the results,
+	// copies of both are made so the originals may be modified after the call.
+	AddFieldAndValue(field, value []byte) (size int)
+
+	// Fields returns the aggregated fields in lexicographical order.
+	Fields() []AggregateField
+}
+
+// AggregateField is a distinct field name along with its distinct values,
+// values are only populated when aggregating both names and values.
+type AggregateField struct {
+	Name   []byte
+	Values [][]byte
+}
+
 // Results is a collection of results for a query.
 type Results interface {
 	// Namespace returns the namespace associated with the result.
@@ -169,6 +246,14 @@ type Block interface {
 		results Results,
 	) (exhaustive bool, err error)
 
+	// Aggregate resolves the given query into the distinct field names,
+	// and optionally field values, of the documents it matches.
+	Aggregate(
+		query Query,
+		opts AggregationOptions,
+		results AggregateResults,
+	) (exhaustive bool, err error)
+
 	// AddResults adds bootstrap results to the block, if c.
 	AddResults(results result.IndexBlock) error
 
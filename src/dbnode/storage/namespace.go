@@ -104,14 +104,18 @@ type dbNamespace struct {
 	blockRetriever     block.DatabaseBlockRetriever
 	namespaceReaderMgr databaseNamespaceReaderManager
 	opts               Options
-	metadata           namespace.Metadata
-	nopts              namespace.Options
-	seriesOpts         series.Options
 	nowFn              clock.NowFn
 	snapshotFilesFn    snapshotFilesFn
 	log                xlog.Logger
 	bootstrapState     BootstrapState
 
+	// The metadata, and the series options derived from it, may be updated
+	// in place and are guarded by their own lock as they are read in paths
+	// that already hold the namespace lock.
+	metadataLock sync.RWMutex
+	metadata     namespace.Metadata
+	seriesOpts   series.Options
+
 	// Contains an entry to all shards for fast shard lookup, an
 	// entry will be nil when this shard does not belong to current database
 	shards []databaseShard
@@ -143,6 +147,7 @@ type databaseNamespaceIndexStatsLastTick struct {
 type databaseNamespaceMetrics struct {
 	bootstrap           instrument.MethodMetrics
 	flush               instrument.MethodMetrics
+	coldFlush           instrument.MethodMetrics
 	flushIndex          instrument.MethodMetrics
 	snapshot            instrument.MethodMetrics
 	write               instrument.MethodMetrics
@@ -151,6 +156,7 @@ type databaseNamespaceMetrics struct {
 	fetchBlocks         instrument.MethodMetrics
 	fetchBlocksMetadata instrument.MethodMetrics
 	queryIDs            instrument.MethodMetrics
+	aggregateQuery      instrument.MethodMetrics
 	unfulfilled         tally.Counter
 	bootstrapStart      tally.Counter
 	bootstrapEnd        tally.Counter
@@ -220,6 +226,7 @@ func newDatabaseNamespaceMetrics(scope tally.Scope, samplingRate float64) databa
 	return databaseNamespaceMetrics{
 		bootstrap:           instrument.NewMethodMetrics(scope, "bootstrap", samplingRate),
 		flush:               instrument.NewMethodMetrics(scope, "flush", samplingRate),
+		coldFlush:           instrument.NewMethodMetrics(scope, "coldFlush", samplingRate),
 		flushIndex:          instrument.NewMethodMetrics(scope, "flushIndex", samplingRate),
 		snapshot:            instrument.NewMethodMetrics(scope, "snapshot", samplingRate),
 		write:               instrument.NewMethodMetrics(scope, "write", overrideWriteSamplingRate),
@@ -228,6 +235,7 @@ func newDatabaseNamespaceMetrics(scope tally.Scope, samplingRate float64) databa
 		fetchBlocks:         instrument.NewMethodMetrics(scope, "fetchBlocks", samplingRate),
 		fetchBlocksMetadata: instrument.NewMethodMetrics(scope, "fetchBlocksMetadata", samplingRate),
 		queryIDs:            instrument.NewMethodMetrics(scope, "queryIDs", samplingRate),
+		aggregateQuery:      instrument.NewMethodMetrics(scope, "aggregateQuery", samplingRate),
 		unfulfilled:         scope.Counter("bootstrap.unfulfilled"),
 		bootstrapStart:      scope.Counter("bootstrap.start"),
 		bootstrapEnd:        scope.Counter("bootstrap.end"),
@@ -299,7 +307,8 @@ func newDatabaseNamespace(
 	tickWorkers.Init()
 
 	seriesOpts := NewSeriesOptionsFromOptions(opts, nopts.RetentionOptions()).
-		SetStats(series.NewStats(scope))
+		SetStats(series.NewStats(scope)).
+		SetColdWritesEnabled(nopts.ColdWritesEnabled())
 	if err := seriesOpts.Validate(); err != nil {
 		return nil, fmt.Errorf(
 			"unable to create namespace %v, invalid series options: %v",
@@ -325,7 +334,6 @@ func newDatabaseNamespace(
 		namespaceReaderMgr:     newNamespaceReaderManager(metadata, scope, opts),
 		opts:                   opts,
 		metadata:               metadata,
-		nopts:                  nopts,
 		seriesOpts:             seriesOpts,
 		nowFn:                  opts.ClockOptions().NowFn(),
 		snapshotFilesFn:        fs.SnapshotFiles,
@@ -364,8 +372,52 @@ func (n *dbNamespace) reportStatusLoop() {
 	}
 }
 
+func (n *dbNamespace) namespaceMetadata() namespace.Metadata {
+	n.metadataLock.RLock()
+	metadata := n.metadata
+	n.metadataLock.RUnlock()
+	return metadata
+}
+
+func (n *dbNamespace) seriesOptions() series.Options {
+	n.metadataLock.RLock()
+	seriesOpts := n.seriesOpts
+	n.metadataLock.RUnlock()
+	return seriesOpts
+}
+
 func (n *dbNamespace) Options() namespace.Options {
-	return n.nopts
+	return n.namespaceMetadata().Options()
+}
+
+func (n *dbNamespace) SetMetadata(metadata namespace.Metadata) error {
+	if !metadata.ID().Equal(n.id) {
+		return fmt.Errorf("unable to update namespace %s with metadata of namespace %s",
+			n.id.String(), metadata.ID().String())
+	}
+
+	n.metadataLock.Lock()
+	if err := namespace.ValidateUpdate(n.metadata.Options(), metadata.Options()); err != nil {
+		n.metadataLock.Unlock()
+		return err
+	}
+	n.metadata = metadata
+	n.seriesOpts = n.seriesOpts.SetRetentionOptions(
+		metadata.Options().RetentionOptions())
+	n.metadataLock.Unlock()
+
+	// Shards created after the metadata was swapped above already see the
+	// new metadata, so only the shards owned at this point need updating.
+	for _, shard := range n.GetOwnedShards() {
+		shard.SetNamespaceMetadata(metadata)
+	}
+
+	if n.reverseIndex != nil {
+		n.reverseIndex.SetRetentionPeriod(
+			metadata.Options().RetentionOptions().RetentionPeriod())
+	}
+
+	return nil
 }
 
 func (n *dbNamespace) ID() ident.ID {
@@ -417,10 +469,10 @@ func (n *dbNamespace) AssignShardSet(shardSet sharding.ShardSet) {
 		if int(shard) < len(existing) && existing[shard] != nil {
 			n.shards[shard] = existing[shard]
 		} else {
-			bootstrapEnabled := n.nopts.BootstrapEnabled()
-			n.shards[shard] = newDatabaseShard(n.metadata, shard, n.blockRetriever,
+			bootstrapEnabled := n.Options().BootstrapEnabled()
+			n.shards[shard] = newDatabaseShard(n.namespaceMetadata(), shard, n.blockRetriever,
 				n.namespaceReaderMgr, n.increasingIndex, n.reverseIndex,
-				bootstrapEnabled, n.opts, n.seriesOpts)
+				bootstrapEnabled, n.opts, n.seriesOptions())
 			n.metrics.shards.add.Inc(1)
 		}
 	}
@@ -616,6 +668,28 @@ func (n *dbNamespace) QueryIDs(
 	return res, err
 }
 
+func (n *dbNamespace) AggregateQuery(
+	ctx context.Context,
+	query index.Query,
+	opts index.AggregationOptions,
+) (index.AggregateQueryResult, error) {
+	callStart := n.nowFn()
+	if n.reverseIndex == nil { // only happens if indexing is enabled.
+		n.metrics.aggregateQuery.ReportError(n.nowFn().Sub(callStart))
+		return index.AggregateQueryResult{}, errNamespaceIndexingDisabled
+	}
+
+	if n.reverseIndex.BootstrapsDone() < 1 {
+		// Similar to reading shard data, return not bootstrapped
+		n.metrics.aggregateQuery.ReportError(n.nowFn().Sub(callStart))
+		return index.AggregateQueryResult{}, xerrors.NewRetryableError(errIndexNotBootstrappedToRead)
+	}
+
+	res, err := n.reverseIndex.AggregateQuery(ctx, query, opts)
+	n.metrics.aggregateQuery.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
+	return res, err
+}
+
 func (n *dbNamespace) ReadEncoded(
 	ctx context.Context,
 	id ident.ID,
@@ -697,7 +771,7 @@ func (n *dbNamespace) Bootstrap(start time.Time, process bootstrap.Process) erro
 		n.metrics.bootstrapEnd.Inc(1)
 	}()
 
-	if !n.nopts.BootstrapEnabled() {
+	if !n.Options().BootstrapEnabled() {
 		success = true
 		n.metrics.bootstrap.ReportSuccess(n.nowFn().Sub(callStart))
 		return nil
@@ -723,7 +797,7 @@ func (n *dbNamespace) Bootstrap(start time.Time, process bootstrap.Process) erro
 		shardIDs[i] = shard.ID()
 	}
 
-	bootstrapResult, err := process.Run(start, n.metadata, shardIDs)
+	bootstrapResult, err := process.Run(start, n.namespaceMetadata(), shardIDs)
 	if err != nil {
 		n.log.Errorf("bootstrap for namespace %s aborted due to error: %v",
 			n.id.String(), err)
@@ -814,13 +888,13 @@ func (n *dbNamespace) Flush(
 	}
 	n.RUnlock()
 
-	if !n.nopts.FlushEnabled() {
+	if !n.Options().FlushEnabled() {
 		n.metrics.flush.ReportSuccess(n.nowFn().Sub(callStart))
 		return nil
 	}
 
 	// check if blockStart is aligned with the namespace's retention options
-	bs := n.nopts.RetentionOptions().BlockSize()
+	bs := n.Options().RetentionOptions().BlockSize()
 	if t := blockStart.Truncate(bs); !blockStart.Equal(t) {
 		return fmt.Errorf("failed to flush at time %v, not aligned to blockSize", blockStart.String())
 	}
@@ -864,6 +938,48 @@ func (n *dbNamespace) Flush(
 	return res
 }
 
+func (n *dbNamespace) ColdFlush(
+	shardBootstrapStatesAtTickStart ShardBootstrapStates,
+	flushPersist persist.FlushPreparer,
+) error {
+	callStart := n.nowFn()
+
+	n.RLock()
+	if n.bootstrapState != Bootstrapped {
+		n.RUnlock()
+		n.metrics.coldFlush.ReportError(n.nowFn().Sub(callStart))
+		return errNamespaceNotBootstrapped
+	}
+	n.RUnlock()
+
+	if !n.Options().FlushEnabled() || !n.Options().ColdWritesEnabled() {
+		n.metrics.coldFlush.ReportSuccess(n.nowFn().Sub(callStart))
+		return nil
+	}
+
+	multiErr := xerrors.NewMultiError()
+	shards := n.GetOwnedShards()
+	for _, shard := range shards {
+		// Same as flushing, only shards that were bootstrapped before the
+		// tick are guaranteed to have had their flushed blocks merged.
+		shardBootstrapStateBeforeTick, ok := shardBootstrapStatesAtTickStart[shard.ID()]
+		if !ok || shardBootstrapStateBeforeTick != Bootstrapped {
+			continue
+		}
+
+		// NB: we still want to proceed if a shard fails to cold flush its data.
+		if err := shard.ColdFlush(flushPersist); err != nil {
+			detailedErr := fmt.Errorf("shard %d failed to cold flush data: %v",
+				shard.ID(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	res := multiErr.FinalError()
+	n.metrics.coldFlush.ReportSuccessOrError(res, n.nowFn().Sub(callStart))
+	return res
+}
+
 func (n *dbNamespace) FlushIndex(
 	flush persist.IndexFlush,
 ) error {
@@ -876,7 +992,7 @@ func (n *dbNamespace) FlushIndex(
 	}
 	n.RUnlock()
 
-	if !n.nopts.FlushEnabled() || !n.nopts.IndexOptions().Enabled() {
+	if !n.Options().FlushEnabled() || !n.Options().IndexOptions().Enabled() {
 		n.metrics.flush.ReportSuccess(n.nowFn().Sub(callStart))
 		return nil
 	}
@@ -903,7 +1019,7 @@ func (n *dbNamespace) Snapshot(
 	}
 	n.RUnlock()
 
-	if !n.nopts.SnapshotEnabled() {
+	if !n.Options().SnapshotEnabled() {
 		// Note that we keep the ability to disable snapshots at the namespace level around for
 		// debugging / performance / flexibility reasons, but disabling it can / will cause data
 		// loss due to the commitlog cleanup logic assuming that a valid snapshot checkpoint file
@@ -949,10 +1065,28 @@ func (n *dbNamespace) NeedsFlush(
 	return n.needsFlushWithLock(alignedInclusiveStart, alignedInclusiveEnd)
 }
 
+func (n *dbNamespace) ColdFlushBlockStarts() []time.Time {
+	var (
+		starts []time.Time
+		seen   = make(map[xtime.UnixNano]struct{})
+	)
+	for _, shard := range n.GetOwnedShards() {
+		for _, blockStart := range shard.ColdFlushBlockStarts() {
+			key := xtime.ToUnixNano(blockStart)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			starts = append(starts, blockStart)
+		}
+	}
+	return starts
+}
+
 func (n *dbNamespace) IsCapturedBySnapshot(
 	alignedInclusiveStart, alignedInclusiveEnd, capturedUpTo time.Time) (bool, error) {
 	var (
-		blockSize      = n.nopts.RetentionOptions().BlockSize()
+		blockSize      = n.Options().RetentionOptions().BlockSize()
 		blockStarts    = timesInRange(alignedInclusiveStart, alignedInclusiveEnd, blockSize)
 		filePathPrefix = n.opts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
 	)
@@ -998,7 +1132,7 @@ func (n *dbNamespace) IsCapturedBySnapshot(
 
 func (n *dbNamespace) needsFlushWithLock(alignedInclusiveStart time.Time, alignedInclusiveEnd time.Time) bool {
 	var (
-		blockSize   = n.nopts.RetentionOptions().BlockSize()
+		blockSize   = n.Options().RetentionOptions().BlockSize()
 		blockStarts = timesInRange(alignedInclusiveStart, alignedInclusiveEnd, blockSize)
 	)
 
@@ -1045,7 +1179,7 @@ func (n *dbNamespace) Repair(
 	repairer databaseShardRepairer,
 	tr xtime.Range,
 ) error {
-	if !n.nopts.RepairEnabled() {
+	if !n.Options().RepairEnabled() {
 		return nil
 	}
 
@@ -1135,7 +1269,7 @@ func (n *dbNamespace) GetOwnedShards() []databaseShard {
 func (n *dbNamespace) GetIndex() (namespaceIndex, error) {
 	n.RLock()
 	defer n.RUnlock()
-	if !n.metadata.Options().IndexOptions().Enabled() {
+	if !n.Options().IndexOptions().Enabled() {
 		return nil, errNamespaceIndexingDisabled
 	}
 	return n.reverseIndex, nil
@@ -1195,9 +1329,9 @@ func (n *dbNamespace) initShards(needBootstrap bool) {
 	shards := n.shardSet.AllIDs()
 	dbShards := make([]databaseShard, n.shardSet.Max()+1)
 	for _, shard := range shards {
-		dbShards[shard] = newDatabaseShard(n.metadata, shard, n.blockRetriever,
+		dbShards[shard] = newDatabaseShard(n.namespaceMetadata(), shard, n.blockRetriever,
 			n.namespaceReaderMgr, n.increasingIndex, n.reverseIndex,
-			needBootstrap, n.opts, n.seriesOpts)
+			needBootstrap, n.opts, n.seriesOptions())
 	}
 	n.shards = dbShards
 	n.Unlock()
@@ -52,6 +52,7 @@ type MetadataConfiguration struct {
 	BootstrapEnabled  *bool                   `yaml:"bootstrapEnabled"`
 	FlushEnabled      *bool                   `yaml:"flushEnabled"`
 	WritesToCommitLog *bool                   `yaml:"writesToCommitLog"`
+	ColdWritesEnabled *bool                   `yaml:"coldWritesEnabled"`
 	CleanupEnabled    *bool                   `yaml:"cleanupEnabled"`
 	RepairEnabled     *bool                   `yaml:"repairEnabled"`
 	Retention         retention.Configuration `yaml:"retention" validate:"nonzero"`
@@ -74,6 +75,9 @@ func (mc *MetadataConfiguration) Metadata() (Metadata, error) {
 	if v := mc.WritesToCommitLog; v != nil {
 		opts = opts.SetWritesToCommitLog(*v)
 	}
+	if v := mc.ColdWritesEnabled; v != nil {
+		opts = opts.SetColdWritesEnabled(*v)
+	}
 	if v := mc.CleanupEnabled; v != nil {
 		opts = opts.SetCleanupEnabled(*v)
 	}
@@ -67,6 +67,7 @@ var (
 			WritesToCommitLog: true,
 			CleanupEnabled:    true,
 			RepairEnabled:     true,
+			ColdWritesEnabled: true,
 			RetentionOptions:  &validRetentionOpts,
 			IndexOptions:      &validIndexOpts,
 		},
@@ -226,6 +227,7 @@ func assertEqualMetadata(t *testing.T, name string, expected nsproto.NamespaceOp
 	require.Equal(t, expected.WritesToCommitLog, opts.WritesToCommitLog())
 	require.Equal(t, expected.CleanupEnabled, opts.CleanupEnabled())
 	require.Equal(t, expected.RepairEnabled, opts.RepairEnabled())
+	require.Equal(t, expected.ColdWritesEnabled, opts.ColdWritesEnabled())
 
 	assertEqualRetentions(t, *expected.RetentionOptions, opts.RetentionOptions())
 }
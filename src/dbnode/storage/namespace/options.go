@@ -39,6 +39,9 @@ const (
 	// Namespace writes go to commit logs by default.
 	defaultWritesToCommitLog = true
 
+	// Namespace rejects writes outside of the buffer window by default.
+	defaultColdWritesEnabled = false
+
 	// Namespace requires fileset/snapshot cleanup by default.
 	defaultCleanupEnabled = true
 
@@ -50,6 +53,7 @@ var (
 	errIndexBlockSizePositive                       = errors.New("index block size must positive")
 	errIndexBlockSizeTooLarge                       = errors.New("index block size needs to be <= namespace retention period")
 	errIndexBlockSizeMustBeAMultipleOfDataBlockSize = errors.New("index block size must be a multiple of data block size")
+	errUpdateNotSupported                           = errors.New("only the retention period of an existing namespace may be updated")
 )
 
 type options struct {
@@ -57,6 +61,7 @@ type options struct {
 	flushEnabled      bool
 	snapshotEnabled   bool
 	writesToCommitLog bool
+	coldWritesEnabled bool
 	cleanupEnabled    bool
 	repairEnabled     bool
 	retentionOpts     retention.Options
@@ -70,6 +75,7 @@ func NewOptions() Options {
 		flushEnabled:      defaultFlushEnabled,
 		snapshotEnabled:   defaultSnapshotEnabled,
 		writesToCommitLog: defaultWritesToCommitLog,
+		coldWritesEnabled: defaultColdWritesEnabled,
 		cleanupEnabled:    defaultCleanupEnabled,
 		repairEnabled:     defaultRepairEnabled,
 		retentionOpts:     retention.NewOptions(),
@@ -106,6 +112,7 @@ func (o *options) Equal(value Options) bool {
 		o.flushEnabled == value.FlushEnabled() &&
 		o.writesToCommitLog == value.WritesToCommitLog() &&
 		o.snapshotEnabled == value.SnapshotEnabled() &&
+		o.coldWritesEnabled == value.ColdWritesEnabled() &&
 		o.cleanupEnabled == value.CleanupEnabled() &&
 		o.repairEnabled == value.RepairEnabled() &&
 		o.retentionOpts.Equal(value.RetentionOptions()) &&
@@ -152,6 +159,16 @@ func (o *options) WritesToCommitLog() bool {
 	return o.writesToCommitLog
 }
 
+func (o *options) SetColdWritesEnabled(value bool) Options {
+	opts := *o
+	opts.coldWritesEnabled = value
+	return &opts
+}
+
+func (o *options) ColdWritesEnabled() bool {
+	return o.coldWritesEnabled
+}
+
 func (o *options) SetCleanupEnabled(value bool) Options {
 	opts := *o
 	opts.cleanupEnabled = value
@@ -191,3 +208,21 @@ func (o *options) SetIndexOptions(value IndexOptions) Options {
 func (o *options) IndexOptions() IndexOptions {
 	return o.indexOpts
 }
+
+// ValidateUpdate returns an error if a live namespace with the existing
+// options cannot be updated in place to the updated options. Only the
+// retention period may currently be changed, any other change requires
+// the namespace to be recreated.
+func ValidateUpdate(existing, updated Options) error {
+	if err := updated.Validate(); err != nil {
+		return err
+	}
+
+	ropts := existing.RetentionOptions().
+		SetRetentionPeriod(updated.RetentionOptions().RetentionPeriod())
+	if !existing.SetRetentionOptions(ropts).Equal(updated) {
+		return errUpdateNotSupported
+	}
+
+	return nil
+}
@@ -51,6 +51,16 @@ func TestOptionsEqualsIndexOpts(t *testing.T) {
 	require.False(t, o2.Equal(o1))
 }
 
+func TestOptionsEqualsColdWrites(t *testing.T) {
+	o1 := NewOptions()
+	require.False(t, o1.ColdWritesEnabled())
+
+	o2 := o1.SetColdWritesEnabled(true)
+	require.True(t, o2.ColdWritesEnabled())
+	require.False(t, o1.Equal(o2))
+	require.False(t, o2.Equal(o1))
+}
+
 func TestOptionsEqualsRetention(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -164,3 +174,27 @@ func TestOptionsValidateNoIndexing(t *testing.T) {
 	rOpts.EXPECT().Validate().Return(nil)
 	require.NoError(t, o1.Validate())
 }
+
+func TestValidateUpdate(t *testing.T) {
+	existing := NewOptions()
+	ropts := existing.RetentionOptions()
+
+	updated := existing.SetRetentionOptions(
+		ropts.SetRetentionPeriod(ropts.RetentionPeriod() * 2))
+	require.NoError(t, ValidateUpdate(existing, updated))
+	require.NoError(t, ValidateUpdate(existing, existing))
+
+	updated = existing.SetRetentionOptions(
+		ropts.SetBufferPast(ropts.BufferPast() * 2))
+	require.Equal(t, errUpdateNotSupported, ValidateUpdate(existing, updated))
+
+	updated = existing.SetSnapshotEnabled(!existing.SnapshotEnabled())
+	require.Equal(t, errUpdateNotSupported, ValidateUpdate(existing, updated))
+
+	updated = existing.SetIndexOptions(
+		existing.IndexOptions().SetBlockSize(existing.IndexOptions().BlockSize() * 2))
+	require.Equal(t, errUpdateNotSupported, ValidateUpdate(existing, updated))
+
+	updated = existing.SetRetentionOptions(ropts.SetRetentionPeriod(0))
+	require.Error(t, ValidateUpdate(existing, updated))
+}
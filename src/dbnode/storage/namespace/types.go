@@ -61,6 +61,14 @@ type Options interface {
 	// WritesToCommitLog returns whether writes for series in this namespace need to go to commit log
 	WritesToCommitLog() bool
 
+	// SetColdWritesEnabled sets whether writes for series in this namespace that fall
+	// outside of the buffer window are accepted and merged into already flushed blocks
+	SetColdWritesEnabled(value bool) Options
+
+	// ColdWritesEnabled returns whether writes for series in this namespace that fall
+	// outside of the buffer window are accepted and merged into already flushed blocks
+	ColdWritesEnabled() bool
+
 	// SetCleanupEnabled sets whether this namespace requires cleaning up fileset/snapshot files
 	SetCleanupEnabled(value bool) Options
 
@@ -66,6 +66,7 @@ type databaseNamespaceReaderManager interface {
 	get(
 		shard uint32,
 		blockStart time.Time,
+		volumeIndex int,
 		position readerPosition,
 	) (fs.DataFileSetReader, error)
 
@@ -107,9 +108,10 @@ type namespaceReaderManager struct {
 }
 
 type cachedOpenReaderKey struct {
-	shard      uint32
-	blockStart xtime.UnixNano
-	position   readerPosition
+	shard       uint32
+	blockStart  xtime.UnixNano
+	volumeIndex int
+	position    readerPosition
 }
 
 type readerPosition struct {
@@ -232,12 +234,14 @@ func (m *namespaceReaderManager) cachedReaderForKey(
 func (m *namespaceReaderManager) get(
 	shard uint32,
 	blockStart time.Time,
+	volumeIndex int,
 	position readerPosition,
 ) (fs.DataFileSetReader, error) {
 	key := cachedOpenReaderKey{
-		shard:      shard,
-		blockStart: xtime.ToUnixNano(blockStart),
-		position:   position,
+		shard:       shard,
+		blockStart:  xtime.ToUnixNano(blockStart),
+		volumeIndex: volumeIndex,
+		position:    position,
 	}
 
 	lookup, err := m.cachedReaderForKey(key)
@@ -253,9 +257,10 @@ func (m *namespaceReaderManager) get(
 	reader := lookup.closedReader
 	openOpts := fs.DataReaderOpenOptions{
 		Identifier: fs.FileSetFileIdentifier{
-			Namespace:  m.namespace.ID(),
-			Shard:      shard,
-			BlockStart: blockStart,
+			Namespace:   m.namespace.ID(),
+			Shard:       shard,
+			BlockStart:  blockStart,
+			VolumeIndex: volumeIndex,
 		},
 	}
 	if err := reader.Open(openOpts); err != nil {
@@ -301,8 +306,9 @@ func (m *namespaceReaderManager) put(reader fs.DataFileSetReader) {
 	}
 
 	key := cachedOpenReaderKey{
-		shard:      status.Shard,
-		blockStart: xtime.ToUnixNano(status.BlockStart),
+		shard:       status.Shard,
+		blockStart:  xtime.ToUnixNano(status.BlockStart),
+		volumeIndex: status.VolumeIndex,
 		position: readerPosition{
 			dataIdx:     reader.EntriesRead(),
 			metadataIdx: reader.MetadataRead(),
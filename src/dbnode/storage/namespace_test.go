@@ -106,6 +106,42 @@ func TestNamespaceName(t *testing.T) {
 	require.True(t, defaultTestNs1ID.Equal(ns.ID()))
 }
 
+func TestNamespaceSetMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idx := NewMocknamespaceIndex(ctrl)
+	ns, closer := newTestNamespaceWithIndex(t, idx)
+	defer closer()
+
+	ropts := defaultTestNs1Opts.RetentionOptions()
+	updated := newTestNamespaceMetadataWithIDOpts(t, defaultTestNs1ID,
+		defaultTestNs1Opts.SetRetentionOptions(
+			ropts.SetRetentionPeriod(2*ropts.RetentionPeriod())))
+	for i := range testShardIDs {
+		shard := NewMockdatabaseShard(ctrl)
+		shard.EXPECT().SetNamespaceMetadata(updated)
+		ns.shards[testShardIDs[i].ID()] = shard
+	}
+	idx.EXPECT().SetRetentionPeriod(2 * ropts.RetentionPeriod())
+
+	require.NoError(t, ns.SetMetadata(updated))
+	require.True(t, updated.Options().Equal(ns.Options()))
+	require.Equal(t, 2*ropts.RetentionPeriod(),
+		ns.seriesOptions().RetentionOptions().RetentionPeriod())
+
+	// Block size changes cannot be applied to a live namespace.
+	unsupported := newTestNamespaceMetadataWithIDOpts(t, defaultTestNs1ID,
+		defaultTestNs1Opts.SetRetentionOptions(
+			ropts.SetBlockSize(2*ropts.BlockSize())))
+	require.Error(t, ns.SetMetadata(unsupported))
+	require.True(t, updated.Options().Equal(ns.Options()))
+
+	// Metadata of a different namespace is rejected.
+	other := newTestNamespaceMetadataWithIDOpts(t, defaultTestNs2ID, defaultTestNs1Opts)
+	require.Error(t, ns.SetMetadata(other))
+}
+
 func TestNamespaceTick(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1171,7 +1207,7 @@ func TestNamespaceIsCapturedBySnapshot(t *testing.T) {
 
 	var (
 		testTime   = time.Now()
-		blockSize  = ns.nopts.RetentionOptions().BlockSize()
+		blockSize  = ns.Options().RetentionOptions().BlockSize()
 		blockStart = time.Now().Truncate(blockSize)
 		testCases  = []struct {
 			title                 string
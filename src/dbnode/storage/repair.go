@@ -34,7 +34,9 @@ import (
 	"github.com/m3db/m3/src/dbnode/clock"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/storage/namespace"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
+	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3x/context"
 	xerrors "github.com/m3db/m3x/errors"
 	"github.com/m3db/m3x/ident"
@@ -59,6 +61,7 @@ type shardRepairer struct {
 	logger   xlog.Logger
 	scope    tally.Scope
 	nowFn    clock.NowFn
+	sleepFn  sleepFn
 }
 
 func newShardRepairer(opts Options, rpopts repair.Options) databaseShardRepairer {
@@ -66,12 +69,13 @@ func newShardRepairer(opts Options, rpopts repair.Options) databaseShardRepairer
 	scope := iopts.MetricsScope().SubScope("repair")
 
 	r := shardRepairer{
-		opts:   opts,
-		rpopts: rpopts,
-		client: rpopts.AdminClient(),
-		logger: iopts.Logger(),
-		scope:  scope,
-		nowFn:  opts.ClockOptions().NowFn(),
+		opts:    opts,
+		rpopts:  rpopts,
+		client:  rpopts.AdminClient(),
+		logger:  iopts.Logger(),
+		scope:   scope,
+		nowFn:   opts.ClockOptions().NowFn(),
+		sleepFn: time.Sleep,
 	}
 	r.recordFn = r.recordDifferences
 
@@ -84,7 +88,7 @@ func (r shardRepairer) Options() repair.Options {
 
 func (r shardRepairer) Repair(
 	ctx context.Context,
-	namespace ident.ID,
+	nsMeta namespace.Metadata,
 	tr xtime.Range,
 	shard databaseShard,
 ) (repair.MetadataComparisonResult, error) {
@@ -122,7 +126,7 @@ func (r shardRepairer) Repair(
 
 	// Add peer metadata
 	level := r.rpopts.RepairConsistencyLevel()
-	peerIter, err := session.FetchBlocksMetadataFromPeers(namespace, shard.ID(), start, end,
+	peerIter, err := session.FetchBlocksMetadataFromPeers(nsMeta.ID(), shard.ID(), start, end,
 		level, result.NewOptions())
 	if err != nil {
 		return repair.MetadataComparisonResult{}, err
@@ -133,11 +137,176 @@ func (r shardRepairer) Repair(
 
 	metadataRes := metadata.Compare()
 
-	r.recordFn(namespace, shard, metadataRes)
+	r.recordFn(nsMeta.ID(), shard, metadataRes)
+
+	// Stream the divergent blocks from peers and merge them into the shard
+	metadatas := peerBlocksToRepair(origin, metadataRes)
+	if err := r.repairBlocks(ctx, session, nsMeta, shard, metadatas); err != nil {
+		return metadataRes, err
+	}
 
 	return metadataRes, nil
 }
 
+func (r shardRepairer) repairBlocks(
+	ctx context.Context,
+	session client.AdminSession,
+	nsMeta namespace.Metadata,
+	shard databaseShard,
+	metadatas []block.ReplicaMetadata,
+) error {
+	var (
+		batchSize   = r.rpopts.RepairFetchBatchSize()
+		numRepaired int64
+		multiErr    = xerrors.NewMultiError()
+	)
+	for start := 0; start < len(metadatas); start += batchSize {
+		end := start + batchSize
+		if end > len(metadatas) {
+			end = len(metadatas)
+		}
+
+		batchStart := r.nowFn()
+		batch := metadatas[start:end]
+		n, err := r.repairBlocksBatch(ctx, session, nsMeta, shard, batch)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+		} else {
+			numRepaired += n
+		}
+
+		r.throttle(len(batch), r.nowFn().Sub(batchStart))
+	}
+
+	r.scope.Counter("repair-blocks").Inc(numRepaired)
+	if err := multiErr.FinalError(); err != nil {
+		r.scope.Counter("repair-blocks-errors").Inc(1)
+		return err
+	}
+	return nil
+}
+
+// repairBlocksBatch fetches a batch of blocks from peers and loads them into
+// the shard, returning the number of blocks repaired.
+func (r shardRepairer) repairBlocksBatch(
+	ctx context.Context,
+	session client.AdminSession,
+	nsMeta namespace.Metadata,
+	shard databaseShard,
+	metadatas []block.ReplicaMetadata,
+) (int64, error) {
+	ropts := result.NewOptions().
+		SetInstrumentOptions(r.opts.InstrumentOptions()).
+		SetDatabaseBlockOptions(r.opts.DatabaseBlockOptions())
+	level := r.rpopts.RepairConsistencyLevel()
+	blocksIter, err := session.FetchBlocksFromPeers(nsMeta, shard.ID(), level,
+		metadatas, ropts)
+	if err != nil {
+		return 0, err
+	}
+
+	// The fetched blocks do not carry tags, use the tags from the peer
+	// metadata so that series only held by peers can be created and indexed.
+	tagsByID := make(map[string]ident.Tags, len(metadatas))
+	for _, m := range metadatas {
+		tagsByID[m.ID.String()] = m.Tags
+	}
+
+	// Each divergent block may be fetched from several peers, blocks for the
+	// same series and start are merged before being loaded.
+	var (
+		res       = result.NewShardResult(len(metadatas), ropts)
+		numBlocks int64
+	)
+	for blocksIter.Next() {
+		_, id, peerBlock := blocksIter.Current()
+		if existing, ok := res.BlockAt(id, peerBlock.StartTime()); ok {
+			if err := existing.Merge(peerBlock); err != nil {
+				res.Close()
+				return 0, err
+			}
+			continue
+		}
+		res.AddBlock(id, tagsByID[id.String()], peerBlock)
+		numBlocks++
+	}
+	if err := blocksIter.Err(); err != nil {
+		res.Close()
+		return 0, err
+	}
+
+	if res.IsEmpty() {
+		return 0, nil
+	}
+
+	// Cannot close the result once loaded as the series take refs to the blocks
+	if err := shard.Load(ctx, res.AllSeries()); err != nil {
+		return 0, err
+	}
+	return numBlocks, nil
+}
+
+// throttle sleeps long enough for the blocks fetched to respect the
+// configured repair fetch rate limit.
+func (r shardRepairer) throttle(numBlocks int, took time.Duration) {
+	limit := r.rpopts.RepairFetchBlocksPerSecond()
+	if limit <= 0 || numBlocks <= 0 {
+		return
+	}
+	target := time.Duration(float64(time.Second) * float64(numBlocks) / float64(limit))
+	if took < target {
+		r.sleepFn(target - took)
+	}
+}
+
+// peerBlocksToRepair returns the replica metadata of the peer blocks which
+// differ from the local replica and need to be fetched to repair it.
+func peerBlocksToRepair(
+	origin topology.Host,
+	diffRes repair.MetadataComparisonResult,
+) []block.ReplicaMetadata {
+	var (
+		metadatas []block.ReplicaMetadata
+		seen      = make(map[string]struct{})
+	)
+	for _, diff := range []repair.ReplicaSeriesMetadata{
+		diffRes.SizeDifferences,
+		diffRes.ChecksumDifferences,
+	} {
+		if diff == nil {
+			continue
+		}
+		for _, entry := range diff.Series().Iter() {
+			series := entry.Value()
+			for _, b := range series.Metadata.Blocks() {
+				for _, hm := range b.Metadata() {
+					if hm.Host.ID() == origin.ID() {
+						continue
+					}
+					if hm.Size == 0 && hm.Checksum == nil {
+						// Peer does not have any data for this block
+						continue
+					}
+
+					key := fmt.Sprintf("%s/%d/%s", series.ID.String(),
+						b.Start().UnixNano(), hm.Host.ID())
+					if _, ok := seen[key]; ok {
+						continue
+					}
+					seen[key] = struct{}{}
+
+					metadatas = append(metadatas, block.ReplicaMetadata{
+						Host: hm.Host,
+						Metadata: block.NewMetadata(series.ID, series.Tags,
+							b.Start(), hm.Size, hm.Checksum, time.Time{}),
+					})
+				}
+			}
+		}
+	}
+	return metadatas
+}
+
 func (r shardRepairer) recordDifferences(
 	namespace ident.ID,
 	shard databaseShard,
@@ -105,6 +105,26 @@ func TestReplicaSeriesMetadataGetOrAdd(t *testing.T) {
 	require.Equal(t, 1, m.Series().Len())
 }
 
+func TestReplicaSeriesMetadataGetOrAddWithTags(t *testing.T) {
+	m := NewReplicaSeriesMetadata()
+	tags := ident.NewTags(ident.StringTag("foo", "bar"))
+
+	// Tags are set if the series was added without any
+	m.GetOrAdd(ident.StringID("foo"))
+	m.GetOrAddWithTags(ident.StringID("foo"), tags)
+	series, exists := m.Series().Get(ident.StringID("foo"))
+	require.True(t, exists)
+	require.True(t, tags.Equal(series.Tags))
+
+	// Existing tags are not replaced
+	m.GetOrAddWithTags(ident.StringID("foo"),
+		ident.NewTags(ident.StringTag("foo", "baz")))
+	series, exists = m.Series().Get(ident.StringID("foo"))
+	require.True(t, exists)
+	require.True(t, tags.Equal(series.Tags))
+	require.Equal(t, 1, m.Series().Len())
+}
+
 type testBlock struct {
 	id     ident.ID
 	ts     time.Time
@@ -37,6 +37,12 @@ const (
 	defaultRepairThrottle         = 90 * time.Second
 	defaultRepairMaxRetries       = 3
 	defaultRepairShardConcurrency = 1
+	// defaultRepairFetchBatchSize is the default number of blocks fetched from
+	// peers and merged into the shard at a time.
+	defaultRepairFetchBatchSize = 1024
+	// defaultRepairFetchBlocksPerSecond is the default rate at which blocks
+	// are fetched from peers during a repair.
+	defaultRepairFetchBlocksPerSecond = 2048
 )
 
 var (
@@ -49,6 +55,8 @@ var (
 	errRepairCheckIntervalTooBig    = errors.New("repair check interval too big in repair options")
 	errInvalidRepairThrottle        = errors.New("invalid repair throttle in repair options")
 	errInvalidRepairMaxRetries      = errors.New("invalid repair max retries in repair options")
+	errInvalidRepairFetchBatchSize  = errors.New("invalid repair fetch batch size in repair options")
+	errInvalidRepairFetchRateLimit  = errors.New("invalid repair fetch blocks per second in repair options")
 	errNoHostBlockMetadataSlicePool = errors.New("no host block metadata pool in repair options")
 )
 
@@ -62,6 +70,8 @@ type options struct {
 	repairCheckInterval        time.Duration
 	repairThrottle             time.Duration
 	repairMaxRetries           int
+	repairFetchBatchSize       int
+	repairFetchBlocksPerSecond int
 	hostBlockMetadataSlicePool HostBlockMetadataSlicePool
 }
 
@@ -76,6 +86,8 @@ func NewOptions() Options {
 		repairCheckInterval:        defaultRepairCheckInterval,
 		repairThrottle:             defaultRepairThrottle,
 		repairMaxRetries:           defaultRepairMaxRetries,
+		repairFetchBatchSize:       defaultRepairFetchBatchSize,
+		repairFetchBlocksPerSecond: defaultRepairFetchBlocksPerSecond,
 		hostBlockMetadataSlicePool: NewHostBlockMetadataSlicePool(nil, 0),
 	}
 }
@@ -170,6 +182,26 @@ func (o *options) RepairMaxRetries() int {
 	return o.repairMaxRetries
 }
 
+func (o *options) SetRepairFetchBatchSize(value int) Options {
+	opts := *o
+	opts.repairFetchBatchSize = value
+	return &opts
+}
+
+func (o *options) RepairFetchBatchSize() int {
+	return o.repairFetchBatchSize
+}
+
+func (o *options) SetRepairFetchBlocksPerSecond(value int) Options {
+	opts := *o
+	opts.repairFetchBlocksPerSecond = value
+	return &opts
+}
+
+func (o *options) RepairFetchBlocksPerSecond() int {
+	return o.repairFetchBlocksPerSecond
+}
+
 func (o *options) SetHostBlockMetadataSlicePool(value HostBlockMetadataSlicePool) Options {
 	opts := *o
 	opts.hostBlockMetadataSlicePool = value
@@ -208,6 +240,12 @@ func (o *options) Validate() error {
 	if o.repairMaxRetries < 0 {
 		return errInvalidRepairMaxRetries
 	}
+	if o.repairFetchBatchSize <= 0 {
+		return errInvalidRepairFetchBatchSize
+	}
+	if o.repairFetchBlocksPerSecond < 0 {
+		return errInvalidRepairFetchRateLimit
+	}
 	if o.hostBlockMetadataSlicePool == nil {
 		return errNoHostBlockMetadataSlicePool
 	}
@@ -108,13 +108,19 @@ type ReplicaSeriesMetadata interface {
 	// GetOrAdd returns the series metadata for an id, creating one if it doesn't exist
 	GetOrAdd(id ident.ID) ReplicaBlocksMetadata
 
+	// GetOrAddWithTags returns the series metadata for an id, creating one with
+	// the tags if it doesn't exist or setting the tags if it has none
+	GetOrAddWithTags(id ident.ID, tags ident.Tags) ReplicaBlocksMetadata
+
 	// Close performs cleanup
 	Close()
 }
 
-// ReplicaSeriesBlocksMetadata represents series metadata and an associated ID.
+// ReplicaSeriesBlocksMetadata represents series metadata and an associated ID
+// and tags.
 type ReplicaSeriesBlocksMetadata struct {
 	ID       ident.ID
+	Tags     ident.Tags
 	Metadata ReplicaBlocksMetadata
 }
 
@@ -206,6 +212,22 @@ type Options interface {
 	// MaxRepairRetries returns the max number of retries for a block start
 	RepairMaxRetries() int
 
+	// SetRepairFetchBatchSize sets the number of blocks fetched from peers
+	// and merged into the shard at a time
+	SetRepairFetchBatchSize(value int) Options
+
+	// RepairFetchBatchSize returns the number of blocks fetched from peers
+	// and merged into the shard at a time
+	RepairFetchBatchSize() int
+
+	// SetRepairFetchBlocksPerSecond sets the max rate at which blocks are
+	// fetched from peers, zero disables the rate limit
+	SetRepairFetchBlocksPerSecond(value int) Options
+
+	// RepairFetchBlocksPerSecond returns the max rate at which blocks are
+	// fetched from peers, zero disables the rate limit
+	RepairFetchBlocksPerSecond() int
+
 	// SetHostBlockMetadataSlicePool sets the hostBlockMetadataSlice pool
 	SetHostBlockMetadataSlicePool(value HostBlockMetadataSlicePool) Options
 
@@ -29,9 +29,11 @@ import (
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
 	"github.com/m3db/m3/src/dbnode/storage/namespace"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3x/context"
 	"github.com/m3db/m3x/ident"
 	xtime "github.com/m3db/m3x/time"
@@ -231,8 +233,11 @@ func TestDatabaseShardRepairerRepair(t *testing.T) {
 		SetClockOptions(copts.SetNowFn(nowFn)).
 		SetInstrumentOptions(iopts.SetMetricsScope(tally.NoopScope))
 
+	nsMeta, err := namespace.NewMetadata(ident.StringID("testNamespace"), namespace.NewOptions())
+	require.NoError(t, err)
+
 	var (
-		namespace       = ident.StringID("testNamespace")
+		nsID            = nsMeta.ID()
 		start           = now
 		end             = now.Add(rtopts.BlockSize())
 		repairTimeRange = xtime.Range{Start: start, End: end}
@@ -291,10 +296,42 @@ func TestDatabaseShardRepairerRepair(t *testing.T) {
 		peerIter.EXPECT().Err().Return(nil),
 	)
 	session.EXPECT().
-		FetchBlocksMetadataFromPeers(namespace, shardID, start, end,
+		FetchBlocksMetadataFromPeers(nsID, shardID, start, end,
 			rpOpts.RepairConsistencyLevel(), gomock.Any()).
 		Return(peerIter, nil)
 
+	peerBlock := block.NewDatabaseBlock(now.Add(time.Hour), rtopts.BlockSize(),
+		ts.Segment{}, opts.DatabaseBlockOptions())
+	peerBlocksIter := client.NewMockPeerBlocksIter(ctrl)
+	gomock.InOrder(
+		peerBlocksIter.EXPECT().Next().Return(true),
+		peerBlocksIter.EXPECT().Current().
+			Return(topology.NewHost("1", "addr1"), ident.StringID("foo"), peerBlock),
+		peerBlocksIter.EXPECT().Next().Return(false),
+		peerBlocksIter.EXPECT().Err().Return(nil),
+	)
+	var fetched []block.ReplicaMetadata
+	session.EXPECT().
+		FetchBlocksFromPeers(nsMeta, shardID, rpOpts.RepairConsistencyLevel(),
+			any, any).
+		DoAndReturn(func(
+			_ namespace.Metadata,
+			_ uint32,
+			_ topology.ReadConsistencyLevel,
+			metadatas []block.ReplicaMetadata,
+			_ result.Options,
+		) (client.PeerBlocksIter, error) {
+			fetched = metadatas
+			return peerBlocksIter, nil
+		})
+
+	var loaded *result.Map
+	shard.EXPECT().Load(any, any).DoAndReturn(
+		func(_ context.Context, seriesToLoad *result.Map) error {
+			loaded = seriesToLoad
+			return nil
+		})
+
 	var (
 		resNamespace ident.ID
 		resShard     databaseShard
@@ -310,8 +347,9 @@ func TestDatabaseShardRepairerRepair(t *testing.T) {
 	}
 
 	ctx := context.NewContext()
-	repairer.Repair(ctx, namespace, repairTimeRange, shard)
-	require.Equal(t, namespace, resNamespace)
+	_, err = repairer.Repair(ctx, nsMeta, repairTimeRange, shard)
+	require.NoError(t, err)
+	require.Equal(t, nsID, resNamespace)
 	require.Equal(t, resShard, shard)
 	require.Equal(t, int64(2), resDiff.NumSeries)
 	require.Equal(t, int64(3), resDiff.NumBlocks)
@@ -330,6 +368,40 @@ func TestDatabaseShardRepairerRepair(t *testing.T) {
 		{Host: topology.NewHost("1", "addr1"), Size: sizes[0], Checksum: &checksums[1]},
 	}
 	require.Equal(t, expected, block.Metadata())
+
+	// Only the size of foo's second block differs so only it is fetched
+	require.Equal(t, 1, len(fetched))
+	require.Equal(t, "1", fetched[0].Host.ID())
+	require.Equal(t, "foo", fetched[0].ID.String())
+	require.Equal(t, now.Add(time.Hour), fetched[0].Start)
+	require.Equal(t, sizes[0], fetched[0].Size)
+	require.Equal(t, checksums[1], *fetched[0].Checksum)
+
+	require.NotNil(t, loaded)
+	require.Equal(t, 1, loaded.Len())
+	loadedSeries, exists := loaded.Get(ident.StringID("foo"))
+	require.True(t, exists)
+	loadedBlock, exists := loadedSeries.Blocks.BlockAt(now.Add(time.Hour))
+	require.True(t, exists)
+	require.Equal(t, peerBlock, loadedBlock)
+}
+
+func TestDatabaseShardRepairerThrottle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rpOpts := testRepairOptions(ctrl).SetRepairFetchBlocksPerSecond(100)
+	repairer := newShardRepairer(testDatabaseOptions(), rpOpts).(shardRepairer)
+
+	var slept []time.Duration
+	repairer.sleepFn = func(d time.Duration) {
+		slept = append(slept, d)
+	}
+
+	repairer.throttle(50, 100*time.Millisecond)
+	repairer.throttle(50, time.Second)
+	repairer.throttle(0, 0)
+	require.Equal(t, []time.Duration{400 * time.Millisecond}, slept)
 }
 
 func TestRepairerRepairTimes(t *testing.T) {
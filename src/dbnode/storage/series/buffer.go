@@ -28,6 +28,7 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/clock"
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	m3dberrors "github.com/m3db/m3/src/dbnode/storage/errors"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -39,6 +40,8 @@ import (
 var (
 	errMoreThanOneStreamAfterMerge = errors.New("buffer has more than one stream after merge")
 	errNoAvailableBuckets          = errors.New("[invariant violated] buffer has no available buckets")
+	errColdFlushAlreadyInProgress  = errors.New("buffer cold flush already in progress for block")
+	errColdWritesDisabled          = errors.New("buffer cannot load cold blocks with cold writes disabled")
 	timeZero                       time.Time
 )
 
@@ -103,6 +106,30 @@ type databaseBuffer interface {
 	Bootstrap(bl block.DatabaseBlock) error
 
 	Reset(opts Options)
+
+	SetOptions(opts Options)
+
+	// ColdStreams returns the streams of data written outside of the buffer
+	// window for a block start that is not held by any of the buffer buckets.
+	ColdStreams(ctx context.Context, blockStart time.Time) []xio.BlockReader
+
+	// LoadCold loads a block for a block start that has already been flushed
+	// as cold writes, so that it is persisted by the next cold flush.
+	LoadCold(bl block.DatabaseBlock) error
+
+	// ColdFlushBlockStarts returns the block starts that have data written
+	// outside of the buffer window which has not been flushed yet.
+	ColdFlushBlockStarts() []time.Time
+
+	// PrepareColdFlush seals the data written outside of the buffer window for
+	// a block start into a block to be merged with the flushed data, the block
+	// remains readable from the buffer until FinishColdFlush is called.
+	PrepareColdFlush(blockStart time.Time) (block.DatabaseBlock, bool, error)
+
+	// FinishColdFlush releases the block sealed by PrepareColdFlush, returning
+	// it to the caller if the cold flush succeeded or otherwise retaining it so
+	// it is included in the next cold flush.
+	FinishColdFlush(blockStart time.Time, success bool) (block.DatabaseBlock, bool)
 }
 
 type bufferStats struct {
@@ -127,6 +154,11 @@ type dbBuffer struct {
 	blockSize         time.Duration
 	bufferPast        time.Duration
 	bufferFuture      time.Duration
+	// coldBuckets hold the writes outside of the buffer window for blocks
+	// that have already been rotated out of the buffer, coldFlushing holds
+	// the sealed cold writes of blocks that are being cold flushed.
+	coldBuckets  map[xtime.UnixNano]*dbBufferBucket
+	coldFlushing map[xtime.UnixNano]block.DatabaseBlock
 }
 
 type databaseBufferDrainFn func(b block.DatabaseBlock)
@@ -141,14 +173,30 @@ func newDatabaseBuffer(drainFn databaseBufferDrainFn) databaseBuffer {
 }
 
 func (b *dbBuffer) Reset(opts Options) {
+	b.SetOptions(opts)
+	// Avoid capturing any variables with callback
+	b.computedForEachBucketAsc(computeAndResetBucketIdx, bucketResetStart)
+	b.resetCold()
+}
+
+func (b *dbBuffer) SetOptions(opts Options) {
 	b.opts = opts
 	b.nowFn = opts.ClockOptions().NowFn()
 	ropts := opts.RetentionOptions()
 	b.blockSize = ropts.BlockSize()
 	b.bufferPast = ropts.BufferPast()
 	b.bufferFuture = ropts.BufferFuture()
-	// Avoid capturing any variables with callback
-	b.computedForEachBucketAsc(computeAndResetBucketIdx, bucketResetStart)
+}
+
+func (b *dbBuffer) resetCold() {
+	for key, bucket := range b.coldBuckets {
+		bucket.finalize()
+		delete(b.coldBuckets, key)
+	}
+	for key, bl := range b.coldFlushing {
+		bl.Close()
+		delete(b.coldFlushing, key)
+	}
 }
 
 func bucketResetStart(now time.Time, b *dbBuffer, idx int, start time.Time) int {
@@ -189,7 +237,10 @@ func (b *dbBuffer) Write(
 		return false, m3dberrors.ErrTooFuture
 	}
 	if !pastLimit.Before(timestamp) {
-		return false, m3dberrors.ErrTooPast
+		if !b.opts.ColdWritesEnabled() {
+			return false, m3dberrors.ErrTooPast
+		}
+		return b.writeCold(now, timestamp, value, unit, annotation)
 	}
 
 	bucketStart := timestamp.Truncate(b.blockSize)
@@ -202,6 +253,36 @@ func (b *dbBuffer) Write(
 	return b.buckets[idx].write(timestamp, value, unit, annotation)
 }
 
+func (b *dbBuffer) writeCold(
+	now time.Time,
+	timestamp time.Time,
+	value float64,
+	unit xtime.Unit,
+	annotation []byte,
+) (bool, error) {
+	if timestamp.Before(retention.FlushTimeStart(b.opts.RetentionOptions(), now)) {
+		return false, m3dberrors.ErrTooPast
+	}
+
+	bucket := b.coldBucketAt(timestamp.Truncate(b.blockSize))
+	return bucket.write(timestamp, value, unit, annotation)
+}
+
+func (b *dbBuffer) coldBucketAt(blockStart time.Time) *dbBufferBucket {
+	key := xtime.ToUnixNano(blockStart)
+	if bucket, ok := b.coldBuckets[key]; ok {
+		return bucket
+	}
+
+	if b.coldBuckets == nil {
+		b.coldBuckets = make(map[xtime.UnixNano]*dbBufferBucket)
+	}
+	bucket := &dbBufferBucket{opts: b.opts}
+	bucket.resetTo(blockStart)
+	b.coldBuckets[key] = bucket
+	return bucket
+}
+
 func (b *dbBuffer) writableBucketIdx(t time.Time) int {
 	return int(t.Truncate(b.blockSize).UnixNano() / int64(b.blockSize) % bucketsLen)
 }
@@ -211,7 +292,10 @@ func (b *dbBuffer) IsEmpty() bool {
 	for i := range b.buckets {
 		canReadAny = canReadAny || b.buckets[i].canRead()
 	}
-	return !canReadAny
+	for _, bucket := range b.coldBuckets {
+		canReadAny = canReadAny || bucket.canRead()
+	}
+	return !canReadAny && len(b.coldFlushing) == 0
 }
 
 func (b *dbBuffer) Stats() bufferStats {
@@ -226,6 +310,12 @@ func (b *dbBuffer) Stats() bufferStats {
 		}
 		stats.wiredBlocks++
 	}
+	for _, bucket := range b.coldBuckets {
+		if bucket.canRead() {
+			stats.wiredBlocks++
+		}
+	}
+	stats.wiredBlocks += len(b.coldFlushing)
 	return stats
 }
 
@@ -244,11 +334,43 @@ func bucketNeedsDrain(now time.Time, b *dbBuffer, idx int, start time.Time) int
 func (b *dbBuffer) Tick() bufferTickResult {
 	// Avoid capturing any variables with callback
 	mergedOutOfOrder := b.computedForEachBucketAsc(computeAndResetBucketIdx, bucketTick)
+	mergedOutOfOrder += b.tickCold()
 	return bufferTickResult{
 		mergedOutOfOrderBlocks: mergedOutOfOrder,
 	}
 }
 
+func (b *dbBuffer) tickCold() int {
+	if len(b.coldBuckets) == 0 {
+		return 0
+	}
+
+	var (
+		mergedOutOfOrderBlocks int
+		earliest               = retention.FlushTimeStart(b.opts.RetentionOptions(), b.nowFn())
+	)
+	for key, bucket := range b.coldBuckets {
+		if key.ToTime().Before(earliest) || !bucket.canRead() {
+			// Either expired or there is nothing left to flush for the block.
+			bucket.finalize()
+			delete(b.coldBuckets, key)
+			continue
+		}
+
+		// Try to merge any out of order encoders to amortize the cost of a cold flush
+		r, err := bucket.merge()
+		if err != nil {
+			log := b.opts.InstrumentOptions().Logger()
+			log.Errorf("buffer cold merge encode error: %v", err)
+		}
+		if r.merges > 0 {
+			mergedOutOfOrderBlocks++
+		}
+	}
+
+	return mergedOutOfOrderBlocks
+}
+
 func bucketTick(now time.Time, b *dbBuffer, idx int, start time.Time) int {
 	// Perform a drain and reset if necessary
 	mergedOutOfOrderBlocks := bucketDrainAndReset(now, b, idx, start)
@@ -365,7 +487,7 @@ func (b *dbBuffer) computedForEachBucketAsc(
 
 func (b *dbBuffer) Snapshot(ctx context.Context, blockStart time.Time) (xio.SegmentReader, error) {
 	var (
-		res xio.SegmentReader
+		res []xio.SegmentReader
 		err error
 	)
 
@@ -384,31 +506,104 @@ func (b *dbBuffer) Snapshot(ctx context.Context, blockStart time.Time) (xio.Segm
 			return
 		}
 
-		// We need to merge all the bootstrapped blocks / encoders into a single stream for
-		// the sake of being able to persist it to disk as a single encoded stream.
-		_, err = bucket.merge()
-		if err != nil {
-			return
+		var stream xio.SegmentReader
+		stream, err = b.snapshotBucket(ctx, bucket)
+		if err == nil {
+			res = append(res, stream)
 		}
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		// This operation is safe because all of the underlying resources will respect the
-		// lifecycle of the context in one way or another. The "bootstrapped blocks" that
-		// we stream from will mark their internal context as dependent on that of the passed
-		// context, and the Encoder's that we stream from actually perform a data copy and
-		// don't share a reference.
-		streams := bucket.streams(ctx)
-		if len(streams) != 1 {
-			// Should never happen as the call to merge above should result in only a single
-			// stream being present.
-			err = errMoreThanOneStreamAfterMerge
-			return
+	// Cold writes are only held in memory until they are cold flushed so they
+	// need to be included in the snapshot to survive a restart.
+	key := xtime.ToUnixNano(blockStart)
+	if bl, ok := b.coldFlushing[key]; ok {
+		stream, err := bl.Stream(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if stream.IsNotEmpty() {
+			res = append(res, stream.SegmentReader)
+		}
+	}
+	if bucket, ok := b.coldBuckets[key]; ok && bucket.canRead() {
+		stream, err := b.snapshotBucket(ctx, bucket)
+		if err != nil {
+			return nil, err
 		}
+		res = append(res, stream)
+	}
 
-		// Direct indexing is safe because canRead guarantees us at least one stream
-		res = streams[0]
-	})
+	return mergeSegmentReaders(ctx, b.opts, blockStart, res)
+}
 
-	return res, err
+func (b *dbBuffer) snapshotBucket(
+	ctx context.Context,
+	bucket *dbBufferBucket,
+) (xio.SegmentReader, error) {
+	// We need to merge all the bootstrapped blocks / encoders into a single stream for
+	// the sake of being able to persist it to disk as a single encoded stream.
+	if _, err := bucket.merge(); err != nil {
+		return nil, err
+	}
+
+	// This operation is safe because all of the underlying resources will respect the
+	// lifecycle of the context in one way or another. The "bootstrapped blocks" that
+	// we stream from will mark their internal context as dependent on that of the passed
+	// context, and the Encoder's that we stream from actually perform a data copy and
+	// don't share a reference.
+	streams := bucket.streams(ctx)
+	if len(streams) != 1 {
+		// Should never happen as the call to merge above should result in only a single
+		// stream being present.
+		return nil, errMoreThanOneStreamAfterMerge
+	}
+
+	// Direct indexing is safe because canRead guarantees us at least one stream
+	return streams[0].SegmentReader, nil
+}
+
+// mergeSegmentReaders merges the streams of a block into a single stream, the
+// merged stream is finalized when the context is closed.
+func mergeSegmentReaders(
+	ctx context.Context,
+	opts Options,
+	blockStart time.Time,
+	readers []xio.SegmentReader,
+) (xio.SegmentReader, error) {
+	switch len(readers) {
+	case 0:
+		return nil, nil
+	case 1:
+		return readers[0], nil
+	}
+
+	var (
+		bopts   = opts.DatabaseBlockOptions()
+		encoder = bopts.EncoderPool().Get()
+		iter    = opts.MultiReaderIteratorPool().Get()
+	)
+	defer iter.Close()
+
+	encoder.Reset(blockStart, bopts.DatabaseBlockAllocSize())
+	iter.Reset(readers, blockStart, opts.RetentionOptions().BlockSize())
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			encoder.Close()
+			return nil, err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		encoder.Close()
+		return nil, err
+	}
+
+	merged := xio.NewSegmentReader(encoder.Discard())
+	ctx.RegisterFinalizer(merged)
+	return merged, nil
 }
 
 func (b *dbBuffer) ReadEncoded(ctx context.Context, start, end time.Time) [][]xio.BlockReader {
@@ -425,7 +620,9 @@ func (b *dbBuffer) ReadEncoded(ctx context.Context, start, end time.Time) [][]xi
 			return
 		}
 
-		res = append(res, bucket.streams(ctx))
+		streams := bucket.streams(ctx)
+		streams = append(streams, b.coldStreams(ctx, bucket.start)...)
+		res = append(res, streams)
 
 		// NB(r): Store the last read time, should not set this when
 		// calling FetchBlocks as a read is differentiated from
@@ -460,6 +657,7 @@ func (b *dbBuffer) FetchBlocks(ctx context.Context, starts []time.Time) []block.
 		}
 
 		streams := bucket.streams(ctx)
+		streams = append(streams, b.coldStreams(ctx, bucket.start)...)
 		res = append(res, block.NewFetchBlockResult(bucket.start, streams, nil))
 	})
 
@@ -505,6 +703,99 @@ func (b *dbBuffer) FetchBlocksMetadata(
 	return res
 }
 
+func (b *dbBuffer) ColdStreams(ctx context.Context, blockStart time.Time) []xio.BlockReader {
+	if len(b.coldBuckets) == 0 && len(b.coldFlushing) == 0 {
+		return nil
+	}
+	for i := range b.buckets {
+		if b.buckets[i].canRead() && b.buckets[i].start.Equal(blockStart) {
+			// Returned along with the bucket by ReadEncoded and FetchBlocks.
+			return nil
+		}
+	}
+	return b.coldStreams(ctx, blockStart)
+}
+
+func (b *dbBuffer) coldStreams(ctx context.Context, blockStart time.Time) []xio.BlockReader {
+	var (
+		streams []xio.BlockReader
+		key     = xtime.ToUnixNano(blockStart)
+	)
+	if bl, ok := b.coldFlushing[key]; ok {
+		if s, err := bl.Stream(ctx); err == nil && s.IsNotEmpty() {
+			// The block stream method registers the stream closer already.
+			streams = append(streams, s)
+		}
+	}
+	if bucket, ok := b.coldBuckets[key]; ok && bucket.canRead() {
+		streams = append(streams, bucket.streams(ctx)...)
+	}
+	return streams
+}
+
+func (b *dbBuffer) LoadCold(bl block.DatabaseBlock) error {
+	if !b.opts.ColdWritesEnabled() {
+		return errColdWritesDisabled
+	}
+
+	b.coldBucketAt(bl.StartTime()).bootstrap(bl)
+	return nil
+}
+
+func (b *dbBuffer) ColdFlushBlockStarts() []time.Time {
+	var starts []time.Time
+	for _, bucket := range b.coldBuckets {
+		if bucket.canRead() {
+			starts = append(starts, bucket.start)
+		}
+	}
+	return starts
+}
+
+func (b *dbBuffer) PrepareColdFlush(blockStart time.Time) (block.DatabaseBlock, bool, error) {
+	key := xtime.ToUnixNano(blockStart)
+	if _, ok := b.coldFlushing[key]; ok {
+		return nil, false, errColdFlushAlreadyInProgress
+	}
+
+	bucket, ok := b.coldBuckets[key]
+	if !ok || !bucket.canRead() {
+		return nil, false, nil
+	}
+
+	// Writes that arrive during the cold flush go to a new bucket and
+	// will be included in the next cold flush.
+	delete(b.coldBuckets, key)
+	result, err := bucket.discardMerged()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if b.coldFlushing == nil {
+		b.coldFlushing = make(map[xtime.UnixNano]block.DatabaseBlock)
+	}
+	b.coldFlushing[key] = result.block
+	return result.block, true, nil
+}
+
+func (b *dbBuffer) FinishColdFlush(blockStart time.Time, success bool) (block.DatabaseBlock, bool) {
+	key := xtime.ToUnixNano(blockStart)
+	bl, ok := b.coldFlushing[key]
+	if !ok {
+		return nil, false
+	}
+
+	delete(b.coldFlushing, key)
+	if success {
+		return bl, true
+	}
+
+	// Retain the data so it is merged with any new cold writes and
+	// included in the next cold flush.
+	b.coldBucketAt(blockStart).bootstrap(bl)
+	return nil, false
+}
+
 type dbBufferBucket struct {
 	opts              Options
 	start             time.Time
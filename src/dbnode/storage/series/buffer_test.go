@@ -36,6 +36,7 @@ import (
 	xerrors "github.com/m3db/m3x/errors"
 	xtime "github.com/m3db/m3x/time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -107,6 +108,90 @@ func TestBufferWriteTooPast(t *testing.T) {
 	assert.False(t, wasWritten)
 }
 
+func TestBufferWriteColdWrites(t *testing.T) {
+	opts := newBufferTestOptions().SetColdWritesEnabled(true)
+	rops := opts.RetentionOptions()
+	curr := time.Now().Truncate(rops.BlockSize())
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr
+	}))
+	buffer := newDatabaseBuffer(nil).(*dbBuffer)
+	buffer.Reset(opts)
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	// Writes older than retention are still rejected.
+	wasWritten, err := buffer.Write(ctx, curr.Add(-2*rops.RetentionPeriod()), 1,
+		xtime.Second, nil)
+	assert.Error(t, err)
+	assert.False(t, wasWritten)
+
+	blockStart := curr.Add(-rops.BlockSize())
+	data := []value{
+		{blockStart.Add(secs(1)), 1, xtime.Second, nil},
+		{blockStart.Add(secs(2)), 2, xtime.Second, nil},
+	}
+	for _, v := range data {
+		verifyWriteToBuffer(t, buffer, v)
+	}
+
+	assert.False(t, buffer.IsEmpty())
+	assert.Equal(t, []time.Time{blockStart}, buffer.ColdFlushBlockStarts())
+
+	streams := buffer.ColdStreams(ctx, blockStart)
+	require.Len(t, streams, 1)
+	assertValuesEqual(t, data, [][]xio.BlockReader{streams}, opts)
+
+	// A failed cold flush retains the data for the next cold flush.
+	bl, ok, err := buffer.PrepareColdFlush(blockStart)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotNil(t, bl)
+
+	_, _, err = buffer.PrepareColdFlush(blockStart)
+	assert.Equal(t, errColdFlushAlreadyInProgress, err)
+
+	_, ok = buffer.FinishColdFlush(blockStart, false)
+	assert.False(t, ok)
+	assert.Equal(t, []time.Time{blockStart}, buffer.ColdFlushBlockStarts())
+
+	// A successful cold flush hands the block back to the caller.
+	_, ok, err = buffer.PrepareColdFlush(blockStart)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	bl, ok = buffer.FinishColdFlush(blockStart, true)
+	require.True(t, ok)
+	require.NotNil(t, bl)
+	bl.Close()
+
+	assert.Empty(t, buffer.ColdFlushBlockStarts())
+	assert.Empty(t, buffer.ColdStreams(ctx, blockStart))
+}
+
+func TestBufferLoadCold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newBufferTestOptions()
+	rops := opts.RetentionOptions()
+	blockStart := time.Now().Truncate(rops.BlockSize()).Add(-2 * rops.BlockSize())
+
+	bl := block.NewMockDatabaseBlock(ctrl)
+	bl.EXPECT().StartTime().Return(blockStart).AnyTimes()
+	bl.EXPECT().Len().Return(1).AnyTimes()
+
+	buffer := newDatabaseBuffer(nil).(*dbBuffer)
+	buffer.Reset(opts)
+	assert.Equal(t, errColdWritesDisabled, buffer.LoadCold(bl))
+	assert.Empty(t, buffer.ColdFlushBlockStarts())
+
+	buffer.Reset(opts.SetColdWritesEnabled(true))
+	require.NoError(t, buffer.LoadCold(bl))
+	assert.Equal(t, []time.Time{blockStart}, buffer.ColdFlushBlockStarts())
+}
+
 // Writes to buffer, verifying no error and that further writes should happen.
 func verifyWriteToBuffer(t *testing.T, buffer databaseBuffer, v value) {
 	ctx := context.NewContext()
@@ -1060,6 +1145,65 @@ func TestBufferSnapshot(t *testing.T) {
 	assert.Equal(t, 1, len(encoders))
 }
 
+func TestBufferSnapshotColdWrites(t *testing.T) {
+	var (
+		opts      = newBufferTestOptions().SetColdWritesEnabled(true)
+		rops      = opts.RetentionOptions()
+		blockSize = rops.BlockSize()
+		curr      = time.Now().Truncate(blockSize)
+		prev      = curr.Add(-blockSize)
+		buffer    = newDatabaseBuffer(nil).(*dbBuffer)
+	)
+	opts = opts.SetClockOptions(opts.ClockOptions().SetNowFn(func() time.Time {
+		return curr
+	}))
+	buffer.Reset(opts)
+
+	// The previous block has warm writes within buffer past and cold writes
+	// outside of it, the block before has only cold writes.
+	warm := []value{
+		{curr.Add(-rops.BufferPast() / 2), 1, xtime.Second, nil},
+	}
+	cold := []value{
+		{prev.Add(secs(30)), 2, xtime.Second, nil},
+		{prev.Add(secs(1)), 3, xtime.Second, nil},
+	}
+	coldOnly := []value{
+		{prev.Add(-blockSize).Add(secs(1)), 4, xtime.Second, nil},
+	}
+	for _, v := range append(append(append([]value{}, warm...), cold...), coldOnly...) {
+		verifyWriteToBuffer(t, buffer, v)
+	}
+	require.Len(t, buffer.ColdFlushBlockStarts(), 2)
+
+	tests := []struct {
+		blockStart time.Time
+		expected   []value
+	}{
+		{blockStart: prev, expected: append(append([]value{}, warm...), cold...)},
+		{blockStart: prev.Add(-blockSize), expected: coldOnly},
+	}
+	for _, test := range tests {
+		ctx := context.NewContext()
+		result, err := buffer.Snapshot(ctx, test.blockStart)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+
+		expected := make([]value, len(test.expected))
+		copy(expected, test.expected)
+		sort.Sort(valuesByTime(expected))
+		assertValuesEqual(t, expected, [][]xio.BlockReader{{
+			xio.BlockReader{
+				SegmentReader: result,
+			},
+		}}, opts)
+		ctx.Close()
+	}
+
+	// Snapshotting does not release the cold writes.
+	assert.Len(t, buffer.ColdFlushBlockStarts(), 2)
+}
+
 func mustGetLastEncoded(t *testing.T, entry inOrderEncoder) ts.Datapoint {
 	last, err := entry.encoder.LastEncoded()
 	require.NoError(t, err)
@@ -117,6 +117,10 @@ func (r Reader) readersWithBlocksMapAndBuffer(
 
 	first, last := alignedStart, alignedEnd
 	for blockAt := first; !blockAt.After(last); blockAt = blockAt.Add(size) {
+		var (
+			blockReaders []xio.BlockReader
+			inMemory     bool
+		)
 		if seriesBlocks != nil {
 			if block, ok := seriesBlocks.BlockAt(blockAt); ok {
 				// Block served from in-memory or in-memory metadata
@@ -126,18 +130,20 @@ func (r Reader) readersWithBlocksMapAndBuffer(
 					return nil, err
 				}
 				if streamedBlock.IsNotEmpty() {
-					results = append(results, []xio.BlockReader{streamedBlock})
+					blockReaders = append(blockReaders, streamedBlock)
 					// NB(r): Mark this block as read now
 					block.SetLastReadTime(now)
 					if r.onRead != nil {
 						r.onRead.OnReadBlock(block)
 					}
 				}
-				continue
+				inMemory = true
 			}
 		}
 
 		switch {
+		case inMemory:
+			// No-op, block was served from memory
 		case cachePolicy == CacheAll:
 			// No-op, block metadata should have been in-memory
 		case r.retriever != nil:
@@ -148,10 +154,20 @@ func (r Reader) readersWithBlocksMapAndBuffer(
 					return nil, err
 				}
 				if streamedBlock.IsNotEmpty() {
-					results = append(results, []xio.BlockReader{streamedBlock})
+					blockReaders = append(blockReaders, streamedBlock)
 				}
 			}
 		}
+
+		if seriesBuffer != nil {
+			// Cold writes for the block that have not been flushed yet
+			// are merged with the rest of the data for the block.
+			blockReaders = append(blockReaders, seriesBuffer.ColdStreams(ctx, blockAt)...)
+		}
+
+		if len(blockReaders) > 0 {
+			results = append(results, blockReaders)
+		}
 	}
 
 	if seriesBuffer != nil {
@@ -191,6 +207,10 @@ func (r Reader) fetchBlocksWithBlocksMapAndBuffer(
 		onRetrieve block.OnRetrieveBlock
 	)
 	for _, start := range starts {
+		var (
+			blockReaders []xio.BlockReader
+			inMemory     bool
+		)
 		if seriesBlocks != nil {
 			if b, exists := seriesBlocks.BlockAt(start); exists {
 				streamedBlock, err := b.Stream(ctx)
@@ -201,14 +221,14 @@ func (r Reader) fetchBlocksWithBlocksMapAndBuffer(
 					res = append(res, r)
 				}
 				if streamedBlock.IsNotEmpty() {
-					b := []xio.BlockReader{streamedBlock}
-					r := block.NewFetchBlockResult(start, b, nil)
-					res = append(res, r)
+					blockReaders = append(blockReaders, streamedBlock)
 				}
-				continue
+				inMemory = true
 			}
 		}
 		switch {
+		case inMemory:
+			// No-op, block was served from memory
 		case cachePolicy == CacheAll:
 			// No-op, block metadata should have been in-memory
 		case r.retriever != nil:
@@ -222,12 +242,21 @@ func (r Reader) fetchBlocksWithBlocksMapAndBuffer(
 					res = append(res, r)
 				}
 				if streamedBlock.IsNotEmpty() {
-					b := []xio.BlockReader{streamedBlock}
-					r := block.NewFetchBlockResult(start, b, nil)
-					res = append(res, r)
+					blockReaders = append(blockReaders, streamedBlock)
 				}
 			}
 		}
+
+		if seriesBuffer != nil {
+			// Cold writes for the block that have not been flushed yet
+			// are returned with the rest of the data for the block.
+			blockReaders = append(blockReaders, seriesBuffer.ColdStreams(ctx, start)...)
+		}
+
+		if len(blockReaders) > 0 {
+			r := block.NewFetchBlockResult(start, blockReaders, nil)
+			res = append(res, r)
+		}
 	}
 
 	if seriesBuffer != nil && !seriesBuffer.IsEmpty() {
@@ -484,6 +484,124 @@ func (s *dbSeries) Bootstrap(bootstrappedBlocks block.DatabaseSeriesBlocks) (Boo
 	return result, multiErr.FinalError()
 }
 
+func (s *dbSeries) Load(
+	ctx context.Context,
+	blocks block.DatabaseSeriesBlocks,
+) (LoadResult, error) {
+	var result LoadResult
+	if blocks == nil {
+		return result, nil
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.bs != bootstrapped {
+		return result, errSeriesNotBootstrapped
+	}
+
+	min, _, err := s.buffer.MinMax()
+	if err != nil {
+		return result, err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for tNano, bl := range blocks.AllBlocks() {
+		t := tNano.ToTime()
+		// Merging into a block that has already been flushed would only be
+		// held in memory, when cold writes are enabled load it as cold writes
+		// so that it is persisted to a new fileset volume by the next cold flush.
+		// This takes precedence over the buffer as a flushed block must not be
+		// drained and flushed again.
+		if s.opts.ColdWritesEnabled() && s.blockRetriever != nil &&
+			s.blockRetriever.IsBlockRetrievable(t) {
+			if err := s.buffer.LoadCold(bl); err != nil {
+				multiErr = multiErr.Add(s.newLoadBlockError(bl, err))
+				continue
+			}
+			result.NumBlocksMovedToBuffer++
+			continue
+		}
+
+		// Same as bootstrapping, if the buffer can still accept the block then
+		// let it be merged and drained as part of the usual lifecycle.
+		if !t.Before(min) {
+			if err := s.buffer.Bootstrap(bl); err != nil {
+				multiErr = multiErr.Add(s.newLoadBlockError(bl, err))
+				continue
+			}
+			result.NumBlocksMovedToBuffer++
+			continue
+		}
+
+		if err := s.loadBlockWithLock(ctx, bl); err != nil {
+			multiErr = multiErr.Add(s.newLoadBlockError(bl, err))
+			continue
+		}
+		result.NumBlocksMerged++
+	}
+
+	return result, multiErr.FinalError()
+}
+
+func (s *dbSeries) loadBlockWithLock(
+	ctx context.Context,
+	newBlock block.DatabaseBlock,
+) error {
+	blockStart := newBlock.StartTime()
+	if existingBlock, ok := s.blocks.BlockAt(blockStart); ok {
+		return existingBlock.Merge(newBlock)
+	}
+
+	if s.blockRetriever == nil || !s.blockRetriever.IsBlockRetrievable(blockStart) {
+		s.addBlockWithLock(newBlock)
+		return nil
+	}
+
+	// NB: The block has been flushed and is not held in memory, since reads
+	// are served from memory before disk when a block is present we need to
+	// merge the flushed data with the loaded block so it is not shadowed.
+	// We do not pass an OnRetrieveBlock callback since we emplace the block
+	// ourselves and already hold the series lock.
+	reader, err := s.blockRetriever.Stream(ctx, s.id, blockStart, nil)
+	if err != nil {
+		return err
+	}
+	if !reader.IsNotEmpty() {
+		s.addBlockWithLock(newBlock)
+		return nil
+	}
+	segment, err := reader.Segment()
+	if err != nil {
+		return err
+	}
+
+	// Copy the data as the reader releases it once the context is closed.
+	blockOpts := s.opts.DatabaseBlockOptions()
+	data := blockOpts.BytesPool().Get(segment.Len())
+	data.IncRef()
+	if segment.Head != nil {
+		data.AppendAll(segment.Head.Bytes())
+	}
+	if segment.Tail != nil {
+		data.AppendAll(segment.Tail.Bytes())
+	}
+	data.DecRef()
+
+	// Reset as an in-memory block since blocks retrieved from disk cannot
+	// be merged into.
+	existingBlock := blockOpts.DatabaseBlockPool().Get()
+	blockSize := s.opts.RetentionOptions().BlockSize()
+	existingBlock.Reset(blockStart, blockSize, ts.NewSegment(data, nil, ts.FinalizeHead))
+	if err := existingBlock.Merge(newBlock); err != nil {
+		existingBlock.Close()
+		return err
+	}
+
+	s.addBlockWithLock(existingBlock)
+	return nil
+}
+
 func (s *dbSeries) OnRetrieveBlock(
 	id ident.ID,
 	tags ident.TagIterator,
@@ -590,6 +708,15 @@ func (s *dbSeries) newBootstrapBlockError(
 	return xerrors.NewRenamedError(err, renamed)
 }
 
+func (s *dbSeries) newLoadBlockError(
+	b block.DatabaseBlock,
+	err error,
+) error {
+	msgFmt := "load series error occurred for %s block at %s: %v"
+	renamed := fmt.Errorf(msgFmt, s.id.String(), b.StartTime().String(), err)
+	return xerrors.NewRenamedError(err, renamed)
+}
+
 func (s *dbSeries) Flush(
 	ctx context.Context,
 	blockStart time.Time,
@@ -631,6 +758,140 @@ func (s *dbSeries) Flush(
 	return FlushOutcomeFlushedToDisk, nil
 }
 
+func (s *dbSeries) ColdFlushBlockStarts() []time.Time {
+	s.RLock()
+	starts := s.buffer.ColdFlushBlockStarts()
+	s.RUnlock()
+	return starts
+}
+
+func (s *dbSeries) ColdFlush(
+	ctx context.Context,
+	blockStart time.Time,
+	existing ts.Segment,
+	persistFn persist.DataFn,
+) (FlushOutcome, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.bs != bootstrapped {
+		return FlushOutcomeErr, errSeriesNotBootstrapped
+	}
+
+	cold, ok, err := s.buffer.PrepareColdFlush(blockStart)
+	if err != nil {
+		return FlushOutcomeErr, err
+	}
+	if !ok {
+		return FlushOutcomeBlockDoesNotExist, nil
+	}
+
+	br, err := cold.Stream(ctx)
+	if err != nil {
+		return FlushOutcomeErr, err
+	}
+	if br.IsEmpty() {
+		return FlushOutcomeErr, errStreamDidNotExistForBlock
+	}
+
+	if existing.Len() == 0 {
+		// Nothing has been flushed for this series yet, the cold writes
+		// make up the entire block.
+		segment, err := br.Segment()
+		if err != nil {
+			return FlushOutcomeErr, err
+		}
+		checksum, err := cold.Checksum()
+		if err != nil {
+			return FlushOutcomeErr, err
+		}
+		if err := persistFn(s.id, s.tags, segment, checksum); err != nil {
+			return FlushOutcomeErr, err
+		}
+		return FlushOutcomeFlushedToDisk, nil
+	}
+
+	segment, err := s.mergeColdWithLock(blockStart, existing, br.SegmentReader)
+	if err != nil {
+		return FlushOutcomeErr, err
+	}
+	defer segment.Finalize()
+
+	err = persistFn(s.id, s.tags, segment, digest.SegmentChecksum(segment))
+	if err != nil {
+		return FlushOutcomeErr, err
+	}
+
+	return FlushOutcomeFlushedToDisk, nil
+}
+
+func (s *dbSeries) mergeColdWithLock(
+	blockStart time.Time,
+	existing ts.Segment,
+	cold xio.SegmentReader,
+) (ts.Segment, error) {
+	var (
+		bopts   = s.opts.DatabaseBlockOptions()
+		encoder = bopts.EncoderPool().Get()
+		iter    = s.opts.MultiReaderIteratorPool().Get()
+		// Rank the cold writes after the flushed data so that they take
+		// precedence over flushed datapoints with the same timestamp.
+		readers = []xio.SegmentReader{xio.NewSegmentReader(existing), cold}
+	)
+	defer iter.Close()
+
+	encoder.Reset(blockStart, bopts.DatabaseBlockAllocSize())
+	iter.Reset(readers, blockStart, s.opts.RetentionOptions().BlockSize())
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			encoder.Close()
+			return ts.Segment{}, err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		encoder.Close()
+		return ts.Segment{}, err
+	}
+
+	return encoder.Discard(), nil
+}
+
+func (s *dbSeries) FinishColdFlush(blockStart time.Time, success bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	cold, ok := s.buffer.FinishColdFlush(blockStart, success)
+	if !ok {
+		return
+	}
+
+	existing, ok := s.blocks.BlockAt(blockStart)
+	if !ok {
+		// The cold writes are now served from disk.
+		cold.Close()
+		return
+	}
+
+	if !existing.WasRetrievedFromDisk() {
+		// Blocks held in memory are read instead of the flushed data so
+		// they need to include the cold writes.
+		if err := existing.Merge(cold); err == nil {
+			return
+		}
+	}
+
+	// The block was retrieved from a volume that has been superseded by
+	// the cold flush, remove it so the next read retrieves the new volume.
+	// As with expiry in tick, blocks retrieved from disk when using the LRU
+	// policy are closed by the WiredList rather than the series.
+	s.blocks.RemoveBlockAt(blockStart)
+	if !(s.opts.CachePolicy() == CacheLRU && existing.WasRetrievedFromDisk()) {
+		existing.Close()
+	}
+	cold.Close()
+}
+
 func (s *dbSeries) Snapshot(
 	ctx context.Context,
 	blockStart time.Time,
@@ -645,22 +906,34 @@ func (s *dbSeries) Snapshot(
 		return errSeriesNotBootstrapped
 	}
 
-	var (
-		stream xio.SegmentReader
-		err    error
-	)
-	block, ok := s.blocks.BlockAt(blockStart)
-	if ok {
+	var streams []xio.SegmentReader
+	// Blocks that have been flushed are already persisted, only the cold
+	// writes held by the buffer need to be snapshotted for these.
+	flushed := s.blockRetriever != nil && s.blockRetriever.IsBlockRetrievable(blockStart)
+	if bl, ok := s.blocks.BlockAt(blockStart); ok && !flushed {
 		// First check if the data has already been rotated out of the buffer
-		// into an immutable block. If it has, there is no need to check the
-		// series buffer as the data can't be in both locations.
-		stream, err = block.Stream(ctx)
-	} else {
-		// If the data hasn't been rotated into an immutable block yet,
-		// then it may be in the series buffer (because its still mutable).
-		stream, err = s.buffer.Snapshot(ctx, blockStart)
+		// into an immutable block. If it has, only cold writes for the block
+		// can still be in the series buffer.
+		stream, err := bl.Stream(ctx)
+		if err != nil {
+			return err
+		}
+		if stream.IsNotEmpty() {
+			streams = append(streams, stream.SegmentReader)
+		}
 	}
 
+	// If the data hasn't been rotated into an immutable block yet,
+	// then it may be in the series buffer (because its still mutable).
+	stream, err := s.buffer.Snapshot(ctx, blockStart)
+	if err != nil {
+		return err
+	}
+	if stream != nil {
+		streams = append(streams, stream)
+	}
+
+	stream, err = mergeSegmentReaders(ctx, s.opts, blockStart, streams)
 	if err != nil {
 		return err
 	}
@@ -709,6 +982,13 @@ func (s *dbSeries) Close() {
 	}
 }
 
+func (s *dbSeries) SetOptions(opts Options) {
+	s.Lock()
+	s.opts = opts
+	s.buffer.SetOptions(opts)
+	s.Unlock()
+}
+
 func (s *dbSeries) Reset(
 	id ident.ID,
 	tags ident.Tags,
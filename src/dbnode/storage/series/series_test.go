@@ -586,6 +586,277 @@ func TestSeriesBootstrapWithError(t *testing.T) {
 	require.Equal(t, 1, series.blocks.Len())
 }
 
+func TestSeriesBootstrapFlushedBlocks(t *testing.T) {
+	tests := []struct {
+		name              string
+		coldWritesEnabled bool
+		inBuffer          bool
+	}{
+		{name: "cold writes disabled", coldWritesEnabled: false},
+		{name: "cold writes enabled", coldWritesEnabled: true},
+		{name: "cold writes disabled in buffer", coldWritesEnabled: false, inBuffer: true},
+		{name: "cold writes enabled in buffer", coldWritesEnabled: true, inBuffer: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			opts := newSeriesTestOptions().SetColdWritesEnabled(test.coldWritesEnabled)
+			now := time.Now()
+			blockSize := 2 * time.Hour
+
+			series := NewDatabaseSeries(ident.StringID("foo"), ident.Tags{}, opts).(*dbSeries)
+
+			bufferMin := now.Truncate(blockSize).Add(-blockSize)
+			bufferMax := now.Truncate(blockSize).Add(2 * blockSize)
+
+			buffer := NewMockdatabaseBuffer(ctrl)
+			buffer.EXPECT().DrainAndReset()
+			buffer.EXPECT().MinMax().Return(bufferMin, bufferMax, nil)
+			series.buffer = buffer
+
+			flushedStart := bufferMin.Add(-blockSize)
+			if test.inBuffer {
+				// The buffer may still hold an undrained bucket for a block
+				// that has been flushed before the restart.
+				flushedStart = bufferMin
+			}
+			blockRetriever := NewMockQueryableBlockRetriever(ctrl)
+			blockRetriever.EXPECT().IsBlockRetrievable(flushedStart).Return(true).AnyTimes()
+			series.blockRetriever = blockRetriever
+
+			blocks := block.NewDatabaseSeriesBlocks(0)
+			flushed := block.NewMockDatabaseBlock(ctrl)
+			flushed.EXPECT().StartTime().Return(flushedStart).AnyTimes()
+			blocks.AddBlock(flushed)
+
+			// Bootstrapped blocks hold data that is already on disk, such as
+			// blocks cached by the filesystem bootstrapper, so they are never
+			// loaded as cold writes which would flush them again.
+			expectedBlocks := 0
+			if test.inBuffer {
+				buffer.EXPECT().Bootstrap(flushed).Return(nil)
+			} else {
+				flushed.EXPECT().SetOnEvictedFromWiredList(nil)
+				expectedBlocks = 1
+			}
+
+			_, err := series.Bootstrap(blocks)
+			require.NoError(t, err)
+			require.Equal(t, expectedBlocks, series.blocks.Len())
+		})
+	}
+}
+
+func TestSeriesLoad(t *testing.T) {
+	tests := []struct {
+		name              string
+		coldWritesEnabled bool
+	}{
+		{name: "cold writes disabled", coldWritesEnabled: false},
+		{name: "cold writes enabled", coldWritesEnabled: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			opts := newSeriesTestOptions().SetColdWritesEnabled(test.coldWritesEnabled)
+			ctx := opts.ContextPool().Get()
+			defer ctx.Close()
+
+			now := time.Now()
+			blockSize := 2 * time.Hour
+
+			series := NewDatabaseSeries(ident.StringID("foo"), ident.Tags{}, opts).(*dbSeries)
+
+			// Loading before bootstrapping should fail
+			_, err := series.Load(ctx, block.NewDatabaseSeriesBlocks(0))
+			require.Equal(t, errSeriesNotBootstrapped, err)
+
+			_, err = series.Bootstrap(nil)
+			require.NoError(t, err)
+
+			bufferMin := now.Truncate(blockSize).Add(-blockSize)
+			bufferMax := now.Truncate(blockSize).Add(2 * blockSize)
+
+			buffer := NewMockdatabaseBuffer(ctrl)
+			buffer.EXPECT().MinMax().Return(bufferMin, bufferMax, nil)
+			series.buffer = buffer
+
+			// Existing in-memory block the loaded block should be merged into
+			existingStart := bufferMin.Add(-blockSize)
+			existing := block.NewMockDatabaseBlock(ctrl)
+			existing.EXPECT().StartTime().Return(existingStart).AnyTimes()
+			existing.EXPECT().SetOnEvictedFromWiredList(nil)
+			series.addBlockWithLock(existing)
+
+			blocks := block.NewDatabaseSeriesBlocks(0)
+
+			merged := block.NewMockDatabaseBlock(ctrl)
+			merged.EXPECT().StartTime().Return(existingStart).AnyTimes()
+			blocks.AddBlock(merged)
+			existing.EXPECT().Merge(merged).Return(nil)
+
+			addedStart := existingStart.Add(-blockSize)
+			added := block.NewMockDatabaseBlock(ctrl)
+			added.EXPECT().StartTime().Return(addedStart).AnyTimes()
+			added.EXPECT().SetOnEvictedFromWiredList(nil)
+			blocks.AddBlock(added)
+
+			buffered := block.NewMockDatabaseBlock(ctrl)
+			buffered.EXPECT().StartTime().Return(bufferMin).AnyTimes()
+			blocks.AddBlock(buffered)
+			buffer.EXPECT().Bootstrap(buffered).Return(nil)
+
+			flushedStart := existingStart.Add(-2 * blockSize)
+			flushed := block.NewMockDatabaseBlock(ctrl)
+			flushed.EXPECT().StartTime().Return(flushedStart).AnyTimes()
+			blocks.AddBlock(flushed)
+
+			blockRetriever := NewMockQueryableBlockRetriever(ctrl)
+			blockRetriever.EXPECT().IsBlockRetrievable(addedStart).Return(false)
+			blockRetriever.EXPECT().IsBlockRetrievable(flushedStart).Return(true)
+			series.blockRetriever = blockRetriever
+
+			expected := LoadResult{
+				NumBlocksMovedToBuffer: 1,
+				NumBlocksMerged:        2,
+			}
+			if test.coldWritesEnabled {
+				// Blocks that have already been flushed are loaded as cold
+				// writes to be persisted by the next cold flush.
+				blockRetriever.EXPECT().IsBlockRetrievable(bufferMin).Return(false)
+				blockRetriever.EXPECT().IsBlockRetrievable(existingStart).Return(false)
+				blockRetriever.EXPECT().IsBlockRetrievable(addedStart).Return(false)
+				buffer.EXPECT().LoadCold(flushed).Return(nil)
+				expected.NumBlocksMovedToBuffer++
+			} else {
+				// Otherwise they are merged with the flushed data in memory.
+				data := checked.NewBytes([]byte{0x1, 0x2, 0x3}, nil)
+				data.IncRef()
+				blockRetriever.EXPECT().Stream(ctx, series.id, flushedStart, nil).
+					Return(xio.BlockReader{
+						SegmentReader: xio.NewSegmentReader(
+							ts.NewSegment(data, nil, ts.FinalizeNone)),
+						Start:     flushedStart,
+						BlockSize: blockSize,
+					}, nil)
+				flushed.EXPECT().WasRetrievedFromDisk().Return(false)
+				expected.NumBlocksMerged++
+			}
+
+			res, err := series.Load(ctx, blocks)
+			require.NoError(t, err)
+			require.Equal(t, expected, res)
+			require.Equal(t, int(expected.NumBlocksMerged), series.blocks.Len())
+
+			if !test.coldWritesEnabled {
+				loaded, ok := series.blocks.BlockAt(flushedStart)
+				require.True(t, ok)
+				require.False(t, loaded.WasRetrievedFromDisk())
+				require.True(t, loaded.HasMergeTarget())
+			}
+		})
+	}
+}
+
+func TestSeriesLoadWithError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSeriesTestOptions().SetColdWritesEnabled(true)
+	ctx := opts.ContextPool().Get()
+	defer ctx.Close()
+
+	now := time.Now()
+	blockSize := 2 * time.Hour
+
+	series := NewDatabaseSeries(ident.StringID("foo"), ident.Tags{}, opts).(*dbSeries)
+	_, err := series.Bootstrap(nil)
+	require.NoError(t, err)
+
+	bufferMin := now.Truncate(blockSize).Add(-blockSize)
+	bufferMax := now.Truncate(blockSize).Add(2 * blockSize)
+
+	buffer := NewMockdatabaseBuffer(ctrl)
+	buffer.EXPECT().MinMax().Return(bufferMin, bufferMax, nil)
+	series.buffer = buffer
+
+	blocks := block.NewDatabaseSeriesBlocks(0)
+
+	buffered := block.NewMockDatabaseBlock(ctrl)
+	buffered.EXPECT().StartTime().Return(bufferMin).AnyTimes()
+	blocks.AddBlock(buffered)
+	buffer.EXPECT().Bootstrap(buffered).Return(errors.New("an error"))
+
+	flushedStart := bufferMin.Add(-blockSize)
+	flushed := block.NewMockDatabaseBlock(ctrl)
+	flushed.EXPECT().StartTime().Return(flushedStart).AnyTimes()
+	blocks.AddBlock(flushed)
+	buffer.EXPECT().LoadCold(flushed).Return(errors.New("an error"))
+
+	blockRetriever := NewMockQueryableBlockRetriever(ctrl)
+	blockRetriever.EXPECT().IsBlockRetrievable(bufferMin).Return(false)
+	blockRetriever.EXPECT().IsBlockRetrievable(flushedStart).Return(true)
+	series.blockRetriever = blockRetriever
+
+	// Blocks that failed to load are not counted.
+	res, err := series.Load(ctx, blocks)
+	require.Error(t, err)
+	require.Equal(t, LoadResult{}, res)
+}
+
+func TestSeriesSnapshotFlushedBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSeriesTestOptions()
+	ctx := opts.ContextPool().Get()
+	defer ctx.Close()
+
+	blockSize := opts.RetentionOptions().BlockSize()
+	blockStart := time.Now().Truncate(blockSize).Add(-2 * blockSize)
+
+	series := NewDatabaseSeries(ident.StringID("foo"), ident.Tags{}, opts).(*dbSeries)
+	_, err := series.Bootstrap(nil)
+	require.NoError(t, err)
+
+	// A block retrieved from disk is already persisted so only the cold
+	// writes held by the buffer are snapshotted.
+	retrieved := block.NewMockDatabaseBlock(ctrl)
+	retrieved.EXPECT().StartTime().Return(blockStart).AnyTimes()
+	retrieved.EXPECT().SetOnEvictedFromWiredList(nil)
+	series.addBlockWithLock(retrieved)
+
+	blockRetriever := NewMockQueryableBlockRetriever(ctrl)
+	blockRetriever.EXPECT().IsBlockRetrievable(blockStart).Return(true)
+	series.blockRetriever = blockRetriever
+
+	cold := checked.NewBytes([]byte{0x1, 0x2, 0x3}, nil)
+	cold.IncRef()
+	buffer := NewMockdatabaseBuffer(ctrl)
+	buffer.EXPECT().Snapshot(ctx, blockStart).
+		Return(xio.NewSegmentReader(ts.NewSegment(cold, nil, ts.FinalizeNone)), nil)
+	series.buffer = buffer
+
+	var persisted []byte
+	err = series.Snapshot(ctx, blockStart, func(
+		id ident.ID,
+		tags ident.Tags,
+		segment ts.Segment,
+		checksum uint32,
+	) error {
+		persisted = segment.Head.Bytes()
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x1, 0x2, 0x3}, persisted)
+}
+
 func TestSeriesFetchBlocks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -611,6 +882,7 @@ func TestSeriesFetchBlocks(t *testing.T) {
 
 	// Set up the buffer
 	buffer := NewMockdatabaseBuffer(ctrl)
+	buffer.EXPECT().ColdStreams(ctx, gomock.Any()).Return(nil).Times(len(starts))
 	buffer.EXPECT().IsEmpty().Return(false)
 	buffer.EXPECT().
 		FetchBlocks(ctx, starts).
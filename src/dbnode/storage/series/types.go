@@ -28,6 +28,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/dbnode/x/xio"
 	"github.com/m3db/m3x/context"
 	"github.com/m3db/m3x/ident"
@@ -91,13 +92,43 @@ type DatabaseSeries interface {
 	// Bootstrap merges the raw series bootstrapped along with any buffered data.
 	Bootstrap(blocks block.DatabaseSeriesBlocks) (BootstrapResult, error)
 
+	// Load merges blocks into an already bootstrapped series, when cold writes
+	// are enabled blocks that have already been flushed are loaded as cold
+	// writes to be persisted by the next cold flush.
+	Load(ctx context.Context, blocks block.DatabaseSeriesBlocks) (LoadResult, error)
+
 	// Flush flushes the data blocks of this series for a given start time.
 	Flush(ctx context.Context, blockStart time.Time, persistFn persist.DataFn) (FlushOutcome, error)
 
-	// Snapshot snapshots the buffer buckets of this series for any data that has
-	// not been rotated into a block yet.
+	// ColdFlushBlockStarts returns the block starts that have writes outside
+	// of the buffer window which have not been flushed yet.
+	ColdFlushBlockStarts() []time.Time
+
+	// ColdFlush merges the writes outside of the buffer window for a given block
+	// start with the existing flushed data of the series for the block, if any,
+	// and persists the result. The cold writes remain readable from the series
+	// until FinishColdFlush is called.
+	ColdFlush(
+		ctx context.Context,
+		blockStart time.Time,
+		existing ts.Segment,
+		persistFn persist.DataFn,
+	) (FlushOutcome, error)
+
+	// FinishColdFlush completes a cold flush for a given block start, releasing
+	// the cold writes if the flush succeeded or otherwise retaining them to be
+	// included in the next cold flush.
+	FinishColdFlush(blockStart time.Time, success bool)
+
+	// Snapshot snapshots the data of this series for a block that has not been
+	// flushed yet, or the cold writes for a block that has been flushed.
 	Snapshot(ctx context.Context, blockStart time.Time, persistFn persist.DataFn) error
 
+	// SetOptions updates the options of the series in place, only options
+	// that may change on a live namespace such as the retention period are
+	// expected to differ from the options the series was reset with.
+	SetOptions(opts Options)
+
 	// Close will close the series and if pooled returned to the pool.
 	Close()
 
@@ -194,6 +225,13 @@ type BootstrapResult struct {
 	NumBlocksMerged        int64
 }
 
+// LoadResult contains information about the result of loading blocks into
+// an already bootstrapped series.
+type LoadResult struct {
+	NumBlocksMovedToBuffer int64
+	NumBlocksMerged        int64
+}
+
 // Options represents the options for series
 type Options interface {
 	// Validate validates the options
@@ -264,6 +302,14 @@ type Options interface {
 
 	// Stats returns the configured Stats.
 	Stats() Stats
+
+	// SetColdWritesEnabled sets whether writes outside of the buffer window
+	// are accepted and buffered to be merged into already flushed blocks
+	SetColdWritesEnabled(value bool) Options
+
+	// ColdWritesEnabled returns whether writes outside of the buffer window
+	// are accepted and buffered to be merged into already flushed blocks
+	ColdWritesEnabled() bool
 }
 
 // Stats is passed down from namespace/shard to avoid allocations per series.
@@ -68,6 +68,7 @@ var (
 	errShardAlreadyTicking                 = errors.New("shard is already ticking")
 	errShardClosingTickTerminated          = errors.New("shard is closing, terminating tick")
 	errShardInvalidPageToken               = errors.New("shard could not unmarshal page token")
+	errShardNotBootstrappedToLoad          = errors.New("shard must be bootstrapped to load blocks")
 	errNewShardEntryTagsTypeInvalid        = errors.New("new shard entry options error: tags type invalid")
 	errNewShardEntryTagsIterNotAtIndexZero = errors.New("new shard entry options error: tags iter not at index zero")
 )
@@ -133,10 +134,11 @@ type dbShard struct {
 	sync.RWMutex
 	block.DatabaseBlockRetriever
 	opts                     Options
-	seriesOpts               series.Options
 	nowFn                    clock.NowFn
 	state                    dbShardState
+	namespaceLock            sync.RWMutex
 	namespace                namespace.Metadata
+	seriesOpts               series.Options
 	seriesBlockRetriever     series.QueryableBlockRetriever
 	seriesOnRetrieveBlock    block.OnRetrieveBlock
 	namespaceReaderMgr       databaseNamespaceReaderManager
@@ -186,10 +188,13 @@ type dbShardMetrics struct {
 	insertAsyncWriteErrors        tally.Counter
 	seriesBootstrapBlocksToBuffer tally.Counter
 	seriesBootstrapBlocksMerged   tally.Counter
+	seriesLoadBlocksToBuffer      tally.Counter
+	seriesLoadBlocksMerged        tally.Counter
 }
 
 func newDatabaseShardMetrics(scope tally.Scope) dbShardMetrics {
 	seriesBootstrapScope := scope.SubScope("series-bootstrap")
+	seriesLoadScope := scope.SubScope("series-load")
 	return dbShardMetrics{
 		create:       scope.Counter("create"),
 		close:        scope.Counter("close"),
@@ -206,6 +211,8 @@ func newDatabaseShardMetrics(scope tally.Scope) dbShardMetrics {
 		}).Counter("insert-async.errors"),
 		seriesBootstrapBlocksToBuffer: seriesBootstrapScope.Counter("blocks-to-buffer"),
 		seriesBootstrapBlocksMerged:   seriesBootstrapScope.Counter("blocks-merged"),
+		seriesLoadBlocksToBuffer:      seriesLoadScope.Counter("blocks-to-buffer"),
+		seriesLoadBlocksMerged:        seriesLoadScope.Counter("blocks-merged"),
 	}
 }
 
@@ -321,6 +328,37 @@ func (s *dbShard) ID() uint32 {
 	return s.shard
 }
 
+func (s *dbShard) namespaceMetadata() namespace.Metadata {
+	s.namespaceLock.RLock()
+	metadata := s.namespace
+	s.namespaceLock.RUnlock()
+	return metadata
+}
+
+func (s *dbShard) seriesOptions() series.Options {
+	s.namespaceLock.RLock()
+	seriesOpts := s.seriesOpts
+	s.namespaceLock.RUnlock()
+	return seriesOpts
+}
+
+func (s *dbShard) SetNamespaceMetadata(metadata namespace.Metadata) {
+	s.namespaceLock.Lock()
+	s.namespace = metadata
+	s.seriesOpts = s.seriesOpts.SetRetentionOptions(
+		metadata.Options().RetentionOptions())
+	seriesOpts := s.seriesOpts
+	s.namespaceLock.Unlock()
+
+	// Series that already exist keep the options they were created with,
+	// so update them in place for changes such as the retention period to
+	// take effect on their next tick.
+	s.forEachShardEntry(func(entry *lookup.Entry) bool {
+		entry.Series.SetOptions(seriesOpts)
+		return true
+	})
+}
+
 func (s *dbShard) NumSeries() int64 {
 	s.RLock()
 	n := s.list.Len()
@@ -873,7 +911,7 @@ func (s *dbShard) writeAndIndex(
 	// Write commit log
 	series := ts.Series{
 		UniqueIndex: commitLogSeriesUniqueIndex,
-		Namespace:   s.namespace.ID(),
+		Namespace:   s.namespaceMetadata().ID(),
 		ID:          commitLogSeriesID,
 		Tags:        commitLogSeriesTags,
 		Shard:       s.shard,
@@ -913,7 +951,7 @@ func (s *dbShard) ReadEncoded(
 
 	retriever := s.seriesBlockRetriever
 	onRetrieve := s.seriesOnRetrieveBlock
-	opts := s.seriesOpts
+	opts := s.seriesOptions()
 	reader := series.NewReaderUsingRetriever(id, retriever, onRetrieve, nil, opts)
 	return reader.ReadEncoded(ctx, start, end)
 }
@@ -1038,7 +1076,7 @@ func (s *dbShard) newShardEntry(
 
 	series := s.seriesPool.Get()
 	series.Reset(seriesID, seriesTags, s.seriesBlockRetriever,
-		s.seriesOnRetrieveBlock, s, s.seriesOpts)
+		s.seriesOnRetrieveBlock, s, s.seriesOptions())
 	uniqueIndex := s.increasingIndex.nextIndex()
 	return lookup.NewEntry(series, uniqueIndex), nil
 }
@@ -1271,7 +1309,7 @@ func (s *dbShard) insertSeriesBatch(inserts []dbShardInsert) error {
 	// Perform any indexing, pending writes or pending retrieved blocks outside of lock
 	ctx := s.contextPool.Get()
 	// TODO(prateek): pool this type
-	indexBlockSize := s.namespace.Options().IndexOptions().BlockSize()
+	indexBlockSize := s.namespaceMetadata().Options().IndexOptions().BlockSize()
 	indexBatch := index.NewWriteBatch(index.WriteBatchOptions{
 		InitialCapacity: numPendingIndexing,
 		IndexBlockSize:  indexBlockSize,
@@ -1373,7 +1411,7 @@ func (s *dbShard) FetchBlocks(
 
 	retriever := s.seriesBlockRetriever
 	onRetrieve := s.seriesOnRetrieveBlock
-	opts := s.seriesOpts
+	opts := s.seriesOptions()
 	// Nil for onRead callback because we don't want peer bootstrapping to impact
 	// the behavior of the LRU
 	var onReadCb block.OnReadBlock
@@ -1552,7 +1590,7 @@ func (s *dbShard) FetchBlocksMetadataV2(
 	// flushed block and work backwards.
 	var (
 		result    = s.opts.FetchBlocksMetadataResultsPool().Get()
-		ropts     = s.namespace.Options().RetentionOptions()
+		ropts     = s.namespaceMetadata().Options().RetentionOptions()
 		blockSize = ropts.BlockSize()
 		// Subtract one blocksize because all fetch requests are exclusive on the end side
 		blockStart      = end.Truncate(blockSize).Add(-1 * blockSize)
@@ -1595,7 +1633,8 @@ func (s *dbShard) FetchBlocksMetadataV2(
 		}
 
 		// Open a reader at this position, potentially from cache
-		reader, err := s.namespaceReaderMgr.get(s.shard, blockStart, pos)
+		reader, err := s.namespaceReaderMgr.get(s.shard, blockStart,
+			s.FlushState(blockStart).VolumeIndex, pos)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -1681,10 +1720,40 @@ func (s *dbShard) Bootstrap(
 	s.bootstrapState = Bootstrapping
 	s.Unlock()
 
+	// Iterate flushed time ranges to determine which blocks are retrievable
+	// before bootstrapping the series, so that data for blocks that have
+	// already been flushed can be loaded as cold writes, and before
+	// servicing reads.
+	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
+	readInfoFilesResults := fs.ReadInfoFiles(fsOpts.FilePathPrefix(), s.namespaceMetadata().ID(), s.shard,
+		fsOpts.InfoReaderBufferSize(), fsOpts.DecodingOptions())
+
+	for _, result := range readInfoFilesResults {
+		if result.Err.Error() != nil {
+			s.logger.WithFields(
+				xlog.NewField("shard", s.ID()),
+				xlog.NewField("namespace", s.namespaceMetadata().ID()),
+				xlog.NewField("error", result.Err.Error()),
+				xlog.NewField("filepath", result.Err.Filepath()),
+			).Error("unable to read info files in shard bootstrap")
+			continue
+		}
+		info := result.Info
+		at := xtime.FromNanoseconds(info.BlockStart)
+		fs := s.FlushState(at)
+		if fs.Status != fileOpNotStarted {
+			continue // Already recorded progress
+		}
+		s.markFlushStateSuccess(at, result.ID.VolumeIndex)
+	}
+
 	var (
 		shardBootstrapResult = dbShardBootstrapResult{}
 		multiErr             = xerrors.NewMultiError()
+		ctx                  = s.contextPool.Get()
 	)
+	defer ctx.Close()
+
 	for _, elem := range bootstrappedSeries.Iter() {
 		dbBlocks := elem.Value()
 
@@ -1721,6 +1790,20 @@ func (s *dbShard) Bootstrap(
 		}
 		shardBootstrapResult.update(bsResult)
 
+		// Writes to blocks that have already been flushed are replayed from
+		// the commit log and snapshots, load them into the bootstrapped series
+		// so that they are persisted by the next cold flush.
+		if dbBlocks.ColdBlocks != nil {
+			loadResult, err := entry.Series.Load(ctx, dbBlocks.ColdBlocks)
+			if err != nil {
+				multiErr = multiErr.Add(err)
+			}
+			shardBootstrapResult.update(series.BootstrapResult{
+				NumBlocksMovedToBuffer: loadResult.NumBlocksMovedToBuffer,
+				NumBlocksMerged:        loadResult.NumBlocksMerged,
+			})
+		}
+
 		// Always decrement the writer count, avoid continue on bootstrap error
 		entry.DecrementReaderWriterCount()
 	}
@@ -1747,31 +1830,6 @@ func (s *dbShard) Bootstrap(
 		return true
 	})
 
-	// Now iterate flushed time ranges to determine which blocks are
-	// retrievable before servicing reads
-	fsOpts := s.opts.CommitLogOptions().FilesystemOptions()
-	readInfoFilesResults := fs.ReadInfoFiles(fsOpts.FilePathPrefix(), s.namespace.ID(), s.shard,
-		fsOpts.InfoReaderBufferSize(), fsOpts.DecodingOptions())
-
-	for _, result := range readInfoFilesResults {
-		if result.Err.Error() != nil {
-			s.logger.WithFields(
-				xlog.NewField("shard", s.ID()),
-				xlog.NewField("namespace", s.namespace.ID()),
-				xlog.NewField("error", result.Err.Error()),
-				xlog.NewField("filepath", result.Err.Filepath()),
-			).Error("unable to read info files in shard bootstrap")
-			continue
-		}
-		info := result.Info
-		at := xtime.FromNanoseconds(info.BlockStart)
-		fs := s.FlushState(at)
-		if fs.Status != fileOpNotStarted {
-			continue // Already recorded progress
-		}
-		s.markFlushStateSuccess(at)
-	}
-
 	s.Lock()
 	s.bootstrapState = Bootstrapped
 	s.Unlock()
@@ -1792,7 +1850,7 @@ func (s *dbShard) Flush(
 	s.RUnlock()
 
 	prepareOpts := persist.DataPrepareOptions{
-		NamespaceMetadata: s.namespace,
+		NamespaceMetadata: s.namespaceMetadata(),
 		Shard:             s.ID(),
 		BlockStart:        blockStart,
 		// We explicitly set delete if exists to false here as we track which
@@ -1803,7 +1861,8 @@ func (s *dbShard) Flush(
 	}
 	prepared, err := flushPreparer.PrepareData(prepareOpts)
 	if err != nil {
-		return s.markFlushStateSuccessOrError(blockStart, err)
+		return s.markFlushStateSuccessOrError(blockStart,
+			prepareOpts.Volume.VolumeIndex, err)
 	}
 
 	var multiErr xerrors.MultiError
@@ -1836,7 +1895,211 @@ func (s *dbShard) Flush(
 		multiErr = multiErr.Add(err)
 	}
 
-	return s.markFlushStateSuccessOrError(blockStart, multiErr.FinalError())
+	return s.markFlushStateSuccessOrError(blockStart,
+		prepareOpts.Volume.VolumeIndex, multiErr.FinalError())
+}
+
+func (s *dbShard) ColdFlush(flushPreparer persist.FlushPreparer) error {
+	// We don't flush data when the shard is still bootstrapping
+	s.RLock()
+	if s.bootstrapState != Bootstrapped {
+		s.RUnlock()
+		return errShardNotBootstrappedToFlush
+	}
+	s.RUnlock()
+
+	// Gather the series with cold writes for each block in a single pass,
+	// cold writes for blocks that have not been flushed yet are left in
+	// memory until the block has been flushed.
+	var entriesByBlockStart map[xtime.UnixNano][]*lookup.Entry
+	s.forEachShardEntry(func(entry *lookup.Entry) bool {
+		for _, blockStart := range entry.Series.ColdFlushBlockStarts() {
+			if s.FlushState(blockStart).Status != fileOpSuccess {
+				continue
+			}
+			if entriesByBlockStart == nil {
+				entriesByBlockStart = make(map[xtime.UnixNano][]*lookup.Entry)
+			}
+			// Hold a reference so the series is not purged mid cold flush.
+			entry.IncrementReaderWriterCount()
+			key := xtime.ToUnixNano(blockStart)
+			entriesByBlockStart[key] = append(entriesByBlockStart[key], entry)
+		}
+		return true
+	})
+
+	var multiErr xerrors.MultiError
+	for blockStart, entries := range entriesByBlockStart {
+		err := s.coldFlushBlock(blockStart.ToTime(), entries, flushPreparer)
+		for _, entry := range entries {
+			entry.DecrementReaderWriterCount()
+		}
+		if err != nil {
+			detailedErr := fmt.Errorf("failed to cold flush block %s: %v",
+				blockStart.ToTime().String(), err)
+			multiErr = multiErr.Add(detailedErr)
+		}
+	}
+
+	return multiErr.FinalError()
+}
+
+func (s *dbShard) ColdFlushBlockStarts() []time.Time {
+	var (
+		starts []time.Time
+		seen   = make(map[xtime.UnixNano]struct{})
+	)
+	s.forEachShardEntry(func(entry *lookup.Entry) bool {
+		for _, blockStart := range entry.Series.ColdFlushBlockStarts() {
+			key := xtime.ToUnixNano(blockStart)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			starts = append(starts, blockStart)
+		}
+		return true
+	})
+	return starts
+}
+
+// coldFlushBlock writes a new volume for a block that has already been
+// flushed which merges the latest volume with the cold writes of the series.
+func (s *dbShard) coldFlushBlock(
+	blockStart time.Time,
+	entries []*lookup.Entry,
+	flushPreparer persist.FlushPreparer,
+) error {
+	filePathPrefix := s.opts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
+	volumeIndex, err := fs.NextDataFileSetVolumeIndex(filePathPrefix,
+		s.namespaceMetadata().ID(), s.ID(), blockStart)
+	if err != nil {
+		return err
+	}
+
+	prepareOpts := persist.DataPrepareOptions{
+		NamespaceMetadata: s.namespaceMetadata(),
+		Shard:             s.ID(),
+		BlockStart:        blockStart,
+		// The next volume index is always greater than that of any existing
+		// volume, including incomplete ones, so it never already exists.
+		DeleteIfExists: false,
+		Volume: persist.DataPrepareVolumeOptions{
+			VolumeIndex: volumeIndex,
+		},
+	}
+	prepared, err := flushPreparer.PrepareData(prepareOpts)
+	if err != nil {
+		return err
+	}
+
+	pending := make(map[string]series.DatabaseSeries, len(entries))
+	for _, entry := range entries {
+		pending[entry.Series.ID().String()] = entry.Series
+	}
+
+	var multiErr xerrors.MultiError
+	tmpCtx := context.NewContext()
+	err = s.coldFlushLatestVolume(tmpCtx, blockStart, pending, prepared.Persist)
+	if err != nil {
+		multiErr = multiErr.Add(err)
+	}
+
+	// The remaining series had no data in the flushed volume.
+	for _, curr := range pending {
+		if !multiErr.Empty() {
+			break
+		}
+		tmpCtx.Reset()
+		_, err := curr.ColdFlush(tmpCtx, blockStart, ts.Segment{}, prepared.Persist)
+		tmpCtx.BlockingClose()
+		if err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
+	if err := prepared.Close(); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+
+	if multiErr.Empty() && s.DatabaseBlockRetriever != nil {
+		// Reads from disk need to use the new volume before the cold writes
+		// are released from memory.
+		if err := s.DatabaseBlockRetriever.ReopenBlock(s.ID(), blockStart); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	if multiErr.Empty() {
+		s.markFlushStateSuccess(blockStart, volumeIndex)
+	}
+
+	success := multiErr.Empty()
+	for _, entry := range entries {
+		entry.Series.FinishColdFlush(blockStart, success)
+	}
+	if !success {
+		return multiErr.FinalError()
+	}
+
+	// The new volume supersedes all previous volumes of the fileset.
+	return fs.DeleteDataFileSetVolumesBefore(filePathPrefix,
+		s.namespaceMetadata().ID(), s.ID(), blockStart, volumeIndex)
+}
+
+func (s *dbShard) coldFlushLatestVolume(
+	ctx context.Context,
+	blockStart time.Time,
+	pending map[string]series.DatabaseSeries,
+	persistFn persist.DataFn,
+) error {
+	reader, err := s.namespaceReaderMgr.get(s.ID(), blockStart,
+		s.FlushState(blockStart).VolumeIndex, readerPosition{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			s.logger.Errorf("error closing reader after cold flush: %v", err)
+		}
+		s.namespaceReaderMgr.put(reader)
+	}()
+
+	for {
+		id, tagsIter, data, checksum, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// NB: The writer holds onto the ID and tags until it is closed so
+		// they are left to be garbage collected rather than finalized.
+		var (
+			segment = ts.NewSegment(data, nil, ts.FinalizeHead)
+			outcome = series.FlushOutcomeBlockDoesNotExist
+		)
+		if curr, ok := pending[string(id.Bytes())]; ok {
+			delete(pending, string(id.Bytes()))
+			ctx.Reset()
+			outcome, err = curr.ColdFlush(ctx, blockStart, segment, persistFn)
+			ctx.BlockingClose()
+		}
+		if err == nil && outcome != series.FlushOutcomeFlushedToDisk {
+			// Series without cold writes are copied to the new volume as is.
+			var tags ident.Tags
+			tags, err = convert.TagsFromTagsIter(id, tagsIter, nil)
+			if err == nil {
+				err = persistFn(id, tags, segment, checksum)
+			}
+		}
+
+		tagsIter.Close()
+		segment.Finalize()
+		if err != nil {
+			return err
+		}
+	}
 }
 
 func (s *dbShard) Snapshot(
@@ -1860,7 +2123,7 @@ func (s *dbShard) Snapshot(
 	}()
 
 	prepareOpts := persist.DataPrepareOptions{
-		NamespaceMetadata: s.namespace,
+		NamespaceMetadata: s.namespaceMetadata(),
 		Shard:             s.ID(),
 		BlockStart:        blockStart,
 		FileSetType:       persist.FileSetSnapshotType,
@@ -1917,19 +2180,26 @@ func (s *dbShard) FlushState(blockStart time.Time) fileOpState {
 	return state
 }
 
-func (s *dbShard) markFlushStateSuccessOrError(blockStart time.Time, err error) error {
+func (s *dbShard) markFlushStateSuccessOrError(
+	blockStart time.Time,
+	volumeIndex int,
+	err error,
+) error {
 	// Track flush state for block state
 	if err == nil {
-		s.markFlushStateSuccess(blockStart)
+		s.markFlushStateSuccess(blockStart, volumeIndex)
 	} else {
 		s.markFlushStateFail(blockStart)
 	}
 	return err
 }
 
-func (s *dbShard) markFlushStateSuccess(blockStart time.Time) {
+func (s *dbShard) markFlushStateSuccess(blockStart time.Time, volumeIndex int) {
 	s.flushState.Lock()
-	s.flushState.statesByTime[xtime.ToUnixNano(blockStart)] = fileOpState{Status: fileOpSuccess}
+	s.flushState.statesByTime[xtime.ToUnixNano(blockStart)] = fileOpState{
+		Status:      fileOpSuccess,
+		VolumeIndex: volumeIndex,
+	}
 	s.flushState.Unlock()
 }
 
@@ -1944,7 +2214,7 @@ func (s *dbShard) markFlushStateFail(blockStart time.Time) {
 
 func (s *dbShard) removeAnyFlushStatesTooEarly(tickStart time.Time) {
 	s.flushState.Lock()
-	earliestFlush := retention.FlushTimeStart(s.namespace.Options().RetentionOptions(), tickStart)
+	earliestFlush := retention.FlushTimeStart(s.namespaceMetadata().Options().RetentionOptions(), tickStart)
 	for t := range s.flushState.statesByTime {
 		if t.ToTime().Before(earliestFlush) {
 			delete(s.flushState.statesByTime, t)
@@ -1977,11 +2247,11 @@ func (s *dbShard) markDoneSnapshotting(success bool, completionTime time.Time) {
 func (s *dbShard) CleanupExpiredFileSets(earliestToRetain time.Time) error {
 	filePathPrefix := s.opts.CommitLogOptions().FilesystemOptions().FilePathPrefix()
 	multiErr := xerrors.NewMultiError()
-	expired, err := s.filesetBeforeFn(filePathPrefix, s.namespace.ID(), s.ID(), earliestToRetain)
+	expired, err := s.filesetBeforeFn(filePathPrefix, s.namespaceMetadata().ID(), s.ID(), earliestToRetain)
 	if err != nil {
 		detailedErr :=
 			fmt.Errorf("encountered errors when getting fileset files for prefix %s namespace %s shard %d: %v",
-				filePathPrefix, s.namespace.ID(), s.ID(), err)
+				filePathPrefix, s.namespaceMetadata().ID(), s.ID(), err)
 		multiErr = multiErr.Add(detailedErr)
 	}
 	if err := s.deleteFilesFn(expired); err != nil {
@@ -1990,12 +2260,74 @@ func (s *dbShard) CleanupExpiredFileSets(earliestToRetain time.Time) error {
 	return multiErr.FinalError()
 }
 
+func (s *dbShard) Load(
+	ctx context.Context,
+	seriesToLoad *result.Map,
+) error {
+	if s.BootstrapState() != Bootstrapped {
+		return errShardNotBootstrappedToLoad
+	}
+
+	var (
+		numBlocksMovedToBuffer int64
+		numBlocksMerged        int64
+		multiErr               = xerrors.NewMultiError()
+	)
+	for _, elem := range seriesToLoad.Iter() {
+		dbBlocks := elem.Value()
+
+		entry, _, err := s.tryRetrieveWritableSeries(dbBlocks.ID)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		if entry == nil {
+			// The series may have never been written to this replica, in
+			// which case insert it synchronously so the blocks can be loaded.
+			entry, err = s.insertSeriesSync(dbBlocks.ID, newTagsArg(dbBlocks.Tags),
+				insertSyncIncReaderWriterCount)
+			if err != nil {
+				multiErr = multiErr.Add(err)
+				continue
+			}
+
+			// The index only accepts writes within its buffer window, so index
+			// the new series at the current time to make it queryable.
+			if s.reverseIndex != nil {
+				now := s.nowFn()
+				if entry.NeedsIndexUpdate(s.reverseIndex.BlockStartForWriteTime(now)) {
+					if err := s.insertSeriesForIndexingAsyncBatched(entry, now,
+						true); err != nil {
+						multiErr = multiErr.Add(err)
+					}
+				}
+			}
+		}
+
+		// Cannot close blocks once done as series takes ref to these
+		loadResult, err := entry.Series.Load(ctx, dbBlocks.Blocks)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+		}
+		numBlocksMovedToBuffer += loadResult.NumBlocksMovedToBuffer
+		numBlocksMerged += loadResult.NumBlocksMerged
+
+		// Always decrement the writer count, avoid continue on load error
+		entry.DecrementReaderWriterCount()
+	}
+
+	s.metrics.seriesLoadBlocksToBuffer.Inc(numBlocksMovedToBuffer)
+	s.metrics.seriesLoadBlocksMerged.Inc(numBlocksMerged)
+
+	return multiErr.FinalError()
+}
+
 func (s *dbShard) Repair(
 	ctx context.Context,
 	tr xtime.Range,
 	repairer databaseShardRepairer,
 ) (repair.MetadataComparisonResult, error) {
-	return repairer.Repair(ctx, s.namespace.ID(), tr, s)
+	return repairer.Repair(ctx, s.namespaceMetadata(), tr, s)
 }
 
 func (s *dbShard) BootstrapState() BootstrapState {
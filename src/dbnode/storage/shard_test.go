@@ -23,6 +23,9 @@ package storage
 import (
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -31,19 +34,23 @@ import (
 	"unsafe"
 
 	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/storage/namespace"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/storage/series/lookup"
 	"github.com/m3db/m3/src/dbnode/ts"
 	xmetrics "github.com/m3db/m3/src/dbnode/x/metrics"
 	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3x/checked"
 	"github.com/m3db/m3x/context"
 	"github.com/m3db/m3x/ident"
+	"github.com/m3db/m3x/pool"
 	xtest "github.com/m3db/m3x/test"
 	xtime "github.com/m3db/m3x/time"
 
@@ -116,6 +123,47 @@ func TestShardBootstrapState(t *testing.T) {
 	require.Equal(t, Bootstrapped, shard.BootstrapState())
 }
 
+func TestShardSetNamespaceMetadataExpiresSeriesBlocks(t *testing.T) {
+	opts := testDatabaseOptions()
+	shard := testDatabaseShard(t, opts)
+	defer shard.Close()
+
+	var (
+		ropts      = defaultTestNs1Opts.RetentionOptions()
+		blockSize  = ropts.BlockSize()
+		now        = time.Now()
+		blockStart = now.Truncate(blockSize).Add(-4 * blockSize)
+	)
+
+	// The block is within the original retention period.
+	blocks := block.NewDatabaseSeriesBlocks(1)
+	blocks.AddBlock(block.NewDatabaseBlock(blockStart, blockSize, ts.Segment{},
+		opts.DatabaseBlockOptions()))
+	s := series.NewDatabaseSeries(ident.StringID("foo"), ident.Tags{},
+		shard.seriesOptions())
+	_, err := s.Bootstrap(blocks)
+	require.NoError(t, err)
+	shard.Lock()
+	shard.insertNewShardEntryWithLock(lookup.NewEntry(s, 0))
+	shard.Unlock()
+
+	_, err = s.Tick()
+	require.NoError(t, err)
+	require.Equal(t, 1, s.NumActiveBlocks())
+
+	// Shortening the retention period expires the block on the next tick.
+	updatedRopts := ropts.SetRetentionPeriod(2 * blockSize)
+	updated := newTestNamespaceMetadataWithIDOpts(t, defaultTestNs1ID,
+		defaultTestNs1Opts.SetRetentionOptions(updatedRopts))
+	shard.SetNamespaceMetadata(updated)
+	require.Equal(t, updatedRopts.RetentionPeriod(),
+		shard.seriesOptions().RetentionOptions().RetentionPeriod())
+
+	_, err = s.Tick()
+	require.Equal(t, series.ErrSeriesAllDatapointsExpired, err)
+	require.Equal(t, 0, s.NumActiveBlocks())
+}
+
 func TestShardFlushStateNotStarted(t *testing.T) {
 	now := time.Now()
 	nowFn := func() time.Time {
@@ -177,6 +225,83 @@ func TestShardBootstrapWithError(t *testing.T) {
 	require.Equal(t, Bootstrapped, s.bootstrapState)
 }
 
+func TestShardLoad(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		lock        sync.Mutex
+		indexWrites []doc.Document
+		opts        = testDatabaseOptions()
+		blockStart  = xtime.ToUnixNano(time.Now().Truncate(
+			namespace.NewIndexOptions().BlockSize()))
+	)
+	idx := NewMocknamespaceIndex(ctrl)
+	idx.EXPECT().BlockStartForWriteTime(gomock.Any()).Return(blockStart).AnyTimes()
+	idx.EXPECT().WriteBatch(gomock.Any()).Do(
+		func(batch *index.WriteBatch) {
+			lock.Lock()
+			indexWrites = append(indexWrites, batch.PendingDocs()...)
+			lock.Unlock()
+			for _, e := range batch.PendingEntries() {
+				e.OnIndexSeries.OnIndexSuccess(blockStart)
+				e.OnIndexSeries.OnIndexFinalize(blockStart)
+			}
+		}).Return(nil).AnyTimes()
+
+	s := testDatabaseShardWithIndexFn(t, opts, idx)
+	defer s.Close()
+
+	ctx := context.NewContext()
+	defer ctx.Close()
+
+	fooID := ident.StringID("foo")
+	barID := ident.StringID("bar")
+	barTags := ident.NewTags(ident.StringTag("name", "bar"))
+
+	fooBlocks := block.NewMockDatabaseSeriesBlocks(ctrl)
+	barBlocks := block.NewDatabaseSeriesBlocks(0)
+
+	seriesToLoad := result.NewMap(result.MapOptions{})
+	seriesToLoad.Set(fooID, result.DatabaseSeriesBlocks{ID: fooID, Blocks: fooBlocks})
+	seriesToLoad.Set(barID, result.DatabaseSeriesBlocks{
+		ID: barID, Tags: barTags, Blocks: barBlocks})
+
+	require.Equal(t, errShardNotBootstrappedToLoad, s.Load(ctx, seriesToLoad))
+
+	require.NoError(t, s.Bootstrap(result.NewMap(result.MapOptions{})))
+
+	fooSeries := addMockSeries(ctrl, s, fooID, ident.Tags{}, 0)
+	fooSeries.EXPECT().Load(ctx, fooBlocks).Return(series.LoadResult{
+		NumBlocksMerged: 1,
+	}, nil)
+
+	require.NoError(t, s.Load(ctx, seriesToLoad))
+
+	// Series only on the peer should be created with its tags and indexed.
+	entry, _, err := s.tryRetrieveWritableSeries(barID)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	entry.DecrementReaderWriterCount()
+	require.True(t, entry.Series.Tags().Equal(barTags))
+
+	for {
+		lock.Lock()
+		l := len(indexWrites)
+		lock.Unlock()
+		if l == 1 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	require.Equal(t, []byte("bar"), indexWrites[0].ID)
+	require.Equal(t, 1, len(indexWrites[0].Fields))
+	require.Equal(t, []byte("name"), indexWrites[0].Fields[0].Name)
+	require.Equal(t, []byte("bar"), indexWrites[0].Fields[0].Value)
+}
+
 func TestShardFlushDuringBootstrap(t *testing.T) {
 	s := testDatabaseShard(t, testDatabaseOptions())
 	defer s.Close()
@@ -311,6 +436,225 @@ func TestShardFlushSeriesFlushSuccess(t *testing.T) {
 	}, flushState)
 }
 
+func TestShardColdFlushDuringBootstrap(t *testing.T) {
+	s := testDatabaseShard(t, testDatabaseOptions())
+	defer s.Close()
+	s.bootstrapState = Bootstrapping
+	err := s.ColdFlush(nil)
+	require.Equal(t, err, errShardNotBootstrappedToFlush)
+}
+
+// testColdFlushShard returns a bootstrapped shard with the first volume of
+// the given block flushed to a temporary directory and the latest volume
+// read by the given reader.
+func testColdFlushShard(
+	t *testing.T,
+	blockStart time.Time,
+	reader fs.DataFileSetReader,
+) (*dbShard, string) {
+	dir, err := ioutil.TempDir("", "testdir")
+	require.NoError(t, err)
+
+	opts := testDatabaseOptions()
+	fsOpts := opts.CommitLogOptions().FilesystemOptions().SetFilePathPrefix(dir)
+	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().SetFilesystemOptions(fsOpts))
+
+	s := testDatabaseShard(t, opts)
+	s.bootstrapState = Bootstrapped
+	s.markFlushStateSuccess(blockStart, 0)
+	s.namespaceReaderMgr.(*namespaceReaderManager).newReaderFn = func(
+		pool.CheckedBytesPool,
+		fs.Options,
+	) (fs.DataFileSetReader, error) {
+		return reader, nil
+	}
+
+	writer, err := fs.NewWriter(fsOpts)
+	require.NoError(t, err)
+	require.NoError(t, writer.Open(fs.DataWriterOpenOptions{
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:  s.namespace.ID(),
+			Shard:      s.shard,
+			BlockStart: blockStart,
+		},
+		BlockSize:   s.namespace.Options().RetentionOptions().BlockSize(),
+		FileSetType: persist.FileSetFlushType,
+	}))
+	require.NoError(t, writer.Close())
+
+	return s, dir
+}
+
+func TestShardColdFlush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		blockStart     = time.Unix(21600, 0)
+		unflushedStart = blockStart.Add(2 * time.Hour)
+		reader         = fs.NewMockDataFileSetReader(ctrl)
+		data           = []byte{1, 2, 3}
+	)
+
+	s, dir := testColdFlushShard(t, blockStart, reader)
+	defer os.RemoveAll(dir)
+	defer s.Close()
+
+	retriever := block.NewMockDatabaseBlockRetriever(ctrl)
+	retriever.EXPECT().ReopenBlock(s.shard, blockStart).Return(nil)
+	s.DatabaseBlockRetriever = retriever
+
+	// The latest volume contains a series with cold writes and a series
+	// without any which is copied to the new volume as is.
+	reader.EXPECT().
+		Open(gomock.Any()).
+		Do(func(opts fs.DataReaderOpenOptions) {
+			require.True(t, blockStart.Equal(opts.Identifier.BlockStart))
+			require.Equal(t, 0, opts.Identifier.VolumeIndex)
+		}).
+		Return(nil)
+	reader.EXPECT().ValidateMetadata().Return(nil)
+	gomock.InOrder(
+		reader.EXPECT().Read().Return(ident.StringID("foo0"),
+			ident.EmptyTagIterator, checked.NewBytes(data, nil), uint32(1), nil),
+		reader.EXPECT().Read().Return(ident.StringID("foo2"),
+			ident.EmptyTagIterator, checked.NewBytes(data, nil), uint32(2), nil),
+		reader.EXPECT().Read().Return(nil, nil, nil, uint32(0), io.EOF),
+	)
+	reader.EXPECT().Close().Return(nil)
+	reader.EXPECT().Status().Return(fs.DataFileSetReaderStatus{})
+
+	var (
+		closed    bool
+		persisted []string
+	)
+	flush := persist.NewMockFlushPreparer(ctrl)
+	prepared := persist.PreparedDataPersist{
+		Persist: func(id ident.ID, _ ident.Tags, _ ts.Segment, _ uint32) error {
+			persisted = append(persisted, id.String())
+			return nil
+		},
+		Close: func() error { closed = true; return nil },
+	}
+	prepareOpts := xtest.CmpMatcher(persist.DataPrepareOptions{
+		NamespaceMetadata: s.namespace,
+		Shard:             s.shard,
+		BlockStart:        blockStart,
+		Volume: persist.DataPrepareVolumeOptions{
+			VolumeIndex: 1,
+		},
+	})
+	flush.EXPECT().PrepareData(prepareOpts).Return(prepared, nil)
+
+	coldFlushed := make(map[string][]byte)
+	for i := 0; i < 3; i++ {
+		id := ident.StringID("foo" + strconv.Itoa(i))
+		curr := series.NewMockDatabaseSeries(ctrl)
+		curr.EXPECT().ID().Return(id).AnyTimes()
+		curr.EXPECT().IsEmpty().Return(false).AnyTimes()
+		s.list.PushBack(lookup.NewEntry(curr, 0))
+		if i == 2 {
+			curr.EXPECT().ColdFlushBlockStarts().Return(nil)
+			continue
+		}
+
+		// Cold writes to blocks that have not been flushed are not flushed.
+		curr.EXPECT().ColdFlushBlockStarts().Return([]time.Time{blockStart, unflushedStart})
+		curr.EXPECT().
+			ColdFlush(gomock.Any(), blockStart, gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, _ time.Time, existing ts.Segment, _ persist.DataFn) {
+				var b []byte
+				if existing.Head != nil {
+					b = append(b, existing.Head.Bytes()...)
+				}
+				coldFlushed[id.String()] = b
+			}).
+			Return(series.FlushOutcomeFlushedToDisk, nil)
+		curr.EXPECT().FinishColdFlush(blockStart, true)
+	}
+
+	require.NoError(t, s.ColdFlush(flush))
+
+	// Series without data in the latest volume are cold flushed on their own.
+	require.Equal(t, map[string][]byte{"foo0": data, "foo1": nil}, coldFlushed)
+	require.Equal(t, []string{"foo2"}, persisted)
+	require.True(t, closed)
+
+	require.Equal(t, fileOpState{
+		Status:      fileOpSuccess,
+		VolumeIndex: 1,
+	}, s.FlushState(blockStart))
+	require.Equal(t, fileOpState{
+		Status: fileOpNotStarted,
+	}, s.FlushState(unflushedStart))
+
+	// The previous volume is superseded by the new volume.
+	exists, err := fs.DataFileSetVolumeExistsAt(dir, s.namespace.ID(), s.shard, blockStart, 0)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestShardColdFlushSeriesColdFlushError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		blockStart = time.Unix(21600, 0)
+		reader     = fs.NewMockDataFileSetReader(ctrl)
+	)
+
+	s, dir := testColdFlushShard(t, blockStart, reader)
+	defer os.RemoveAll(dir)
+	defer s.Close()
+
+	// The block is not reopened when the cold flush fails.
+	s.DatabaseBlockRetriever = block.NewMockDatabaseBlockRetriever(ctrl)
+
+	reader.EXPECT().Open(gomock.Any()).Return(nil)
+	reader.EXPECT().ValidateMetadata().Return(nil)
+	reader.EXPECT().Read().Return(ident.StringID("foo0"),
+		ident.EmptyTagIterator, checked.NewBytes([]byte{1, 2, 3}, nil), uint32(1), nil)
+	reader.EXPECT().Close().Return(nil)
+	reader.EXPECT().Status().Return(fs.DataFileSetReaderStatus{})
+
+	var closed bool
+	flush := persist.NewMockFlushPreparer(ctrl)
+	prepared := persist.PreparedDataPersist{
+		Persist: func(ident.ID, ident.Tags, ts.Segment, uint32) error { return nil },
+		Close:   func() error { closed = true; return nil },
+	}
+	flush.EXPECT().PrepareData(gomock.Any()).Return(prepared, nil)
+
+	for i := 0; i < 2; i++ {
+		curr := series.NewMockDatabaseSeries(ctrl)
+		curr.EXPECT().ID().Return(ident.StringID("foo" + strconv.Itoa(i))).AnyTimes()
+		curr.EXPECT().IsEmpty().Return(false).AnyTimes()
+		curr.EXPECT().ColdFlushBlockStarts().Return([]time.Time{blockStart})
+		if i == 0 {
+			curr.EXPECT().
+				ColdFlush(gomock.Any(), blockStart, gomock.Any(), gomock.Any()).
+				Return(series.FlushOutcomeErr, errors.New("error bar"))
+		}
+		// The cold writes of all the series are retained for the next cold flush.
+		curr.EXPECT().FinishColdFlush(blockStart, false)
+		s.list.PushBack(lookup.NewEntry(curr, 0))
+	}
+
+	err := s.ColdFlush(flush)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "error bar")
+	require.True(t, closed)
+
+	require.Equal(t, fileOpState{
+		Status:      fileOpSuccess,
+		VolumeIndex: 0,
+	}, s.FlushState(blockStart))
+
+	exists, err := fs.DataFileSetVolumeExistsAt(dir, s.namespace.ID(), s.shard, blockStart, 0)
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
 func TestShardSnapshotShardNotBootstrapped(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -957,8 +1301,8 @@ func TestShardReadEncodedCachesSeriesWithRecentlyReadPolicy(t *testing.T) {
 	ropts := shard.seriesOpts.RetentionOptions()
 	end := opts.ClockOptions().NowFn()().Truncate(ropts.BlockSize())
 	start := end.Add(-2 * ropts.BlockSize())
-	shard.markFlushStateSuccess(start)
-	shard.markFlushStateSuccess(start.Add(ropts.BlockSize()))
+	shard.markFlushStateSuccess(start, 0)
+	shard.markFlushStateSuccess(start.Add(ropts.BlockSize()), 0)
 
 	retriever := block.NewMockDatabaseBlockRetriever(ctrl)
 	shard.setBlockRetriever(retriever)
@@ -141,6 +141,14 @@ type Database interface {
 		opts index.QueryOptions,
 	) (index.QueryResults, error)
 
+	// AggregateQuery resolves the given query into aggregated tags.
+	AggregateQuery(
+		ctx context.Context,
+		namespace ident.ID,
+		query index.Query,
+		opts index.AggregationOptions,
+	) (index.AggregateQueryResult, error)
+
 	// ReadEncoded retrieves encoded segments for an ID
 	ReadEncoded(
 		ctx context.Context,
@@ -241,6 +249,10 @@ type databaseNamespace interface {
 	// AssignShardSet sets the shard set assignment and returns immediately.
 	AssignShardSet(shardSet sharding.ShardSet)
 
+	// SetMetadata updates the namespace metadata in place, returning an error
+	// if the options changed cannot be safely updated on a live namespace.
+	SetMetadata(metadata namespace.Metadata) error
+
 	// GetOwnedShards returns the database shards.
 	GetOwnedShards() []databaseShard
 
@@ -278,6 +290,13 @@ type databaseNamespace interface {
 		opts index.QueryOptions,
 	) (index.QueryResults, error)
 
+	// AggregateQuery resolves the given query into aggregated tags.
+	AggregateQuery(
+		ctx context.Context,
+		query index.Query,
+		opts index.AggregationOptions,
+	) (index.AggregateQueryResult, error)
+
 	// ReadEncoded reads data for given id within [start, end).
 	ReadEncoded(
 		ctx context.Context,
@@ -314,6 +333,13 @@ type databaseNamespace interface {
 		flush persist.FlushPreparer,
 	) error
 
+	// ColdFlush merges any writes outside of the buffer window with the
+	// blocks that have already been flushed.
+	ColdFlush(
+		ShardBootstrapStates ShardBootstrapStates,
+		flush persist.FlushPreparer,
+	) error
+
 	// FlushIndex flushes in-memory index data.
 	FlushIndex(
 		flush persist.IndexFlush,
@@ -327,6 +353,10 @@ type databaseNamespace interface {
 	// NB: The start/end times are assumed to be aligned to block size boundary.
 	NeedsFlush(alignedInclusiveStart time.Time, alignedInclusiveEnd time.Time) bool
 
+	// ColdFlushBlockStarts returns the block starts that have writes outside
+	// of the buffer window which have not been cold flushed yet.
+	ColdFlushBlockStarts() []time.Time
+
 	// IsCapturedBySnapshot accepts a time t (system time, not datapoint timestamp time)
 	// as well as a [start, end] range (inclusive on both sides) and determines if all of
 	// the data for all of its shards in the namespace blocks contained within the range
@@ -374,6 +404,10 @@ type databaseShard interface {
 	// Close will release the shard resources and close the shard.
 	Close() error
 
+	// SetNamespaceMetadata updates the metadata of the namespace the
+	// shard belongs to.
+	SetNamespaceMetadata(metadata namespace.Metadata)
+
 	// Tick performs any updates to ensure series drain their buffers
 	// and blocks are flushed, etc.
 	Tick(c context.Cancellable, tickStart time.Time) (tickResult, error)
@@ -432,6 +466,14 @@ type databaseShard interface {
 		flush persist.FlushPreparer,
 	) error
 
+	// ColdFlush merges the writes outside of the buffer window of the series'
+	// in this shard with the blocks that have already been flushed.
+	ColdFlush(flush persist.FlushPreparer) error
+
+	// ColdFlushBlockStarts returns the block starts that have writes outside
+	// of the buffer window which have not been cold flushed yet.
+	ColdFlushBlockStarts() []time.Time
+
 	// Snapshot snapshot's the unflushed series' in this shard.
 	Snapshot(blockStart, snapshotStart time.Time, flush persist.SnapshotPreparer) error
 
@@ -444,6 +486,13 @@ type databaseShard interface {
 	// CleanupExpiredFileSets removes expired fileset files.
 	CleanupExpiredFileSets(earliestToRetain time.Time) error
 
+	// Load merges the provided series blocks into an already bootstrapped
+	// shard, used to apply blocks fetched from peers during repair.
+	Load(
+		ctx context.Context,
+		seriesToLoad *result.Map,
+	) error
+
 	// Repair repairs the shard data for a given time.
 	Repair(
 		ctx context.Context,
@@ -472,6 +521,14 @@ type namespaceIndex interface {
 		opts index.QueryOptions,
 	) (index.QueryResults, error)
 
+	// AggregateQuery resolves the given query into the distinct tag
+	// names, and optionally tag values, of the series it matches.
+	AggregateQuery(
+		ctx context.Context,
+		query index.Query,
+		opts index.AggregationOptions,
+	) (index.AggregateQueryResult, error)
+
 	// Bootstrap bootstraps the index the provided segments.
 	Bootstrap(
 		bootstrapResults result.IndexResults,
@@ -480,6 +537,10 @@ type namespaceIndex interface {
 	// BootstrapsDone returns the number of completed bootstraps.
 	BootstrapsDone() uint
 
+	// SetRetentionPeriod updates the retention period used to expire blocks
+	// and fileset files.
+	SetRetentionPeriod(retentionPeriod time.Duration)
+
 	// CleanupExpiredFileSets removes expired fileset files. Expiration is calcuated
 	// using the provided `t` as the frame of reference.
 	CleanupExpiredFileSets(t time.Time) error
@@ -606,7 +667,7 @@ type databaseShardRepairer interface {
 	// Repair repairs the data for a given namespace and shard.
 	Repair(
 		ctx context.Context,
-		namespace ident.ID,
+		nsMeta namespace.Metadata,
 		tr xtime.Range,
 		shard databaseShard,
 	) (repair.MetadataComparisonResult, error)
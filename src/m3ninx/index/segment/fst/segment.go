@@ -32,6 +32,7 @@ import (
 	sgmt "github.com/m3db/m3/src/m3ninx/index/segment"
 	"github.com/m3db/m3/src/m3ninx/index/segment/fst/encoding"
 	"github.com/m3db/m3/src/m3ninx/index/segment/fst/encoding/docs"
+	fstregexp "github.com/m3db/m3/src/m3ninx/index/segment/fst/regexp"
 	"github.com/m3db/m3/src/m3ninx/postings"
 	"github.com/m3db/m3/src/m3ninx/postings/pilosa"
 	"github.com/m3db/m3/src/m3ninx/postings/roaring"
@@ -423,6 +424,93 @@ func (r *fsSegment) MatchRegexp(field []byte, compiled index.CompiledRegex) (pos
 	return pl, nil
 }
 
+func (r *fsSegment) MatchField(field []byte) (postings.List, error) {
+	r.RLock()
+	defer r.RUnlock()
+	if r.closed {
+		return nil, errReaderClosed
+	}
+
+	return r.matchTermsRangeWithRLock(field, nil, nil)
+}
+
+func (r *fsSegment) MatchPrefix(field []byte, prefix []byte) (postings.List, error) {
+	r.RLock()
+	defer r.RUnlock()
+	if r.closed {
+		return nil, errReaderClosed
+	}
+
+	if len(prefix) == 0 {
+		return r.matchTermsRangeWithRLock(field, nil, nil)
+	}
+
+	// NB: IncrementBytes returns nil if the prefix overflows, which results in an
+	// iteration with no upper bound, i.e. through to the last term.
+	return r.matchTermsRangeWithRLock(field, prefix, fstregexp.IncrementBytes(prefix))
+}
+
+// matchTermsRangeWithRLock returns the union of the postings lists of all terms
+// of the given field within [startInclusive, endExclusive), a nil bound being
+// treated as unbounded.
+func (r *fsSegment) matchTermsRangeWithRLock(
+	field, startInclusive, endExclusive []byte,
+) (postings.List, error) {
+	termsFST, exists, err := r.retrieveTermsFSTWithRLock(field)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		// i.e. we don't know anything about the field, so can early return an empty postings list
+		return r.opts.PostingsListPool().Get(), nil
+	}
+
+	var (
+		fstCloser     = x.NewSafeCloser(termsFST)
+		iter, iterErr = termsFST.Iterator(startInclusive, endExclusive)
+		iterCloser    = x.NewSafeCloser(iter)
+		pls           []postings.List
+	)
+	defer func() {
+		iterCloser.Close()
+		fstCloser.Close()
+	}()
+
+	for {
+		if iterErr == vellum.ErrIteratorDone {
+			break
+		}
+
+		if iterErr != nil {
+			return nil, iterErr
+		}
+
+		_, postingsOffset := iter.Current()
+		nextPl, err := r.retrievePostingsListWithRLock(postingsOffset)
+		if err != nil {
+			return nil, err
+		}
+		pls = append(pls, nextPl)
+		iterErr = iter.Next()
+	}
+
+	pl, err := roaring.Union(pls)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := iterCloser.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := fstCloser.Close(); err != nil {
+		return nil, err
+	}
+
+	return pl, nil
+}
+
 func (r *fsSegment) MatchAll() (postings.MutableList, error) {
 	r.RLock()
 	defer r.RUnlock()
@@ -587,6 +675,24 @@ func (sr *fsSegmentReader) MatchRegexp(field []byte, compiled index.CompiledRege
 	return sr.fsSegment.MatchRegexp(field, compiled)
 }
 
+func (sr *fsSegmentReader) MatchField(field []byte) (postings.List, error) {
+	sr.RLock()
+	defer sr.RUnlock()
+	if sr.closed {
+		return nil, errReaderClosed
+	}
+	return sr.fsSegment.MatchField(field)
+}
+
+func (sr *fsSegmentReader) MatchPrefix(field []byte, prefix []byte) (postings.List, error) {
+	sr.RLock()
+	defer sr.RUnlock()
+	if sr.closed {
+		return nil, errReaderClosed
+	}
+	return sr.fsSegment.MatchPrefix(field, prefix)
+}
+
 func (sr *fsSegmentReader) MatchAll() (postings.MutableList, error) {
 	sr.RLock()
 	defer sr.RUnlock()
@@ -23,6 +23,7 @@ package fst
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"sort"
 	"sync"
 	"testing"
@@ -299,6 +300,82 @@ func TestPostingsListRegexAll(t *testing.T) {
 	}
 }
 
+func TestPostingsListEqualForMatchField(t *testing.T) {
+	for _, test := range testDocuments {
+		t.Run(test.name, func(t *testing.T) {
+			memSeg, fstSeg := newTestSegments(t, test.docs)
+			memReader, err := memSeg.Reader()
+			require.NoError(t, err)
+			fstReader, err := fstSeg.Reader()
+			require.NoError(t, err)
+
+			fieldsIter, err := memSeg.Fields()
+			require.NoError(t, err)
+			fields := toSlice(t, fieldsIter)
+			for _, f := range fields {
+				memPl, err := memReader.MatchField(f)
+				require.NoError(t, err)
+				fstPl, err := fstReader.MatchField(f)
+				require.NoError(t, err)
+				require.True(t, memPl.Equal(fstPl),
+					fmt.Sprintf("%s - [%v] != [%v]", string(f), pprintIter(memPl), pprintIter(fstPl)))
+
+				c, err := index.CompileRegex([]byte(".*"))
+				require.NoError(t, err)
+				regexpPl, err := fstReader.MatchRegexp(f, c)
+				require.NoError(t, err)
+				require.True(t, regexpPl.Equal(fstPl))
+			}
+
+			memPl, err := memReader.MatchField([]byte("unknown-field"))
+			require.NoError(t, err)
+			require.True(t, memPl.IsEmpty())
+			fstPl, err := fstReader.MatchField([]byte("unknown-field"))
+			require.NoError(t, err)
+			require.True(t, fstPl.IsEmpty())
+		})
+	}
+}
+
+func TestPostingsListEqualForMatchPrefix(t *testing.T) {
+	for _, test := range testDocuments {
+		t.Run(test.name, func(t *testing.T) {
+			memSeg, fstSeg := newTestSegments(t, test.docs)
+			memReader, err := memSeg.Reader()
+			require.NoError(t, err)
+			fstReader, err := fstSeg.Reader()
+			require.NoError(t, err)
+
+			fieldsIter, err := memSeg.Fields()
+			require.NoError(t, err)
+			fields := toSlice(t, fieldsIter)
+			for _, f := range fields {
+				termsIter, err := memSeg.Terms(f)
+				require.NoError(t, err)
+				terms := toTermPostings(t, termsIter)
+
+				for term := range terms {
+					for _, prefix := range []string{"", term[:len(term)/2], term} {
+						memPl, err := memReader.MatchPrefix(f, []byte(prefix))
+						require.NoError(t, err)
+						fstPl, err := fstReader.MatchPrefix(f, []byte(prefix))
+						require.NoError(t, err)
+						require.True(t, memPl.Equal(fstPl),
+							fmt.Sprintf("%s:%s - [%v] != [%v]", string(f), prefix, pprintIter(memPl), pprintIter(fstPl)))
+
+						c, err := index.CompileRegex([]byte(regexp.QuoteMeta(prefix) + ".*"))
+						require.NoError(t, err)
+						regexpPl, err := fstReader.MatchRegexp(f, c)
+						require.NoError(t, err)
+						require.True(t, regexpPl.Equal(fstPl),
+							fmt.Sprintf("%s:%s - [%v] != [%v]", string(f), prefix, pprintIter(regexpPl), pprintIter(fstPl)))
+					}
+				}
+			}
+		})
+	}
+}
+
 func TestSegmentDocs(t *testing.T) {
 	for _, test := range testDocuments {
 		t.Run(test.name, func(t *testing.T) {
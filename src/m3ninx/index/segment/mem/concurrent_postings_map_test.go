@@ -58,6 +58,19 @@ func TestConcurrentPostingsMap(t *testing.T) {
 	re = regexp.MustCompile("abc.*")
 	_, ok = pm.GetRegex(re)
 	require.False(t, ok)
+
+	pl, ok = pm.GetPrefix([]byte("ba"))
+	require.True(t, ok)
+	require.Equal(t, 2, pl.Len())
+	require.True(t, pl.Contains(2))
+	require.True(t, pl.Contains(4))
+
+	_, ok = pm.GetPrefix([]byte("abc"))
+	require.False(t, ok)
+
+	pl, ok = pm.GetAll()
+	require.True(t, ok)
+	require.Equal(t, 4, pl.Len())
 }
 
 func TestConcurrentPostingsMapKeys(t *testing.T) {
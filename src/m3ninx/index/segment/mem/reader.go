@@ -91,6 +91,30 @@ func (r *reader) MatchRegexp(field []byte, compiled index.CompiledRegex) (postin
 	return r.segment.matchRegexp(field, compileRE)
 }
 
+func (r *reader) MatchField(field []byte) (postings.List, error) {
+	r.RLock()
+	defer r.RUnlock()
+	if r.closed {
+		return nil, errSegmentReaderClosed
+	}
+
+	// A reader can return IDs in the posting list which are greater than its limit.
+	// See MatchTerm for details.
+	return r.segment.matchField(field)
+}
+
+func (r *reader) MatchPrefix(field, prefix []byte) (postings.List, error) {
+	r.RLock()
+	defer r.RUnlock()
+	if r.closed {
+		return nil, errSegmentReaderClosed
+	}
+
+	// A reader can return IDs in the posting list which are greater than its limit.
+	// See MatchTerm for details.
+	return r.segment.matchPrefix(field, prefix)
+}
+
 func (r *reader) MatchAll() (postings.MutableList, error) {
 	r.RLock()
 	defer r.RUnlock()
@@ -85,6 +85,56 @@ func TestReaderMatchRegex(t *testing.T) {
 	require.NoError(t, reader.Close())
 }
 
+func TestReaderMatchField(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	maxID := postings.ID(55)
+
+	name := []byte("apple")
+	postingsList := roaring.NewPostingsList()
+	require.NoError(t, postingsList.Insert(postings.ID(42)))
+	require.NoError(t, postingsList.Insert(postings.ID(50)))
+	require.NoError(t, postingsList.Insert(postings.ID(57)))
+
+	segment := NewMockReadableSegment(mockCtrl)
+	gomock.InOrder(
+		segment.EXPECT().matchField(name).Return(postingsList, nil),
+	)
+
+	reader := newReader(segment, readerDocRange{0, maxID}, postings.NewPool(nil, roaring.NewPostingsList))
+	actual, err := reader.MatchField(name)
+	require.NoError(t, err)
+	require.True(t, postingsList.Equal(actual))
+
+	require.NoError(t, reader.Close())
+}
+
+func TestReaderMatchPrefix(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	maxID := postings.ID(55)
+
+	name, prefix := []byte("apple"), []byte("re")
+	postingsList := roaring.NewPostingsList()
+	require.NoError(t, postingsList.Insert(postings.ID(42)))
+	require.NoError(t, postingsList.Insert(postings.ID(50)))
+	require.NoError(t, postingsList.Insert(postings.ID(57)))
+
+	segment := NewMockReadableSegment(mockCtrl)
+	gomock.InOrder(
+		segment.EXPECT().matchPrefix(name, prefix).Return(postingsList, nil),
+	)
+
+	reader := newReader(segment, readerDocRange{0, maxID}, postings.NewPool(nil, roaring.NewPostingsList))
+	actual, err := reader.MatchPrefix(name, prefix)
+	require.NoError(t, err)
+	require.True(t, postingsList.Equal(actual))
+
+	require.NoError(t, reader.Close())
+}
+
 func TestReaderMatchAll(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -118,6 +118,34 @@ func (d *termsDict) MatchRegexp(
 	return pl
 }
 
+func (d *termsDict) MatchField(field []byte) postings.List {
+	d.fields.RLock()
+	postingsMap, ok := d.fields.Get(field)
+	d.fields.RUnlock()
+	if !ok {
+		return d.opts.PostingsListPool().Get()
+	}
+	pl, ok := postingsMap.GetAll()
+	if !ok {
+		return d.opts.PostingsListPool().Get()
+	}
+	return pl
+}
+
+func (d *termsDict) MatchPrefix(field, prefix []byte) postings.List {
+	d.fields.RLock()
+	postingsMap, ok := d.fields.Get(field)
+	d.fields.RUnlock()
+	if !ok {
+		return d.opts.PostingsListPool().Get()
+	}
+	pl, ok := postingsMap.GetPrefix(prefix)
+	if !ok {
+		return d.opts.PostingsListPool().Get()
+	}
+	return pl
+}
+
 func (d *termsDict) Reset() {
 	d.fields.Lock()
 	defer d.fields.Unlock()
@@ -45,6 +45,14 @@ type termsDictionary interface {
 	// given egular expression.
 	MatchRegexp(field []byte, compiled *re.Regexp) postings.List
 
+	// MatchField returns the postings list corresponding to documents which contain
+	// the given field.
+	MatchField(field []byte) postings.List
+
+	// MatchPrefix returns the postings list corresponding to documents which match the
+	// given field and whose term begins with the given prefix.
+	MatchPrefix(field, prefix []byte) postings.List
+
 	// Fields returns the known fields.
 	Fields() sgmt.FieldsIterator
 
@@ -68,6 +76,13 @@ type ReadableSegment interface {
 	// matchRegexp returns the postings list of documents which match the given regular expression.
 	matchRegexp(field []byte, compiled *re.Regexp) (postings.List, error)
 
+	// matchField returns the postings list of documents which contain the given field.
+	matchField(field []byte) (postings.List, error)
+
+	// matchPrefix returns the postings list of documents whose term for the given field
+	// begins with the given prefix.
+	matchPrefix(field, prefix []byte) (postings.List, error)
+
 	// getDoc returns the document associated with the given ID.
 	getDoc(id postings.ID) (doc.Document, error)
 }
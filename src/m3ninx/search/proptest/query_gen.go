@@ -102,10 +102,63 @@ func genRegexpQuery(docs []doc.Document) gopter.Gen {
 	}
 }
 
+func genFieldQuery(docs []doc.Document) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		docIDRes, ok := gen.IntRange(0, len(docs)-1)(genParams).Retrieve()
+		if !ok {
+			panic("unable to generate field query") // should never happen
+		}
+		docID := docIDRes.(int)
+
+		doc := docs[docID]
+		fieldRes, ok := gen.IntRange(0, len(doc.Fields)-1)(genParams).Retrieve()
+		if !ok {
+			panic("unable to generate field query fields") // should never happen
+		}
+
+		fieldID := fieldRes.(int)
+		field := doc.Fields[fieldID]
+
+		q := query.NewFieldQuery(field.Name)
+		return gopter.NewGenResult(q, gopter.NoShrinker)
+	}
+}
+
+func genPrefixQuery(docs []doc.Document) gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		docIDRes, ok := gen.IntRange(0, len(docs)-1)(genParams).Retrieve()
+		if !ok {
+			panic("unable to generate prefix query") // should never happen
+		}
+		docID := docIDRes.(int)
+
+		doc := docs[docID]
+		fieldRes, ok := gen.IntRange(0, len(doc.Fields)-1)(genParams).Retrieve()
+		if !ok {
+			panic("unable to generate prefix query fields") // should never happen
+		}
+
+		fieldID := fieldRes.(int)
+		field := doc.Fields[fieldID]
+
+		idx := genParams.NextUint64() % uint64(len(field.Value)+1)
+		prefix := append([]byte(nil), field.Value[:idx]...)
+
+		q := query.NewPrefixQuery(field.Name, prefix)
+		return gopter.NewGenResult(q, gopter.NoShrinker)
+	}
+}
+
+func genAllQuery(docs []doc.Document) gopter.Gen {
+	return gen.Const(query.NewAllQuery())
+}
+
 func genNegationQuery(docs []doc.Document) gopter.Gen {
 	return gen.OneGenOf(
 		genTermQuery(docs),
 		genRegexpQuery(docs),
+		genFieldQuery(docs),
+		genPrefixQuery(docs),
 	).
 		Map(func(q search.Query) search.Query {
 			return query.NewNegationQuery(q)
@@ -117,6 +170,8 @@ func genConjuctionQuery(docs []doc.Document) gopter.Gen {
 		gen.OneGenOf(
 			genTermQuery(docs),
 			genRegexpQuery(docs),
+			genFieldQuery(docs),
+			genPrefixQuery(docs),
 			genNegationQuery(docs)),
 		reflect.TypeOf((*search.Query)(nil)).Elem()).
 		Map(func(qs []search.Query) search.Query {
@@ -129,6 +184,8 @@ func genDisjunctionQuery(docs []doc.Document) gopter.Gen {
 		gen.OneGenOf(
 			genTermQuery(docs),
 			genRegexpQuery(docs),
+			genFieldQuery(docs),
+			genPrefixQuery(docs),
 			genNegationQuery(docs)),
 		reflect.TypeOf((*search.Query)(nil)).Elem()).
 		Map(func(qs []search.Query) search.Query {
@@ -140,6 +197,9 @@ func genQuery(docs []doc.Document) gopter.Gen {
 	return gen.OneGenOf(
 		genTermQuery(docs),
 		genRegexpQuery(docs),
+		genFieldQuery(docs),
+		genPrefixQuery(docs),
+		genAllQuery(docs),
 		genNegationQuery(docs),
 		genConjuctionQuery(docs),
 		genDisjunctionQuery(docs))
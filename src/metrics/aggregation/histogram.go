@@ -0,0 +1,103 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+var (
+	// DefaultHistogramBuckets are the default upper bounds of the histogram
+	// buckets, which match the default buckets of the Prometheus client libraries.
+	DefaultHistogramBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+	// HistogramSumTypeString is the type string of the histogram sum series.
+	HistogramSumTypeString = []byte("_sum")
+
+	// HistogramCountTypeString is the type string of the histogram count series.
+	HistogramCountTypeString = []byte("_count")
+
+	histogramBucketTypeStringPrefix = []byte(`_bucket{le="`)
+	histogramBucketTypeStringSuffix = []byte(`"}`)
+	histogramBucketNameSuffix       = []byte("_bucket")
+
+	errNoHistogramBuckets = errors.New("no histogram buckets")
+)
+
+// HistogramBucketTypeString returns the type string of the histogram bucket
+// series with the given upper bound, e.g. `_bucket{le="0.5"}`.
+func HistogramBucketTypeString(upperBound float64) []byte {
+	res := make([]byte, 0, len(histogramBucketTypeStringPrefix)+len(histogramBucketTypeStringSuffix)+8)
+	res = append(res, histogramBucketTypeStringPrefix...)
+	res = append(res, formatHistogramUpperBound(upperBound)...)
+	res = append(res, histogramBucketTypeStringSuffix...)
+	return res
+}
+
+// ParseHistogramTypeString parses a histogram type string into the suffix to
+// append to the metric name and, for bucket series, the bucket upper bound.
+// It returns false if the type string is not a histogram type string.
+func ParseHistogramTypeString(typeString []byte) (nameSuffix []byte, upperBound []byte, ok bool) {
+	switch {
+	case bytes.Equal(typeString, HistogramSumTypeString):
+		return HistogramSumTypeString, nil, true
+	case bytes.Equal(typeString, HistogramCountTypeString):
+		return HistogramCountTypeString, nil, true
+	case bytes.HasPrefix(typeString, histogramBucketTypeStringPrefix) &&
+		bytes.HasSuffix(typeString, histogramBucketTypeStringSuffix):
+		start := len(histogramBucketTypeStringPrefix)
+		end := len(typeString) - len(histogramBucketTypeStringSuffix)
+		if start >= end {
+			return nil, nil, false
+		}
+		return histogramBucketNameSuffix, typeString[start:end], true
+	default:
+		return nil, nil, false
+	}
+}
+
+// ValidateHistogramBuckets validates that the histogram bucket upper bounds
+// are non-empty, finite and strictly increasing.
+func ValidateHistogramBuckets(buckets []float64) error {
+	if len(buckets) == 0 {
+		return errNoHistogramBuckets
+	}
+	for i, b := range buckets {
+		if math.IsNaN(b) || math.IsInf(b, 0) {
+			return fmt.Errorf("invalid histogram bucket upper bound %v", b)
+		}
+		if i > 0 && b <= buckets[i-1] {
+			return fmt.Errorf("histogram buckets %v are not strictly increasing", buckets)
+		}
+	}
+	return nil
+}
+
+func formatHistogramUpperBound(upperBound float64) string {
+	if math.IsInf(upperBound, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(upperBound, 'g', -1, 64)
+}
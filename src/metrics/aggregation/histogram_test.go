@@ -0,0 +1,91 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogramBucketTypeString(t *testing.T) {
+	require.Equal(t, []byte(`_bucket{le="0.005"}`), HistogramBucketTypeString(0.005))
+	require.Equal(t, []byte(`_bucket{le="2.5"}`), HistogramBucketTypeString(2.5))
+	require.Equal(t, []byte(`_bucket{le="10"}`), HistogramBucketTypeString(10))
+	require.Equal(t, []byte(`_bucket{le="+Inf"}`), HistogramBucketTypeString(math.Inf(1)))
+}
+
+func TestParseHistogramTypeString(t *testing.T) {
+	inputs := []struct {
+		typeString         []byte
+		expectedNameSuffix []byte
+		expectedUpperBound []byte
+		expectedOK         bool
+	}{
+		{
+			typeString:         HistogramBucketTypeString(0.25),
+			expectedNameSuffix: []byte("_bucket"),
+			expectedUpperBound: []byte("0.25"),
+			expectedOK:         true,
+		},
+		{
+			typeString:         HistogramBucketTypeString(math.Inf(1)),
+			expectedNameSuffix: []byte("_bucket"),
+			expectedUpperBound: []byte("+Inf"),
+			expectedOK:         true,
+		},
+		{
+			typeString:         HistogramSumTypeString,
+			expectedNameSuffix: []byte("_sum"),
+			expectedOK:         true,
+		},
+		{
+			typeString:         HistogramCountTypeString,
+			expectedNameSuffix: []byte("_count"),
+			expectedOK:         true,
+		},
+		{
+			typeString: []byte(`_bucket{le=""}`),
+		},
+		{
+			typeString: []byte("p99"),
+		},
+		{
+			typeString: nil,
+		},
+	}
+	for _, input := range inputs {
+		nameSuffix, upperBound, ok := ParseHistogramTypeString(input.typeString)
+		require.Equal(t, input.expectedOK, ok)
+		require.Equal(t, input.expectedNameSuffix, nameSuffix)
+		require.Equal(t, input.expectedUpperBound, upperBound)
+	}
+}
+
+func TestValidateHistogramBuckets(t *testing.T) {
+	require.NoError(t, ValidateHistogramBuckets(DefaultHistogramBuckets))
+	require.Equal(t, errNoHistogramBuckets, ValidateHistogramBuckets(nil))
+	require.Error(t, ValidateHistogramBuckets([]float64{1, 1}))
+	require.Error(t, ValidateHistogramBuckets([]float64{2, 1}))
+	require.Error(t, ValidateHistogramBuckets([]float64{1, math.NaN()}))
+	require.Error(t, ValidateHistogramBuckets([]float64{1, math.Inf(1)}))
+}
@@ -66,7 +66,7 @@ func TestIDDecompressError(t *testing.T) {
 	_, err := decompressor.Decompress([IDLen]uint64{1})
 	require.Error(t, err)
 
-	max, err := compressor.Compress([]Type{Last, Min, Max, Mean, Median, Count, Sum, SumSq, Stdev, P95, P99, P999, P9999})
+	max, err := compressor.Compress([]Type{Last, Min, Max, Mean, Median, Count, Sum, SumSq, Stdev, P95, P99, P999, P9999, Histogram})
 	require.NoError(t, err)
 
 	max[0] = max[0] << 1
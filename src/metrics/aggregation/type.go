@@ -54,6 +54,7 @@ const (
 	P99
 	P999
 	P9999
+	Histogram
 
 	nextTypeID = iota
 )
@@ -77,28 +78,29 @@ var (
 
 	// ValidTypes is the list of all the valid aggregation types.
 	ValidTypes = map[Type]struct{}{
-		Last:   emptyStruct,
-		Min:    emptyStruct,
-		Max:    emptyStruct,
-		Mean:   emptyStruct,
-		Median: emptyStruct,
-		Count:  emptyStruct,
-		Sum:    emptyStruct,
-		SumSq:  emptyStruct,
-		Stdev:  emptyStruct,
-		P10:    emptyStruct,
-		P20:    emptyStruct,
-		P30:    emptyStruct,
-		P40:    emptyStruct,
-		P50:    emptyStruct,
-		P60:    emptyStruct,
-		P70:    emptyStruct,
-		P80:    emptyStruct,
-		P90:    emptyStruct,
-		P95:    emptyStruct,
-		P99:    emptyStruct,
-		P999:   emptyStruct,
-		P9999:  emptyStruct,
+		Last:      emptyStruct,
+		Min:       emptyStruct,
+		Max:       emptyStruct,
+		Mean:      emptyStruct,
+		Median:    emptyStruct,
+		Count:     emptyStruct,
+		Sum:       emptyStruct,
+		SumSq:     emptyStruct,
+		Stdev:     emptyStruct,
+		P10:       emptyStruct,
+		P20:       emptyStruct,
+		P30:       emptyStruct,
+		P40:       emptyStruct,
+		P50:       emptyStruct,
+		P60:       emptyStruct,
+		P70:       emptyStruct,
+		P80:       emptyStruct,
+		P90:       emptyStruct,
+		P95:       emptyStruct,
+		P99:       emptyStruct,
+		P999:      emptyStruct,
+		P9999:     emptyStruct,
+		Histogram: emptyStruct,
 	}
 
 	typeStringMap map[string]Type
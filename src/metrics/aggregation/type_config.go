@@ -47,6 +47,9 @@ type TypesConfiguration struct {
 	// GaugeTransformFnType configures the type string transformation function for gauges.
 	GaugeTransformFnType *transformFnType `yaml:"gaugeTransformFnType"`
 
+	// HistogramBuckets configures the histogram bucket upper bounds for timers.
+	HistogramBuckets *[]float64 `yaml:"histogramBuckets"`
+
 	// Pool of aggregation types.
 	AggregationTypesPool pool.ObjectPoolConfiguration `yaml:"aggregationTypesPool"`
 
@@ -87,6 +90,12 @@ func (c TypesConfiguration) NewOptions(instrumentOpts instrument.Options) (Types
 		}
 		opts = opts.SetGaugeTypeStringTransformFn(fn)
 	}
+	if c.HistogramBuckets != nil {
+		if err := ValidateHistogramBuckets(*c.HistogramBuckets); err != nil {
+			return nil, err
+		}
+		opts = opts.SetHistogramBuckets(*c.HistogramBuckets)
+	}
 
 	// Set aggregation types pool.
 	scope := instrumentOpts.MetricsScope()
@@ -79,6 +79,29 @@ defaultTimerAggregationTypes:
 	require.Equal(t, []byte("last"), opts.TypeStringForGauge(Last))
 }
 
+func TestTypesConfigurationHistogramBuckets(t *testing.T) {
+	str := `
+histogramBuckets: [0.1, 0.5, 1]
+`
+
+	var cfg TypesConfiguration
+	require.NoError(t, yaml.Unmarshal([]byte(str), &cfg))
+	opts, err := cfg.NewOptions(instrument.NewOptions())
+	require.NoError(t, err)
+	require.Equal(t, []float64{0.1, 0.5, 1}, opts.HistogramBuckets())
+}
+
+func TestTypesConfigurationInvalidHistogramBuckets(t *testing.T) {
+	str := `
+histogramBuckets: [1, 0.5]
+`
+
+	var cfg TypesConfiguration
+	require.NoError(t, yaml.Unmarshal([]byte(str), &cfg))
+	_, err := cfg.NewOptions(instrument.NewOptions())
+	require.Error(t, err)
+}
+
 func TestTypesConfigurationError(t *testing.T) {
 	str := `
 defaultGaugeAggregationTypes: [Max]
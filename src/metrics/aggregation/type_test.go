@@ -31,13 +31,13 @@ import (
 )
 
 func TestTypeIsValid(t *testing.T) {
-	require.True(t, P9999.IsValid())
-	require.False(t, Type(int(P9999)+1).IsValid())
+	require.True(t, Histogram.IsValid())
+	require.False(t, Type(int(Histogram)+1).IsValid())
 }
 
 func TestTypeMaxID(t *testing.T) {
-	require.Equal(t, maxTypeID, P9999.ID())
-	require.Equal(t, P9999, Type(maxTypeID))
+	require.Equal(t, maxTypeID, Histogram.ID())
+	require.Equal(t, Histogram, Type(maxTypeID))
 	require.Equal(t, maxTypeID, len(ValidTypes))
 }
 
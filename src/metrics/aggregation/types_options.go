@@ -22,6 +22,7 @@ package aggregation
 
 import (
 	"bytes"
+	"math"
 	"strconv"
 	"strings"
 
@@ -81,6 +82,12 @@ type TypesOptions interface {
 	// GaugeTypeStringTransformFn returns the transformation function for gauge type strings.
 	GaugeTypeStringTransformFn() TypeStringTransformFn
 
+	// SetHistogramBuckets sets the histogram bucket upper bounds for timers.
+	SetHistogramBuckets(value []float64) TypesOptions
+
+	// HistogramBuckets returns the histogram bucket upper bounds for timers.
+	HistogramBuckets() []float64
+
 	// SetTypesPool sets the aggregation types pool.
 	SetTypesPool(pool TypesPool) TypesOptions
 
@@ -116,6 +123,10 @@ type TypesOptions interface {
 	// Quantiles returns the quantiles for timers.
 	Quantiles() []float64
 
+	// HistogramBucketTypeStrings returns the type strings of the histogram bucket
+	// series for timers, one for each histogram bucket followed by the +Inf bucket.
+	HistogramBucketTypeStrings() [][]byte
+
 	// IsContainedInDefaultAggregationTypes checks if the given aggregation type is
 	// contained in the default aggregation types for the metric type.
 	IsContainedInDefaultAggregationTypes(at Type, mt metric.Type) bool
@@ -142,15 +153,16 @@ var (
 		Last,
 	}
 	defaultTypeStringsMap = map[Type][]byte{
-		Last:   []byte("last"),
-		Sum:    []byte("sum"),
-		SumSq:  []byte("sum_sq"),
-		Mean:   []byte("mean"),
-		Min:    []byte("lower"),
-		Max:    []byte("upper"),
-		Count:  []byte("count"),
-		Stdev:  []byte("stdev"),
-		Median: []byte("median"),
+		Last:      []byte("last"),
+		Sum:       []byte("sum"),
+		SumSq:     []byte("sum_sq"),
+		Mean:      []byte("mean"),
+		Min:       []byte("lower"),
+		Max:       []byte("upper"),
+		Count:     []byte("count"),
+		Stdev:     []byte("stdev"),
+		Median:    []byte("median"),
+		Histogram: []byte("histogram"),
 	}
 )
 
@@ -162,6 +174,7 @@ type options struct {
 	counterTypeStringTransformFn   TypeStringTransformFn
 	timerTypeStringTransformFn     TypeStringTransformFn
 	gaugeTypeStringTransformFn     TypeStringTransformFn
+	histogramBuckets               []float64
 	aggTypesPool                   TypesPool
 	quantilesPool                  pool.FloatsPool
 
@@ -169,6 +182,8 @@ type options struct {
 	timerTypeStrings   [][]byte
 	gaugeTypeStrings   [][]byte
 	quantiles          []float64
+
+	histogramBucketTypeStrings [][]byte
 }
 
 // NewTypesOptions returns a default TypesOptions.
@@ -181,6 +196,7 @@ func NewTypesOptions() TypesOptions {
 		counterTypeStringTransformFn:   NoOpTransform,
 		timerTypeStringTransformFn:     NoOpTransform,
 		gaugeTypeStringTransformFn:     NoOpTransform,
+		histogramBuckets:               DefaultHistogramBuckets,
 	}
 	o.initPools()
 	o.computeAllDerived()
@@ -274,6 +290,17 @@ func (o *options) GaugeTypeStringTransformFn() TypeStringTransformFn {
 	return o.gaugeTypeStringTransformFn
 }
 
+func (o *options) SetHistogramBuckets(value []float64) TypesOptions {
+	opts := *o
+	opts.histogramBuckets = value
+	opts.computeAllDerived()
+	return &opts
+}
+
+func (o *options) HistogramBuckets() []float64 {
+	return o.histogramBuckets
+}
+
 func (o *options) SetTypesPool(pool TypesPool) TypesOptions {
 	opts := *o
 	opts.aggTypesPool = pool
@@ -322,6 +349,10 @@ func (o *options) Quantiles() []float64 {
 	return o.quantiles
 }
 
+func (o *options) HistogramBucketTypeStrings() [][]byte {
+	return o.histogramBucketTypeStrings
+}
+
 func (o *options) IsContainedInDefaultAggregationTypes(at Type, mt metric.Type) bool {
 	var aggTypes Types
 	switch mt {
@@ -340,6 +371,7 @@ func (o *options) computeAllDerived() {
 	o.computeCounterTypeStrings()
 	o.computeTimerTypeStrings()
 	o.computeGaugeTypeStrings()
+	o.computeHistogramBucketTypeStrings()
 }
 
 func (o *options) computeQuantiles() {
@@ -358,6 +390,15 @@ func (o *options) computeGaugeTypeStrings() {
 	o.gaugeTypeStrings = o.computeTypeStrings(o.gaugeTypeStringTransformFn)
 }
 
+func (o *options) computeHistogramBucketTypeStrings() {
+	res := make([][]byte, 0, len(o.histogramBuckets)+1)
+	for _, upperBound := range o.histogramBuckets {
+		res = append(res, HistogramBucketTypeString(upperBound))
+	}
+	res = append(res, HistogramBucketTypeString(math.Inf(1)))
+	o.histogramBucketTypeStrings = res
+}
+
 func (o *options) computeTypeStrings(transformFn TypeStringTransformFn) [][]byte {
 	res := make([][]byte, maxTypeID+1)
 	for aggType := range ValidTypes {
@@ -434,6 +434,22 @@ func TestSetQuantilesPool(t *testing.T) {
 	require.Equal(t, p, o.QuantilesPool())
 }
 
+func TestOptionsSetHistogramBuckets(t *testing.T) {
+	o := NewTypesOptions()
+	require.Equal(t, DefaultHistogramBuckets, o.HistogramBuckets())
+
+	buckets := []float64{0.1, 1, 10}
+	o = o.SetHistogramBuckets(buckets)
+	require.Equal(t, buckets, o.HistogramBuckets())
+	expected := [][]byte{
+		[]byte(`_bucket{le="0.1"}`),
+		[]byte(`_bucket{le="1"}`),
+		[]byte(`_bucket{le="10"}`),
+		[]byte(`_bucket{le="+Inf"}`),
+	}
+	require.Equal(t, expected, o.HistogramBucketTypeStrings())
+}
+
 func validateQuantiles(t *testing.T, o TypesOptions) {
 	typeStringFn := o.QuantileTypeStringFn()
 	quantiles, _ := o.DefaultTimerAggregationTypes().PooledQuantiles(nil)
@@ -450,28 +466,29 @@ func validateQuantiles(t *testing.T, o TypesOptions) {
 
 func typeStrings(overrides map[Type][]byte) [][]byte {
 	defaultTypeStrings := map[Type][]byte{
-		Last:   []byte("last"),
-		Min:    []byte("lower"),
-		Max:    []byte("upper"),
-		Mean:   []byte("mean"),
-		Median: []byte("median"),
-		Count:  []byte("count"),
-		Sum:    []byte("sum"),
-		SumSq:  []byte("sum_sq"),
-		Stdev:  []byte("stdev"),
-		P10:    []byte("p10"),
-		P20:    []byte("p20"),
-		P30:    []byte("p30"),
-		P40:    []byte("p40"),
-		P50:    []byte("p50"),
-		P60:    []byte("p60"),
-		P70:    []byte("p70"),
-		P80:    []byte("p80"),
-		P90:    []byte("p90"),
-		P95:    []byte("p95"),
-		P99:    []byte("p99"),
-		P999:   []byte("p999"),
-		P9999:  []byte("p9999"),
+		Last:      []byte("last"),
+		Min:       []byte("lower"),
+		Max:       []byte("upper"),
+		Mean:      []byte("mean"),
+		Median:    []byte("median"),
+		Count:     []byte("count"),
+		Sum:       []byte("sum"),
+		SumSq:     []byte("sum_sq"),
+		Stdev:     []byte("stdev"),
+		P10:       []byte("p10"),
+		P20:       []byte("p20"),
+		P30:       []byte("p30"),
+		P40:       []byte("p40"),
+		P50:       []byte("p50"),
+		P60:       []byte("p60"),
+		P70:       []byte("p70"),
+		P80:       []byte("p80"),
+		P90:       []byte("p90"),
+		P95:       []byte("p95"),
+		P99:       []byte("p99"),
+		P999:      []byte("p999"),
+		P9999:     []byte("p9999"),
+		Histogram: []byte("histogram"),
 	}
 	res := make([][]byte, maxTypeID+1)
 	for t, bstr := range defaultTypeStrings {
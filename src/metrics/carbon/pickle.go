@@ -0,0 +1,224 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package carbon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/m3db/m3x/instrument"
+
+	"github.com/hydrogen18/stalecucumber"
+)
+
+const (
+	picklePayloadHeaderSize = 4
+
+	// Matches the maximum message length accepted by the carbon pickle receiver.
+	maxPicklePayloadSize = 1 << 20 // 1MiB
+)
+
+var (
+	errPicklePayloadTooLarge = errors.New("pickle payload exceeds max size")
+	errInvalidPickleMetric   = errors.New("invalid pickle metric")
+)
+
+// A PickleScanner is used to scan carbon metrics sent using the pickle protocol
+// from an underlying io.Reader. Each message consists of a 4 byte big endian
+// length header followed by a pickled list of (path, (timestamp, value)) tuples.
+type PickleScanner struct {
+	r       io.Reader
+	header  [picklePayloadHeaderSize]byte
+	payload []byte
+	metrics []Metric
+	idx     int
+	err     error
+
+	timestamp time.Time
+	path      []byte
+	value     float64
+
+	// The number of malformed metrics encountered.
+	MalformedCount int
+
+	iOpts instrument.Options
+}
+
+// NewPickleScanner creates a new carbon pickle scanner.
+func NewPickleScanner(r io.Reader, iOpts instrument.Options) *PickleScanner {
+	return &PickleScanner{r: r, iOpts: iOpts}
+}
+
+// Scan scans for the next carbon metric. Malformed metrics are skipped but counted.
+func (s *PickleScanner) Scan() bool {
+	for s.idx >= len(s.metrics) {
+		if !s.readPayload() {
+			return false
+		}
+	}
+
+	m := s.metrics[s.idx]
+	s.path, s.timestamp, s.value = m.Name, m.Time, m.Val
+	s.idx++
+	return true
+}
+
+func (s *PickleScanner) readPayload() bool {
+	if s.err != nil {
+		return false
+	}
+
+	if _, err := io.ReadFull(s.r, s.header[:]); err != nil {
+		if err != io.EOF {
+			s.err = err
+		}
+		return false
+	}
+
+	size := binary.BigEndian.Uint32(s.header[:])
+	if size > maxPicklePayloadSize {
+		// Can't skip past the payload safely without reading it all, so give up.
+		s.err = fmt.Errorf("%v: size=%d, max=%d",
+			errPicklePayloadTooLarge, size, maxPicklePayloadSize)
+		return false
+	}
+
+	if cap(s.payload) < int(size) {
+		s.payload = make([]byte, size)
+	}
+	s.payload = s.payload[:size]
+	if _, err := io.ReadFull(s.r, s.payload); err != nil {
+		s.err = err
+		return false
+	}
+
+	s.metrics, s.idx = s.metrics[:0], 0
+	unpickled, err := stalecucumber.Unpickle(bytes.NewReader(s.payload))
+	if err != nil {
+		s.iOpts.Logger().Errorf(
+			"error trying to unpickle malformed carbon pickle payload, err: %s", err.Error())
+		s.MalformedCount++
+		return true
+	}
+
+	items, ok := unpickled.([]interface{})
+	if !ok {
+		s.iOpts.Logger().Errorf(
+			"error trying to scan malformed carbon pickle payload of type: %T", unpickled)
+		s.MalformedCount++
+		return true
+	}
+
+	for _, item := range items {
+		m, err := ParsePickleMetric(item)
+		if err != nil {
+			s.iOpts.Logger().Errorf(
+				"error trying to scan malformed carbon pickle metric: %v, err: %s",
+				item, err.Error())
+			s.MalformedCount++
+			continue
+		}
+		s.metrics = append(s.metrics, m)
+	}
+
+	return true
+}
+
+// Metric returns the path, timestamp, and value of the last parsed metric.
+func (s *PickleScanner) Metric() ([]byte, time.Time, float64) {
+	return s.path, s.timestamp, s.value
+}
+
+// Err returns any errors in the scan.
+func (s *PickleScanner) Err() error { return s.err }
+
+// ParsePickleMetric parses a single unpickled (path, (timestamp, value)) tuple
+// into a carbon metric.
+func ParsePickleMetric(item interface{}) (Metric, error) {
+	tuple, ok := item.([]interface{})
+	if !ok || len(tuple) != 2 {
+		return Metric{}, errInvalidPickleMetric
+	}
+
+	var name []byte
+	switch v := tuple[0].(type) {
+	case string:
+		name = []byte(v)
+	case []byte:
+		name = v
+	default:
+		return Metric{}, errInvalidPickleMetric
+	}
+	if len(name) == 0 {
+		return Metric{}, errInvalidLine
+	}
+	if !utf8.Valid(name) {
+		return Metric{}, errNotUTF8
+	}
+
+	datapoint, ok := tuple[1].([]interface{})
+	if !ok || len(datapoint) != 2 {
+		return Metric{}, errInvalidPickleMetric
+	}
+
+	tsInSecs, err := parsePickleFloat(datapoint[0])
+	if err != nil {
+		return Metric{}, fmt.Errorf("invalid timestamp %v: %v", datapoint[0], err)
+	}
+
+	value, err := parsePickleFloat(datapoint[1])
+	if err != nil {
+		return Metric{}, fmt.Errorf("invalid value %v: %v", datapoint[1], err)
+	}
+
+	return Metric{
+		Name: name,
+		Time: time.Unix(int64(tsInSecs), 0),
+		Val:  value,
+	}, nil
+}
+
+func parsePickleFloat(v interface{}) (float64, error) {
+	switch n := v.(type) {
+	case float64:
+		return n, nil
+	case int64:
+		return float64(n), nil
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(n).Float64()
+		return f, nil
+	case string:
+		if val := strings.ToLower(n); val == negativeNanStr || val == nanStr {
+			return mathNan, nil
+		}
+		return strconv.ParseFloat(n, floatBitSize)
+	default:
+		return 0, fmt.Errorf("unexpected type %T", v)
+	}
+}
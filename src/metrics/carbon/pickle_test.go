@@ -0,0 +1,124 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package carbon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hydrogen18/stalecucumber"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePicklePayload(t *testing.T, w io.Writer, metrics interface{}) {
+	var payload bytes.Buffer
+	_, err := stalecucumber.NewPickler(&payload).Pickle(metrics)
+	require.NoError(t, err)
+
+	var header [picklePayloadHeaderSize]byte
+	binary.BigEndian.PutUint32(header[:], uint32(payload.Len()))
+	_, err = w.Write(header[:])
+	require.NoError(t, err)
+	_, err = w.Write(payload.Bytes())
+	require.NoError(t, err)
+}
+
+func TestPickleScannerMetric(t *testing.T) {
+	var buf bytes.Buffer
+	writePicklePayload(t, &buf, []interface{}{
+		[]interface{}{"foo.bar.zed", []interface{}{int64(1428951394), 45565.02}},
+		[]interface{}{"foo.bar.quad", []interface{}{float64(1428951394), int64(10)}},
+		// Malformed, missing the value.
+		[]interface{}{"foo.bar.malformed", []interface{}{int64(1428951394)}},
+	})
+	writePicklePayload(t, &buf, []interface{}{
+		[]interface{}{"foo.bar.nan", []interface{}{"1428951394", "nan"}},
+		[]interface{}{"short", []interface{}{int64(1), "1"}},
+	})
+
+	expected := []struct {
+		path  string
+		time  time.Time
+		value float64
+	}{
+		{"foo.bar.zed", time.Unix(1428951394, 0), 45565.02},
+		{"foo.bar.quad", time.Unix(1428951394, 0), 10},
+		{"foo.bar.nan", time.Unix(1428951394, 0), math.NaN()},
+		{"short", time.Unix(1, 0), 1},
+	}
+
+	s := NewPickleScanner(&buf, testIOpts)
+	for _, e := range expected {
+		require.True(t, s.Scan(), "could not scan %s, err: %v", e.path, s.Err())
+		name, ts, value := s.Metric()
+		assert.Equal(t, e.path, string(name))
+		assert.Equal(t, e.time, ts)
+		if math.IsNaN(e.value) {
+			assert.True(t, math.IsNaN(value))
+		} else {
+			assert.Equal(t, e.value, value)
+		}
+	}
+
+	assert.False(t, s.Scan())
+	assert.NoError(t, s.Err())
+	assert.Equal(t, 1, s.MalformedCount)
+}
+
+func TestPickleScannerPayloadTooLarge(t *testing.T) {
+	var header [picklePayloadHeaderSize]byte
+	binary.BigEndian.PutUint32(header[:], maxPicklePayloadSize+1)
+
+	s := NewPickleScanner(bytes.NewReader(header[:]), testIOpts)
+	assert.False(t, s.Scan())
+	assert.Error(t, s.Err())
+}
+
+func TestPickleScannerTruncatedPayload(t *testing.T) {
+	var buf bytes.Buffer
+	writePicklePayload(t, &buf, []interface{}{
+		[]interface{}{"foo.bar.zed", []interface{}{int64(1), float64(1)}},
+	})
+
+	s := NewPickleScanner(bytes.NewReader(buf.Bytes()[:buf.Len()-1]), testIOpts)
+	assert.False(t, s.Scan())
+	assert.Equal(t, io.ErrUnexpectedEOF, s.Err())
+}
+
+func TestParsePickleMetricErrors(t *testing.T) {
+	for _, item := range []interface{}{
+		"foo.bar",
+		[]interface{}{"foo.bar"},
+		[]interface{}{int64(1), []interface{}{int64(1), float64(1)}},
+		[]interface{}{"", []interface{}{int64(1), float64(1)}},
+		[]interface{}{"foo.bar", []interface{}{"abc", float64(1)}},
+		[]interface{}{"foo.bar", []interface{}{int64(1), "abc"}},
+		[]interface{}{"foo.bar", []interface{}{int64(1), nil}},
+	} {
+		_, err := ParsePickleMetric(item)
+		assert.Error(t, err, "expected error for: %v", item)
+	}
+}
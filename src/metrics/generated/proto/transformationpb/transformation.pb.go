@@ -52,17 +52,26 @@ const (
 	TransformationType_UNKNOWN   TransformationType = 0
 	TransformationType_ABSOLUTE  TransformationType = 1
 	TransformationType_PERSECOND TransformationType = 2
+	TransformationType_INCREASE  TransformationType = 3
+	TransformationType_ADD       TransformationType = 4
+	TransformationType_RESET     TransformationType = 5
 )
 
 var TransformationType_name = map[int32]string{
 	0: "UNKNOWN",
 	1: "ABSOLUTE",
 	2: "PERSECOND",
+	3: "INCREASE",
+	4: "ADD",
+	5: "RESET",
 }
 var TransformationType_value = map[string]int32{
 	"UNKNOWN":   0,
 	"ABSOLUTE":  1,
 	"PERSECOND": 2,
+	"INCREASE":  3,
+	"ADD":       4,
+	"RESET":     5,
 }
 
 func (x TransformationType) String() string {
@@ -81,17 +90,18 @@ func init() {
 }
 
 var fileDescriptorTransformation = []byte{
-	// 180 bytes of a gzipped FileDescriptorProto
+	// 208 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x0a, 0x49, 0xcf, 0x2c, 0xc9,
 	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0x35, 0x4e, 0x49, 0xd2, 0xcf, 0x35, 0xd6, 0x2f,
 	0x2e, 0x4a, 0xd6, 0xcf, 0x4d, 0x2d, 0x29, 0xca, 0x4c, 0x2e, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
 	0x4a, 0x2c, 0x49, 0x4d, 0xd1, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0xd7, 0x2f, 0x29, 0x4a, 0xcc, 0x2b,
 	0x4e, 0xcb, 0x2f, 0xca, 0x4d, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x48, 0x42, 0x13, 0xd0, 0x03, 0xab,
-	0x12, 0x12, 0x40, 0x57, 0xa6, 0x65, 0xc7, 0x25, 0x14, 0x82, 0x22, 0x16, 0x52, 0x59, 0x90, 0x2a,
+	0x12, 0x12, 0x40, 0x57, 0xa6, 0x95, 0xc0, 0x25, 0x14, 0x82, 0x22, 0x16, 0x52, 0x59, 0x90, 0x2a,
 	0xc4, 0xcd, 0xc5, 0x1e, 0xea, 0xe7, 0xed, 0xe7, 0x1f, 0xee, 0x27, 0xc0, 0x20, 0xc4, 0xc3, 0xc5,
 	0xe1, 0xe8, 0x14, 0xec, 0xef, 0x13, 0x1a, 0xe2, 0x2a, 0xc0, 0x28, 0xc4, 0xcb, 0xc5, 0x19, 0xe0,
-	0x1a, 0x14, 0xec, 0xea, 0xec, 0xef, 0xe7, 0x22, 0xc0, 0xe4, 0x14, 0x78, 0xe2, 0x91, 0x1c, 0xe3,
-	0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x4f, 0xa1,
-	0xcb, 0x93, 0xd8, 0xc0, 0xe2, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xe7, 0xc2, 0x13,
-	0x03, 0x01, 0x00, 0x00,
+	0x1a, 0x14, 0xec, 0xea, 0xec, 0xef, 0xe7, 0x22, 0xc0, 0x04, 0x92, 0xf4, 0xf4, 0x73, 0x0e, 0x72,
+	0x75, 0x0c, 0x76, 0x15, 0x60, 0x16, 0x62, 0xe7, 0x62, 0x76, 0x74, 0x71, 0x11, 0x60, 0x11, 0xe2,
+	0xe4, 0x62, 0x0d, 0x72, 0x0d, 0x76, 0x0d, 0x11, 0x60, 0x75, 0x0a, 0x3c, 0xf1, 0x48, 0x8e, 0xf1,
+	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0xa7, 0xd0,
+	0x6f, 0x49, 0x6c, 0x60, 0x71, 0x63, 0xc0, 0x00, 0x71, 0x38, 0xb4, 0xa1, 0x25, 0x01, 0x00, 0x00,
 }
@@ -28,6 +28,9 @@ type Store interface {
 	// ReadRuleSet returns the persisted ruleset in kv store.
 	ReadRuleSet(nsName string) (RuleSet, error)
 
+	// ReadRuleSetVersion returns the persisted ruleset in kv store at the given version.
+	ReadRuleSetVersion(nsName string, version int) (RuleSet, error)
+
 	// WriteRuleSet saves the given ruleset to the backing store.
 	WriteRuleSet(rs MutableRuleSet) error
 
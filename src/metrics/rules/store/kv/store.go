@@ -70,10 +70,32 @@ func (s *store) ReadRuleSet(nsName string) (rules.RuleSet, error) {
 	if err != nil {
 		return nil, wrapReadError(err)
 	}
+	return newRuleSetFromValue(nsName, value)
+}
+
+func (s *store) ReadRuleSetVersion(nsName string, version int) (rules.RuleSet, error) {
+	if version <= 0 {
+		return nil, merrors.NewInvalidInputError(
+			fmt.Sprintf("invalid ruleset version %d for %s", version, nsName),
+		)
+	}
+	ruleSetKey := s.ruleSetKey(nsName)
+	values, err := s.kvStore.History(ruleSetKey, version, version+1)
+	if err != nil {
+		return nil, wrapReadError(err)
+	}
+	if len(values) == 0 {
+		return nil, merrors.NewNotFoundError(
+			fmt.Sprintf("ruleset version %d not found for %s", version, nsName),
+		)
+	}
+	return newRuleSetFromValue(nsName, values[0])
+}
 
+func newRuleSetFromValue(nsName string, value kv.Value) (rules.RuleSet, error) {
 	version := value.Version()
 	var ruleSet rulepb.RuleSet
-	if err = value.Unmarshal(&ruleSet); err != nil {
+	if err := value.Unmarshal(&ruleSet); err != nil {
 		return nil, fmt.Errorf("could not fetch RuleSet %s: %v", nsName, err.Error())
 	}
 
@@ -431,6 +431,35 @@ func TestReadRuleSetNotFound(t *testing.T) {
 	require.IsType(t, merrors.NewNotFoundError(""), err)
 }
 
+func TestReadRuleSetVersion(t *testing.T) {
+	s := testStore()
+	defer s.Close()
+
+	_, e := s.(*store).kvStore.Set(testRuleSetKey, testRuleSet)
+	require.NoError(t, e)
+	_, e = s.(*store).kvStore.Set(testRuleSetKey, &rulepb.RuleSet{Namespace: testNamespace})
+	require.NoError(t, e)
+
+	rs, err := s.ReadRuleSetVersion(testNamespace, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, rs.Version())
+	mrs, err := rs.MappingRules()
+	require.NoError(t, err)
+	require.NotEmpty(t, mrs)
+
+	rs, err = s.ReadRuleSetVersion(testNamespace, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, rs.Version())
+	mrs, err = rs.MappingRules()
+	require.NoError(t, err)
+	require.Empty(t, mrs)
+
+	_, err = s.ReadRuleSetVersion(testNamespace, 3)
+	require.IsType(t, merrors.NewNotFoundError(""), err)
+	_, err = s.ReadRuleSetVersion(testNamespace, 0)
+	require.IsType(t, merrors.NewInvalidInputError(""), err)
+}
+
 func TestReadRuleSetError(t *testing.T) {
 	s := testStore()
 	defer s.Close()
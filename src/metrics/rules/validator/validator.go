@@ -42,6 +42,7 @@ var (
 	errMoreThanOneAggregationOpInPipeline = errors.New("more than one aggregation operation in pipeline")
 	errAggregationOpNotFirstInPipeline    = errors.New("aggregation operation is not the first operation in pipeline")
 	errNoRollupOpInPipeline               = errors.New("no rollup operation in pipeline")
+	errHistogramNotLastInPipeline         = errors.New("histogram aggregation is not in the last operation in pipeline")
 )
 
 type validator struct {
@@ -307,6 +308,9 @@ func (v *validator) validatePipeline(pipeline mpipeline.Pipeline, types []metric
 			if err := v.validateAggregationOp(pipelineOp.Aggregation, types); err != nil {
 				return fmt.Errorf("invalid aggregation operation at index %d: %v", i, err)
 			}
+			if i != numPipelineOps-1 && pipelineOp.Aggregation.Type == aggregation.Histogram {
+				return errHistogramNotLastInPipeline
+			}
 		case mpipeline.TransformationOpType:
 			transformOp := pipelineOp.Transformation
 			if transformOp.Type.IsBinaryTransform() {
@@ -330,6 +334,11 @@ func (v *validator) validatePipeline(pipeline mpipeline.Pipeline, types []metric
 			if err := v.validateRollupOp(pipelineOp.Rollup, i, types, previousRollupTags); err != nil {
 				return fmt.Errorf("invalid rollup operation at index %d: %v", i, err)
 			}
+			// Histograms are flushed as multiple series and as such can only be
+			// produced by the last operation in the pipeline.
+			if i != numPipelineOps-1 && pipelineOp.Rollup.AggregationID.Contains(aggregation.Histogram) {
+				return errHistogramNotLastInPipeline
+			}
 			previousRollupTags = make(map[string]struct{}, len(pipelineOp.Rollup.Tags))
 			for _, tag := range pipelineOp.Rollup.Tags {
 				previousRollupTags[string(tag)] = struct{}{}
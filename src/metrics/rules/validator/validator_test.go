@@ -657,6 +657,80 @@ func TestValidatorValidateRollupRulePipelineAggregationOpDisallowedAggregationTy
 	require.True(t, strings.Contains(err.Error(), "invalid aggregation operation at index 0"))
 }
 
+func TestValidatorValidateRollupRulePipelineHistogramAggregationOpNotLast(t *testing.T) {
+	view := view.RuleSet{
+		RollupRules: []view.RollupRule{
+			{
+				Name:   "snapshot1",
+				Filter: testTypeTag + ":" + testTimerType,
+				Targets: []view.RollupTarget{
+					{
+						Pipeline: pipeline.NewPipeline([]pipeline.OpUnion{
+							{
+								Type:        pipeline.AggregationOpType,
+								Aggregation: pipeline.AggregationOp{Type: aggregation.Histogram},
+							},
+							{
+								Type: pipeline.RollupOpType,
+								Rollup: pipeline.RollupOp{
+									NewName:       []byte("rName1"),
+									Tags:          [][]byte{[]byte("rtagName1"), []byte("rtagName2")},
+									AggregationID: aggregation.DefaultID,
+								},
+							},
+						}),
+						StoragePolicies: testStoragePolicies(),
+					},
+				},
+			},
+		},
+	}
+	allowedAggregationTypes := aggregation.Types{aggregation.Histogram}
+	opts := testValidatorOptions().
+		SetAllowedFirstLevelAggregationTypesFor(metric.TimerType, allowedAggregationTypes)
+	validator := NewValidator(opts)
+	err := validator.ValidateSnapshot(view)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), errHistogramNotLastInPipeline.Error()))
+}
+
+func TestValidatorValidateRollupRulePipelineHistogramRollupOpNotLast(t *testing.T) {
+	view := view.RuleSet{
+		RollupRules: []view.RollupRule{
+			{
+				Name:   "snapshot1",
+				Filter: testTypeTag + ":" + testTimerType,
+				Targets: []view.RollupTarget{
+					{
+						Pipeline: pipeline.NewPipeline([]pipeline.OpUnion{
+							{
+								Type: pipeline.RollupOpType,
+								Rollup: pipeline.RollupOp{
+									NewName:       []byte("rName1"),
+									Tags:          [][]byte{[]byte("rtagName1"), []byte("rtagName2")},
+									AggregationID: aggregation.MustCompressTypes(aggregation.Histogram),
+								},
+							},
+							{
+								Type:           pipeline.TransformationOpType,
+								Transformation: pipeline.TransformationOp{Type: transformation.Absolute},
+							},
+						}),
+						StoragePolicies: testStoragePolicies(),
+					},
+				},
+			},
+		},
+	}
+	allowedAggregationTypes := aggregation.Types{aggregation.Histogram}
+	opts := testValidatorOptions().
+		SetAllowedFirstLevelAggregationTypesFor(metric.TimerType, allowedAggregationTypes)
+	validator := NewValidator(opts)
+	err := validator.ValidateSnapshot(view)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), errHistogramNotLastInPipeline.Error()))
+}
+
 func TestValidatorValidateRollupRulePipelineTransformationDerivativeOrderNotSupported(t *testing.T) {
 	view := view.RuleSet{
 		RollupRules: []view.RollupRule{
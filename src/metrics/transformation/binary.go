@@ -29,6 +29,10 @@ const (
 	nanosPerSecond = time.Second / time.Nanosecond
 )
 
+func transformPerSecond() BinaryTransform {
+	return BinaryTransformFn(perSecond)
+}
+
 // perSecond computes the derivative between consecutive datapoints, taking into
 // account the time interval between the values.
 // * It skips NaN values.
@@ -45,3 +49,25 @@ func perSecond(prev, curr Datapoint) Datapoint {
 	rate := diff * float64(nanosPerSecond) / float64(curr.TimeNanos-prev.TimeNanos)
 	return Datapoint{TimeNanos: curr.TimeNanos, Value: rate}
 }
+
+func transformIncrease() BinaryTransform {
+	return BinaryTransformFn(increase)
+}
+
+// increase computes the difference between consecutive datapoints, unlike
+// perSecond it does not account for the time interval between the values.
+// * It skips NaN values.
+// * It assumes the timestamps are monotonically increasing, otherwise an empty
+//   datapoint is returned.
+// * If the current value is less than the previous value, the counter is assumed
+//   to have been reset and the current value is returned as the increase.
+func increase(prev, curr Datapoint) Datapoint {
+	if prev.TimeNanos >= curr.TimeNanos || math.IsNaN(prev.Value) || math.IsNaN(curr.Value) {
+		return emptyDatapoint
+	}
+	diff := curr.Value - prev.Value
+	if diff < 0 {
+		diff = curr.Value
+	}
+	return Datapoint{TimeNanos: curr.TimeNanos, Value: diff}
+}
@@ -79,3 +79,49 @@ func TestPerSecond(t *testing.T) {
 		}
 	}
 }
+
+func TestIncrease(t *testing.T) {
+	inputs := []struct {
+		prev        Datapoint
+		curr        Datapoint
+		expectedNaN bool
+		expected    Datapoint
+	}{
+		{
+			prev:     Datapoint{TimeNanos: time.Unix(1230, 0).UnixNano(), Value: 25},
+			curr:     Datapoint{TimeNanos: time.Unix(1240, 0).UnixNano(), Value: 30},
+			expected: Datapoint{TimeNanos: time.Unix(1240, 0).UnixNano(), Value: 5},
+		},
+		{
+			prev:     Datapoint{TimeNanos: time.Unix(1230, 0).UnixNano(), Value: 30},
+			curr:     Datapoint{TimeNanos: time.Unix(1240, 0).UnixNano(), Value: 20},
+			expected: Datapoint{TimeNanos: time.Unix(1240, 0).UnixNano(), Value: 20},
+		},
+		{
+			prev:        Datapoint{TimeNanos: time.Unix(1230, 0).UnixNano(), Value: 25},
+			curr:        Datapoint{TimeNanos: time.Unix(1230, 0).UnixNano(), Value: 30},
+			expectedNaN: true,
+			expected:    emptyDatapoint,
+		},
+		{
+			prev:        Datapoint{TimeNanos: time.Unix(1230, 0).UnixNano(), Value: math.NaN()},
+			curr:        Datapoint{TimeNanos: time.Unix(1240, 0).UnixNano(), Value: 20},
+			expectedNaN: true,
+			expected:    emptyDatapoint,
+		},
+		{
+			prev:        Datapoint{TimeNanos: time.Unix(1230, 0).UnixNano(), Value: 20},
+			curr:        Datapoint{TimeNanos: time.Unix(1240, 0).UnixNano(), Value: math.NaN()},
+			expectedNaN: true,
+			expected:    emptyDatapoint,
+		},
+	}
+
+	for _, input := range inputs {
+		if input.expectedNaN {
+			require.True(t, increase(input.prev, input.curr).IsEmpty())
+		} else {
+			require.Equal(t, input.expected, increase(input.prev, input.curr))
+		}
+	}
+}
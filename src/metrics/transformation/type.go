@@ -35,11 +35,14 @@ const (
 	UnknownType Type = iota
 	Absolute
 	PerSecond
+	Increase
+	Add
+	Reset
 )
 
 // IsValid checks if the transformation type is valid.
 func (t Type) IsValid() bool {
-	return t.IsUnaryTransform() || t.IsBinaryTransform()
+	return t.IsUnaryTransform() || t.IsBinaryTransform() || t.IsUnaryMultiOutputTransform()
 }
 
 // IsUnaryTransform returns whether this is a unary transformation.
@@ -54,17 +57,50 @@ func (t Type) IsBinaryTransform() bool {
 	return exists
 }
 
-// UnaryTransform returns the unary transformation function associated with
+// IsUnaryMultiOutputTransform returns whether this is a unary transformation
+// that outputs multiple datapoints.
+func (t Type) IsUnaryMultiOutputTransform() bool {
+	_, exists := unaryMultiOutputTransforms[t]
+	return exists
+}
+
+// NewOp returns a new transformation operation for the transformation type.
+func (t Type) NewOp() (Op, error) {
+	switch {
+	case t.IsUnaryTransform():
+		tf, err := t.UnaryTransform()
+		if err != nil {
+			return Op{}, err
+		}
+		return Op{opType: t, unary: tf}, nil
+	case t.IsBinaryTransform():
+		tf, err := t.BinaryTransform()
+		if err != nil {
+			return Op{}, err
+		}
+		return Op{opType: t, binary: tf}, nil
+	case t.IsUnaryMultiOutputTransform():
+		tf, err := t.UnaryMultiOutputTransform()
+		if err != nil {
+			return Op{}, err
+		}
+		return Op{opType: t, unaryMulti: tf}, nil
+	default:
+		return Op{}, fmt.Errorf("unknown transformation type: %v", t)
+	}
+}
+
+// UnaryTransform returns a new unary transformation associated with
 // the transformation type if applicable, or an error otherwise.
 func (t Type) UnaryTransform() (UnaryTransform, error) {
-	tf, exists := unaryTransforms[t]
+	fn, exists := unaryTransforms[t]
 	if !exists {
 		return nil, fmt.Errorf("%v is not a unary transfomration", t)
 	}
-	return tf, nil
+	return fn(), nil
 }
 
-// MustUnaryTransform returns the unary transformation function associated with
+// MustUnaryTransform returns a new unary transformation associated with
 // the transformation type if applicable, or panics otherwise.
 func (t Type) MustUnaryTransform() UnaryTransform {
 	tf, err := t.UnaryTransform()
@@ -74,17 +110,17 @@ func (t Type) MustUnaryTransform() UnaryTransform {
 	return tf
 }
 
-// BinaryTransform returns the binary transformation function associated with
+// BinaryTransform returns a new binary transformation associated with
 // the transformation type if applicable, or an error otherwise.
 func (t Type) BinaryTransform() (BinaryTransform, error) {
-	tf, exists := binaryTransforms[t]
+	fn, exists := binaryTransforms[t]
 	if !exists {
 		return nil, fmt.Errorf("%v is not a binary transfomration", t)
 	}
-	return tf, nil
+	return fn(), nil
 }
 
-// MustBinaryTransform returns the binary transformation function associated with
+// MustBinaryTransform returns a new binary transformation associated with
 // the transformation type if applicable, or an error otherwise.
 func (t Type) MustBinaryTransform() BinaryTransform {
 	tf, err := t.BinaryTransform()
@@ -94,6 +130,26 @@ func (t Type) MustBinaryTransform() BinaryTransform {
 	return tf
 }
 
+// UnaryMultiOutputTransform returns a new unary multi-output transformation
+// associated with the transformation type if applicable, or an error otherwise.
+func (t Type) UnaryMultiOutputTransform() (UnaryMultiOutputTransform, error) {
+	fn, exists := unaryMultiOutputTransforms[t]
+	if !exists {
+		return nil, fmt.Errorf("%v is not a unary multi-output transfomration", t)
+	}
+	return fn(), nil
+}
+
+// MustUnaryMultiOutputTransform returns a new unary multi-output transformation
+// associated with the transformation type if applicable, or panics otherwise.
+func (t Type) MustUnaryMultiOutputTransform() UnaryMultiOutputTransform {
+	tf, err := t.UnaryMultiOutputTransform()
+	if err != nil {
+		panic(err)
+	}
+	return tf
+}
+
 // ToProto converts the transformation type to a protobuf message in place.
 func (t Type) ToProto(pb *transformationpb.TransformationType) error {
 	switch t {
@@ -101,6 +157,12 @@ func (t Type) ToProto(pb *transformationpb.TransformationType) error {
 		*pb = transformationpb.TransformationType_ABSOLUTE
 	case PerSecond:
 		*pb = transformationpb.TransformationType_PERSECOND
+	case Increase:
+		*pb = transformationpb.TransformationType_INCREASE
+	case Add:
+		*pb = transformationpb.TransformationType_ADD
+	case Reset:
+		*pb = transformationpb.TransformationType_RESET
 	default:
 		return fmt.Errorf("unknown transformation type: %v", t)
 	}
@@ -114,6 +176,12 @@ func (t *Type) FromProto(pb transformationpb.TransformationType) error {
 		*t = Absolute
 	case transformationpb.TransformationType_PERSECOND:
 		*t = PerSecond
+	case transformationpb.TransformationType_INCREASE:
+		*t = Increase
+	case transformationpb.TransformationType_ADD:
+		*t = Add
+	case transformationpb.TransformationType_RESET:
+		*t = Reset
 	default:
 		return fmt.Errorf("unknown transformation type in proto: %v", pb)
 	}
@@ -168,12 +236,48 @@ func ParseType(str string) (Type, error) {
 	return t, nil
 }
 
+// Op is a transformation operation created from a transformation type. Since
+// transformations may keep state across evaluations, an op should not be shared
+// between series.
+type Op struct {
+	opType     Type
+	unary      UnaryTransform
+	binary     BinaryTransform
+	unaryMulti UnaryMultiOutputTransform
+}
+
+// Type returns the transformation type of the operation.
+func (o Op) Type() Type { return o.opType }
+
+// UnaryTransform returns the unary transformation of the operation, and
+// whether the operation is a unary transformation.
+func (o Op) UnaryTransform() (UnaryTransform, bool) {
+	return o.unary, o.unary != nil
+}
+
+// BinaryTransform returns the binary transformation of the operation, and
+// whether the operation is a binary transformation.
+func (o Op) BinaryTransform() (BinaryTransform, bool) {
+	return o.binary, o.binary != nil
+}
+
+// UnaryMultiOutputTransform returns the unary multi-output transformation of
+// the operation, and whether the operation is a unary multi-output transformation.
+func (o Op) UnaryMultiOutputTransform() (UnaryMultiOutputTransform, bool) {
+	return o.unaryMulti, o.unaryMulti != nil
+}
+
 var (
-	unaryTransforms = map[Type]UnaryTransform{
-		Absolute: absolute,
+	unaryTransforms = map[Type]func() UnaryTransform{
+		Absolute: transformAbsolute,
+		Add:      transformAdd,
 	}
-	binaryTransforms = map[Type]BinaryTransform{
-		PerSecond: perSecond,
+	binaryTransforms = map[Type]func() BinaryTransform{
+		PerSecond: transformPerSecond,
+		Increase:  transformIncrease,
+	}
+	unaryMultiOutputTransforms = map[Type]func() UnaryMultiOutputTransform{
+		Reset: transformReset,
 	}
 	typeStringMap map[string]Type
 )
@@ -186,4 +290,7 @@ func init() {
 	for t := range binaryTransforms {
 		typeStringMap[t.String()] = t
 	}
+	for t := range unaryMultiOutputTransforms {
+		typeStringMap[t.String()] = t
+	}
 }
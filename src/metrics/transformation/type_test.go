@@ -41,8 +41,11 @@ func TestIsUnaryTransform(t *testing.T) {
 		expected bool
 	}{
 		{typ: Absolute, expected: true},
+		{typ: Add, expected: true},
 		{typ: UnknownType, expected: false},
 		{typ: PerSecond, expected: false},
+		{typ: Increase, expected: false},
+		{typ: Reset, expected: false},
 		{typ: Type(10000), expected: false},
 	}
 
@@ -57,8 +60,11 @@ func TestIsBinaryTransform(t *testing.T) {
 		expected bool
 	}{
 		{typ: PerSecond, expected: true},
+		{typ: Increase, expected: true},
 		{typ: UnknownType, expected: false},
 		{typ: Absolute, expected: false},
+		{typ: Add, expected: false},
+		{typ: Reset, expected: false},
 		{typ: Type(10000), expected: false},
 	}
 
@@ -67,9 +73,27 @@ func TestIsBinaryTransform(t *testing.T) {
 	}
 }
 
+func TestIsUnaryMultiOutputTransform(t *testing.T) {
+	inputs := []struct {
+		typ      Type
+		expected bool
+	}{
+		{typ: Reset, expected: true},
+		{typ: UnknownType, expected: false},
+		{typ: Absolute, expected: false},
+		{typ: PerSecond, expected: false},
+		{typ: Type(10000), expected: false},
+	}
+
+	for _, input := range inputs {
+		require.Equal(t, input.expected, input.typ.IsUnaryMultiOutputTransform())
+	}
+}
+
 func TestUnaryTransform(t *testing.T) {
 	inputs := []Type{
 		Absolute,
+		Add,
 	}
 
 	for _, input := range inputs {
@@ -96,6 +120,7 @@ func TestUnaryTransformErrors(t *testing.T) {
 func TestMustUnaryTransform(t *testing.T) {
 	inputs := []Type{
 		Absolute,
+		Add,
 	}
 
 	for _, input := range inputs {
@@ -122,6 +147,7 @@ func TestMustUnaryTransformPanics(t *testing.T) {
 func TestBinaryTransform(t *testing.T) {
 	inputs := []Type{
 		PerSecond,
+		Increase,
 	}
 
 	for _, input := range inputs {
@@ -148,6 +174,7 @@ func TestBinaryTransformErrors(t *testing.T) {
 func TestMustBinaryTransform(t *testing.T) {
 	inputs := []Type{
 		PerSecond,
+		Increase,
 	}
 
 	for _, input := range inputs {
@@ -171,6 +198,65 @@ func TestMustBinaryTransformPanics(t *testing.T) {
 	}
 }
 
+func TestUnaryMultiOutputTransform(t *testing.T) {
+	tf, err := Reset.UnaryMultiOutputTransform()
+	require.NoError(t, err)
+	require.NotNil(t, tf)
+
+	for _, input := range []Type{UnknownType, Absolute, PerSecond, Type(10000)} {
+		tf, err := input.UnaryMultiOutputTransform()
+		require.Error(t, err)
+		require.Nil(t, tf)
+	}
+}
+
+func TestMustUnaryMultiOutputTransform(t *testing.T) {
+	var tf UnaryMultiOutputTransform
+	require.NotPanics(t, func() { tf = Reset.MustUnaryMultiOutputTransform() })
+	require.NotNil(t, tf)
+
+	tf = nil
+	require.Panics(t, func() { tf = Absolute.MustUnaryMultiOutputTransform() })
+	require.Nil(t, tf)
+}
+
+func TestUnaryTransformNewInstance(t *testing.T) {
+	first := Add.MustUnaryTransform()
+	second := Add.MustUnaryTransform()
+	require.Equal(t, 3.0, first.Evaluate(Datapoint{TimeNanos: 1, Value: 3}).Value)
+	require.Equal(t, 5.0, first.Evaluate(Datapoint{TimeNanos: 2, Value: 2}).Value)
+	require.Equal(t, 2.0, second.Evaluate(Datapoint{TimeNanos: 2, Value: 2}).Value)
+}
+
+func TestTypeNewOp(t *testing.T) {
+	op, err := Absolute.NewOp()
+	require.NoError(t, err)
+	require.Equal(t, Absolute, op.Type())
+	_, ok := op.UnaryTransform()
+	require.True(t, ok)
+	_, ok = op.BinaryTransform()
+	require.False(t, ok)
+
+	op, err = Increase.NewOp()
+	require.NoError(t, err)
+	require.Equal(t, Increase, op.Type())
+	_, ok = op.BinaryTransform()
+	require.True(t, ok)
+	_, ok = op.UnaryMultiOutputTransform()
+	require.False(t, ok)
+
+	op, err = Reset.NewOp()
+	require.NoError(t, err)
+	require.Equal(t, Reset, op.Type())
+	_, ok = op.UnaryMultiOutputTransform()
+	require.True(t, ok)
+	_, ok = op.UnaryTransform()
+	require.False(t, ok)
+
+	_, err = UnknownType.NewOp()
+	require.Error(t, err)
+}
+
 func TestTypeString(t *testing.T) {
 	inputs := []struct {
 		typ      Type
@@ -179,6 +265,9 @@ func TestTypeString(t *testing.T) {
 		{typ: UnknownType, expected: "UnknownType"},
 		{typ: Absolute, expected: "Absolute"},
 		{typ: PerSecond, expected: "PerSecond"},
+		{typ: Increase, expected: "Increase"},
+		{typ: Add, expected: "Add"},
+		{typ: Reset, expected: "Reset"},
 		{typ: Type(1000), expected: "Type(1000)"},
 	}
 
@@ -218,3 +307,23 @@ func TestTypeRoundTrip(t *testing.T) {
 	require.NoError(t, res.FromProto(pb))
 	require.Equal(t, testType, res)
 }
+
+func TestTypeRoundTripAllTypes(t *testing.T) {
+	for _, typ := range []Type{Absolute, PerSecond, Increase, Add, Reset} {
+		var (
+			pb  transformationpb.TransformationType
+			res Type
+		)
+		require.NoError(t, typ.ToProto(&pb))
+		require.NoError(t, res.FromProto(pb))
+		require.Equal(t, typ, res)
+	}
+}
+
+func TestParseType(t *testing.T) {
+	for _, typ := range []Type{Absolute, PerSecond, Increase, Add, Reset} {
+		parsed, err := ParseType(typ.String())
+		require.NoError(t, err)
+		require.Equal(t, typ, parsed)
+	}
+}
@@ -22,9 +22,25 @@ package transformation
 
 import "math"
 
+func transformAbsolute() UnaryTransform {
+	return UnaryTransformFn(absolute)
+}
+
 func absolute(dp Datapoint) Datapoint {
 	var res Datapoint
 	res.TimeNanos = dp.TimeNanos
 	res.Value = math.Abs(dp.Value)
 	return res
 }
+
+// transformAdd returns a unary transform that adds each datapoint to a
+// running total and outputs the total so far. NaN values are skipped.
+func transformAdd() UnaryTransform {
+	var total float64
+	return UnaryTransformFn(func(dp Datapoint) Datapoint {
+		if !math.IsNaN(dp.Value) {
+			total += dp.Value
+		}
+		return Datapoint{TimeNanos: dp.TimeNanos, Value: total}
+	})
+}
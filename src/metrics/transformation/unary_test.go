@@ -21,6 +21,7 @@
 package transformation
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -49,3 +50,32 @@ func TestAbsolute(t *testing.T) {
 		require.Equal(t, input.expected, absolute(input.dp))
 	}
 }
+
+func TestAdd(t *testing.T) {
+	inputs := []struct {
+		dp       Datapoint
+		expected Datapoint
+	}{
+		{
+			dp:       Datapoint{TimeNanos: 10, Value: 1.5},
+			expected: Datapoint{TimeNanos: 10, Value: 1.5},
+		},
+		{
+			dp:       Datapoint{TimeNanos: 20, Value: 2},
+			expected: Datapoint{TimeNanos: 20, Value: 3.5},
+		},
+		{
+			dp:       Datapoint{TimeNanos: 30, Value: math.NaN()},
+			expected: Datapoint{TimeNanos: 30, Value: 3.5},
+		},
+		{
+			dp:       Datapoint{TimeNanos: 40, Value: -1},
+			expected: Datapoint{TimeNanos: 40, Value: 2.5},
+		},
+	}
+
+	tf := transformAdd()
+	for _, input := range inputs {
+		require.Equal(t, input.expected, tf.Evaluate(input.dp))
+	}
+}
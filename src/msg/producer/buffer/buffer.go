@@ -50,6 +50,7 @@ type bufferMetrics struct {
 	cleanupNoProgress tally.Counter
 	dropOldestSync    tally.Counter
 	dropOldestAsync   tally.Counter
+	replayWriteError  tally.Counter
 	messageBuffered   tally.Gauge
 	byteBuffered      tally.Gauge
 	bufferScanBatch   tally.Timer
@@ -66,6 +67,7 @@ func newBufferMetrics(
 		cleanupNoProgress: scope.Counter("cleanup-no-progress"),
 		dropOldestSync:    scope.Counter("drop-oldest-sync"),
 		dropOldestAsync:   scope.Counter("drop-oldest-async"),
+		replayWriteError:  scope.Counter("replay-write-error"),
 		messageBuffered:   scope.Gauge("message-buffered"),
 		byteBuffered:      scope.Gauge("byte-buffered"),
 		bufferScanBatch:   instrument.MustCreateSampledTimer(scope.Timer("buffer-scan-batch"), samplingRate),
@@ -84,6 +86,8 @@ type buffer struct {
 	maxMessageSize   int
 	onFinalizeFn     producer.OnFinalizeFn
 	retrier          retry.Retrier
+	spill            *spill
+	writeFn          producer.WriteFn
 	m                bufferMetrics
 
 	size         *atomic.Uint64
@@ -102,8 +106,19 @@ func NewBuffer(opts Options) (producer.Buffer, error) {
 	if err := opts.Validate(); err != nil {
 		return nil, err
 	}
-	maxBufferSize := uint64(opts.MaxBufferSize())
-	allowedSpillover := float64(maxBufferSize) * opts.AllowedSpilloverRatio()
+	var (
+		maxBufferSize    = uint64(opts.MaxBufferSize())
+		allowedSpillover = float64(maxBufferSize) * opts.AllowedSpilloverRatio()
+		scope            = opts.InstrumentOptions().MetricsScope()
+		s                *spill
+	)
+	if spillOpts := opts.SpillOptions(); spillOpts != nil {
+		var err error
+		s, err = newSpill(spillOpts, opts.OnFullStrategy(), scope)
+		if err != nil {
+			return nil, err
+		}
+	}
 	b := &buffer{
 		bufferList:       list.New(),
 		maxBufferSize:    maxBufferSize,
@@ -111,8 +126,9 @@ func NewBuffer(opts Options) (producer.Buffer, error) {
 		maxMessageSize:   opts.MaxMessageSize(),
 		opts:             opts,
 		retrier:          retry.NewRetrier(opts.CleanupRetryOptions()),
+		spill:            s,
 		m: newBufferMetrics(
-			opts.InstrumentOptions().MetricsScope(),
+			scope,
 			opts.InstrumentOptions().MetricsSamplingRate(),
 		),
 		size:         atomic.NewUint64(0),
@@ -137,6 +153,24 @@ func (b *buffer) Add(m producer.Message) (*producer.RefCountedMessage, error) {
 	}
 	messageSize := uint64(s)
 	newBufferSize := b.size.Add(messageSize)
+	if b.spill != nil {
+		// NB: Once the buffer is full, messages are spilled to disk until all
+		// the spilled messages have been replayed to keep the messages in order.
+		spilled, err := b.spill.Add(m, newBufferSize > b.maxBufferSize)
+		if err != nil || spilled {
+			b.size.Sub(messageSize)
+		}
+		if err != nil {
+			b.RUnlock()
+			return nil, err
+		}
+		if spilled {
+			b.RUnlock()
+			// The message is owned by the spill now.
+			m.Finalize(producer.Consumed)
+			return nil, nil
+		}
+	}
 	if newBufferSize > b.maxBufferSize {
 		if err := b.produceOnFull(newBufferSize, messageSize); err != nil {
 			b.RUnlock()
@@ -176,13 +210,22 @@ func (b *buffer) produceOnFull(newBufferSize uint64, messageSize uint64) error {
 	return nil
 }
 
-func (b *buffer) Init() {
+func (b *buffer) Init(fn producer.WriteFn) {
+	b.writeFn = fn
 	b.wg.Add(1)
 	go func() {
 		b.cleanupUntilClose()
 		b.wg.Done()
 	}()
 
+	if b.spill != nil {
+		b.wg.Add(1)
+		go func() {
+			b.replayUntilClose()
+			b.wg.Done()
+		}()
+	}
+
 	if b.opts.OnFullStrategy() != DropOldest {
 		return
 	}
@@ -251,6 +294,9 @@ func (b *buffer) cleanup() error {
 	}
 	b.m.messageBuffered.Update(float64(b.bufferLen()))
 	b.m.byteBuffered.Update(float64(b.size.Load()))
+	if b.spill != nil {
+		b.spill.updateMetrics()
+	}
 	if totalRemoved == 0 {
 		b.m.cleanupNoProgress.Inc(1)
 		return errCleanupNoProgress
@@ -295,6 +341,50 @@ func (b *buffer) cleanupBatchWithListLock(
 	return next, removed
 }
 
+func (b *buffer) replayUntilClose() {
+	ticker := time.NewTicker(b.opts.SpillOptions().ReplayInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.replay()
+		case <-b.doneCh:
+			return
+		}
+	}
+}
+
+// replay moves spilled messages back into the buffer, in the order they were
+// spilled, for as long as there is room in the buffer.
+func (b *buffer) replay() {
+	for {
+		b.RLock()
+		if b.isClosed {
+			b.RUnlock()
+			return
+		}
+		var room uint64
+		if size := b.size.Load(); size < b.maxBufferSize {
+			room = b.maxBufferSize - size
+		}
+		m, ok := b.spill.Next(room)
+		if !ok {
+			b.RUnlock()
+			return
+		}
+		b.size.Add(uint64(m.Size()))
+		rm := producer.NewRefCountedMessage(m, b.onFinalizeFn)
+		b.listLock.Lock()
+		b.bufferList.PushBack(rm)
+		b.listLock.Unlock()
+		if err := b.writeFn(rm); err != nil {
+			b.m.replayWriteError.Inc(1)
+		}
+		b.RUnlock()
+	}
+}
+
 func (b *buffer) dropOldestUntilClose() {
 	ticker := time.NewTicker(b.opts.DropOldestInterval())
 	defer ticker.Stop()
@@ -369,6 +459,11 @@ func (b *buffer) Close(ct producer.CloseType) {
 	close(b.doneCh)
 	close(b.dropOldestCh)
 	b.wg.Wait()
+	if b.spill != nil {
+		// NB: Messages spilled to disk will be replayed by the next buffer
+		// using the same spill path, unless everything should be dropped.
+		b.spill.Close(ct == producer.DropEverything)
+	}
 }
 
 func (b *buffer) waitUntilAllDataConsumed() {
@@ -21,6 +21,7 @@
 package buffer
 
 import (
+	"os"
 	"sync"
 	"testing"
 	"time"
@@ -48,6 +49,25 @@ func TestOptionsValidation(t *testing.T) {
 
 	opts = opts.SetScanBatchSize(0)
 	require.Equal(t, errInvalidScanBatchSize, opts.Validate())
+
+	opts = NewOptions().SetSpillOptions(NewSpillOptions())
+	require.Equal(t, errEmptySpillPath, opts.Validate())
+
+	spillOpts := NewSpillOptions().SetPath("/tmp")
+	opts = opts.SetSpillOptions(spillOpts)
+	require.NoError(t, opts.Validate())
+
+	opts = opts.SetSpillOptions(spillOpts.SetMaxSize(1))
+	require.Equal(t, errInvalidSpillMaxSegmentSize, opts.Validate())
+
+	opts = opts.SetSpillOptions(spillOpts.SetMaxSegmentSize(1).SetMaxSize(1))
+	require.Equal(t, errInvalidSpillMaxSize, opts.Validate())
+
+	opts = opts.SetSpillOptions(spillOpts.SetMaxSegmentSize(0))
+	require.Equal(t, errNegativeSpillMaxSegmentSize, opts.Validate())
+
+	opts = opts.SetSpillOptions(spillOpts.SetReplayInterval(0))
+	require.Equal(t, errNonPositiveSpillReplayInterval, opts.Validate())
 }
 
 func TestBuffer(t *testing.T) {
@@ -273,7 +293,7 @@ func TestBufferCleanupBackground(t *testing.T) {
 	require.Equal(t, rm.Size(), uint64(mm.Size()))
 	require.Equal(t, rm.Size(), b.size.Load())
 
-	b.Init()
+	b.Init(nil)
 	mm.EXPECT().Finalize(producer.Consumed)
 	rm.IncRef()
 	rm.DecRef()
@@ -327,7 +347,7 @@ func TestBufferCloseDropEverything(t *testing.T) {
 	require.Equal(t, rm.Size(), uint64(mm.Size()))
 	require.Equal(t, rm.Size(), b.size.Load())
 
-	b.Init()
+	b.Init(nil)
 	mm.EXPECT().Finalize(producer.Dropped)
 	b.Close(producer.DropEverything)
 	for {
@@ -383,7 +403,7 @@ func TestBufferDropOldestAsyncOnFull(t *testing.T) {
 	mm.EXPECT().Finalize(producer.Dropped).Do(func(interface{}) {
 		wg.Done()
 	}).Times(2)
-	b.Init()
+	b.Init(nil)
 	wg.Wait()
 	require.True(t, rd1.IsDroppedOrConsumed())
 	require.True(t, rd2.IsDroppedOrConsumed())
@@ -471,6 +491,122 @@ func TestBufferReturnErrorOnFull(t *testing.T) {
 	require.Equal(t, 300, int(b.size.Load()))
 }
 
+func TestBufferSpillAndReplay(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	mm1 := newMockSpillMessage(ctrl, 1, "foo")
+	mm2 := newMockSpillMessage(ctrl, 2, "bar")
+	mm3 := newMockSpillMessage(ctrl, 3, "baz")
+
+	b := mustNewBuffer(t, testOptions().
+		SetMaxMessageSize(3).
+		SetMaxBufferSize(3).
+		SetSpillOptions(testSpillOptions(dir)),
+	)
+
+	rm1, err := b.Add(mm1)
+	require.NoError(t, err)
+	require.NotNil(t, rm1)
+
+	// The buffer is full so the message is spilled to disk.
+	mm2.EXPECT().Finalize(producer.Consumed)
+	rm2, err := b.Add(mm2)
+	require.NoError(t, err)
+	require.Nil(t, rm2)
+	require.Equal(t, 3, int(b.size.Load()))
+
+	// Messages are spilled while there are spilled messages to keep the order.
+	mm3.EXPECT().Finalize(producer.Consumed)
+	rm3, err := b.Add(mm3)
+	require.NoError(t, err)
+	require.Nil(t, rm3)
+	require.Equal(t, 2, b.spill.Len())
+
+	replayedCh := make(chan *producer.RefCountedMessage, 2)
+	b.Init(func(rm *producer.RefCountedMessage) error {
+		replayedCh <- rm
+		return nil
+	})
+
+	// Consuming the message makes room to replay the next one.
+	mm1.EXPECT().Finalize(producer.Consumed)
+	rm1.IncRef()
+	rm1.DecRef()
+
+	replayed := <-replayedCh
+	require.Equal(t, uint32(2), replayed.Shard())
+	require.Equal(t, []byte("bar"), replayed.Bytes())
+	select {
+	case <-replayedCh:
+		require.FailNow(t, "unexpected replay while buffer is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	replayed.IncRef()
+	replayed.DecRef()
+	replayed = <-replayedCh
+	require.Equal(t, uint32(3), replayed.Shard())
+	require.Equal(t, []byte("baz"), replayed.Bytes())
+	require.Equal(t, 0, b.spill.Len())
+
+	replayed.IncRef()
+	replayed.DecRef()
+	b.Close(producer.WaitForConsumption)
+	require.Equal(t, 0, int(b.size.Load()))
+}
+
+func TestBufferSpillSurvivesRestart(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	mm1 := newMockSpillMessage(ctrl, 1, "foo")
+	mm2 := newMockSpillMessage(ctrl, 2, "bar")
+	opts := testOptions().
+		SetMaxMessageSize(3).
+		SetMaxBufferSize(3).
+		SetSpillOptions(testSpillOptions(dir))
+
+	b := mustNewBuffer(t, opts)
+	rm1, err := b.Add(mm1)
+	require.NoError(t, err)
+	mm2.EXPECT().Finalize(producer.Consumed)
+	_, err = b.Add(mm2)
+	require.NoError(t, err)
+
+	mm1.EXPECT().Finalize(producer.Consumed)
+	rm1.IncRef()
+	rm1.DecRef()
+	require.NoError(t, b.cleanup())
+	b.Close(producer.WaitForConsumption)
+
+	b = mustNewBuffer(t, opts)
+	require.Equal(t, 1, b.spill.Len())
+
+	replayedCh := make(chan *producer.RefCountedMessage, 1)
+	b.Init(func(rm *producer.RefCountedMessage) error {
+		replayedCh <- rm
+		return nil
+	})
+	replayed := <-replayedCh
+	require.Equal(t, uint32(2), replayed.Shard())
+	require.Equal(t, []byte("bar"), replayed.Bytes())
+
+	b.Close(producer.DropEverything)
+	require.True(t, replayed.IsDroppedOrConsumed())
+	require.Equal(t, 0, numSpillSegments(t, dir))
+}
+
 func mustNewBuffer(t testing.TB, opts Options) *buffer {
 	b, err := NewBuffer(opts)
 	require.NoError(t, err)
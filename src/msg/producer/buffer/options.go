@@ -37,6 +37,10 @@ const (
 	defaultCleanupInitialBackoff = 10 * time.Second
 	defaultAllowedSpilloverRatio = 0.2
 	defaultCleanupMaxBackoff     = time.Minute
+
+	defaultSpillMaxSegmentSize = 64 * 1024 * 1024   // 64MB.
+	defaultSpillMaxSize        = 1024 * 1024 * 1024 // 1GB.
+	defaultSpillReplayInterval = 100 * time.Millisecond
 )
 
 var (
@@ -44,6 +48,12 @@ var (
 	errInvalidMaxMessageSize  = errors.New("invalid max message size")
 	errNegativeMaxBufferSize  = errors.New("negative max buffer size")
 	errNegativeMaxMessageSize = errors.New("negative max message size")
+	errInvalidSpillMaxSize    = errors.New("invalid spill max size")
+
+	errEmptySpillPath                 = errors.New("empty spill path")
+	errNegativeSpillMaxSegmentSize    = errors.New("negative spill max segment size")
+	errInvalidSpillMaxSegmentSize     = errors.New("invalid spill max segment size")
+	errNonPositiveSpillReplayInterval = errors.New("non-positive spill replay interval")
 )
 
 type bufferOptions struct {
@@ -55,6 +65,7 @@ type bufferOptions struct {
 	scanBatchSize         int
 	allowedSpilloverRatio float64
 	rOpts                 retry.Options
+	spillOpts             SpillOptions
 	iOpts                 instrument.Options
 }
 
@@ -156,6 +167,16 @@ func (opts *bufferOptions) SetCleanupRetryOptions(value retry.Options) Options {
 	return &o
 }
 
+func (opts *bufferOptions) SpillOptions() SpillOptions {
+	return opts.spillOpts
+}
+
+func (opts *bufferOptions) SetSpillOptions(value SpillOptions) Options {
+	o := *opts
+	o.spillOpts = value
+	return &o
+}
+
 func (opts *bufferOptions) InstrumentOptions() instrument.Options {
 	return opts.iOpts
 }
@@ -180,5 +201,88 @@ func (opts *bufferOptions) Validate() error {
 		// Max message size can only be as large as max buffer size.
 		return errInvalidMaxMessageSize
 	}
+	if opts.SpillOptions() == nil {
+		return nil
+	}
+	if err := opts.SpillOptions().Validate(); err != nil {
+		return err
+	}
+	if opts.MaxMessageSize()+spillRecordHeaderSize > opts.SpillOptions().MaxSize() {
+		// Any message must fit in the spill.
+		return errInvalidSpillMaxSize
+	}
+	return nil
+}
+
+type spillOptions struct {
+	path           string
+	maxSegmentSize int
+	maxSize        int
+	replayInterval time.Duration
+}
+
+// NewSpillOptions creates SpillOptions.
+func NewSpillOptions() SpillOptions {
+	return &spillOptions{
+		maxSegmentSize: defaultSpillMaxSegmentSize,
+		maxSize:        defaultSpillMaxSize,
+		replayInterval: defaultSpillReplayInterval,
+	}
+}
+
+func (opts *spillOptions) Path() string {
+	return opts.path
+}
+
+func (opts *spillOptions) SetPath(value string) SpillOptions {
+	o := *opts
+	o.path = value
+	return &o
+}
+
+func (opts *spillOptions) MaxSegmentSize() int {
+	return opts.maxSegmentSize
+}
+
+func (opts *spillOptions) SetMaxSegmentSize(value int) SpillOptions {
+	o := *opts
+	o.maxSegmentSize = value
+	return &o
+}
+
+func (opts *spillOptions) MaxSize() int {
+	return opts.maxSize
+}
+
+func (opts *spillOptions) SetMaxSize(value int) SpillOptions {
+	o := *opts
+	o.maxSize = value
+	return &o
+}
+
+func (opts *spillOptions) ReplayInterval() time.Duration {
+	return opts.replayInterval
+}
+
+func (opts *spillOptions) SetReplayInterval(value time.Duration) SpillOptions {
+	o := *opts
+	o.replayInterval = value
+	return &o
+}
+
+func (opts *spillOptions) Validate() error {
+	if opts.Path() == "" {
+		return errEmptySpillPath
+	}
+	if opts.MaxSegmentSize() <= 0 {
+		return errNegativeSpillMaxSegmentSize
+	}
+	if opts.MaxSegmentSize() > opts.MaxSize() {
+		// Max segment size can only be as large as max size.
+		return errInvalidSpillMaxSegmentSize
+	}
+	if opts.ReplayInterval() <= 0 {
+		return errNonPositiveSpillReplayInterval
+	}
 	return nil
 }
@@ -0,0 +1,531 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/msg/producer"
+
+	"github.com/uber-go/tally"
+)
+
+const (
+	spillSegmentPrefix = "spill-"
+	spillSegmentSuffix = ".log"
+
+	// spillRecordHeaderSize is the size of the header preceding the bytes of
+	// each spilled message: spilled at (8), shard (4), length (4), checksum (4).
+	spillRecordHeaderSize = 20
+
+	spillFileMode = 0666
+	spillDirMode  = 0755
+)
+
+var (
+	errSpillClosed  = errors.New("spill closed")
+	errSpillCorrupt = errors.New("spill record corrupt")
+)
+
+type spillMetrics struct {
+	messageSpilled  tally.Counter
+	byteSpilled     tally.Counter
+	messageReplayed tally.Counter
+	messageDropped  tally.Counter
+	byteDropped     tally.Counter
+	corrupted       tally.Counter
+	writeError      tally.Counter
+	readError       tally.Counter
+	messageBuffered tally.Gauge
+	byteBuffered    tally.Gauge
+	diskBytes       tally.Gauge
+	replayLag       tally.Gauge
+}
+
+func newSpillMetrics(scope tally.Scope) spillMetrics {
+	return spillMetrics{
+		messageSpilled:  scope.Counter("spill-message-spilled"),
+		byteSpilled:     scope.Counter("spill-byte-spilled"),
+		messageReplayed: scope.Counter("spill-message-replayed"),
+		messageDropped:  scope.Counter("spill-message-dropped"),
+		byteDropped:     scope.Counter("spill-byte-dropped"),
+		corrupted:       scope.Counter("spill-corrupted"),
+		writeError:      scope.Counter("spill-write-error"),
+		readError:       scope.Counter("spill-read-error"),
+		messageBuffered: scope.Gauge("spill-message-buffered"),
+		byteBuffered:    scope.Gauge("spill-byte-buffered"),
+		diskBytes:       scope.Gauge("spill-disk-bytes"),
+		replayLag:       scope.Gauge("spill-replay-lag-seconds"),
+	}
+}
+
+// spilledMessage is a message replayed from disk.
+type spilledMessage struct {
+	shard     uint32
+	bytes     []byte
+	spilledAt time.Time
+}
+
+func (m *spilledMessage) Shard() uint32                    { return m.shard }
+func (m *spilledMessage) Bytes() []byte                    { return m.bytes }
+func (m *spilledMessage) Size() int                        { return len(m.bytes) }
+func (m *spilledMessage) Finalize(producer.FinalizeReason) {}
+
+type spillSegment struct {
+	seq  uint64
+	path string
+	// size is the number of bytes of valid records in the segment.
+	size int64
+	// numUnread is the number of messages in the segment not yet replayed.
+	numUnread int
+}
+
+// spill is an append only log of messages which did not fit in the buffer,
+// stored as a sequence of segment files on disk. Messages are replayed in the
+// order they were spilled and segments are removed once fully replayed, the
+// read position within a segment is not persisted so messages may be replayed
+// more than once across restarts.
+type spill struct {
+	sync.Mutex
+
+	opts           SpillOptions
+	strategy       OnFullStrategy
+	maxSegmentSize int64
+	maxSize        int64
+	m              spillMetrics
+
+	segments   []*spillSegment
+	writeFile  *os.File
+	writeSeg   *spillSegment
+	readFile   *os.File
+	readSeg    *spillSegment
+	readOffset int64
+	pending    *spilledMessage
+	pendingLen int64
+
+	numUnread   int
+	bytesUnread int64
+	diskSize    int64
+	closed      bool
+}
+
+func newSpill(
+	opts SpillOptions,
+	strategy OnFullStrategy,
+	scope tally.Scope,
+) (*spill, error) {
+	s := &spill{
+		opts:           opts,
+		strategy:       strategy,
+		maxSegmentSize: int64(opts.MaxSegmentSize()),
+		maxSize:        int64(opts.MaxSize()),
+		m:              newSpillMetrics(scope),
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// open loads the segments left on disk by a previous producer, new messages
+// are always appended to a new segment.
+func (s *spill) open() error {
+	if err := os.MkdirAll(s.opts.Path(), spillDirMode); err != nil {
+		return err
+	}
+	files, err := ioutil.ReadDir(s.opts.Path())
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		seq, ok := parseSpillSegmentName(f.Name())
+		if !ok || f.IsDir() {
+			continue
+		}
+		seg := &spillSegment{
+			seq:  seq,
+			path: filepath.Join(s.opts.Path(), f.Name()),
+		}
+		if err := s.scanSegment(seg); err != nil {
+			return err
+		}
+		s.segments = append(s.segments, seg)
+		s.numUnread += seg.numUnread
+		s.bytesUnread += seg.size
+		s.diskSize += seg.size
+	}
+	sort.Slice(s.segments, func(i, j int) bool {
+		return s.segments[i].seq < s.segments[j].seq
+	})
+	s.updateMetrics()
+	return nil
+}
+
+// scanSegment counts the valid records of the segment, a segment may end
+// with a partially written record if the producer did not shut down cleanly.
+func (s *spill) scanSegment(seg *spillSegment) error {
+	f, err := os.Open(seg.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	for {
+		_, n, err := readSpillRecord(f, seg.size, info.Size())
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			s.m.corrupted.Inc(1)
+			return nil
+		}
+		seg.size += n
+		seg.numUnread++
+	}
+}
+
+// Add appends the message to the spill if force is set or if there are
+// messages in the spill which have not been replayed yet, so that messages
+// are always replayed in order. Returns whether the message was spilled.
+func (s *spill) Add(m producer.Message, force bool) (bool, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed {
+		return false, errSpillClosed
+	}
+	if !force && s.numUnread == 0 {
+		return false, nil
+	}
+
+	record := encodeSpillRecord(m, time.Now())
+	recordLen := int64(len(record))
+	if err := s.makeRoomWithLock(recordLen); err != nil {
+		return false, err
+	}
+	if err := s.appendWithLock(record); err != nil {
+		s.m.writeError.Inc(1)
+		return false, err
+	}
+	s.numUnread++
+	s.bytesUnread += recordLen
+	s.diskSize += recordLen
+	s.m.messageSpilled.Inc(1)
+	s.m.byteSpilled.Inc(int64(m.Size()))
+	return true, nil
+}
+
+func (s *spill) makeRoomWithLock(recordLen int64) error {
+	for s.diskSize+recordLen > s.maxSize {
+		if s.strategy != DropOldest || len(s.segments) == 0 {
+			return errBufferFull
+		}
+		if err := s.dropOldestSegmentWithLock(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *spill) dropOldestSegmentWithLock() error {
+	seg := s.segments[0]
+	if seg == s.writeSeg {
+		if err := s.closeWriteWithLock(); err != nil {
+			return err
+		}
+	}
+	bytesUnread := seg.size
+	if seg == s.readSeg {
+		bytesUnread -= s.readOffset
+		s.closeReadWithLock()
+	}
+	s.segments = s.segments[1:]
+	s.numUnread -= seg.numUnread
+	s.bytesUnread -= bytesUnread
+	s.diskSize -= seg.size
+	s.m.messageDropped.Inc(int64(seg.numUnread))
+	s.m.byteDropped.Inc(bytesUnread)
+	return os.Remove(seg.path)
+}
+
+func (s *spill) appendWithLock(record []byte) error {
+	recordLen := int64(len(record))
+	if s.writeSeg != nil && s.writeSeg.size > 0 &&
+		s.writeSeg.size+recordLen > s.maxSegmentSize {
+		if err := s.closeWriteWithLock(); err != nil {
+			return err
+		}
+	}
+	if s.writeSeg == nil {
+		if err := s.newWriteSegmentWithLock(); err != nil {
+			return err
+		}
+	}
+	if _, err := s.writeFile.Write(record); err != nil {
+		return err
+	}
+	s.writeSeg.size += recordLen
+	s.writeSeg.numUnread++
+	return nil
+}
+
+func (s *spill) newWriteSegmentWithLock() error {
+	var seq uint64
+	if len(s.segments) > 0 {
+		seq = s.segments[len(s.segments)-1].seq + 1
+	}
+	seg := &spillSegment{
+		seq:  seq,
+		path: filepath.Join(s.opts.Path(), spillSegmentName(seq)),
+	}
+	f, err := os.OpenFile(seg.path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, spillFileMode)
+	if err != nil {
+		return err
+	}
+	s.segments = append(s.segments, seg)
+	s.writeSeg = seg
+	s.writeFile = f
+	return nil
+}
+
+func (s *spill) closeWriteWithLock() error {
+	if s.writeFile == nil {
+		return nil
+	}
+	err := s.writeFile.Sync()
+	if closeErr := s.writeFile.Close(); err == nil {
+		err = closeErr
+	}
+	s.writeFile = nil
+	s.writeSeg = nil
+	return err
+}
+
+// Next returns the next message to replay if it fits in the given number of
+// bytes, the message is removed from the spill.
+func (s *spill) Next(maxSize uint64) (*spilledMessage, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed {
+		return nil, false
+	}
+	if s.pending == nil && !s.readNextWithLock() {
+		return nil, false
+	}
+	if uint64(s.pending.Size()) > maxSize {
+		return nil, false
+	}
+
+	m := s.pending
+	s.pending = nil
+	s.readOffset += s.pendingLen
+	s.readSeg.numUnread--
+	s.numUnread--
+	s.bytesUnread -= s.pendingLen
+	s.m.messageReplayed.Inc(1)
+	return m, true
+}
+
+// readNextWithLock reads the next unread message into pending, removing the
+// segments which have been fully replayed.
+func (s *spill) readNextWithLock() bool {
+	for len(s.segments) > 0 {
+		seg := s.segments[0]
+		if s.readSeg != seg {
+			s.closeReadWithLock()
+			f, err := os.Open(seg.path)
+			if err != nil {
+				s.m.readError.Inc(1)
+				return false
+			}
+			s.readFile = f
+			s.readSeg = seg
+			s.readOffset = 0
+		}
+
+		if s.readOffset < seg.size {
+			m, n, err := readSpillRecord(s.readFile, s.readOffset, seg.size)
+			if err == nil {
+				s.pending = m
+				s.pendingLen = n
+				return true
+			}
+			// Skip the rest of a corrupt segment.
+			s.m.corrupted.Inc(1)
+			s.m.messageDropped.Inc(int64(seg.numUnread))
+			s.numUnread -= seg.numUnread
+			s.bytesUnread -= seg.size - s.readOffset
+			seg.numUnread = 0
+			s.readOffset = seg.size
+		}
+
+		if seg == s.writeSeg {
+			// Fully replayed, but still being written to.
+			return false
+		}
+		s.closeReadWithLock()
+		s.segments = s.segments[1:]
+		s.diskSize -= seg.size
+		if err := os.Remove(seg.path); err != nil {
+			s.m.writeError.Inc(1)
+		}
+	}
+	return false
+}
+
+func (s *spill) closeReadWithLock() {
+	if s.readFile != nil {
+		s.readFile.Close()
+	}
+	s.readFile = nil
+	s.readSeg = nil
+	s.readOffset = 0
+	s.pending = nil
+	s.pendingLen = 0
+}
+
+// Len returns the number of messages which have not been replayed yet.
+func (s *spill) Len() int {
+	s.Lock()
+	n := s.numUnread
+	s.Unlock()
+	return n
+}
+
+func (s *spill) updateMetrics() {
+	s.Lock()
+	defer s.Unlock()
+
+	s.m.messageBuffered.Update(float64(s.numUnread))
+	s.m.byteBuffered.Update(float64(s.bytesUnread))
+	s.m.diskBytes.Update(float64(s.diskSize))
+	// NB: The replay lag is the age of the oldest message not replayed yet.
+	var lag time.Duration
+	if !s.closed && (s.pending != nil || s.readNextWithLock()) {
+		lag = time.Since(s.pending.spilledAt)
+	}
+	s.m.replayLag.Update(lag.Seconds())
+}
+
+// Close closes the spill, the messages not replayed yet are kept on disk
+// unless drop is set.
+func (s *spill) Close(drop bool) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	s.closeReadWithLock()
+	err := s.closeWriteWithLock()
+	if !drop {
+		return err
+	}
+	for _, seg := range s.segments {
+		if removeErr := os.Remove(seg.path); removeErr != nil && err == nil {
+			err = removeErr
+		}
+	}
+	s.m.messageDropped.Inc(int64(s.numUnread))
+	s.m.byteDropped.Inc(s.bytesUnread)
+	s.segments = nil
+	s.numUnread = 0
+	s.bytesUnread = 0
+	s.diskSize = 0
+	return err
+}
+
+func encodeSpillRecord(m producer.Message, spilledAt time.Time) []byte {
+	b := m.Bytes()
+	record := make([]byte, spillRecordHeaderSize+len(b))
+	binary.BigEndian.PutUint64(record[0:8], uint64(spilledAt.UnixNano()))
+	binary.BigEndian.PutUint32(record[8:12], m.Shard())
+	binary.BigEndian.PutUint32(record[12:16], uint32(len(b)))
+	binary.BigEndian.PutUint32(record[16:20], crc32.ChecksumIEEE(b))
+	copy(record[spillRecordHeaderSize:], b)
+	return record
+}
+
+// readSpillRecord reads the record at the given offset, which must end before
+// the given size, and returns the message along with the size of the record.
+func readSpillRecord(
+	r io.ReaderAt,
+	offset int64,
+	size int64,
+) (*spilledMessage, int64, error) {
+	if offset >= size {
+		return nil, 0, io.EOF
+	}
+	var header [spillRecordHeaderSize]byte
+	if n, _ := r.ReadAt(header[:], offset); n < spillRecordHeaderSize {
+		return nil, 0, errSpillCorrupt
+	}
+	length := binary.BigEndian.Uint32(header[12:16])
+	if offset+spillRecordHeaderSize+int64(length) > size {
+		return nil, 0, errSpillCorrupt
+	}
+	b := make([]byte, length)
+	if n, _ := r.ReadAt(b, offset+spillRecordHeaderSize); n < len(b) {
+		return nil, 0, errSpillCorrupt
+	}
+	if crc32.ChecksumIEEE(b) != binary.BigEndian.Uint32(header[16:20]) {
+		return nil, 0, errSpillCorrupt
+	}
+	return &spilledMessage{
+		shard:     binary.BigEndian.Uint32(header[8:12]),
+		bytes:     b,
+		spilledAt: time.Unix(0, int64(binary.BigEndian.Uint64(header[0:8]))),
+	}, int64(spillRecordHeaderSize + length), nil
+}
+
+func spillSegmentName(seq uint64) string {
+	return fmt.Sprintf("%s%020d%s", spillSegmentPrefix, seq, spillSegmentSuffix)
+}
+
+func parseSpillSegmentName(name string) (uint64, bool) {
+	if !strings.HasPrefix(name, spillSegmentPrefix) ||
+		!strings.HasSuffix(name, spillSegmentSuffix) {
+		return 0, false
+	}
+	var seq uint64
+	_, err := fmt.Sscanf(
+		strings.TrimSuffix(strings.TrimPrefix(name, spillSegmentPrefix), spillSegmentSuffix),
+		"%d", &seq,
+	)
+	return seq, err == nil
+}
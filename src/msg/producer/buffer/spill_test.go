@@ -0,0 +1,259 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package buffer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/msg/producer"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func TestSpillAddAndNext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	s := mustNewSpill(t, testSpillOptions(dir), DropOldest)
+	defer s.Close(true)
+
+	mm1 := newMockSpillMessage(ctrl, 1, "foo")
+	mm2 := newMockSpillMessage(ctrl, 2, "barbaz")
+
+	// Nothing is spilled unless forced while the spill is empty.
+	spilled, err := s.Add(mm1, false)
+	require.NoError(t, err)
+	require.False(t, spilled)
+	require.Equal(t, 0, s.Len())
+
+	spilled, err = s.Add(mm1, true)
+	require.NoError(t, err)
+	require.True(t, spilled)
+
+	// Messages are always spilled while the spill is not empty.
+	spilled, err = s.Add(mm2, false)
+	require.NoError(t, err)
+	require.True(t, spilled)
+	require.Equal(t, 2, s.Len())
+
+	_, ok := s.Next(2)
+	require.False(t, ok)
+
+	m, ok := s.Next(3)
+	require.True(t, ok)
+	require.Equal(t, uint32(1), m.Shard())
+	require.Equal(t, []byte("foo"), m.Bytes())
+	require.Equal(t, 1, s.Len())
+
+	m, ok = s.Next(100)
+	require.True(t, ok)
+	require.Equal(t, uint32(2), m.Shard())
+	require.Equal(t, []byte("barbaz"), m.Bytes())
+	require.Equal(t, 0, s.Len())
+
+	_, ok = s.Next(100)
+	require.False(t, ok)
+}
+
+func TestSpillSegmentsRemovedOnceReplayed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	// Every record is written to its own segment.
+	opts := testSpillOptions(dir).SetMaxSegmentSize(spillRecordHeaderSize + 3)
+	s := mustNewSpill(t, opts, DropOldest)
+	defer s.Close(true)
+
+	mm := newMockSpillMessage(ctrl, 1, "foo")
+	for i := 0; i < 3; i++ {
+		_, err := s.Add(mm, true)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, numSpillSegments(t, dir))
+
+	for i := 0; i < 3; i++ {
+		_, ok := s.Next(100)
+		require.True(t, ok)
+	}
+	_, ok := s.Next(100)
+	require.False(t, ok)
+
+	// Only the segment being written to is kept.
+	require.Equal(t, 1, numSpillSegments(t, dir))
+}
+
+func TestSpillReopen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	s := mustNewSpill(t, testSpillOptions(dir), DropOldest)
+	for i, b := range []string{"a", "b", "c"} {
+		_, err := s.Add(newMockSpillMessage(ctrl, uint32(i), b), true)
+		require.NoError(t, err)
+	}
+	m, ok := s.Next(100)
+	require.True(t, ok)
+	require.Equal(t, []byte("a"), m.Bytes())
+	require.NoError(t, s.Close(false))
+
+	// The read position is not persisted so replayed messages in a segment
+	// which was not fully replayed are replayed again.
+	s = mustNewSpill(t, testSpillOptions(dir), DropOldest)
+	defer s.Close(true)
+	require.Equal(t, 3, s.Len())
+
+	_, err := s.Add(newMockSpillMessage(ctrl, 3, "d"), false)
+	require.NoError(t, err)
+	require.Equal(t, 2, numSpillSegments(t, dir))
+
+	for _, b := range []string{"a", "b", "c", "d"} {
+		m, ok := s.Next(100)
+		require.True(t, ok)
+		require.Equal(t, []byte(b), m.Bytes())
+	}
+	require.Equal(t, 0, s.Len())
+}
+
+func TestSpillReopenWithCorruptTail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	s := mustNewSpill(t, testSpillOptions(dir), DropOldest)
+	for _, b := range []string{"a", "b"} {
+		_, err := s.Add(newMockSpillMessage(ctrl, 0, b), true)
+		require.NoError(t, err)
+	}
+	require.NoError(t, s.Close(false))
+
+	// Partially written record.
+	f, err := os.OpenFile(
+		filepath.Join(dir, spillSegmentName(0)), os.O_APPEND|os.O_WRONLY, 0,
+	)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	s = mustNewSpill(t, testSpillOptions(dir), DropOldest)
+	defer s.Close(true)
+	require.Equal(t, 2, s.Len())
+
+	for _, b := range []string{"a", "b"} {
+		m, ok := s.Next(100)
+		require.True(t, ok)
+		require.Equal(t, []byte(b), m.Bytes())
+	}
+	_, ok := s.Next(100)
+	require.False(t, ok)
+	require.Equal(t, 0, numSpillSegments(t, dir))
+}
+
+func TestSpillFull(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := mustTempDir(t)
+	defer os.RemoveAll(dir)
+
+	recordSize := spillRecordHeaderSize + 3
+	opts := testSpillOptions(dir).
+		SetMaxSegmentSize(recordSize).
+		SetMaxSize(2 * recordSize)
+	mm := newMockSpillMessage(ctrl, 0, "foo")
+
+	s := mustNewSpill(t, opts, ReturnError)
+	for i := 0; i < 2; i++ {
+		_, err := s.Add(mm, true)
+		require.NoError(t, err)
+	}
+	_, err := s.Add(mm, true)
+	require.Equal(t, errBufferFull, err)
+	require.Equal(t, 2, s.Len())
+	require.NoError(t, s.Close(true))
+	require.Equal(t, 0, numSpillSegments(t, dir))
+
+	s = mustNewSpill(t, opts, DropOldest)
+	defer s.Close(true)
+	for _, b := range []string{"a", "b", "c"} {
+		_, err := s.Add(newMockSpillMessage(ctrl, 0, b+"12"), true)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, s.Len())
+	require.Equal(t, 2, numSpillSegments(t, dir))
+
+	m, ok := s.Next(100)
+	require.True(t, ok)
+	require.Equal(t, []byte("b12"), m.Bytes())
+}
+
+func mustTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "buffer-spill-test")
+	require.NoError(t, err)
+	return dir
+}
+
+func mustNewSpill(t *testing.T, opts SpillOptions, strategy OnFullStrategy) *spill {
+	s, err := newSpill(opts, strategy, tally.NoopScope)
+	require.NoError(t, err)
+	return s
+}
+
+func numSpillSegments(t *testing.T, dir string) int {
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	return len(files)
+}
+
+func newMockSpillMessage(
+	ctrl *gomock.Controller,
+	shard uint32,
+	b string,
+) *producer.MockMessage {
+	mm := producer.NewMockMessage(ctrl)
+	mm.EXPECT().Shard().Return(shard).AnyTimes()
+	mm.EXPECT().Bytes().Return([]byte(b)).AnyTimes()
+	mm.EXPECT().Size().Return(len(b)).AnyTimes()
+	return mm
+}
+
+func testSpillOptions(dir string) SpillOptions {
+	return NewSpillOptions().
+		SetPath(dir).
+		SetReplayInterval(10 * time.Millisecond)
+}
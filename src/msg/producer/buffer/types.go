@@ -96,6 +96,13 @@ type Options interface {
 	// SetCleanupRetryOptions sets the cleanup retry options.
 	SetCleanupRetryOptions(value retry.Options) Options
 
+	// SpillOptions returns the spill options, the buffer spills messages to
+	// disk when it is full only if the spill options are set.
+	SpillOptions() SpillOptions
+
+	// SetSpillOptions sets the spill options.
+	SetSpillOptions(value SpillOptions) Options
+
 	// InstrumentOptions returns the instrument options.
 	InstrumentOptions() instrument.Options
 
@@ -105,3 +112,35 @@ type Options interface {
 	// Validate validates the options.
 	Validate() error
 }
+
+// SpillOptions configs the spilling of buffered messages to disk.
+type SpillOptions interface {
+	// Path returns the directory the spilled messages are stored in.
+	Path() string
+
+	// SetPath sets the directory the spilled messages are stored in.
+	SetPath(value string) SpillOptions
+
+	// MaxSegmentSize returns the max size of a single segment file.
+	MaxSegmentSize() int
+
+	// SetMaxSegmentSize sets the max size of a single segment file.
+	SetMaxSegmentSize(value int) SpillOptions
+
+	// MaxSize returns the max total size of the segment files on disk.
+	MaxSize() int
+
+	// SetMaxSize sets the max total size of the segment files on disk.
+	SetMaxSize(value int) SpillOptions
+
+	// ReplayInterval returns the interval to replay spilled messages into the
+	// buffer.
+	ReplayInterval() time.Duration
+
+	// SetReplayInterval sets the interval to replay spilled messages into the
+	// buffer.
+	SetReplayInterval(value time.Duration) SpillOptions
+
+	// Validate validates the options.
+	Validate() error
+}
@@ -38,6 +38,7 @@ type BufferConfiguration struct {
 	ScanBatchSize         *int                   `yaml:"scanBatchSize"`
 	AllowedSpilloverRatio *float64               `yaml:"allowedSpilloverRatio"`
 	CleanupRetry          *retry.Configuration   `yaml:"cleanupRetry"`
+	Spill                 *SpillConfiguration    `yaml:"spill"`
 }
 
 // NewOptions creates new buffer options.
@@ -67,5 +68,31 @@ func (c *BufferConfiguration) NewOptions(iOpts instrument.Options) buffer.Option
 	if c.CleanupRetry != nil {
 		opts = opts.SetCleanupRetryOptions(c.CleanupRetry.NewOptions(iOpts.MetricsScope()))
 	}
+	if c.Spill != nil {
+		opts = opts.SetSpillOptions(c.Spill.NewOptions())
+	}
 	return opts.SetInstrumentOptions(iOpts)
 }
+
+// SpillConfiguration configs the spilling of buffered messages to disk.
+type SpillConfiguration struct {
+	Path           string         `yaml:"path" validate:"nonzero"`
+	MaxSegmentSize *int           `yaml:"maxSegmentSize"`
+	MaxSize        *int           `yaml:"maxSize"`
+	ReplayInterval *time.Duration `yaml:"replayInterval"`
+}
+
+// NewOptions creates new spill options.
+func (c *SpillConfiguration) NewOptions() buffer.SpillOptions {
+	opts := buffer.NewSpillOptions().SetPath(c.Path)
+	if c.MaxSegmentSize != nil {
+		opts = opts.SetMaxSegmentSize(*c.MaxSegmentSize)
+	}
+	if c.MaxSize != nil {
+		opts = opts.SetMaxSize(*c.MaxSize)
+	}
+	if c.ReplayInterval != nil {
+		opts = opts.SetReplayInterval(*c.ReplayInterval)
+	}
+	return opts
+}
@@ -43,6 +43,11 @@ dropOldestInterval: 500ms
 allowedSpilloverRatio: 0.1
 cleanupRetry:
   initialBackoff: 2s
+spill:
+  path: /var/lib/m3/spill
+  maxSegmentSize: 1024
+  maxSize: 4096
+  replayInterval: 200ms
 `
 
 	var cfg BufferConfiguration
@@ -57,6 +62,13 @@ cleanupRetry:
 	require.Equal(t, 500*time.Millisecond, bOpts.DropOldestInterval())
 	require.Equal(t, 0.1, bOpts.AllowedSpilloverRatio())
 	require.Equal(t, 2*time.Second, bOpts.CleanupRetryOptions().InitialBackoff())
+
+	sOpts := bOpts.SpillOptions()
+	require.NotNil(t, sOpts)
+	require.Equal(t, "/var/lib/m3/spill", sOpts.Path())
+	require.Equal(t, 1024, sOpts.MaxSegmentSize())
+	require.Equal(t, 4096, sOpts.MaxSize())
+	require.Equal(t, 200*time.Millisecond, sOpts.ReplayInterval())
 }
 
 func TestEmptyBufferConfiguration(t *testing.T) {
@@ -34,8 +34,13 @@ func NewProducer(opts Options) Producer {
 }
 
 func (p *producer) Init() error {
-	p.Buffer.Init()
-	return p.Writer.Init()
+	// NB: Must init writer first so messages replayed by the buffer can
+	// be written to the consumer services.
+	if err := p.Writer.Init(); err != nil {
+		return err
+	}
+	p.Buffer.Init(p.Writer.Write)
+	return nil
 }
 
 func (p *producer) Produce(m Message) error {
@@ -43,6 +48,10 @@ func (p *producer) Produce(m Message) error {
 	if err != nil {
 		return err
 	}
+	if rm == nil {
+		// The message was spilled to disk and will be written on replay.
+		return nil
+	}
 	return p.Writer.Write(rm)
 }
 
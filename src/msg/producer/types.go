@@ -85,6 +85,9 @@ type Producer interface {
 	Close(ct CloseType)
 }
 
+// WriteFn writes a reference counted message.
+type WriteFn func(rm *RefCountedMessage) error
+
 // FilterFunc can filter message.
 type FilterFunc func(m Message) bool
 
@@ -106,10 +109,13 @@ type Options interface {
 // Buffer buffers all the messages in the producer.
 type Buffer interface {
 	// Add adds message to the buffer and returns a reference counted message.
+	// If the buffer spilled the message to disk, a nil reference counted message
+	// is returned and the message will be written by the buffer once replayed.
 	Add(m Message) (*RefCountedMessage, error)
 
-	// Init initializes the buffer.
-	Init()
+	// Init initializes the buffer, messages replayed from disk will be written
+	// with the given write function.
+	Init(fn WriteFn)
 
 	// Close stops the buffer from accepting new requests immediately.
 	// If the CloseType is WaitForConsumption, then it will block until all the messages have been consumed.
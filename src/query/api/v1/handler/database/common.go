@@ -68,4 +68,14 @@ func RegisterRoutes(
 	r.HandleFunc(ConfigSetBootstrappersURL, wrapped(
 		NewConfigSetBootstrappersHandler(client)).ServeHTTP).
 		Methods(ConfigSetBootstrappersHTTPMethod)
+
+	r.HandleFunc(RuntimeGetURL, wrapped(
+		NewRuntimeGetHandler(client)).ServeHTTP).
+		Methods(RuntimeGetHTTPMethod)
+	r.HandleFunc(RuntimeSetURL, wrapped(
+		NewRuntimeSetHandler(client)).ServeHTTP).
+		Methods(RuntimeSetHTTPMethod)
+	r.HandleFunc(RuntimeDeleteURL, wrapped(
+		NewRuntimeDeleteHandler(client)).ServeHTTP).
+		Methods(RuntimeDeleteHTTPMethod)
 }
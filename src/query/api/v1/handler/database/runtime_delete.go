@@ -0,0 +1,82 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"encoding/json"
+	"net/http"
+
+	clusterclient "github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/query/util/logging"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// RuntimeDeleteURL is the url for the database runtime options delete handler.
+	RuntimeDeleteURL = RuntimeGetURL
+
+	// RuntimeDeleteHTTPMethod is the HTTP method used with this resource.
+	RuntimeDeleteHTTPMethod = http.MethodDelete
+)
+
+type runtimeDeleteHandler struct {
+	client clusterclient.Client
+}
+
+// NewRuntimeDeleteHandler returns a new instance of a database runtime options
+// delete handler, which reverts every node to the runtime options in its configuration.
+func NewRuntimeDeleteHandler(
+	client clusterclient.Client,
+) http.Handler {
+	return &runtimeDeleteHandler{
+		client: client,
+	}
+}
+
+func (h *runtimeDeleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx)
+
+	store, err := h.client.KV()
+	if err != nil {
+		logger.Error("unable to get kv store", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	for _, key := range runtimeOptionKeys {
+		if _, err := store.Delete(key); err != nil && err != kv.ErrNotFound {
+			logger.Error("unable to delete kv key",
+				zap.String("key", key), zap.Any("error", err))
+			xhttp.Error(w, err, http.StatusInternalServerError)
+			return
+		}
+	}
+
+	json.NewEncoder(w).Encode(struct {
+		Deleted bool `json:"deleted"`
+	}{
+		Deleted: true,
+	})
+}
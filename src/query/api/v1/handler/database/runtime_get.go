@@ -0,0 +1,140 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"net/http"
+
+	clusterclient "github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/dbnode/kvconfig"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/query/util/logging"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+const (
+	// RuntimeGetURL is the url for the database runtime options get handler.
+	RuntimeGetURL = handler.RoutePrefixV1 + "/services/" + handler.M3DBServiceName + "/runtime"
+
+	// RuntimeGetHTTPMethod is the HTTP method used with this resource.
+	RuntimeGetHTTPMethod = http.MethodGet
+)
+
+// runtimeOptionKeys are the KV keys of all runtime options that
+// can be set through the runtime handlers.
+var runtimeOptionKeys = []string{
+	kvconfig.PersistRateLimitEnabledKey,
+	kvconfig.PersistRateLimitMbpsKey,
+	kvconfig.ClusterNewSeriesInsertLimitKey,
+	kvconfig.WriteNewSeriesAsyncKey,
+}
+
+type runtimeGetHandler struct {
+	client clusterclient.Client
+}
+
+// NewRuntimeGetHandler returns a new instance of a database runtime options get handler.
+func NewRuntimeGetHandler(
+	client clusterclient.Client,
+) http.Handler {
+	return &runtimeGetHandler{
+		client: client,
+	}
+}
+
+func (h *runtimeGetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx)
+
+	store, err := h.client.KV()
+	if err != nil {
+		logger.Error("unable to get kv store", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	opts, err := getRuntimeOptions(store)
+	if err != nil {
+		logger.Error("unable to get runtime options", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	xhttp.WriteProtoMsgJSONResponse(w, opts, logger)
+}
+
+// getRuntimeOptions returns the runtime options currently set in KV, options
+// without a value set are left nil as each node falls back to its configuration.
+func getRuntimeOptions(store kv.Store) (*admin.DatabaseRuntimeOptions, error) {
+	var (
+		opts                    = &admin.DatabaseRuntimeOptions{}
+		persistRateLimitEnabled = &commonpb.BoolProto{}
+		persistRateLimitMbps    = &commonpb.Float64Proto{}
+		clusterNewSeriesLimit   = &commonpb.Int64Proto{}
+		writeNewSeriesAsync     = &commonpb.BoolProto{}
+	)
+	for _, option := range []struct {
+		key   string
+		value proto.Message
+		apply func()
+	}{
+		{
+			key:   kvconfig.PersistRateLimitEnabledKey,
+			value: persistRateLimitEnabled,
+			apply: func() { opts.PersistRateLimitEnabled = persistRateLimitEnabled },
+		},
+		{
+			key:   kvconfig.PersistRateLimitMbpsKey,
+			value: persistRateLimitMbps,
+			apply: func() { opts.PersistRateLimitMbps = persistRateLimitMbps },
+		},
+		{
+			key:   kvconfig.ClusterNewSeriesInsertLimitKey,
+			value: clusterNewSeriesLimit,
+			apply: func() { opts.ClusterNewSeriesInsertLimit = clusterNewSeriesLimit },
+		},
+		{
+			key:   kvconfig.WriteNewSeriesAsyncKey,
+			value: writeNewSeriesAsync,
+			apply: func() { opts.WriteNewSeriesAsync = writeNewSeriesAsync },
+		},
+	} {
+		value, err := store.Get(option.key)
+		if err == kv.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := value.Unmarshal(option.value); err != nil {
+			return nil, err
+		}
+		option.apply()
+	}
+
+	return opts, nil
+}
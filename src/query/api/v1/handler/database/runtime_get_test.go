@@ -0,0 +1,135 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/dbnode/kvconfig"
+	xtest "github.com/m3db/m3/src/x/test"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeGetHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, mockStore, _ := SetupDatabaseTest(t, ctrl)
+	handler := NewRuntimeGetHandler(mockClient)
+	w := httptest.NewRecorder()
+
+	mbpsValue := kv.NewMockValue(ctrl)
+	mbpsValue.EXPECT().
+		Unmarshal(gomock.Any()).
+		Return(nil).
+		Do(func(v proto.Message) {
+			value, ok := v.(*commonpb.Float64Proto)
+			require.True(t, ok)
+			value.Value = 32
+		})
+	asyncValue := kv.NewMockValue(ctrl)
+	asyncValue.EXPECT().
+		Unmarshal(gomock.Any()).
+		Return(nil).
+		Do(func(v proto.Message) {
+			value, ok := v.(*commonpb.BoolProto)
+			require.True(t, ok)
+			value.Value = true
+		})
+
+	mockStore.EXPECT().
+		Get(kvconfig.PersistRateLimitEnabledKey).
+		Return(nil, kv.ErrNotFound)
+	mockStore.EXPECT().
+		Get(kvconfig.PersistRateLimitMbpsKey).
+		Return(mbpsValue, nil)
+	mockStore.EXPECT().
+		Get(kvconfig.ClusterNewSeriesInsertLimitKey).
+		Return(nil, kv.ErrNotFound)
+	mockStore.EXPECT().
+		Get(kvconfig.WriteNewSeriesAsyncKey).
+		Return(asyncValue, nil)
+
+	req := httptest.NewRequest("GET", "/services/m3db/runtime", nil)
+	require.NotNil(t, req)
+
+	handler.ServeHTTP(w, req)
+
+	resp := w.Result()
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	expectedResponse := `
+	{
+		"persistRateLimitEnabled": null,
+		"persistRateLimitMbps": {"value": 32},
+		"clusterNewSeriesInsertLimit": null,
+		"writeNewSeriesAsync": {"value": true}
+	}
+	`
+	assert.Equal(t, stripAllWhitespace(expectedResponse), string(body),
+		xtest.Diff(mustPrettyJSON(t, expectedResponse), mustPrettyJSON(t, string(body))))
+}
+
+func TestRuntimeGetHandlerNoneSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, mockStore, _ := SetupDatabaseTest(t, ctrl)
+	handler := NewRuntimeGetHandler(mockClient)
+	w := httptest.NewRecorder()
+
+	mockStore.EXPECT().
+		Get(gomock.Any()).
+		Return(nil, kv.ErrNotFound).
+		Times(len(runtimeOptionKeys))
+
+	req := httptest.NewRequest("GET", "/services/m3db/runtime", nil)
+	require.NotNil(t, req)
+
+	handler.ServeHTTP(w, req)
+
+	resp := w.Result()
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	expectedResponse := `
+	{
+		"persistRateLimitEnabled": null,
+		"persistRateLimitMbps": null,
+		"clusterNewSeriesInsertLimit": null,
+		"writeNewSeriesAsync": null
+	}
+	`
+	assert.Equal(t, stripAllWhitespace(expectedResponse), string(body),
+		xtest.Diff(mustPrettyJSON(t, expectedResponse), mustPrettyJSON(t, string(body))))
+}
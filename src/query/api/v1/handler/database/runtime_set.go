@@ -0,0 +1,150 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	clusterclient "github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/dbnode/kvconfig"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/query/util/logging"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"go.uber.org/zap"
+)
+
+const (
+	// RuntimeSetURL is the url for the database runtime options set handler.
+	RuntimeSetURL = RuntimeGetURL
+
+	// RuntimeSetHTTPMethod is the HTTP method used with this resource.
+	RuntimeSetHTTPMethod = http.MethodPost
+)
+
+var (
+	errNoRuntimeOptions = errors.New("no runtime options set")
+)
+
+type runtimeSetHandler struct {
+	client clusterclient.Client
+}
+
+// NewRuntimeSetHandler returns a new instance of a database runtime options set handler.
+func NewRuntimeSetHandler(
+	client clusterclient.Client,
+) http.Handler {
+	return &runtimeSetHandler{
+		client: client,
+	}
+}
+
+func (h *runtimeSetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx)
+
+	opts, rErr := h.parseRequest(r)
+	if rErr != nil {
+		logger.Error("unable to parse request", zap.Any("error", rErr))
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	store, err := h.client.KV()
+	if err != nil {
+		logger.Error("unable to get kv store", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	if err := setRuntimeOptions(store, opts); err != nil {
+		logger.Error("unable to set kv key", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	xhttp.WriteProtoMsgJSONResponse(w, opts, logger)
+}
+
+func (h *runtimeSetHandler) parseRequest(
+	r *http.Request,
+) (*admin.DatabaseRuntimeOptions, *xhttp.ParseError) {
+	opts := new(admin.DatabaseRuntimeOptions)
+
+	defer r.Body.Close()
+
+	if err := jsonpb.Unmarshal(r.Body, opts); err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	if err := validateRuntimeOptions(opts); err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	return opts, nil
+}
+
+func validateRuntimeOptions(opts *admin.DatabaseRuntimeOptions) error {
+	if opts.PersistRateLimitEnabled == nil &&
+		opts.PersistRateLimitMbps == nil &&
+		opts.ClusterNewSeriesInsertLimit == nil &&
+		opts.WriteNewSeriesAsync == nil {
+		return errNoRuntimeOptions
+	}
+
+	if v := opts.PersistRateLimitMbps; v != nil && v.Value <= 0 {
+		return fmt.Errorf("persist rate limit must be positive: %v", v.Value)
+	}
+
+	if v := opts.ClusterNewSeriesInsertLimit; v != nil && v.Value < 0 {
+		return fmt.Errorf("cluster new series insert limit must not be negative: %v", v.Value)
+	}
+
+	return nil
+}
+
+func setRuntimeOptions(store kv.Store, opts *admin.DatabaseRuntimeOptions) error {
+	if v := opts.PersistRateLimitEnabled; v != nil {
+		if _, err := store.Set(kvconfig.PersistRateLimitEnabledKey, v); err != nil {
+			return err
+		}
+	}
+	if v := opts.PersistRateLimitMbps; v != nil {
+		if _, err := store.Set(kvconfig.PersistRateLimitMbpsKey, v); err != nil {
+			return err
+		}
+	}
+	if v := opts.ClusterNewSeriesInsertLimit; v != nil {
+		if _, err := store.Set(kvconfig.ClusterNewSeriesInsertLimitKey, v); err != nil {
+			return err
+		}
+	}
+	if v := opts.WriteNewSeriesAsync; v != nil {
+		if _, err := store.Set(kvconfig.WriteNewSeriesAsyncKey, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
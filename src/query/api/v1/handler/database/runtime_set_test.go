@@ -0,0 +1,118 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package database
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/dbnode/kvconfig"
+	xtest "github.com/m3db/m3/src/x/test"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeSetHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, mockStore, _ := SetupDatabaseTest(t, ctrl)
+	handler := NewRuntimeSetHandler(mockClient)
+	w := httptest.NewRecorder()
+
+	jsonInput := `
+		{
+			"persistRateLimitEnabled": {"value": true},
+			"persistRateLimitMbps": {"value": 16},
+			"clusterNewSeriesInsertLimit": {"value": "10000"}
+		}
+	`
+
+	mockStore.EXPECT().
+		Set(kvconfig.PersistRateLimitEnabledKey, gomock.Any()).
+		Return(int(1), nil).
+		Do(func(key string, value *commonpb.BoolProto) {
+			assert.True(t, value.Value)
+		})
+	mockStore.EXPECT().
+		Set(kvconfig.PersistRateLimitMbpsKey, gomock.Any()).
+		Return(int(1), nil).
+		Do(func(key string, value *commonpb.Float64Proto) {
+			assert.Equal(t, float64(16), value.Value)
+		})
+	mockStore.EXPECT().
+		Set(kvconfig.ClusterNewSeriesInsertLimitKey, gomock.Any()).
+		Return(int(1), nil).
+		Do(func(key string, value *commonpb.Int64Proto) {
+			assert.Equal(t, int64(10000), value.Value)
+		})
+
+	req := httptest.NewRequest("POST", "/services/m3db/runtime", strings.NewReader(jsonInput))
+	require.NotNil(t, req)
+
+	handler.ServeHTTP(w, req)
+
+	resp := w.Result()
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	expectedResponse := `
+	{
+		"persistRateLimitEnabled": {"value": true},
+		"persistRateLimitMbps": {"value": 16},
+		"clusterNewSeriesInsertLimit": {"value": "10000"},
+		"writeNewSeriesAsync": null
+	}
+	`
+	assert.Equal(t, stripAllWhitespace(expectedResponse), string(body),
+		xtest.Diff(mustPrettyJSON(t, expectedResponse), mustPrettyJSON(t, string(body))))
+}
+
+func TestRuntimeSetHandlerInvalidRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient, _, _ := SetupDatabaseTest(t, ctrl)
+	handler := NewRuntimeSetHandler(mockClient)
+
+	for _, jsonInput := range []string{
+		`{}`,
+		`{"persistRateLimitMbps": {"value": 0}}`,
+		`{"clusterNewSeriesInsertLimit": {"value": "-1"}}`,
+		`{"foo": {"value": true}}`,
+	} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/services/m3db/runtime", strings.NewReader(jsonInput))
+		require.NotNil(t, req)
+
+		handler.ServeHTTP(w, req)
+
+		resp := w.Result()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, jsonInput)
+	}
+}
@@ -24,12 +24,14 @@ import (
 	"bytes"
 	"context"
 	"net/http"
+	"sync"
 
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/util/logging"
 	"github.com/m3db/m3/src/x/net/http"
+	xerrors "github.com/m3db/m3x/errors"
 
 	"go.uber.org/zap"
 )
@@ -64,52 +66,77 @@ func (h *grahiteFindHandler) ServeHTTP(
 	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
 	logger := logging.WithContext(ctx)
 	w.Header().Set("Content-Type", "application/json")
-	query, rErr := parseFindParamsToQuery(r)
+	leavesQuery, branchesQuery, raw, rErr := parseFindParamsToQueries(r)
 	if rErr != nil {
 		xhttp.Error(w, rErr.Inner(), rErr.Code())
 		return
 	}
 
-	opts := storage.NewFetchOptions()
-	result, err := h.storage.FetchTags(ctx, query, opts)
-	if err != nil {
+	var (
+		opts = storage.NewFetchOptions()
+
+		leavesResult, branchesResult *storage.CompleteTagsResult
+		leavesErr, branchesErr       error
+		wg                           sync.WaitGroup
+	)
+
+	// NB: the leaf and branch nodes are completed from the index using the tag
+	// aggregation of the last metric part, which avoids fetching the IDs of
+	// every matching series.
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		leavesResult, leavesErr = h.storage.CompleteTags(ctx, leavesQuery, opts)
+	}()
+
+	go func() {
+		defer wg.Done()
+		branchesResult, branchesErr = h.storage.CompleteTags(ctx, branchesQuery, opts)
+	}()
+
+	wg.Wait()
+	if err := xerrors.FirstError(leavesErr, branchesErr); err != nil {
 		logger.Error("unable to complete tags", zap.Error(err))
 		xhttp.Error(w, err, http.StatusBadRequest)
 		return
 	}
 
-	partCount := graphite.CountMetricParts(query.Raw)
-	partName := graphite.TagName(partCount - 1)
-	seenMap := make(map[string]bool, len(result.Metrics))
-	for _, m := range result.Metrics {
-		tags := m.Tags.Tags
-		index := 0
-		// TODO: make this more performant by computing the index for the tag name.
-		for i, tag := range tags {
-			if bytes.Equal(partName, tag.Name) {
-				index = i
-				break
-			}
-		}
-
-		value := tags[index].Value
-		// If this value has already been encountered, check if
-		if hadExtra, seen := seenMap[string(value)]; seen && hadExtra {
-			continue
-		}
+	// NB: a value which has children is always returned as a branch node.
+	partName := leavesQuery.FilterNameTags[0]
+	seenMap := make(map[string]bool)
+	for _, value := range completedTagValues(leavesResult, partName) {
+		seenMap[string(value)] = false
+	}
 
-		hasExtraParts := len(tags) > partCount
-		seenMap[string(value)] = hasExtraParts
+	for _, value := range completedTagValues(branchesResult, partName) {
+		seenMap[string(value)] = true
 	}
 
-	prefix := graphite.DropLastMetricPart(query.Raw)
+	prefix := graphite.DropLastMetricPart(raw)
 	if len(prefix) > 0 {
 		prefix += "."
 	}
 
 	// TODO: Support multiple result types
-	if err = findResultsJSON(w, prefix, seenMap); err != nil {
+	if err := findResultsJSON(w, prefix, seenMap); err != nil {
 		logger.Error("unable to print find results", zap.Error(err))
 		xhttp.Error(w, err, http.StatusBadRequest)
 	}
 }
+
+func completedTagValues(
+	result *storage.CompleteTagsResult,
+	name []byte,
+) [][]byte {
+	if result == nil {
+		return nil
+	}
+
+	for _, tag := range result.CompletedTags {
+		if bytes.Equal(name, tag.Name) {
+			return tag.Values
+		}
+	}
+
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/m3db/m3/src/query/errors"
@@ -34,8 +35,13 @@ import (
 	"github.com/m3db/m3/src/x/net/http"
 )
 
-func parseFindParamsToQuery(r *http.Request) (
-	*storage.FetchQuery,
+// parseFindParamsToQueries parses the find request into the queries which
+// complete the leaf and branch nodes matching the find query, along with the
+// raw find query.
+func parseFindParamsToQueries(r *http.Request) (
+	*storage.CompleteTagsQuery,
+	*storage.CompleteTagsQuery,
+	string,
 	*xhttp.ParseError,
 ) {
 	values := r.URL.Query()
@@ -56,7 +62,7 @@ func parseFindParamsToQuery(r *http.Request) (
 	)
 
 	if err != nil {
-		return nil, xhttp.NewParseError(fmt.Errorf("invalid 'from': %s", fromString),
+		return nil, nil, "", xhttp.NewParseError(fmt.Errorf("invalid 'from': %s", fromString),
 			http.StatusBadRequest)
 	}
 
@@ -67,23 +73,38 @@ func parseFindParamsToQuery(r *http.Request) (
 	)
 
 	if err != nil {
-		return nil, xhttp.NewParseError(fmt.Errorf("invalid 'until': %s", untilString),
+		return nil, nil, "", xhttp.NewParseError(fmt.Errorf("invalid 'until': %s", untilString),
 			http.StatusBadRequest)
 	}
 
 	query := values.Get("query")
 	if query == "" {
-		return nil, xhttp.NewParseError(errors.ErrNoQueryFound, http.StatusBadRequest)
+		return nil, nil, "", xhttp.NewParseError(errors.ErrNoQueryFound, http.StatusBadRequest)
 	}
 
-	matchers := graphiteStorage.TranslateQueryToMatchers(query)
-	return &storage.FetchQuery{
-		Raw:         query,
-		TagMatchers: matchers,
-		Start:       from,
-		End:         until,
-		Interval:    0,
-	}, nil
+	// NB: only the values of the last metric part are completed, filtered by
+	// the preceding metric parts.
+	var (
+		partCount        = graphite.CountMetricParts(query)
+		filter           = [][]byte{graphite.TagName(partCount - 1)}
+		leaves, branches = graphiteStorage.TranslateFindQueryToMatchers(query)
+	)
+
+	leavesQuery := &storage.CompleteTagsQuery{
+		FilterNameTags: filter,
+		TagMatchers:    leaves,
+		Start:          from,
+		End:            until,
+	}
+
+	branchesQuery := &storage.CompleteTagsQuery{
+		FilterNameTags: filter,
+		TagMatchers:    branches,
+		Start:          from,
+		End:            until,
+	}
+
+	return leavesQuery, branchesQuery, query, nil
 }
 
 func findResultsJSON(
@@ -91,12 +112,18 @@ func findResultsJSON(
 	prefix string,
 	tags map[string]bool,
 ) error {
+	values := make([]string, 0, len(tags))
+	for value := range tags {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
 	jw := json.NewWriter(w)
 	jw.BeginArray()
 
-	for value, hasChildren := range tags {
+	for _, value := range values {
 		leaf := 1
-		if hasChildren {
+		if tags[value] {
 			leaf = 0
 		}
 		jw.BeginObject()
@@ -0,0 +1,152 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/mock"
+	"github.com/m3db/m3/src/query/util/logging"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// completeTagsStorage returns the leaf or branch values for the queried metric
+// part depending on whether the query is terminated or expects children.
+type completeTagsStorage struct {
+	mock.Storage
+
+	t        *testing.T
+	leaves   [][]byte
+	branches [][]byte
+	err      error
+}
+
+func (s *completeTagsStorage) CompleteTags(
+	ctx context.Context,
+	query *storage.CompleteTagsQuery,
+	_ *storage.FetchOptions,
+) (*storage.CompleteTagsResult, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	require.Equal(s.t, 1, len(query.FilterNameTags))
+	require.False(s.t, query.CompleteNameOnly)
+
+	last := query.TagMatchers[len(query.TagMatchers)-1]
+	values := s.branches
+	if last.Type == models.MatchNotRegexp {
+		values = s.leaves
+	}
+
+	return &storage.CompleteTagsResult{
+		CompletedTags: []storage.CompletedTag{
+			{Name: query.FilterNameTags[0], Values: values},
+		},
+	}, nil
+}
+
+func newGraphiteFindHTTPRequest(t *testing.T, query string) *http.Request {
+	req, err := http.NewRequest(FindHTTPMethods[0], FindURL, nil)
+	require.NoError(t, err)
+	req.URL.RawQuery = query
+	return req
+}
+
+func TestFindNoQuery(t *testing.T) {
+	handler := NewFindHandler(&completeTagsStorage{t: t})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newGraphiteFindHTTPRequest(t, ""))
+
+	res := recorder.Result()
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestFindParseQueries(t *testing.T) {
+	req := newGraphiteFindHTTPRequest(t, "query=foo.b*&from=-2h&until=now")
+	leaves, branches, raw, err := parseFindParamsToQueries(req)
+	require.Nil(t, err)
+	require.Equal(t, "foo.b*", raw)
+
+	filter := [][]byte{graphite.TagName(1)}
+	assert.Equal(t, filter, leaves.FilterNameTags)
+	assert.Equal(t, filter, branches.FilterNameTags)
+
+	require.Equal(t, 3, len(leaves.TagMatchers))
+	assert.Equal(t, models.MatchNotRegexp, leaves.TagMatchers[2].Type)
+	assert.Equal(t, graphite.TagName(2), leaves.TagMatchers[2].Name)
+	require.Equal(t, 3, len(branches.TagMatchers))
+	assert.Equal(t, models.MatchRegexp, branches.TagMatchers[2].Type)
+	assert.Equal(t, graphite.TagName(2), branches.TagMatchers[2].Name)
+
+	assert.True(t, leaves.Start.Before(leaves.End))
+	assert.Equal(t, leaves.Start, branches.Start)
+	assert.Equal(t, leaves.End, branches.End)
+}
+
+func TestFindResults(t *testing.T) {
+	store := &completeTagsStorage{
+		t:        t,
+		leaves:   [][]byte{[]byte("bar"), []byte("baz")},
+		branches: [][]byte{[]byte("baz"), []byte("qux")},
+	}
+	handler := NewFindHandler(store)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newGraphiteFindHTTPRequest(t, "query=foo.*"))
+
+	res := recorder.Result()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	buf, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	expected := `[` +
+		`{"id":"foo.bar","text":"bar","leaf":1,"expandable":0,"allowChildren":0},` +
+		`{"id":"foo.baz","text":"baz","leaf":0,"expandable":1,"allowChildren":1},` +
+		`{"id":"foo.qux","text":"qux","leaf":0,"expandable":1,"allowChildren":1}` +
+		`]`
+	assert.Equal(t, expected, string(buf))
+}
+
+func TestFindStorageError(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	store := &completeTagsStorage{t: t, err: errors.New("storage error")}
+	handler := NewFindHandler(store)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newGraphiteFindHTTPRequest(t, "query=foo.*"))
+
+	res := recorder.Result()
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
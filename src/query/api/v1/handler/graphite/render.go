@@ -29,6 +29,7 @@ import (
 	"sync"
 
 	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/graphite/common"
 	"github.com/m3db/m3/src/query/graphite/errors"
 	"github.com/m3db/m3/src/query/graphite/native"
@@ -51,7 +52,8 @@ var (
 // A renderHandler implements the graphite /render endpoint, including full
 // support for executing functions. It only works against data in M3.
 type renderHandler struct {
-	engine *native.Engine
+	engine         *native.Engine
+	globalEnforcer cost.GlobalEnforcer
 }
 
 type respError struct {
@@ -59,13 +61,20 @@ type respError struct {
 	code int
 }
 
-// NewRenderHandler returns a new render handler around the given storage.
+// NewRenderHandler returns a new render handler around the given storage,
+// enforcing the resource limits of each request with the global enforcer.
 func NewRenderHandler(
 	storage storage.Storage,
+	globalEnforcer cost.GlobalEnforcer,
 ) http.Handler {
+	if globalEnforcer == nil {
+		globalEnforcer = cost.NoopGlobalEnforcer()
+	}
+
 	wrappedStore := graphite.NewM3WrappedStorage(storage)
 	return &renderHandler{
-		engine: native.NewEngine(wrappedStore),
+		engine:         native.NewEngine(wrappedStore),
+		globalEnforcer: globalEnforcer,
 	}
 }
 
@@ -94,6 +103,10 @@ func (h *renderHandler) serveHTTP(
 		return respError{err: err, code: http.StatusBadRequest}
 	}
 
+	enforcer := h.globalEnforcer.QueryEnforcer()
+	defer enforcer.Close()
+	reqCtx = cost.NewContext(reqCtx, enforcer)
+
 	var (
 		results = make([]ts.SeriesList, len(p.Targets))
 		errorCh = make(chan error, 1)
@@ -39,7 +39,7 @@ import (
 
 func TestParseNoQuery(t *testing.T) {
 	mockStorage := mock.NewMockStorage()
-	handler := NewRenderHandler(mockStorage)
+	handler := NewRenderHandler(mockStorage, nil)
 
 	recorder := httptest.NewRecorder()
 	handler.ServeHTTP(recorder, newGraphiteReadHTTPRequest(t))
@@ -51,7 +51,7 @@ func TestParseNoQuery(t *testing.T) {
 func TestParseQueryNoResults(t *testing.T) {
 	mockStorage := mock.NewMockStorage()
 	mockStorage.SetFetchResult(&storage.FetchResult{}, nil)
-	handler := NewRenderHandler(mockStorage)
+	handler := NewRenderHandler(mockStorage, nil)
 
 	req := newGraphiteReadHTTPRequest(t)
 	req.URL.RawQuery = "target=foo.bar&from=-2h&until=now"
@@ -82,7 +82,7 @@ func TestParseQueryResults(t *testing.T) {
 	}
 
 	mockStorage.SetFetchResult(&storage.FetchResult{SeriesList: seriesList}, nil)
-	handler := NewRenderHandler(mockStorage)
+	handler := NewRenderHandler(mockStorage, nil)
 
 	req := newGraphiteReadHTTPRequest(t)
 	req.URL.RawQuery = fmt.Sprintf("target=foo.bar&from=%d&until=%d",
@@ -123,7 +123,7 @@ func TestParseQueryResultsMaxDatapoints(t *testing.T) {
 	}
 
 	mockStorage.SetFetchResult(&storage.FetchResult{SeriesList: seriesList}, nil)
-	handler := NewRenderHandler(mockStorage)
+	handler := NewRenderHandler(mockStorage, nil)
 
 	req := newGraphiteReadHTTPRequest(t)
 	req.URL.RawQuery = "target=foo.bar&from=" + startStr + "&until=" + endStr + "&maxDataPoints=1"
@@ -158,7 +158,7 @@ func TestParseQueryResultsMultiTarget(t *testing.T) {
 	}
 
 	mockStorage.SetFetchResult(&storage.FetchResult{SeriesList: seriesList}, nil)
-	handler := NewRenderHandler(mockStorage)
+	handler := NewRenderHandler(mockStorage, nil)
 
 	req := newGraphiteReadHTTPRequest(t)
 	req.URL.RawQuery = fmt.Sprintf("target=foo.bar&target=baz.qux&from=%d&until=%d",
@@ -0,0 +1,202 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// TagsURL is the url for listing graphite tags.
+	TagsURL = handler.RoutePrefixV1 + "/graphite/tags"
+
+	// TagsAutoCompleteTagsURL is the url for auto completing graphite tags.
+	TagsAutoCompleteTagsURL = TagsURL + "/autoComplete/tags"
+
+	// TagsAutoCompleteValuesURL is the url for auto completing graphite tag
+	// values.
+	TagsAutoCompleteValuesURL = TagsURL + "/autoComplete/values"
+)
+
+var (
+	// TagsHTTPMethods is the HTTP methods used with the tags resources.
+	TagsHTTPMethods = []string{http.MethodGet, http.MethodPost}
+)
+
+type graphiteTagsHandler struct {
+	storage storage.Storage
+}
+
+// NewTagsHandler returns a new instance of handler listing graphite tags.
+func NewTagsHandler(
+	storage storage.Storage,
+) http.Handler {
+	return &graphiteTagsHandler{
+		storage: storage,
+	}
+}
+
+func (h *graphiteTagsHandler) ServeHTTP(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx)
+	w.Header().Set("Content-Type", "application/json")
+	query, filter, rErr := parseTagsParamsToQuery(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	opts := storage.NewFetchOptions()
+	result, err := h.storage.CompleteTags(ctx, query, opts)
+	if err != nil {
+		logger.Error("unable to complete tags", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	names := completedTagNames(result, func(name string) bool {
+		return filter == nil || filter.MatchString(name)
+	})
+
+	if err := tagsResultsJSON(w, names); err != nil {
+		logger.Error("unable to print tags results", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+	}
+}
+
+type graphiteAutoCompleteTagsHandler struct {
+	storage storage.Storage
+}
+
+// NewAutoCompleteTagsHandler returns a new instance of handler auto completing
+// graphite tags.
+func NewAutoCompleteTagsHandler(
+	storage storage.Storage,
+) http.Handler {
+	return &graphiteAutoCompleteTagsHandler{
+		storage: storage,
+	}
+}
+
+func (h *graphiteAutoCompleteTagsHandler) ServeHTTP(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx)
+	w.Header().Set("Content-Type", "application/json")
+	query, tagPrefix, limit, rErr := parseAutoCompleteTagsParamsToQuery(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	opts := storage.NewFetchOptions()
+	result, err := h.storage.CompleteTags(ctx, query, opts)
+	if err != nil {
+		logger.Error("unable to complete tags", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	// NB: tags which are already constrained by the given tag expressions are
+	// not completed, matching graphite.
+	constrained := make(map[string]struct{}, len(query.TagMatchers))
+	if len(r.Form["expr"]) > 0 {
+		for _, matcher := range query.TagMatchers {
+			constrained[string(matcher.Name)] = struct{}{}
+		}
+	}
+
+	names := completedTagNames(result, func(name string) bool {
+		_, isConstrained := constrained[name]
+		return !isConstrained && strings.HasPrefix(name, tagPrefix)
+	})
+
+	if err := autoCompleteResultsJSON(w, names, limit); err != nil {
+		logger.Error("unable to print tags auto complete results", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+	}
+}
+
+type graphiteAutoCompleteValuesHandler struct {
+	storage storage.Storage
+}
+
+// NewAutoCompleteValuesHandler returns a new instance of handler auto
+// completing graphite tag values.
+func NewAutoCompleteValuesHandler(
+	storage storage.Storage,
+) http.Handler {
+	return &graphiteAutoCompleteValuesHandler{
+		storage: storage,
+	}
+}
+
+func (h *graphiteAutoCompleteValuesHandler) ServeHTTP(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
+	logger := logging.WithContext(ctx)
+	w.Header().Set("Content-Type", "application/json")
+	query, valuePrefix, limit, rErr := parseAutoCompleteValuesParamsToQuery(r)
+	if rErr != nil {
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	opts := storage.NewFetchOptions()
+	result, err := h.storage.CompleteTags(ctx, query, opts)
+	if err != nil {
+		logger.Error("unable to complete tag values", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	prefix := []byte(valuePrefix)
+	values := make([]string, 0, limit)
+	for _, value := range completedTagValues(result, query.FilterNameTags[0]) {
+		if bytes.HasPrefix(value, prefix) {
+			values = append(values, string(value))
+		}
+	}
+
+	sort.Strings(values)
+	if err := autoCompleteResultsJSON(w, values, limit); err != nil {
+		logger.Error("unable to print tag values auto complete results", zap.Error(err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	graphiteStorage "github.com/m3db/m3/src/query/graphite/storage"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/util/json"
+	"github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	// defaultTagsAutoCompleteLimit is the default number of results returned
+	// by the tag auto complete endpoints, matching graphite.
+	defaultTagsAutoCompleteLimit = 100
+
+	// internalTagPrefix prefixes the names of tags which are internal to M3,
+	// such as the graphite path tags, and are never returned as graphite tags.
+	internalTagPrefix = "__"
+)
+
+var (
+	// taggedSeriesMatcher matches every graphite tagged series, since each has
+	// a name tag.
+	taggedSeriesMatcher = models.Matcher{
+		Type:  models.MatchRegexp,
+		Name:  []byte(graphite.TaggedNameTag),
+		Value: []byte(graphite.MatchAllPattern),
+	}
+)
+
+// parseTagsParamsToQuery parses a tags request into the query which completes
+// the names of every tag of graphite tagged series, along with the optional
+// regexp filtering the tag names.
+func parseTagsParamsToQuery(r *http.Request) (
+	*storage.CompleteTagsQuery,
+	*regexp.Regexp,
+	*xhttp.ParseError,
+) {
+	var filter *regexp.Regexp
+	if f := r.FormValue("filter"); f != "" {
+		re, err := regexp.Compile(f)
+		if err != nil {
+			return nil, nil, xhttp.NewParseError(
+				fmt.Errorf("invalid 'filter': %v", err), http.StatusBadRequest)
+		}
+
+		filter = re
+	}
+
+	return &storage.CompleteTagsQuery{
+		CompleteNameOnly: true,
+		TagMatchers:      models.Matchers{taggedSeriesMatcher},
+	}, filter, nil
+}
+
+// parseAutoCompleteTagsParamsToQuery parses a tags auto complete request into
+// the query which completes the tag names of the series matching the given tag
+// expressions, along with the tag name prefix and result limit.
+func parseAutoCompleteTagsParamsToQuery(r *http.Request) (
+	*storage.CompleteTagsQuery,
+	string,
+	int,
+	*xhttp.ParseError,
+) {
+	matchers, limit, rErr := parseAutoCompleteParams(r)
+	if rErr != nil {
+		return nil, "", 0, rErr
+	}
+
+	return &storage.CompleteTagsQuery{
+		CompleteNameOnly: true,
+		TagMatchers:      matchers,
+	}, r.FormValue("tagPrefix"), limit, nil
+}
+
+// parseAutoCompleteValuesParamsToQuery parses a tag values auto complete
+// request into the query which completes the values of the given tag for the
+// series matching the given tag expressions, along with the value prefix and
+// result limit.
+func parseAutoCompleteValuesParamsToQuery(r *http.Request) (
+	*storage.CompleteTagsQuery,
+	string,
+	int,
+	*xhttp.ParseError,
+) {
+	matchers, limit, rErr := parseAutoCompleteParams(r)
+	if rErr != nil {
+		return nil, "", 0, rErr
+	}
+
+	tag := r.FormValue("tag")
+	if tag == "" {
+		return nil, "", 0, xhttp.NewParseError(
+			fmt.Errorf("no 'tag' specified"), http.StatusBadRequest)
+	}
+
+	valuePrefix := r.FormValue("valuePrefix")
+	matchers = append(matchers, models.Matcher{
+		Type:  models.MatchRegexp,
+		Name:  []byte(tag),
+		Value: []byte(regexp.QuoteMeta(valuePrefix) + graphite.MatchAllPattern),
+	})
+
+	return &storage.CompleteTagsQuery{
+		FilterNameTags: [][]byte{[]byte(tag)},
+		TagMatchers:    matchers,
+	}, valuePrefix, limit, nil
+}
+
+func parseAutoCompleteParams(r *http.Request) (
+	models.Matchers,
+	int,
+	*xhttp.ParseError,
+) {
+	if err := r.ParseForm(); err != nil {
+		return nil, 0, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	limit := defaultTagsAutoCompleteLimit
+	if l := r.Form.Get("limit"); l != "" {
+		parsed, err := strconv.Atoi(l)
+		if err != nil || parsed <= 0 {
+			return nil, 0, xhttp.NewParseError(
+				fmt.Errorf("invalid 'limit': %s", l), http.StatusBadRequest)
+		}
+
+		limit = parsed
+	}
+
+	exprs := r.Form["expr"]
+	if len(exprs) == 0 {
+		return models.Matchers{taggedSeriesMatcher}, limit, nil
+	}
+
+	matchers, err := graphiteStorage.TranslateTagExpressionsToMatchers(exprs)
+	if err != nil {
+		return nil, 0, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	return matchers, limit, nil
+}
+
+// completedTagNames returns the sorted names of the completed graphite tags
+// which are accepted by the given filter.
+func completedTagNames(
+	result *storage.CompleteTagsResult,
+	accept func(name string) bool,
+) []string {
+	names := make([]string, 0, len(result.CompletedTags))
+	for _, tag := range result.CompletedTags {
+		name := string(tag.Name)
+		if strings.HasPrefix(name, internalTagPrefix) || !accept(name) {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func tagsResultsJSON(w io.Writer, names []string) error {
+	jw := json.NewWriter(w)
+	jw.BeginArray()
+
+	for _, name := range names {
+		jw.BeginObject()
+
+		jw.BeginObjectField("tag")
+		jw.WriteString(name)
+
+		jw.EndObject()
+	}
+
+	jw.EndArray()
+	return jw.Close()
+}
+
+func autoCompleteResultsJSON(w io.Writer, results []string, limit int) error {
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	jw := json.NewWriter(w)
+	jw.BeginArray()
+
+	for _, result := range results {
+		jw.WriteString(result)
+	}
+
+	jw.EndArray()
+	return jw.Close()
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tagsStorage records the completed query and returns the given result.
+type tagsStorage struct {
+	mock.Storage
+
+	query  *storage.CompleteTagsQuery
+	result *storage.CompleteTagsResult
+}
+
+func (s *tagsStorage) CompleteTags(
+	ctx context.Context,
+	query *storage.CompleteTagsQuery,
+	_ *storage.FetchOptions,
+) (*storage.CompleteTagsResult, error) {
+	s.query = query
+	return s.result, nil
+}
+
+func newNameOnlyResult(names ...string) *storage.CompleteTagsResult {
+	tags := make([]storage.CompletedTag, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, storage.CompletedTag{Name: []byte(name)})
+	}
+
+	return &storage.CompleteTagsResult{
+		CompleteNameOnly: true,
+		CompletedTags:    tags,
+	}
+}
+
+func serveTagsRequest(
+	t *testing.T,
+	handler http.Handler,
+	url string,
+	query string,
+) (int, string) {
+	req, err := http.NewRequest(TagsHTTPMethods[0], url, nil)
+	require.NoError(t, err)
+	req.URL.RawQuery = query
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	res := recorder.Result()
+	buf, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	return res.StatusCode, string(buf)
+}
+
+func TestTags(t *testing.T) {
+	store := &tagsStorage{
+		result: newNameOnlyResult("name", "host", "__g0__", "dc"),
+	}
+	handler := NewTagsHandler(store)
+
+	code, body := serveTagsRequest(t, handler, TagsURL, "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, `[{"tag":"dc"},{"tag":"host"},{"tag":"name"}]`, body)
+
+	require.NotNil(t, store.query)
+	assert.True(t, store.query.CompleteNameOnly)
+	assert.Equal(t, models.Matchers{taggedSeriesMatcher}, store.query.TagMatchers)
+
+	code, body = serveTagsRequest(t, handler, TagsURL, "filter=^(dc|name)$")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, `[{"tag":"dc"},{"tag":"name"}]`, body)
+
+	code, _ = serveTagsRequest(t, handler, TagsURL, "filter=(")
+	require.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestAutoCompleteTags(t *testing.T) {
+	store := &tagsStorage{
+		result: newNameOnlyResult("name", "host", "hostgroup", "dc", "__name__"),
+	}
+	handler := NewAutoCompleteTagsHandler(store)
+
+	code, body := serveTagsRequest(t, handler, TagsAutoCompleteTagsURL, "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, `["dc","host","hostgroup","name"]`, body)
+
+	code, body = serveTagsRequest(t, handler, TagsAutoCompleteTagsURL,
+		"tagPrefix=ho&limit=1")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, `["host"]`, body)
+
+	code, body = serveTagsRequest(t, handler, TagsAutoCompleteTagsURL,
+		"expr=name=cpu&expr=dc!=east")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, `["host","hostgroup"]`, body)
+
+	require.Equal(t, 2, len(store.query.TagMatchers))
+	assert.Equal(t, models.MatchEqual, store.query.TagMatchers[0].Type)
+	assert.Equal(t, models.MatchNotEqual, store.query.TagMatchers[1].Type)
+
+	code, _ = serveTagsRequest(t, handler, TagsAutoCompleteTagsURL, "limit=-1")
+	require.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = serveTagsRequest(t, handler, TagsAutoCompleteTagsURL, "expr=dc")
+	require.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestAutoCompleteValues(t *testing.T) {
+	store := &tagsStorage{
+		result: &storage.CompleteTagsResult{
+			CompletedTags: []storage.CompletedTag{
+				{
+					Name: []byte("dc"),
+					Values: [][]byte{
+						[]byte("west"), []byte("east"), []byte("eastern"),
+					},
+				},
+			},
+		},
+	}
+	handler := NewAutoCompleteValuesHandler(store)
+
+	code, body := serveTagsRequest(t, handler, TagsAutoCompleteValuesURL, "tag=dc")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, `["east","eastern","west"]`, body)
+
+	assert.Equal(t, [][]byte{[]byte("dc")}, store.query.FilterNameTags)
+	require.Equal(t, 2, len(store.query.TagMatchers))
+	assert.Equal(t, taggedSeriesMatcher, store.query.TagMatchers[0])
+	assert.Equal(t, []byte("dc"), store.query.TagMatchers[1].Name)
+	assert.Equal(t, []byte(".*"), store.query.TagMatchers[1].Value)
+
+	code, body = serveTagsRequest(t, handler, TagsAutoCompleteValuesURL,
+		"tag=dc&valuePrefix=ea&limit=1&expr=name=cpu")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, `["east"]`, body)
+
+	require.Equal(t, 2, len(store.query.TagMatchers))
+	assert.Equal(t, []byte("name"), store.query.TagMatchers[0].Name)
+	assert.Equal(t, []byte("cpu"), store.query.TagMatchers[0].Value)
+	assert.Equal(t, []byte("ea.*"), store.query.TagMatchers[1].Value)
+
+	code, _ = serveTagsRequest(t, handler, TagsAutoCompleteValuesURL, "")
+	require.Equal(t, http.StatusBadRequest, code)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package influxdb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var (
+	errMissingMeasurement = errors.New("missing measurement")
+	errMissingFields      = errors.New("missing fields")
+	errInvalidTag         = errors.New("invalid tag, expected key=value")
+	errInvalidField       = errors.New("invalid field, expected key=value")
+	errUnterminatedString = errors.New("unterminated string field value")
+)
+
+// point is a single parsed line of the InfluxDB line protocol. Only fields
+// with numeric or boolean values are retained since they are the only ones
+// that can be stored as a datapoint.
+type point struct {
+	measurement []byte
+	tags        []tag
+	fields      []field
+	timestamp   time.Time
+}
+
+type tag struct {
+	key   []byte
+	value []byte
+}
+
+type field struct {
+	key   []byte
+	value float64
+}
+
+// parsePoints parses a body of newline separated points, a point without a
+// timestamp is assigned the provided time.
+func parsePoints(body []byte, precision time.Duration, now time.Time) ([]point, error) {
+	var (
+		points []point
+		lineNo int
+	)
+	for len(body) > 0 {
+		var line []byte
+		if idx := bytes.IndexByte(body, '\n'); idx >= 0 {
+			line, body = body[:idx], body[idx+1:]
+		} else {
+			line, body = body, nil
+		}
+		lineNo++
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		p, err := parsePoint(line, precision, now)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse line %d: %q: %v", lineNo, line, err)
+		}
+		points = append(points, p)
+	}
+
+	return points, nil
+}
+
+func parsePoint(line []byte, precision time.Duration, now time.Time) (point, error) {
+	keySection, rest := splitUnescaped(line, ' ', false)
+	fieldSection, rest := splitUnescaped(bytes.TrimLeft(rest, " "), ' ', true)
+	tsSection := bytes.TrimSpace(rest)
+
+	var p point
+	measurement, keySection := splitUnescaped(keySection, ',', false)
+	if len(measurement) == 0 {
+		return point{}, errMissingMeasurement
+	}
+	p.measurement = unescape(measurement)
+
+	for len(keySection) > 0 {
+		var pair []byte
+		pair, keySection = splitUnescaped(keySection, ',', false)
+		key, value := splitUnescaped(pair, '=', false)
+		if len(key) == 0 || len(value) == 0 {
+			return point{}, errInvalidTag
+		}
+		p.tags = append(p.tags, tag{key: unescape(key), value: unescape(value)})
+	}
+
+	if len(fieldSection) == 0 {
+		return point{}, errMissingFields
+	}
+	for len(fieldSection) > 0 {
+		var pair []byte
+		pair, fieldSection = splitUnescaped(fieldSection, ',', true)
+		key, value := splitUnescaped(pair, '=', false)
+		if len(key) == 0 || len(value) == 0 {
+			return point{}, errInvalidField
+		}
+
+		v, ok, err := parseFieldValue(value)
+		if err != nil {
+			return point{}, fmt.Errorf("invalid field %s: %v", key, err)
+		}
+		if !ok {
+			// Non-numeric field, skip.
+			continue
+		}
+		p.fields = append(p.fields, field{key: unescape(key), value: v})
+	}
+
+	p.timestamp = now
+	if len(tsSection) > 0 {
+		ts, err := strconv.ParseInt(string(tsSection), 10, 64)
+		if err != nil {
+			return point{}, fmt.Errorf("invalid timestamp: %v", err)
+		}
+		p.timestamp = time.Unix(0, ts*int64(precision))
+	}
+
+	return p, nil
+}
+
+// parseFieldValue parses a field value, returning false if the value is
+// a valid but non-numeric value (i.e. a string).
+func parseFieldValue(value []byte) (float64, bool, error) {
+	switch value[0] {
+	case '"':
+		if len(value) < 2 || value[len(value)-1] != '"' {
+			return 0, false, errUnterminatedString
+		}
+		return 0, false, nil
+	}
+
+	switch string(value) {
+	case "t", "T", "true", "True", "TRUE":
+		return 1, true, nil
+	case "f", "F", "false", "False", "FALSE":
+		return 0, true, nil
+	}
+
+	switch value[len(value)-1] {
+	case 'i':
+		v, err := strconv.ParseInt(string(value[:len(value)-1]), 10, 64)
+		return float64(v), err == nil, err
+	case 'u':
+		v, err := strconv.ParseUint(string(value[:len(value)-1]), 10, 64)
+		return float64(v), err == nil, err
+	}
+
+	v, err := strconv.ParseFloat(string(value), 64)
+	return v, err == nil, err
+}
+
+// splitUnescaped splits b at the first occurrence of sep that is not escaped
+// with a backslash, and optionally not within a double quoted string.
+func splitUnescaped(b []byte, sep byte, quotes bool) ([]byte, []byte) {
+	inQuotes := false
+	for i := 0; i < len(b); i++ {
+		switch {
+		case b[i] == '\\':
+			// Skip the escaped character.
+			i++
+		case quotes && b[i] == '"':
+			inQuotes = !inQuotes
+		case b[i] == sep && !inQuotes:
+			return b[:i], b[i+1:]
+		}
+	}
+
+	return b, nil
+}
+
+// unescape removes the backslash from escaped commas, equals signs and spaces.
+func unescape(b []byte) []byte {
+	if bytes.IndexByte(b, '\\') < 0 {
+		return b
+	}
+
+	result := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		if b[i] == '\\' && i+1 < len(b) {
+			switch b[i+1] {
+			case ',', '=', ' ':
+				i++
+			}
+		}
+		result = append(result, b[i])
+	}
+
+	return result
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package influxdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePoints(t *testing.T) {
+	now := time.Unix(1000, 0)
+	body := []byte(`
+# comment
+cpu,host=a,region=us-west usage_idle=90.5,usage_user=5i 1556813561098000000
+mem,host=b used=10u,available=true,status="ok, all good" 1556813561098000000
+weather,location=us\,midwest,city=new\ york temp\ f=82
+disk free=1e3,label="with \"quotes\" and spaces"
+`)
+
+	points, err := parsePoints(body, time.Nanosecond, now)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(points))
+
+	assert.Equal(t, point{
+		measurement: []byte("cpu"),
+		tags: []tag{
+			{key: []byte("host"), value: []byte("a")},
+			{key: []byte("region"), value: []byte("us-west")},
+		},
+		fields: []field{
+			{key: []byte("usage_idle"), value: 90.5},
+			{key: []byte("usage_user"), value: 5},
+		},
+		timestamp: time.Unix(0, 1556813561098000000),
+	}, points[0])
+
+	assert.Equal(t, point{
+		measurement: []byte("mem"),
+		tags: []tag{
+			{key: []byte("host"), value: []byte("b")},
+		},
+		fields: []field{
+			{key: []byte("used"), value: 10},
+			{key: []byte("available"), value: 1},
+		},
+		timestamp: time.Unix(0, 1556813561098000000),
+	}, points[1])
+
+	assert.Equal(t, point{
+		measurement: []byte("weather"),
+		tags: []tag{
+			{key: []byte("location"), value: []byte("us,midwest")},
+			{key: []byte("city"), value: []byte("new york")},
+		},
+		fields: []field{
+			{key: []byte("temp f"), value: 82},
+		},
+		timestamp: now,
+	}, points[2])
+
+	assert.Equal(t, point{
+		measurement: []byte("disk"),
+		fields: []field{
+			{key: []byte("free"), value: 1000},
+		},
+		timestamp: now,
+	}, points[3])
+}
+
+func TestParsePointsPrecision(t *testing.T) {
+	points, err := parsePoints([]byte("cpu value=1 1556813561"), time.Second, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(points))
+	assert.Equal(t, time.Unix(1556813561, 0), points[0].timestamp)
+}
+
+func TestParsePointsErrors(t *testing.T) {
+	for _, line := range []string{
+		"cpu",
+		"cpu ",
+		",host=a value=1",
+		"cpu,host value=1",
+		"cpu,host= value=1",
+		"cpu value",
+		"cpu value=",
+		"cpu value=abc",
+		"cpu value=1x",
+		"cpu value=\"unterminated",
+		"cpu value=1 abc",
+	} {
+		_, err := parsePoints([]byte(line), time.Nanosecond, time.Now())
+		assert.Error(t, err, "expected error for line: %s", line)
+	}
+}
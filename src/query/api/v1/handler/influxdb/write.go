@@ -0,0 +1,260 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package influxdb
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/net/http"
+	"github.com/m3db/m3x/clock"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	// InfluxWriteURL is the url for the influxdb write handler.
+	InfluxWriteURL = handler.RoutePrefixV1 + "/influxdb/write"
+
+	// InfluxWriteHTTPMethod is the HTTP method used with this resource.
+	InfluxWriteHTTPMethod = http.MethodPost
+
+	precisionParam = "precision"
+)
+
+var (
+	errNoDownsamplerAndWriter = errors.New("no ingest.DownsamplerAndWriter was set")
+	errEmptyBody              = errors.New("empty request body")
+)
+
+// InfluxWriteHandler represents a handler for the InfluxDB line protocol
+// write endpoint. Each field of a point is written as a separate series
+// named measurement_field with the point's tags.
+type InfluxWriteHandler struct {
+	downsamplerAndWriter ingest.DownsamplerAndWriter
+	tagOptions           models.TagOptions
+	nowFn                clock.NowFn
+	metrics              influxWriteMetrics
+}
+
+// NewInfluxWriteHandler returns a new instance of handler.
+func NewInfluxWriteHandler(
+	downsamplerAndWriter ingest.DownsamplerAndWriter,
+	tagOptions models.TagOptions,
+	scope tally.Scope,
+) (http.Handler, error) {
+	if downsamplerAndWriter == nil {
+		return nil, errNoDownsamplerAndWriter
+	}
+
+	return &InfluxWriteHandler{
+		downsamplerAndWriter: downsamplerAndWriter,
+		tagOptions:           tagOptions,
+		nowFn:                time.Now,
+		metrics:              newInfluxWriteMetrics(scope),
+	}, nil
+}
+
+type influxWriteMetrics struct {
+	writeSuccess      tally.Counter
+	writeErrorsServer tally.Counter
+	writeErrorsClient tally.Counter
+}
+
+func newInfluxWriteMetrics(scope tally.Scope) influxWriteMetrics {
+	return influxWriteMetrics{
+		writeSuccess:      scope.Counter("influx.write.success"),
+		writeErrorsServer: scope.Tagged(map[string]string{"code": "5XX"}).Counter("influx.write.errors"),
+		writeErrorsClient: scope.Tagged(map[string]string{"code": "4XX"}).Counter("influx.write.errors"),
+	}
+}
+
+func (h *InfluxWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	iter, rErr := h.parseRequest(r)
+	if rErr != nil {
+		h.metrics.writeErrorsClient.Inc(1)
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	if err := h.downsamplerAndWriter.WriteBatch(r.Context(), iter); err != nil {
+		h.metrics.writeErrorsServer.Inc(1)
+		logging.WithContext(r.Context()).Error("Write error", zap.Any("err", err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	h.metrics.writeSuccess.Inc(1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *InfluxWriteHandler) parseRequest(r *http.Request) (*influxTSIter, *xhttp.ParseError) {
+	if r.Body == nil {
+		return nil, xhttp.NewParseError(errEmptyBody, http.StatusBadRequest)
+	}
+	defer r.Body.Close()
+
+	precision, unit, err := parsePrecision(r.URL.Query().Get(precisionParam))
+	if err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+		}
+		defer gzipReader.Close()
+		body = gzipReader
+	}
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	points, err := parsePoints(data, precision, h.nowFn())
+	if err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	return newInfluxTSIter(points, unit, h.tagOptions), nil
+}
+
+// parsePrecision returns the duration and unit of timestamps for the given
+// precision, defaulting to nanoseconds.
+func parsePrecision(precision string) (time.Duration, xtime.Unit, error) {
+	switch precision {
+	case "", "n", "ns":
+		return time.Nanosecond, xtime.Nanosecond, nil
+	case "u", "us", "µ":
+		return time.Microsecond, xtime.Microsecond, nil
+	case "ms":
+		return time.Millisecond, xtime.Millisecond, nil
+	case "s":
+		return time.Second, xtime.Second, nil
+	case "m":
+		return time.Minute, xtime.Minute, nil
+	case "h":
+		return time.Hour, xtime.Hour, nil
+	default:
+		return 0, xtime.None, fmt.Errorf("invalid precision: %s", precision)
+	}
+}
+
+func newInfluxTSIter(points []point, unit xtime.Unit, tagOpts models.TagOptions) *influxTSIter {
+	// Construct the tags and datapoints upfront so that if the iterator
+	// is reset, we don't have to generate them twice.
+	var (
+		tags       []models.Tags
+		datapoints []ts.Datapoints
+	)
+	for _, p := range points {
+		pointTags := make([]models.Tag, 0, len(p.tags))
+		for _, t := range p.tags {
+			pointTags = append(pointTags, models.Tag{
+				Name:  sanitizeName(t.key, false),
+				Value: t.value,
+			})
+		}
+
+		for _, f := range p.fields {
+			name := make([]byte, 0, len(p.measurement)+1+len(f.key))
+			name = append(name, p.measurement...)
+			name = append(name, '_')
+			name = append(name, f.key...)
+
+			tags = append(tags, models.NewTags(len(pointTags)+1, tagOpts).
+				AddTags(pointTags).
+				SetName(sanitizeName(name, true)))
+			datapoints = append(datapoints, ts.Datapoints{
+				{Timestamp: p.timestamp, Value: f.value},
+			})
+		}
+	}
+
+	return &influxTSIter{
+		idx:        -1,
+		unit:       unit,
+		tags:       tags,
+		datapoints: datapoints,
+	}
+}
+
+type influxTSIter struct {
+	idx        int
+	unit       xtime.Unit
+	tags       []models.Tags
+	datapoints []ts.Datapoints
+}
+
+func (i *influxTSIter) Next() bool {
+	i.idx++
+	return i.idx < len(i.tags)
+}
+
+func (i *influxTSIter) Current() (models.Tags, ts.Datapoints, xtime.Unit) {
+	if len(i.tags) == 0 || i.idx < 0 || i.idx >= len(i.tags) {
+		return models.EmptyTags(), nil, 0
+	}
+
+	return i.tags[i.idx], i.datapoints[i.idx], i.unit
+}
+
+func (i *influxTSIter) Reset() error {
+	i.idx = -1
+	return nil
+}
+
+func (i *influxTSIter) Error() error {
+	return nil
+}
+
+// sanitizeName replaces characters that are not valid in a Prometheus metric
+// name (or label name, which excludes colons) with underscores so the series
+// can be queried with PromQL.
+func sanitizeName(name []byte, allowColon bool) []byte {
+	for i, c := range name {
+		valid := c == '_' ||
+			(c >= 'a' && c <= 'z') ||
+			(c >= 'A' && c <= 'Z') ||
+			(i > 0 && c >= '0' && c <= '9') ||
+			(allowColon && c == ':')
+		if !valid {
+			name[i] = '_'
+		}
+	}
+
+	return name
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package influxdb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/util/logging"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+type writtenSeries struct {
+	tags      map[string]string
+	timestamp time.Time
+	value     float64
+	unit      xtime.Unit
+}
+
+func newTestHandler(t *testing.T, ctrl *gomock.Controller) (http.Handler, *[]writtenSeries) {
+	var written []writtenSeries
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, iter ingest.DownsampleAndWriteIter) error {
+			for iter.Next() {
+				tags, dps, unit := iter.Current()
+				m := make(map[string]string, tags.Len())
+				for _, tag := range tags.Tags {
+					m[string(tag.Name)] = string(tag.Value)
+				}
+				require.Equal(t, 1, len(dps))
+				written = append(written, writtenSeries{
+					tags:      m,
+					timestamp: dps[0].Timestamp,
+					value:     dps[0].Value,
+					unit:      unit,
+				})
+			}
+			return iter.Error()
+		}).AnyTimes()
+
+	handler, err := NewInfluxWriteHandler(mockDownsamplerAndWriter,
+		models.NewTagOptions(), tally.NoopScope)
+	require.NoError(t, err)
+	return handler, &written
+}
+
+func TestInfluxWrite(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, written := newTestHandler(t, ctrl)
+
+	body := "cpu,host=a,dc.name=us-west usage.idle=90.5,usage_user=5i 1556813561\n" +
+		"mem,host=b status=\"ok\" 1556813561\n"
+	req := httptest.NewRequest(InfluxWriteHTTPMethod,
+		InfluxWriteURL+"?precision=s", strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, []writtenSeries{
+		{
+			tags: map[string]string{
+				"__name__": "cpu_usage_idle",
+				"host":     "a",
+				"dc_name":  "us-west",
+			},
+			timestamp: time.Unix(1556813561, 0),
+			value:     90.5,
+			unit:      xtime.Second,
+		},
+		{
+			tags: map[string]string{
+				"__name__": "cpu_usage_user",
+				"host":     "a",
+				"dc_name":  "us-west",
+			},
+			timestamp: time.Unix(1556813561, 0),
+			value:     5,
+			unit:      xtime.Second,
+		},
+	}, *written)
+}
+
+func TestInfluxWriteGzip(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, written := newTestHandler(t, ctrl)
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	_, err := gzipWriter.Write([]byte("cpu value=1 1556813561000"))
+	require.NoError(t, err)
+	require.NoError(t, gzipWriter.Close())
+
+	req := httptest.NewRequest(InfluxWriteHTTPMethod,
+		InfluxWriteURL+"?precision=ms", &buf)
+	req.Header.Set("Content-Encoding", "gzip")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+	require.Equal(t, 1, len(*written))
+	assert.Equal(t, "cpu_value", (*written)[0].tags["__name__"])
+	assert.Equal(t, time.Unix(1556813561, 0), (*written)[0].timestamp)
+	assert.Equal(t, xtime.Millisecond, (*written)[0].unit)
+}
+
+func TestInfluxWriteBadRequest(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler, written := newTestHandler(t, ctrl)
+
+	for _, url := range []string{
+		InfluxWriteURL + "?precision=d",
+		InfluxWriteURL,
+	} {
+		req := httptest.NewRequest(InfluxWriteHTTPMethod, url,
+			strings.NewReader("cpu value=abc"))
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	}
+	assert.Equal(t, 0, len(*written))
+}
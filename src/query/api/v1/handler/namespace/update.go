@@ -0,0 +1,242 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package namespace
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	clusterclient "github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cluster/kv"
+	nsproto "github.com/m3db/m3/src/dbnode/generated/proto/namespace"
+	"github.com/m3db/m3/src/dbnode/storage/namespace"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/query/util/logging"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+)
+
+var (
+	// M3DBUpdateURL is the url for the M3DB namespace update handler.
+	M3DBUpdateURL = path.Join(handler.RoutePrefixV1, M3DBServiceNamespacePathName)
+
+	// UpdateHTTPMethod is the HTTP method used with this resource.
+	UpdateHTTPMethod = http.MethodPut
+
+	errEmptyNamespaceName       = errors.New("must specify namespace name to update")
+	errEmptyUpdateOptions       = errors.New("must specify the retention period to update")
+	errUnsupportedUpdateOptions = errors.New("only the retention period of an existing namespace may be updated")
+)
+
+// UpdateHandler is the handler for namespace updates.
+type UpdateHandler Handler
+
+// NewUpdateHandler returns a new instance of UpdateHandler.
+func NewUpdateHandler(client clusterclient.Client) *UpdateHandler {
+	return &UpdateHandler{client: client}
+}
+
+func (h *UpdateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.WithContext(ctx)
+
+	md, rErr := h.parseRequest(r)
+	if rErr != nil {
+		logger.Error("unable to parse request", zap.Any("error", rErr))
+		xhttp.Error(w, rErr.Inner(), rErr.Code())
+		return
+	}
+
+	opts := handler.NewServiceOptions("kv", r.Header, nil)
+	nsRegistry, err := h.Update(md, opts)
+	if err != nil {
+		logger.Error("unable to update namespace", zap.Any("error", err))
+		if err == errNamespaceNotFound {
+			xhttp.Error(w, err, http.StatusNotFound)
+		} else {
+			xhttp.Error(w, err, http.StatusBadRequest)
+		}
+		return
+	}
+
+	resp := &admin.NamespaceGetResponse{
+		Registry: &nsRegistry,
+	}
+
+	xhttp.WriteProtoMsgJSONResponse(w, resp, logger)
+}
+
+func (h *UpdateHandler) parseRequest(r *http.Request) (*admin.NamespaceUpdateRequest, *xhttp.ParseError) {
+	defer r.Body.Close()
+	rBody, err := xhttp.DurationToNanosBytes(r.Body)
+	if err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	updateReq := new(admin.NamespaceUpdateRequest)
+	if err := jsonpb.Unmarshal(bytes.NewReader(rBody), updateReq); err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	if err := validateUpdateRequest(updateReq); err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	return updateReq, nil
+}
+
+// validateUpdateRequest ensures the request only sets options that can be
+// safely changed on a live namespace. Since proto3 does not distinguish
+// unset fields from zero values every other option must be left unset.
+// Options such as bufferPast, snapshotEnabled or the index options are
+// rejected as changing them requires the namespace to be recreated.
+func validateUpdateRequest(req *admin.NamespaceUpdateRequest) error {
+	if req.Name == "" {
+		return errEmptyNamespaceName
+	}
+
+	if fields := unsupportedUpdateFields(req.Options); len(fields) > 0 {
+		return fmt.Errorf("%v: unable to update %s", errUnsupportedUpdateOptions,
+			strings.Join(fields, ", "))
+	}
+
+	ropts := req.Options.GetRetentionOptions()
+	if ropts == nil || ropts.RetentionPeriodNanos == 0 {
+		return errEmptyUpdateOptions
+	}
+
+	expected := &nsproto.NamespaceOptions{
+		RetentionOptions: &nsproto.RetentionOptions{
+			RetentionPeriodNanos: ropts.RetentionPeriodNanos,
+		},
+	}
+	if !proto.Equal(req.Options, expected) {
+		return errUnsupportedUpdateOptions
+	}
+
+	return nil
+}
+
+// unsupportedUpdateFields returns the names of the options set in the
+// request which cannot be changed on a live namespace.
+func unsupportedUpdateFields(opts *nsproto.NamespaceOptions) []string {
+	if opts == nil {
+		return nil
+	}
+
+	var fields []string
+	add := func(set bool, name string) {
+		if set {
+			fields = append(fields, name)
+		}
+	}
+	add(opts.BootstrapEnabled, "bootstrapEnabled")
+	add(opts.FlushEnabled, "flushEnabled")
+	add(opts.WritesToCommitLog, "writesToCommitLog")
+	add(opts.CleanupEnabled, "cleanupEnabled")
+	add(opts.RepairEnabled, "repairEnabled")
+	add(opts.SnapshotEnabled, "snapshotEnabled")
+	add(opts.ColdWritesEnabled, "coldWritesEnabled")
+	if ropts := opts.RetentionOptions; ropts != nil {
+		add(ropts.BlockSizeNanos != 0, "retentionOptions.blockSize")
+		add(ropts.BufferFutureNanos != 0, "retentionOptions.bufferFuture")
+		add(ropts.BufferPastNanos != 0, "retentionOptions.bufferPast")
+		add(ropts.BlockDataExpiry, "retentionOptions.blockDataExpiry")
+		add(ropts.BlockDataExpiryAfterNotAccessPeriodNanos != 0,
+			"retentionOptions.blockDataExpiryAfterNotAccessPeriod")
+	}
+	if iopts := opts.IndexOptions; iopts != nil {
+		add(iopts.Enabled, "indexOptions.enabled")
+		add(iopts.BlockSizeNanos != 0, "indexOptions.blockSize")
+	}
+	return fields
+}
+
+// Update updates a namespace in place.
+func (h *UpdateHandler) Update(updateReq *admin.NamespaceUpdateRequest, opts handler.ServiceOptions) (nsproto.Registry, error) {
+	var emptyReg = nsproto.Registry{}
+
+	kvOpts := kv.NewOverrideOptions().
+		SetEnvironment(opts.ServiceEnvironment).
+		SetZone(opts.ServiceZone)
+
+	store, err := h.client.Store(kvOpts)
+	if err != nil {
+		return emptyReg, err
+	}
+
+	currentMetadata, version, err := Metadata(store)
+	if err != nil {
+		return emptyReg, err
+	}
+
+	mdIdx := -1
+	for idx, md := range currentMetadata {
+		if md.ID().String() == updateReq.Name {
+			mdIdx = idx
+			break
+		}
+	}
+
+	if mdIdx == -1 {
+		return emptyReg, errNamespaceNotFound
+	}
+
+	var (
+		existing      = currentMetadata[mdIdx]
+		existingOpts  = existing.Options()
+		retentionOpts = existingOpts.RetentionOptions().SetRetentionPeriod(
+			time.Duration(updateReq.Options.RetentionOptions.RetentionPeriodNanos))
+		updatedOpts = existingOpts.SetRetentionOptions(retentionOpts)
+	)
+	if err := namespace.ValidateUpdate(existingOpts, updatedOpts); err != nil {
+		return emptyReg, fmt.Errorf("unable to update namespace: %v", err)
+	}
+
+	updated, err := namespace.NewMetadata(existing.ID(), updatedOpts)
+	if err != nil {
+		return emptyReg, fmt.Errorf("unable to get metadata: %v", err)
+	}
+
+	currentMetadata[mdIdx] = updated
+	nsMap, err := namespace.NewMap(currentMetadata)
+	if err != nil {
+		return emptyReg, err
+	}
+
+	// The CheckAndSet ensures the update fails rather than clobbering any
+	// concurrent change to the registry since it was read.
+	protoRegistry := namespace.ToProto(nsMap)
+	_, err = store.CheckAndSet(M3DBNodeNamespacesKey, version, protoRegistry)
+	if err != nil {
+		return emptyReg, fmt.Errorf("failed to update namespace: %v", err)
+	}
+
+	return *protoRegistry, nil
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package namespace
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	nsproto "github.com/m3db/m3/src/dbnode/generated/proto/namespace"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testUpdateJSON = `
+{
+		"name": "testNamespace",
+		"options": {
+			"retentionOptions": {
+				"retentionPeriodNanos": 345600000000000
+			}
+		}
+}
+`
+
+func testUpdateRegistry() nsproto.Registry {
+	return nsproto.Registry{
+		Namespaces: map[string]*nsproto.NamespaceOptions{
+			"testNamespace": &nsproto.NamespaceOptions{
+				BootstrapEnabled:  true,
+				FlushEnabled:      true,
+				SnapshotEnabled:   true,
+				WritesToCommitLog: true,
+				CleanupEnabled:    false,
+				RepairEnabled:     false,
+				RetentionOptions: &nsproto.RetentionOptions{
+					RetentionPeriodNanos:                     172800000000000,
+					BlockSizeNanos:                           7200000000000,
+					BufferFutureNanos:                        600000000000,
+					BufferPastNanos:                          600000000000,
+					BlockDataExpiry:                          true,
+					BlockDataExpiryAfterNotAccessPeriodNanos: 3600000000000,
+				},
+			},
+		},
+	}
+}
+
+func TestNamespaceUpdateHandler(t *testing.T) {
+	mockClient, mockKV, ctrl := SetupNamespaceTest(t)
+	updateHandler := NewUpdateHandler(mockClient)
+	mockClient.EXPECT().Store(gomock.Any()).Return(mockKV, nil)
+
+	mockValue := kv.NewMockValue(ctrl)
+	mockValue.EXPECT().Unmarshal(gomock.Any()).Return(nil).SetArg(0, testUpdateRegistry())
+	mockValue.EXPECT().Version().Return(3)
+	mockKV.EXPECT().Get(M3DBNodeNamespacesKey).Return(mockValue, nil)
+	mockKV.EXPECT().CheckAndSet(M3DBNodeNamespacesKey, 3, gomock.Not(nil)).Return(4, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(UpdateHTTPMethod, M3DBUpdateURL, strings.NewReader(testUpdateJSON))
+	require.NotNil(t, req)
+	updateHandler.ServeHTTP(w, req)
+
+	resp := w.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var getResp admin.NamespaceGetResponse
+	require.NoError(t, jsonpb.Unmarshal(resp.Body, &getResp))
+
+	expected := testUpdateRegistry()
+	expected.Namespaces["testNamespace"].RetentionOptions.RetentionPeriodNanos = 345600000000000
+	actual := getResp.Registry.Namespaces["testNamespace"]
+	require.NotNil(t, actual)
+	assert.Equal(t, expected.Namespaces["testNamespace"].RetentionOptions, actual.RetentionOptions)
+	assert.Equal(t, expected.Namespaces["testNamespace"].SnapshotEnabled, actual.SnapshotEnabled)
+}
+
+func TestNamespaceUpdateHandlerNotFound(t *testing.T) {
+	mockClient, mockKV, _ := SetupNamespaceTest(t)
+	updateHandler := NewUpdateHandler(mockClient)
+	mockClient.EXPECT().Store(gomock.Any()).Return(mockKV, nil)
+	mockKV.EXPECT().Get(M3DBNodeNamespacesKey).Return(nil, kv.ErrNotFound)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(UpdateHTTPMethod, M3DBUpdateURL, strings.NewReader(testUpdateJSON))
+	require.NotNil(t, req)
+	updateHandler.ServeHTTP(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestNamespaceUpdateHandlerInvalidRequests(t *testing.T) {
+	mockClient, _, _ := SetupNamespaceTest(t)
+	updateHandler := NewUpdateHandler(mockClient)
+
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "missing name",
+			input: `{"options": {"retentionOptions": {"retentionPeriodNanos": 345600000000000}}}`,
+			err:   errEmptyNamespaceName.Error(),
+		},
+		{
+			name:  "missing options",
+			input: `{"name": "testNamespace"}`,
+			err:   errEmptyUpdateOptions.Error(),
+		},
+		{
+			name:  "missing retention period",
+			input: `{"name": "testNamespace", "options": {"retentionOptions": {}}}`,
+			err:   errEmptyUpdateOptions.Error(),
+		},
+		{
+			name:  "block size",
+			input: `{"name": "testNamespace", "options": {"retentionOptions": {"retentionPeriodNanos": 345600000000000, "blockSizeNanos": 3600000000000}}}`,
+			err:   errUnsupportedUpdateOptions.Error() + ": unable to update retentionOptions.blockSize",
+		},
+		{
+			name:  "buffer past",
+			input: `{"name": "testNamespace", "options": {"retentionOptions": {"bufferPastNanos": 600000000000}}}`,
+			err:   errUnsupportedUpdateOptions.Error() + ": unable to update retentionOptions.bufferPast",
+		},
+		{
+			name:  "snapshot enabled",
+			input: `{"name": "testNamespace", "options": {"snapshotEnabled": true, "retentionOptions": {"retentionPeriodNanos": 345600000000000}}}`,
+			err:   errUnsupportedUpdateOptions.Error() + ": unable to update snapshotEnabled",
+		},
+		{
+			name:  "index options",
+			input: `{"name": "testNamespace", "options": {"retentionOptions": {"retentionPeriodNanos": 345600000000000}, "indexOptions": {"enabled": true, "blockSizeNanos": 3600000000000}}}`,
+			err:   errUnsupportedUpdateOptions.Error() + ": unable to update indexOptions.enabled, indexOptions.blockSize",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(UpdateHTTPMethod, M3DBUpdateURL, strings.NewReader(test.input))
+			require.NotNil(t, req)
+			updateHandler.ServeHTTP(w, req)
+
+			resp := w.Result()
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			assert.Equal(t, "{\"error\":\""+test.err+"\"}\n", string(body))
+		})
+	}
+}
@@ -24,20 +24,26 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/m3db/m3/src/cmd/services/m3query/config"
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/cache"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/m3ql"
+	"github.com/m3db/m3/src/query/parser/promql"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/util/httperrors"
 	"github.com/m3db/m3/src/query/util/logging"
 	opentracingutil "github.com/m3db/m3/src/query/util/opentracing"
+	xhttp "github.com/m3db/m3/src/x/net/http"
 
 	opentracingext "github.com/opentracing/opentracing-go/ext"
 	opentracinglog "github.com/opentracing/opentracing-go/log"
+	pql "github.com/prometheus/prometheus/promql"
 	"github.com/uber-go/tally"
 	"go.uber.org/zap"
 )
@@ -50,6 +56,15 @@ const (
 	// PromReadHTTPMethod is the HTTP method used with this resource.
 	PromReadHTTPMethod = http.MethodGet
 
+	// M3QLReadURL is the url for the M3QL query range handler
+	M3QLReadURL = handler.RoutePrefixV1 + "/m3ql/query_range"
+
+	// M3QLReadHTTPMethod is the HTTP method used with this resource.
+	M3QLReadHTTPMethod = http.MethodGet
+
+	// resultHeaderPrefix is the prefix of M3 request headers.
+	resultHeaderPrefix = "M3-"
+
 	// TODO: Move to config
 	initialBlockAlloc = 10
 )
@@ -62,12 +77,19 @@ var (
 // PromReadHandler represents a handler for prometheus read endpoint.
 type PromReadHandler struct {
 	engine          *executor.Engine
+	parseFn         parseFn
+	normalizeFn     normalizeFn
 	tagOpts         models.TagOptions
 	limitsCfg       *config.LimitsConfiguration
 	promReadMetrics promReadMetrics
 	timeoutOps      *prometheus.TimeoutOpts
+	resultsCache    cache.ResultsCache
 }
 
+// normalizeFn normalizes a query so that equivalent queries share
+// cached results.
+type normalizeFn func(query string) string
+
 type promReadMetrics struct {
 	fetchSuccess      tally.Counter
 	fetchErrorsServer tally.Counter
@@ -109,23 +131,58 @@ func NewPromReadHandler(
 	limitsCfg *config.LimitsConfiguration,
 	scope tally.Scope,
 	timeoutOpts *prometheus.TimeoutOpts,
+	resultsCache cache.ResultsCache,
 ) *PromReadHandler {
 	h := &PromReadHandler{
 		engine:          engine,
+		parseFn:         promql.Parse,
+		normalizeFn:     normalizePromQL,
 		tagOpts:         tagOpts,
 		limitsCfg:       limitsCfg,
 		promReadMetrics: newPromReadMetrics(scope),
 		timeoutOps:      timeoutOpts,
+		resultsCache:    resultsCache,
 	}
 
 	h.promReadMetrics.maxDatapoints.Update(float64(limitsCfg.MaxComputedDatapoints))
 	return h
 }
 
+// NewM3QLReadHandler returns a new instance of handler which evaluates
+// M3QL pipelines instead of PromQL expressions.
+func NewM3QLReadHandler(
+	engine *executor.Engine,
+	tagOpts models.TagOptions,
+	limitsCfg *config.LimitsConfiguration,
+	scope tally.Scope,
+	timeoutOpts *prometheus.TimeoutOpts,
+	resultsCache cache.ResultsCache,
+) *PromReadHandler {
+	h := NewPromReadHandler(engine, tagOpts, limitsCfg, scope, timeoutOpts, resultsCache)
+	h.parseFn = m3ql.Parse
+	h.normalizeFn = normalizeM3QL
+	return h
+}
+
+// normalizePromQL normalizes a PromQL query by re-printing its parsed
+// expression, falling back to the raw query if it fails to parse.
+func normalizePromQL(query string) string {
+	expr, err := pql.ParseExpr(query)
+	if err != nil {
+		return "promql:" + query
+	}
+	return "promql:" + expr.String()
+}
+
+// normalizeM3QL normalizes an M3QL query by collapsing whitespace.
+func normalizeM3QL(query string) string {
+	return "m3ql:" + strings.Join(strings.Fields(query), " ")
+}
+
 func (h *PromReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	timer := h.promReadMetrics.fetchTimerSuccess.Start()
 
-	result, params, respErr := h.ServeHTTPWithEngine(w, r, h.engine)
+	result, params, respErr := h.serveHTTPWithEngine(w, r, h.engine, h.resultsCache)
 	if respErr != nil {
 		httperrors.ErrorWithReqInfo(w, r, respErr.Code, respErr.Err)
 		return
@@ -145,10 +202,20 @@ func (h *PromReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	renderResultsJSON(w, result, params)
 }
 
-// ServeHTTPWithEngine returns query results from the storage
+// ServeHTTPWithEngine returns query results from the storage, bypassing
+// the results cache.
 func (h *PromReadHandler) ServeHTTPWithEngine(
 	w http.ResponseWriter,
 	r *http.Request, engine *executor.Engine,
+) ([]*ts.Series, models.RequestParams, *RespError) {
+	return h.serveHTTPWithEngine(w, r, engine, nil)
+}
+
+func (h *PromReadHandler) serveHTTPWithEngine(
+	w http.ResponseWriter,
+	r *http.Request,
+	engine *executor.Engine,
+	resultsCache cache.ResultsCache,
 ) ([]*ts.Series, models.RequestParams, *RespError) {
 	ctx := context.WithValue(r.Context(), handler.HeaderKey, r.Header)
 	logger := logging.WithContext(ctx)
@@ -168,7 +235,22 @@ func (h *PromReadHandler) ServeHTTPWithEngine(
 		return nil, emptyReqParams, &RespError{Err: err, Code: http.StatusBadRequest}
 	}
 
-	result, err := read(ctx, engine, h.tagOpts, w, params)
+	var (
+		result []*ts.Series
+		err    error
+	)
+	// NB: debug requests are not served from the results cache so that the
+	// query is always executed and logged.
+	if resultsCache != nil && !params.Debug && !hasResultHeaders(r.Header) {
+		result, err = readCached(ctx, engine, resultsCache,
+			h.normalizeFn(params.Query), h.parseFn, h.tagOpts, w, params)
+	} else {
+		result, err = read(ctx, engine, h.parseFn, h.tagOpts, w, params)
+	}
+	if parseErr, ok := err.(*xhttp.ParseError); ok {
+		h.promReadMetrics.fetchErrorsClient.Inc(1)
+		return nil, emptyReqParams, &RespError{Err: parseErr.Inner(), Code: parseErr.Code()}
+	}
 	if err != nil {
 		sp := opentracingutil.SpanFromContextOrNoop(ctx)
 		sp.LogFields(opentracinglog.Error(err))
@@ -181,6 +263,19 @@ func (h *PromReadHandler) ServeHTTPWithEngine(
 	return result, params, nil
 }
 
+// hasResultHeaders returns whether the request carries M3 headers, which are
+// forwarded to the storages and may change the results of the query, such
+// as limits or the type of metrics to query. Since headers are not part of
+// the cache key, such requests are not served from the results cache.
+func hasResultHeaders(header http.Header) bool {
+	for name := range header {
+		if strings.HasPrefix(http.CanonicalHeaderKey(name), resultHeaderPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (h *PromReadHandler) validateRequest(params *models.RequestParams) error {
 	// Impose a rough limit on the number of returned time series. This is intended to prevent things like
 	// querying from the beginning of time with a 1s step size.
@@ -29,18 +29,24 @@ import (
 
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/cache"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/models"
-	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/parser"
 	"github.com/m3db/m3/src/query/ts"
 	opentracingutil "github.com/m3db/m3/src/query/util/opentracing"
+	xhttp "github.com/m3db/m3/src/x/net/http"
 
 	opentracinglog "github.com/opentracing/opentracing-go/log"
 )
 
+// parseFn parses a query into a parser which can produce the query DAG.
+type parseFn func(query string, tagOpts models.TagOptions) (parser.Parser, error)
+
 func read(
 	reqCtx context.Context,
 	engine *executor.Engine,
+	parse parseFn,
 	tagOpts models.TagOptions,
 	w http.ResponseWriter,
 	params models.RequestParams,
@@ -48,6 +54,47 @@ func read(
 	ctx, cancel := context.WithTimeout(reqCtx, params.Timeout)
 	defer cancel()
 
+	// Detect clients closing connections
+	handler.CloseWatcher(ctx, cancel, w)
+
+	return execute(ctx, engine, parse, tagOpts, params)
+}
+
+// readCached reads the query through the results cache, the intervals of the
+// query which are computed share the deadline of the request.
+func readCached(
+	reqCtx context.Context,
+	engine *executor.Engine,
+	resultsCache cache.ResultsCache,
+	normalizedQuery string,
+	parse parseFn,
+	tagOpts models.TagOptions,
+	w http.ResponseWriter,
+	params models.RequestParams,
+) ([]*ts.Series, error) {
+	ctx, cancel := context.WithTimeout(reqCtx, params.Timeout)
+	defer cancel()
+
+	// Detect clients closing connections
+	handler.CloseWatcher(ctx, cancel, w)
+
+	query := cache.Query{
+		Normalized:       normalizedQuery,
+		LookbackDuration: engine.LookbackDuration(),
+	}
+	return resultsCache.Fetch(ctx, query, params,
+		func(ctx context.Context, params models.RequestParams) ([]*ts.Series, error) {
+			return execute(ctx, engine, parse, tagOpts, params)
+		})
+}
+
+func execute(
+	ctx context.Context,
+	engine *executor.Engine,
+	parse parseFn,
+	tagOpts models.TagOptions,
+	params models.RequestParams,
+) ([]*ts.Series, error) {
 	sp := opentracingutil.SpanFromContextOrNoop(ctx)
 	sp.LogFields(
 		opentracinglog.String("params.query", params.Query),
@@ -58,18 +105,23 @@ func read(
 	)
 
 	opts := &executor.EngineOptions{}
-	// Detect clients closing connections
-	handler.CloseWatcher(ctx, cancel, w)
-
 	// TODO: Capture timing
-	parser, err := promql.Parse(params.Query, tagOpts)
+	p, err := parse(params.Query, tagOpts)
 	if err != nil {
-		return nil, err
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
+	}
+
+	// Compile the query upfront so that queries which cannot be lowered into
+	// a DAG are reported as client errors rather than execution errors.
+	nodes, edges, err := p.DAG()
+	if err != nil {
+		return nil, xhttp.NewParseError(err, http.StatusBadRequest)
 	}
 
 	// Results is closed by execute
 	results := make(chan executor.Query)
-	go engine.ExecuteExpr(ctx, parser, opts, params, results)
+	compiled := compiledParser{Parser: p, nodes: nodes, edges: edges}
+	go engine.ExecuteExpr(ctx, compiled, opts, params, results)
 	// Block slices are sorted by start time
 	// TODO: Pooling
 	sortedBlockList := make([]blockWithMeta, 0, initialBlockAlloc)
@@ -135,6 +187,18 @@ func read(
 	return sortedBlocksToSeriesList(sortedBlockList)
 }
 
+// compiledParser returns the DAG already compiled from a query so that the
+// engine does not parse and lower the query again.
+type compiledParser struct {
+	parser.Parser
+	nodes parser.Nodes
+	edges parser.Edges
+}
+
+func (p compiledParser) DAG() (parser.Nodes, parser.Edges, error) {
+	return p.nodes, p.edges, nil
+}
+
 func drainResultChan(resultsChan chan executor.Query) {
 	for result := range resultsChan {
 		// Ignore errors during drain
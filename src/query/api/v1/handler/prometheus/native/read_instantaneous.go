@@ -28,6 +28,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/promql"
 	"github.com/m3db/m3/src/query/util/httperrors"
 	"github.com/m3db/m3/src/query/util/logging"
 
@@ -77,7 +78,7 @@ func (h *PromReadInstantHandler) ServeHTTP(w http.ResponseWriter, r *http.Reques
 		logger.Info("Request params", zap.Any("params", params))
 	}
 
-	result, err := read(ctx, h.engine, h.tagOpts, w, params)
+	result, err := read(ctx, h.engine, promql.Parse, h.tagOpts, w, params)
 	if err != nil {
 		logger.Error("unable to fetch data", zap.Error(err))
 		httperrors.ErrorWithReqInfo(w, r, http.StatusBadRequest, rErr)
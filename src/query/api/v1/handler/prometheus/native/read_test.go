@@ -32,10 +32,14 @@ import (
 
 	"github.com/m3db/m3/src/cmd/services/m3query/config"
 	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/cache"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/parser/promql"
 	"github.com/m3db/m3/src/query/storage/mock"
 	"github.com/m3db/m3/src/query/test"
+	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/util/logging"
 
 	"github.com/stretchr/testify/assert"
@@ -60,7 +64,7 @@ func TestPromReadHandler_Read(t *testing.T) {
 	r, parseErr := parseParams(req, timeoutOpts)
 	require.Nil(t, parseErr)
 	assert.Equal(t, models.FormatPromQL, r.FormatType)
-	seriesList, err := read(context.TODO(), promRead.engine, promRead.tagOpts, httptest.NewRecorder(), r)
+	seriesList, err := read(context.TODO(), promRead.engine, promql.Parse, promRead.tagOpts, httptest.NewRecorder(), r)
 	require.NoError(t, err)
 	require.Len(t, seriesList, 2)
 	s := seriesList[0]
@@ -71,6 +75,48 @@ func TestPromReadHandler_Read(t *testing.T) {
 	}
 }
 
+type countingParser struct {
+	parser.Parser
+	dagCalls int
+}
+
+func (p *countingParser) DAG() (parser.Nodes, parser.Edges, error) {
+	p.dagCalls++
+	return p.Parser.DAG()
+}
+
+func TestReadCompilesQueryOnce(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	values, bounds := test.GenerateValuesAndBounds(nil, nil)
+
+	setup := newTestSetup()
+	promRead := setup.Handler
+
+	b := test.NewBlockFromValues(bounds, values)
+	setup.Storage.SetFetchBlocksResult(block.Result{Blocks: []block.Block{b}}, nil)
+
+	req, _ := http.NewRequest("GET", PromReadURL, nil)
+	req.URL.RawQuery = defaultParams().Encode()
+
+	r, parseErr := parseParams(req, timeoutOpts)
+	require.Nil(t, parseErr)
+
+	var p *countingParser
+	parse := func(query string, tagOpts models.TagOptions) (parser.Parser, error) {
+		parsed, err := promql.Parse(query, tagOpts)
+		if err != nil {
+			return nil, err
+		}
+		p = &countingParser{Parser: parsed}
+		return p, nil
+	}
+	seriesList, err := read(context.TODO(), promRead.engine, parse, promRead.tagOpts, httptest.NewRecorder(), r)
+	require.NoError(t, err)
+	require.Len(t, seriesList, 2)
+	require.Equal(t, 1, p.dagCalls)
+}
+
 type M3QLResp []struct {
 	Target     string            `json:"target"`
 	Tags       map[string]string `json:"tags"`
@@ -108,6 +154,49 @@ func TestPromReadHandler_ReadM3QL(t *testing.T) {
 	assert.Equal(t, 10000, m3qlResp[1].StepSizeMs)
 }
 
+func TestM3QLReadHandler_Read(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	values, bounds := test.GenerateValuesAndBounds(nil, nil)
+
+	mockStorage := mock.NewMockStorage()
+	b := test.NewBlockFromValues(bounds, values)
+	mockStorage.SetFetchBlocksResult(block.Result{Blocks: []block.Block{b}}, nil)
+
+	m3qlRead := NewM3QLReadHandler(
+		executor.NewEngine(mockStorage, tally.NewTestScope("test", nil), time.Minute, nil),
+		models.NewTagOptions(),
+		&config.LimitsConfiguration{},
+		tally.NewTestScope("", nil),
+		timeoutOpts,
+		nil,
+	)
+
+	params := defaultParams()
+	params.Set(queryParam, "fetch name:dummy | abs")
+	req, _ := http.NewRequest("GET", M3QLReadURL, nil)
+	req.URL.RawQuery = params.Encode()
+
+	r, parseErr := parseParams(req, timeoutOpts)
+	require.Nil(t, parseErr)
+	seriesList, err := read(context.TODO(), m3qlRead.engine, m3qlRead.parseFn,
+		m3qlRead.tagOpts, httptest.NewRecorder(), r)
+	require.NoError(t, err)
+	require.Len(t, seriesList, 2)
+
+	params.Set(queryParam, "fetch name:dummy | unknown_function")
+	req.URL.RawQuery = params.Encode()
+	recorder := httptest.NewRecorder()
+	m3qlRead.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	params.Set(queryParam, "fetch name:dummy |")
+	req.URL.RawQuery = params.Encode()
+	recorder = httptest.NewRecorder()
+	m3qlRead.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
 func newReadRequest(t *testing.T, params url.Values) *http.Request {
 	req, err := http.NewRequest("GET", PromReadURL, nil)
 	require.NoError(t, err)
@@ -126,15 +215,78 @@ func newTestSetup() *testSetup {
 	return &testSetup{
 		Storage: mockStorage,
 		Handler: NewPromReadHandler(
-			executor.NewEngine(mockStorage, tally.NewTestScope("test", nil), time.Minute),
+			executor.NewEngine(mockStorage, tally.NewTestScope("test", nil), time.Minute, nil),
 			models.NewTagOptions(),
 			&config.LimitsConfiguration{},
 			tally.NewTestScope("", nil),
 			timeoutOpts,
+			nil,
 		),
 	}
 }
 
+type recordingResultsCache struct {
+	queries []string
+}
+
+func (c *recordingResultsCache) Fetch(
+	ctx context.Context,
+	query cache.Query,
+	params models.RequestParams,
+	fetchFn cache.FetchFn,
+) ([]*ts.Series, error) {
+	c.queries = append(c.queries, query.Normalized)
+	return fetchFn(ctx, params)
+}
+
+func TestPromReadHandler_ServeHTTP_resultsCache(t *testing.T) {
+	logging.InitWithCores(nil)
+
+	values, bounds := test.GenerateValuesAndBounds(nil, nil)
+
+	setup := newTestSetup()
+	resultsCache := &recordingResultsCache{}
+	setup.Handler.resultsCache = resultsCache
+
+	b := test.NewBlockFromValues(bounds, values)
+	setup.Storage.SetFetchBlocksResult(block.Result{Blocks: []block.Block{b}}, nil)
+
+	params := defaultParams()
+	params.Set(queryParam, "sum(  dummy )")
+	req := newReadRequest(t, params)
+
+	recorder := httptest.NewRecorder()
+	setup.Handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, []string{"promql:sum(dummy)"}, resultsCache.queries)
+
+	// Debug requests bypass the results cache.
+	params.Set(debugParam, "true")
+	recorder = httptest.NewRecorder()
+	setup.Handler.ServeHTTP(recorder, newReadRequest(t, params))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, 1, len(resultsCache.queries))
+	params.Del(debugParam)
+
+	// The debug endpoint path bypasses the results cache.
+	_, _, respErr := setup.Handler.ServeHTTPWithEngine(httptest.NewRecorder(),
+		newReadRequest(t, params), setup.Handler.engine)
+	require.Nil(t, respErr)
+	assert.Equal(t, 1, len(resultsCache.queries))
+
+	// Requests with M3 headers bypass the results cache.
+	req = newReadRequest(t, params)
+	req.Header.Set("M3-Metrics-Type", "aggregated")
+	recorder = httptest.NewRecorder()
+	setup.Handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, 1, len(resultsCache.queries))
+}
+
+func TestNormalizeM3QL(t *testing.T) {
+	assert.Equal(t, "m3ql:fetch name:foo | abs", normalizeM3QL(" fetch  name:foo |\tabs "))
+}
+
 func TestPromReadHandler_ServeHTTP_maxComputedDatapoints(t *testing.T) {
 	setup := newTestSetup()
 	setup.Handler.limitsCfg = &config.LimitsConfiguration{
@@ -30,7 +30,9 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/util/logging"
 	xhttp "github.com/m3db/m3/src/x/net/http"
 
@@ -51,14 +53,26 @@ const (
 // PromReadHandler represents a handler for prometheus read endpoint.
 type PromReadHandler struct {
 	engine          *executor.Engine
+	querier         m3.Querier
+	tagOptions      models.TagOptions
 	promReadMetrics promReadMetrics
 	timeoutOpts     *prometheus.TimeoutOpts
 }
 
-// NewPromReadHandler returns a new instance of handler.
-func NewPromReadHandler(engine *executor.Engine, scope tally.Scope, timeoutOpts *prometheus.TimeoutOpts) http.Handler {
+// NewPromReadHandler returns a new instance of handler. If querier is not nil
+// streamed XOR chunk responses are encoded directly from compressed series
+// rather than from series decoded by the engine.
+func NewPromReadHandler(
+	engine *executor.Engine,
+	querier m3.Querier,
+	tagOptions models.TagOptions,
+	scope tally.Scope,
+	timeoutOpts *prometheus.TimeoutOpts,
+) http.Handler {
 	return &PromReadHandler{
 		engine:          engine,
+		querier:         querier,
+		tagOptions:      tagOptions,
 		promReadMetrics: newPromReadMetrics(scope),
 		timeoutOpts:     timeoutOpts,
 	}
@@ -96,6 +110,12 @@ func (h *PromReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	responseType := negotiateResponseType(req.AcceptedResponseTypes)
+	if responseType == prompb.ReadRequest_STREAMED_XOR_CHUNKS {
+		h.serveStreamed(ctx, w, req, timeout)
+		return
+	}
+
 	result, err := h.read(ctx, w, req, timeout)
 	if err != nil {
 		h.promReadMetrics.fetchErrorsServer.Inc(1)
@@ -130,6 +150,34 @@ func (h *PromReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	h.promReadMetrics.fetchSuccess.Inc(1)
 }
 
+func (h *PromReadHandler) serveStreamed(
+	ctx context.Context,
+	w http.ResponseWriter,
+	req *prompb.ReadRequest,
+	timeout time.Duration,
+) {
+	logger := logging.WithContext(ctx)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	w.Header().Set("Content-Type", streamedContentType)
+
+	writer := newChunkedWriter(w)
+	if err := h.readStreamed(ctx, w, writer, req); err != nil {
+		h.promReadMetrics.fetchErrorsServer.Inc(1)
+		logger.Error("unable to stream read results", zap.Any("error", err))
+		// Once a frame has been written the status code has been sent and
+		// the client will detect the truncated stream instead.
+		if !writer.written {
+			w.Header().Set("Content-Type", "application/json")
+			xhttp.Error(w, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	h.promReadMetrics.fetchSuccess.Inc(1)
+}
+
 func (h *PromReadHandler) parseRequest(
 	r *http.Request,
 ) (*prompb.ReadRequest, *xhttp.ParseError) {
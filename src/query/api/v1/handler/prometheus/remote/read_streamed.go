@@ -0,0 +1,331 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package remote
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"net/http"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/tsdb/chunkenc"
+)
+
+const (
+	// streamedContentType is the content type of a streamed chunked response.
+	streamedContentType = "application/x-streamed-protobuf; proto=prometheus.ChunkedReadResponse"
+
+	// maxSamplesPerChunk is the number of samples encoded in a single XOR
+	// chunk, matching the chunk size Prometheus itself cuts at.
+	maxSamplesPerChunk = 120
+)
+
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// negotiateResponseType returns the first response type accepted by the
+// client that the handler supports, defaulting to samples.
+func negotiateResponseType(
+	accepted []prompb.ReadRequest_ResponseType,
+) prompb.ReadRequest_ResponseType {
+	for _, responseType := range accepted {
+		switch responseType {
+		case prompb.ReadRequest_SAMPLES, prompb.ReadRequest_STREAMED_XOR_CHUNKS:
+			return responseType
+		}
+	}
+
+	return prompb.ReadRequest_SAMPLES
+}
+
+// chunkedWriter writes length and checksum delimited ChunkedReadResponse
+// frames, flushing after each frame so clients can decode series as they
+// arrive.
+type chunkedWriter struct {
+	writer  io.Writer
+	flusher http.Flusher
+	header  [binary.MaxVarintLen32 + crc32.Size]byte
+	written bool
+}
+
+func newChunkedWriter(w io.Writer) *chunkedWriter {
+	flusher, _ := w.(http.Flusher)
+	return &chunkedWriter{
+		writer:  w,
+		flusher: flusher,
+	}
+}
+
+func (w *chunkedWriter) write(resp *prompb.ChunkedReadResponse) error {
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	n := binary.PutUvarint(w.header[:], uint64(len(data)))
+	binary.BigEndian.PutUint32(w.header[n:], crc32.Checksum(data, castagnoliTable))
+
+	w.written = true
+	if _, err := w.writer.Write(w.header[:n+crc32.Size]); err != nil {
+		return err
+	}
+
+	if _, err := w.writer.Write(data); err != nil {
+		return err
+	}
+
+	if w.flusher != nil {
+		w.flusher.Flush()
+	}
+
+	return nil
+}
+
+// sampleIterator iterates over the samples of a single series in time order.
+type sampleIterator interface {
+	Next() bool
+	At() (int64, float64)
+	Err() error
+}
+
+// seriesIteratorSamples adapts a compressed series iterator, decoding one
+// datapoint at a time rather than materializing the series.
+type seriesIteratorSamples struct {
+	iter encoding.SeriesIterator
+}
+
+func (s seriesIteratorSamples) Next() bool { return s.iter.Next() }
+func (s seriesIteratorSamples) Err() error { return s.iter.Err() }
+func (s seriesIteratorSamples) At() (int64, float64) {
+	dp, _, _ := s.iter.Current()
+	return storage.TimeToTimestamp(dp.Timestamp), dp.Value
+}
+
+// datapointSamples adapts already decoded datapoints.
+type datapointSamples struct {
+	datapoints ts.Datapoints
+	idx        int
+	current    ts.Datapoint
+}
+
+func (s *datapointSamples) Next() bool {
+	if s.idx >= len(s.datapoints) {
+		return false
+	}
+
+	s.current = s.datapoints[s.idx]
+	s.idx++
+	return true
+}
+
+func (s *datapointSamples) Err() error { return nil }
+func (s *datapointSamples) At() (int64, float64) {
+	return storage.TimeToTimestamp(s.current.Timestamp), s.current.Value
+}
+
+// encodeChunks re-encodes the samples of a series into XOR chunks of at
+// most maxSamplesPerChunk samples each.
+func encodeChunks(iter sampleIterator) ([]*prompb.Chunk, error) {
+	var (
+		chunks   []*prompb.Chunk
+		chunk    *chunkenc.XORChunk
+		appender chunkenc.Appender
+		current  *prompb.Chunk
+	)
+
+	for iter.Next() {
+		t, v := iter.At()
+		if chunk == nil {
+			var err error
+			chunk = chunkenc.NewXORChunk()
+			appender, err = chunk.Appender()
+			if err != nil {
+				return nil, err
+			}
+
+			current = &prompb.Chunk{
+				MinTimeMs: t,
+				Type:      prompb.Chunk_XOR,
+			}
+		}
+
+		appender.Append(t, v)
+		current.MaxTimeMs = t
+		if chunk.NumSamples() >= maxSamplesPerChunk {
+			current.Data = chunk.Bytes()
+			chunks = append(chunks, current)
+			chunk = nil
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	if chunk != nil {
+		current.Data = chunk.Bytes()
+		chunks = append(chunks, current)
+	}
+
+	return chunks, nil
+}
+
+func (h *PromReadHandler) readStreamed(
+	ctx context.Context,
+	w http.ResponseWriter,
+	writer *chunkedWriter,
+	r *prompb.ReadRequest,
+) error {
+	for i, promQuery := range r.Queries {
+		query, err := storage.PromReadQueryToM3(promQuery)
+		if err != nil {
+			return err
+		}
+
+		queryIndex := int64(i)
+		if h.querier != nil {
+			err = h.streamCompressed(ctx, writer, query, queryIndex)
+		} else {
+			err = h.streamDecoded(ctx, w, writer, query, queryIndex)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// streamCompressed streams series directly from compressed series
+// iterators, avoiding decoding whole series into memory.
+func (h *PromReadHandler) streamCompressed(
+	ctx context.Context,
+	writer *chunkedWriter,
+	query *storage.FetchQuery,
+	queryIndex int64,
+) error {
+	// Charge fetched series and decoded datapoints against the same per
+	// query and global limits the engine applies to decoded reads.
+	enforcer := h.engine.GlobalEnforcer().QueryEnforcer()
+	defer enforcer.Close()
+
+	fetchOpts := storage.NewFetchOptions()
+	fetchOpts.Limit = 0
+	fetchOpts.Enforcer = enforcer
+	iters, cleanup, err := h.querier.FetchCompressed(ctx, query, fetchOpts)
+	if err != nil {
+		return err
+	}
+
+	defer cleanup()
+	for _, iter := range iters.Iters() {
+		tags, err := storage.FromIdentTagIteratorToTags(iter.Tags(), h.tagOptions)
+		if err != nil {
+			return err
+		}
+
+		chunks, err := encodeChunks(seriesIteratorSamples{iter: iter})
+		if err != nil {
+			return err
+		}
+
+		if err := writer.write(&prompb.ChunkedReadResponse{
+			ChunkedSeries: []*prompb.ChunkedSeries{
+				{
+					Labels: storage.TagsToPromLabels(tags),
+					Chunks: chunks,
+				},
+			},
+			QueryIndex: queryIndex,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// streamDecoded streams series fetched through the engine, used when no
+// compressed querier is available.
+func (h *PromReadHandler) streamDecoded(
+	ctx context.Context,
+	w http.ResponseWriter,
+	writer *chunkedWriter,
+	query *storage.FetchQuery,
+	queryIndex int64,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Results is closed by execute
+	results := make(chan *storage.QueryResult)
+
+	opts := &executor.EngineOptions{}
+	// Detect clients closing connections
+	handler.CloseWatcher(ctx, cancel, w)
+	go h.engine.Execute(ctx, query, opts, results)
+
+	var err error
+	for result := range results {
+		// Continue draining results so that Execute is able to return.
+		if err != nil {
+			continue
+		}
+
+		if result.Err != nil {
+			err = result.Err
+			continue
+		}
+
+		for _, series := range result.FetchResult.SeriesList {
+			var chunks []*prompb.Chunk
+			chunks, err = encodeChunks(&datapointSamples{
+				datapoints: series.Values().Datapoints(),
+			})
+			if err != nil {
+				break
+			}
+
+			err = writer.write(&prompb.ChunkedReadResponse{
+				ChunkedSeries: []*prompb.ChunkedSeries{
+					{
+						Labels: storage.TagsToPromLabels(series.Tags),
+						Chunks: chunks,
+					},
+				},
+				QueryIndex: queryIndex,
+			})
+			if err != nil {
+				break
+			}
+		}
+	}
+
+	return err
+}
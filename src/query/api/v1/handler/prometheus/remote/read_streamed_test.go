@@ -0,0 +1,295 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package remote
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/test"
+	testm3 "github.com/m3db/m3/src/query/test/m3"
+	"github.com/m3db/m3/src/query/test/seriesiter"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/query/util/logging"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/prometheus/tsdb/chunkenc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+type compressedQuerier struct {
+	iters encoding.SeriesIterators
+}
+
+func (q *compressedQuerier) FetchCompressed(
+	_ context.Context,
+	_ *storage.FetchQuery,
+	_ *storage.FetchOptions,
+) (encoding.SeriesIterators, m3.Cleanup, error) {
+	return q.iters, func() error { return nil }, nil
+}
+
+func (q *compressedQuerier) SearchCompressed(
+	_ context.Context,
+	_ *storage.FetchQuery,
+	_ *storage.FetchOptions,
+) ([]m3.MultiTagResult, m3.Cleanup, error) {
+	return nil, func() error { return nil }, nil
+}
+
+func decodeChunk(t *testing.T, chunk *prompb.Chunk) []prompb.Sample {
+	require.Equal(t, prompb.Chunk_XOR, chunk.Type)
+	c, err := chunkenc.FromData(chunkenc.EncXOR, chunk.Data)
+	require.NoError(t, err)
+
+	var samples []prompb.Sample
+	it := c.Iterator()
+	for it.Next() {
+		ts, v := it.At()
+		samples = append(samples, prompb.Sample{Timestamp: ts, Value: v})
+	}
+
+	require.NoError(t, it.Err())
+	return samples
+}
+
+func readFrames(t *testing.T, r io.Reader) []*prompb.ChunkedReadResponse {
+	var (
+		reader    = bufio.NewReader(r)
+		responses []*prompb.ChunkedReadResponse
+	)
+
+	for {
+		size, err := binary.ReadUvarint(reader)
+		if err == io.EOF {
+			return responses
+		}
+
+		require.NoError(t, err)
+		var checksum [crc32.Size]byte
+		_, err = io.ReadFull(reader, checksum[:])
+		require.NoError(t, err)
+
+		data := make([]byte, size)
+		_, err = io.ReadFull(reader, data)
+		require.NoError(t, err)
+		require.Equal(t, binary.BigEndian.Uint32(checksum[:]),
+			crc32.Checksum(data, castagnoliTable))
+
+		var resp prompb.ChunkedReadResponse
+		require.NoError(t, proto.Unmarshal(data, &resp))
+		responses = append(responses, &resp)
+	}
+}
+
+func TestNegotiateResponseType(t *testing.T) {
+	assert.Equal(t, prompb.ReadRequest_SAMPLES, negotiateResponseType(nil))
+	assert.Equal(t, prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+		negotiateResponseType([]prompb.ReadRequest_ResponseType{
+			prompb.ReadRequest_ResponseType(10),
+			prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+			prompb.ReadRequest_SAMPLES,
+		}))
+	assert.Equal(t, prompb.ReadRequest_SAMPLES,
+		negotiateResponseType([]prompb.ReadRequest_ResponseType{
+			prompb.ReadRequest_SAMPLES,
+			prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+		}))
+}
+
+func TestEncodeChunks(t *testing.T) {
+	start := time.Unix(1000, 0)
+	datapoints := make(ts.Datapoints, 0, 250)
+	for i := 0; i < 250; i++ {
+		datapoints = append(datapoints, ts.Datapoint{
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+			Value:     float64(i),
+		})
+	}
+
+	datapoints[5].Value = math.NaN()
+	chunks, err := encodeChunks(&datapointSamples{datapoints: datapoints})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(chunks))
+
+	var decoded []prompb.Sample
+	for i, chunk := range chunks {
+		samples := decodeChunk(t, chunk)
+		assert.Equal(t, samples[0].Timestamp, chunk.MinTimeMs)
+		assert.Equal(t, samples[len(samples)-1].Timestamp, chunk.MaxTimeMs)
+		if i < 2 {
+			assert.Equal(t, maxSamplesPerChunk, len(samples))
+		}
+
+		decoded = append(decoded, samples...)
+	}
+
+	require.Equal(t, len(datapoints), len(decoded))
+	for i, dp := range datapoints {
+		assert.Equal(t, storage.TimeToTimestamp(dp.Timestamp), decoded[i].Timestamp)
+		if i == 5 {
+			assert.True(t, math.IsNaN(decoded[i].Value))
+			continue
+		}
+
+		assert.Equal(t, dp.Value, decoded[i].Value)
+	}
+}
+
+func TestEncodeChunksEmpty(t *testing.T) {
+	chunks, err := encodeChunks(&datapointSamples{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(chunks))
+}
+
+func TestPromReadStreamedCompressed(t *testing.T) {
+	logging.InitWithCores(nil)
+	iter, err := test.BuildTestSeriesIterator()
+	require.NoError(t, err)
+
+	promRead := &PromReadHandler{
+		engine:          executor.NewEngine(nil, tally.NewTestScope("test", nil), defaultLookbackDuration, nil),
+		querier:         &compressedQuerier{iters: encoding.NewSeriesIterators([]encoding.SeriesIterator{iter}, nil)},
+		tagOptions:      models.NewTagOptions(),
+		promReadMetrics: promReadTestMetrics,
+		timeoutOpts:     timeoutOpts,
+	}
+
+	readReq := test.GeneratePromReadRequest()
+	readReq.AcceptedResponseTypes = []prompb.ReadRequest_ResponseType{
+		prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+	}
+
+	data, err := proto.Marshal(readReq)
+	require.NoError(t, err)
+	req, err := http.NewRequest(PromReadHTTPMethod, PromReadURL,
+		bytes.NewReader(snappy.Encode(nil, data)))
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	promRead.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, streamedContentType, recorder.Header().Get("Content-Type"))
+
+	responses := readFrames(t, recorder.Body)
+	require.Equal(t, 1, len(responses))
+	assert.Equal(t, int64(0), responses[0].QueryIndex)
+	require.Equal(t, 1, len(responses[0].ChunkedSeries))
+
+	series := responses[0].ChunkedSeries[0]
+	labels := make(map[string]string, len(series.Labels))
+	for _, l := range series.Labels {
+		labels[string(l.Name)] = string(l.Value)
+	}
+
+	assert.Equal(t, test.TestTags, labels)
+
+	var samples []prompb.Sample
+	for _, chunk := range series.Chunks {
+		samples = append(samples, decodeChunk(t, chunk)...)
+	}
+
+	// The test iterator holds values 3..30 and 101..130.
+	require.Equal(t, 58, len(samples))
+	assert.Equal(t, float64(3), samples[0].Value)
+	assert.Equal(t, float64(130), samples[len(samples)-1].Value)
+}
+
+func TestPromReadStreamedCompressedEnforcesLimits(t *testing.T) {
+	logging.InitWithCores(nil)
+	tests := []struct {
+		name       string
+		thresholds cost.Thresholds
+		err        string
+	}{
+		{
+			name:       "fetched series",
+			thresholds: cost.Thresholds{cost.FetchedSeries: 2},
+			err:        "fetched-series limit",
+		},
+		{
+			name:       "decoded datapoints",
+			thresholds: cost.Thresholds{cost.DecodedDatapoints: 3},
+			err:        "decoded-datapoints limit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store, session := testm3.NewStorageAndSession(t, ctrl)
+			session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(seriesiter.NewMockSeriesIters(ctrl, seriesiter.GenerateTag(), 2, 4), true, nil)
+			session.EXPECT().IteratorPools().Return(nil, nil)
+
+			querier, ok := store.(m3.Querier)
+			require.True(t, ok)
+
+			globalEnforcer := cost.NewGlobalEnforcer(nil,
+				cost.NewStaticLimitManagers(tt.thresholds, nil), nil)
+			promRead := &PromReadHandler{
+				engine: executor.NewEngine(store, tally.NewTestScope("test", nil),
+					defaultLookbackDuration, globalEnforcer),
+				querier:         querier,
+				tagOptions:      models.NewTagOptions(),
+				promReadMetrics: promReadTestMetrics,
+				timeoutOpts:     timeoutOpts,
+			}
+
+			readReq := test.GeneratePromReadRequest()
+			readReq.AcceptedResponseTypes = []prompb.ReadRequest_ResponseType{
+				prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+			}
+
+			data, err := proto.Marshal(readReq)
+			require.NoError(t, err)
+			req, err := http.NewRequest(PromReadHTTPMethod, PromReadURL,
+				bytes.NewReader(snappy.Encode(nil, data)))
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			promRead.ServeHTTP(recorder, req)
+			assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tt.err)
+		})
+	}
+}
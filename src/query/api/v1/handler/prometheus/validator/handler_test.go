@@ -301,11 +301,12 @@ func newServer() (*httptest.Server, *PromDebugHandler) {
 	mockStorage := mock.NewMockStorage()
 	debugHandler := NewPromDebugHandler(
 		native.NewPromReadHandler(
-			executor.NewEngine(mockStorage, tally.NewTestScope("test_engine", nil), defaultLookbackDuration),
+			executor.NewEngine(mockStorage, tally.NewTestScope("test_engine", nil), defaultLookbackDuration, nil),
 			models.NewTagOptions(),
 			&config.LimitsConfiguration{},
 			tally.NewTestScope("test", nil),
 			timeoutOpts,
+			nil,
 		), tally.NewTestScope("test", nil),
 		defaultLookbackDuration,
 	)
@@ -72,6 +72,12 @@ func RegisterRoutes(r *mux.Router, client clusterclient.Client, cfg config.Confi
 		Methods(GetHTTPMethod)
 	r.HandleFunc(AddURL, wrapped(NewAddHandler(client, cfg)).ServeHTTP).
 		Methods(AddHTTPMethod)
+	r.HandleFunc(UpdateURL, wrapped(NewUpdateHandler(client, cfg)).ServeHTTP).
+		Methods(UpdateHTTPMethod)
+	r.HandleFunc(DeleteURL, wrapped(NewDeleteHandler(client, cfg)).ServeHTTP).
+		Methods(DeleteHTTPMethod)
+	r.HandleFunc(DeleteConsumerServiceURL, wrapped(NewDeleteConsumerServiceHandler(client, cfg)).ServeHTTP).
+		Methods(DeleteConsumerServiceHTTPMethod)
 }
 
 func topicName(headers http.Header) string {
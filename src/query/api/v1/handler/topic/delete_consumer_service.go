@@ -0,0 +1,154 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package topic
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+
+	clusterclient "github.com/m3db/m3/src/cluster/client"
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/msg/topic"
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/net/http"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const (
+	consumerServiceVar            = "consumerService"
+	consumerServiceEnvironmentVar = "environment"
+	consumerServiceZoneVar        = "zone"
+
+	// DeleteConsumerServiceHTTPMethod is the HTTP method used with this resource.
+	DeleteConsumerServiceHTTPMethod = http.MethodDelete
+)
+
+var (
+	// DeleteConsumerServiceURL is the url for the handler removing a consumer
+	// service from a topic (with the DELETE method).
+	DeleteConsumerServiceURL = path.Join(handler.RoutePrefixV1, "topic",
+		fmt.Sprintf("{%s}", consumerServiceVar))
+)
+
+// DeleteConsumerServiceHandler is the handler for removing consumer services
+// from a topic.
+type DeleteConsumerServiceHandler Handler
+
+// NewDeleteConsumerServiceHandler returns a new instance of DeleteConsumerServiceHandler.
+func NewDeleteConsumerServiceHandler(
+	client clusterclient.Client,
+	cfg config.Configuration,
+) *DeleteConsumerServiceHandler {
+	return &DeleteConsumerServiceHandler{client: client, cfg: cfg, serviceFn: Service}
+}
+
+func (h *DeleteConsumerServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx         = r.Context()
+		logger      = logging.WithContext(ctx)
+		name        = mux.Vars(r)[consumerServiceVar]
+		environment = r.FormValue(consumerServiceEnvironmentVar)
+		zone        = r.FormValue(consumerServiceZoneVar)
+	)
+
+	service, err := h.serviceFn(h.client)
+	if err != nil {
+		logger.Error("unable to get service", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	t, err := service.Get(topicName(r.Header))
+	if err != nil {
+		logger.Error("unable to get topic", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusNotFound)
+		return
+	}
+
+	var matches []topic.ConsumerService
+	for _, cs := range t.ConsumerServices() {
+		sid := cs.ServiceID()
+		if sid.Name() != name {
+			continue
+		}
+		if environment != "" && sid.Environment() != environment {
+			continue
+		}
+		if zone != "" && sid.Zone() != zone {
+			continue
+		}
+		matches = append(matches, cs)
+	}
+
+	switch len(matches) {
+	case 0:
+		err := fmt.Errorf("could not find consumer service %s in the topic", name)
+		logger.Error("unable to find consumer service", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusNotFound)
+		return
+	case 1:
+	default:
+		err := fmt.Errorf("found %d consumer services named %s, specify %s and %s to select one",
+			len(matches), name, consumerServiceEnvironmentVar, consumerServiceZoneVar)
+		logger.Error("ambiguous consumer service", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	t, err = t.RemoveConsumerService(matches[0].ServiceID())
+	if err != nil {
+		logger.Error("unable to remove consumer service", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusBadRequest)
+		return
+	}
+
+	t, err = service.CheckAndSet(t, t.Version())
+	if err == kv.ErrVersionMismatch {
+		logger.Error("topic changed while removing consumer service", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusConflict)
+		return
+	}
+	if err != nil {
+		logger.Error("unable to persist topic", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	topicProto, err := topic.ToProto(t)
+	if err != nil {
+		logger.Error("unable to get topic protobuf", zap.Any("error", err))
+		xhttp.Error(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	resp := &admin.TopicGetResponse{
+		Topic:   topicProto,
+		Version: uint32(t.Version()),
+	}
+
+	xhttp.WriteProtoMsgJSONResponse(w, resp, logger)
+}
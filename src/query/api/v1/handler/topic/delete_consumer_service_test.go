@@ -0,0 +1,179 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package topic
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/services"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/msg/generated/proto/topicpb"
+	"github.com/m3db/m3/src/msg/topic"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+func testTopicWithConsumerServices() topic.Topic {
+	return topic.NewTopic().
+		SetName(DefaultTopicName).
+		SetNumberOfShards(256).
+		SetVersion(2).
+		SetConsumerServices([]topic.ConsumerService{
+			topic.NewConsumerService().
+				SetServiceID(services.NewServiceID().
+					SetName("m3coordinator").SetEnvironment("env1").SetZone("zone1")).
+				SetConsumptionType(topic.Shared),
+			topic.NewConsumerService().
+				SetServiceID(services.NewServiceID().
+					SetName("m3coordinator").SetEnvironment("env2").SetZone("zone1")).
+				SetConsumptionType(topic.Shared),
+			topic.NewConsumerService().
+				SetServiceID(services.NewServiceID().
+					SetName("m3aggregator").SetEnvironment("env1").SetZone("zone1")).
+				SetConsumptionType(topic.Replicated),
+		})
+}
+
+func TestTopicDeleteConsumerServiceHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	handler := NewDeleteConsumerServiceHandler(nil, config.Configuration{})
+	handler.serviceFn = testServiceFn(mockService)
+
+	mockService.EXPECT().Get(DefaultTopicName).Return(testTopicWithConsumerServices(), nil)
+	mockService.EXPECT().
+		CheckAndSet(gomock.Any(), 2).
+		DoAndReturn(func(updated topic.Topic, version int) (topic.Topic, error) {
+			return updated.SetVersion(version + 1), nil
+		})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("DELETE", "/topic/m3aggregator", nil)
+	require.NotNil(t, req)
+	req = mux.SetURLVars(req, map[string]string{"consumerService": "m3aggregator"})
+	handler.ServeHTTP(w, req)
+
+	resp := w.Result()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	var respProto admin.TopicGetResponse
+	require.NoError(t, jsonUnmarshaler.Unmarshal(bytes.NewBuffer(body), &respProto))
+
+	validateEqualTopicProto(t, topicpb.Topic{
+		Name:           DefaultTopicName,
+		NumberOfShards: 256,
+		ConsumerServices: []*topicpb.ConsumerService{
+			&topicpb.ConsumerService{
+				ConsumptionType: topicpb.ConsumptionType_SHARED,
+				ServiceId: &topicpb.ServiceID{
+					Name:        "m3coordinator",
+					Environment: "env1",
+					Zone:        "zone1",
+				},
+			},
+			&topicpb.ConsumerService{
+				ConsumptionType: topicpb.ConsumptionType_SHARED,
+				ServiceId: &topicpb.ServiceID{
+					Name:        "m3coordinator",
+					Environment: "env2",
+					Zone:        "zone1",
+				},
+			},
+		},
+	}, *respProto.Topic)
+	require.Equal(t, uint32(3), respProto.Version)
+}
+
+func TestTopicDeleteConsumerServiceHandlerWithEnvironment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	handler := NewDeleteConsumerServiceHandler(nil, config.Configuration{})
+	handler.serviceFn = testServiceFn(mockService)
+
+	// Ambiguous without an environment.
+	mockService.EXPECT().Get(DefaultTopicName).Return(testTopicWithConsumerServices(), nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("DELETE", "/topic/m3coordinator", nil)
+	req = mux.SetURLVars(req, map[string]string{"consumerService": "m3coordinator"})
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	// Unique with an environment.
+	mockService.EXPECT().Get(DefaultTopicName).Return(testTopicWithConsumerServices(), nil)
+	mockService.EXPECT().
+		CheckAndSet(gomock.Any(), 2).
+		DoAndReturn(func(updated topic.Topic, version int) (topic.Topic, error) {
+			require.Len(t, updated.ConsumerServices(), 2)
+			for _, cs := range updated.ConsumerServices() {
+				require.NotEqual(t, "env2", cs.ServiceID().Environment())
+			}
+			return updated.SetVersion(version + 1), nil
+		})
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("DELETE", "/topic/m3coordinator?environment=env2", nil)
+	req = mux.SetURLVars(req, map[string]string{"consumerService": "m3coordinator"})
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+}
+
+func TestTopicDeleteConsumerServiceHandlerErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	handler := NewDeleteConsumerServiceHandler(nil, config.Configuration{})
+	handler.serviceFn = testServiceFn(mockService)
+
+	// Unknown consumer service.
+	mockService.EXPECT().Get(DefaultTopicName).Return(testTopicWithConsumerServices(), nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("DELETE", "/topic/foo", nil)
+	req = mux.SetURLVars(req, map[string]string{"consumerService": "foo"})
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+
+	// Topic changed concurrently.
+	mockService.EXPECT().Get(DefaultTopicName).Return(testTopicWithConsumerServices(), nil)
+	mockService.EXPECT().CheckAndSet(gomock.Any(), 2).Return(nil, kv.ErrVersionMismatch)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("DELETE", "/topic/m3aggregator", nil)
+	req = mux.SetURLVars(req, map[string]string{"consumerService": "m3aggregator"})
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+}
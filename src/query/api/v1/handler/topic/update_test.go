@@ -0,0 +1,147 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package topic
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/msg/generated/proto/topicpb"
+	"github.com/m3db/m3/src/msg/topic"
+	"github.com/m3db/m3/src/query/generated/proto/admin"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicUpdateHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	handler := NewUpdateHandler(nil, config.Configuration{})
+	handler.serviceFn = testServiceFn(mockService)
+
+	updateProto := admin.TopicUpdateRequest{
+		ConsumerService: &topicpb.ConsumerService{
+			ConsumptionType: topicpb.ConsumptionType_REPLICATED,
+			ServiceId: &topicpb.ServiceID{
+				Name:        "m3aggregator",
+				Environment: "env1",
+				Zone:        "zone1",
+			},
+			MessageTtlNanos: int64(10 * time.Minute),
+		},
+	}
+	b := bytes.NewBuffer(nil)
+	require.NoError(t, jsonMarshaler.Marshal(b, &updateProto))
+
+	mockService.EXPECT().Get(DefaultTopicName).Return(testTopicWithConsumerServices(), nil)
+	mockService.EXPECT().CheckAndSet(gomock.Any(), 2).Return(testTopicWithConsumerServices().SetVersion(3), nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("PUT", "/topic", b)
+	require.NotNil(t, req)
+	handler.ServeHTTP(w, req)
+
+	resp := w.Result()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+	var respProto admin.TopicGetResponse
+	require.NoError(t, jsonUnmarshaler.Unmarshal(bytes.NewBuffer(body), &respProto))
+	require.Equal(t, uint32(3), respProto.Version)
+}
+
+func TestTopicUpdateHandlerPersistsMessageTTL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	handler := NewUpdateHandler(nil, config.Configuration{})
+	handler.serviceFn = testServiceFn(mockService)
+
+	updateProto := admin.TopicUpdateRequest{
+		ConsumerService: &topicpb.ConsumerService{
+			ConsumptionType: topicpb.ConsumptionType_SHARED,
+			ServiceId: &topicpb.ServiceID{
+				Name:        "m3coordinator",
+				Environment: "env2",
+				Zone:        "zone1",
+			},
+			MessageTtlNanos: int64(time.Minute),
+		},
+	}
+	b := bytes.NewBuffer(nil)
+	require.NoError(t, jsonMarshaler.Marshal(b, &updateProto))
+
+	mockService.EXPECT().Get(DefaultTopicName).Return(testTopicWithConsumerServices(), nil)
+	mockService.EXPECT().
+		CheckAndSet(gomock.Any(), 2).
+		DoAndReturn(func(updated topic.Topic, version int) (topic.Topic, error) {
+			css := updated.ConsumerServices()
+			require.Len(t, css, 3)
+			require.Equal(t, int64(0), css[0].MessageTTLNanos())
+			require.Equal(t, "env2", css[1].ServiceID().Environment())
+			require.Equal(t, int64(time.Minute), css[1].MessageTTLNanos())
+			return updated.SetVersion(version + 1), nil
+		})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("PUT", "/topic", b)
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+}
+
+func TestTopicUpdateHandlerConsumptionTypeChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := setupTest(t, ctrl)
+	handler := NewUpdateHandler(nil, config.Configuration{})
+	handler.serviceFn = testServiceFn(mockService)
+
+	updateProto := admin.TopicUpdateRequest{
+		ConsumerService: &topicpb.ConsumerService{
+			ConsumptionType: topicpb.ConsumptionType_SHARED,
+			ServiceId: &topicpb.ServiceID{
+				Name:        "m3aggregator",
+				Environment: "env1",
+				Zone:        "zone1",
+			},
+		},
+	}
+	b := bytes.NewBuffer(nil)
+	require.NoError(t, jsonMarshaler.Marshal(b, &updateProto))
+
+	mockService.EXPECT().Get(DefaultTopicName).Return(testTopicWithConsumerServices(), nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("PUT", "/topic", b)
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
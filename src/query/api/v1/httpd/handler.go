@@ -35,6 +35,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler"
 	"github.com/m3db/m3/src/query/api/v1/handler/database"
 	"github.com/m3db/m3/src/query/api/v1/handler/graphite"
+	"github.com/m3db/m3/src/query/api/v1/handler/influxdb"
 	m3json "github.com/m3db/m3/src/query/api/v1/handler/json"
 	"github.com/m3db/m3/src/query/api/v1/handler/namespace"
 	"github.com/m3db/m3/src/query/api/v1/handler/openapi"
@@ -44,6 +45,7 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/remote"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/validator"
 	"github.com/m3db/m3/src/query/api/v1/handler/topic"
+	"github.com/m3db/m3/src/query/cache"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
@@ -51,6 +53,7 @@ import (
 	"github.com/m3db/m3/src/query/util/logging"
 	xhttp "github.com/m3db/m3/src/x/net/http"
 	"github.com/m3db/m3/src/x/net/http/cors"
+	"github.com/m3db/m3x/instrument"
 
 	"github.com/gorilla/mux"
 	"github.com/opentracing-contrib/go-stdlib/nethttp"
@@ -64,8 +67,10 @@ const (
 )
 
 var (
-	remoteSource = map[string]string{"source": "remote"}
-	nativeSource = map[string]string{"source": "native"}
+	remoteSource   = map[string]string{"source": "remote"}
+	nativeSource   = map[string]string{"source": "native"}
+	m3qlSource     = map[string]string{"source": "m3ql"}
+	influxdbSource = map[string]string{"source": "influxdb"}
 
 	defaultTimeout = 30 * time.Second
 )
@@ -77,6 +82,7 @@ type Handler struct {
 	storage              storage.Storage
 	downsamplerAndWriter ingest.DownsamplerAndWriter
 	engine               *executor.Engine
+	querier              m3.Querier
 	clusters             m3.Clusters
 	clusterClient        clusterclient.Client
 	config               config.Configuration
@@ -85,6 +91,7 @@ type Handler struct {
 	createdAt            time.Time
 	tagOptions           models.TagOptions
 	timeoutOpts          *prometheus.TimeoutOpts
+	resultsCache         cache.ResultsCache
 }
 
 // Router returns the http handler registered with all relevant routes for query.
@@ -92,11 +99,13 @@ func (h *Handler) Router() http.Handler {
 	return h.handler
 }
 
-// NewHandler returns a new instance of handler with routes.
+// NewHandler returns a new instance of handler with routes. The querier is
+// optional and when set is used to serve reads directly from compressed data.
 func NewHandler(
 	downsamplerAndWriter ingest.DownsamplerAndWriter,
 	tagOptions models.TagOptions,
 	engine *executor.Engine,
+	querier m3.Querier,
 	m3dbClusters m3.Clusters,
 	clusterClient clusterclient.Client,
 	cfg config.Configuration,
@@ -118,12 +127,23 @@ func NewHandler(
 		timeoutOpts.FetchTimeout = *embeddedDbCfg.Client.FetchTimeout
 	}
 
+	var resultsCache cache.ResultsCache
+	if resultsCfg := cfg.Cache.Results; resultsCfg != nil {
+		var err error
+		resultsCache, err = resultsCfg.NewResultsCache(
+			instrument.NewOptions().SetMetricsScope(scope))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	h := &Handler{
 		router:               r,
 		handler:              handlerWithMiddleware,
 		storage:              downsamplerAndWriter.Storage(),
 		downsamplerAndWriter: downsamplerAndWriter,
 		engine:               engine,
+		querier:              querier,
 		clusters:             m3dbClusters,
 		clusterClient:        clusterClient,
 		config:               cfg,
@@ -132,6 +152,7 @@ func NewHandler(
 		createdAt:            time.Now(),
 		tagOptions:           tagOptions,
 		timeoutOpts:          timeoutOpts,
+		resultsCache:         resultsCache,
 	}
 	return h, nil
 }
@@ -165,7 +186,8 @@ func (h *Handler) RegisterRoutes() error {
 	h.router.PathPrefix(openapi.StaticURLPrefix).Handler(wrapped(openapi.StaticHandler()))
 
 	// Prometheus remote read/write endpoints
-	promRemoteReadHandler := remote.NewPromReadHandler(h.engine, h.scope.Tagged(remoteSource), h.timeoutOpts)
+	promRemoteReadHandler := remote.NewPromReadHandler(h.engine, h.querier,
+		h.tagOptions, h.scope.Tagged(remoteSource), h.timeoutOpts)
 	promRemoteWriteHandler, err := remote.NewPromWriteHandler(
 		h.downsamplerAndWriter,
 		h.tagOptions,
@@ -181,6 +203,7 @@ func (h *Handler) RegisterRoutes() error {
 		h.config.LimitsOrDefault(),
 		h.scope.Tagged(nativeSource),
 		h.timeoutOpts,
+		h.resultsCache,
 	)
 
 	h.router.HandleFunc(remote.PromReadURL,
@@ -196,6 +219,19 @@ func (h *Handler) RegisterRoutes() error {
 		wrapped(native.NewPromReadInstantHandler(h.engine, h.tagOptions, h.timeoutOpts)).ServeHTTP,
 	).Methods(native.PromReadInstantHTTPMethod)
 
+	// M3QL read endpoint
+	m3qlReadHandler := native.NewM3QLReadHandler(
+		h.engine,
+		h.tagOptions,
+		h.config.LimitsOrDefault(),
+		h.scope.Tagged(m3qlSource),
+		h.timeoutOpts,
+		h.resultsCache,
+	)
+	h.router.HandleFunc(native.M3QLReadURL,
+		wrapped(m3qlReadHandler).ServeHTTP,
+	).Methods(native.M3QLReadHTTPMethod)
+
 	// Native M3 search and write endpoints
 	h.router.HandleFunc(handler.SearchURL,
 		wrapped(handler.NewSearchHandler(h.storage)).ServeHTTP,
@@ -204,6 +240,19 @@ func (h *Handler) RegisterRoutes() error {
 		wrapped(m3json.NewWriteJSONHandler(h.storage)).ServeHTTP,
 	).Methods(m3json.JSONWriteHTTPMethod)
 
+	// InfluxDB line protocol write endpoint
+	influxWriteHandler, err := influxdb.NewInfluxWriteHandler(
+		h.downsamplerAndWriter,
+		h.tagOptions,
+		h.scope.Tagged(influxdbSource),
+	)
+	if err != nil {
+		return err
+	}
+	h.router.HandleFunc(influxdb.InfluxWriteURL,
+		panicOnly(influxWriteHandler).ServeHTTP,
+	).Methods(influxdb.InfluxWriteHTTPMethod)
+
 	// Tag completion endpoints
 	h.router.HandleFunc(native.CompleteTagsURL,
 		wrapped(native.NewCompleteTagsHandler(h.storage)).ServeHTTP,
@@ -224,13 +273,26 @@ func (h *Handler) RegisterRoutes() error {
 
 	// Graphite endpoints
 	h.router.HandleFunc(graphite.ReadURL,
-		wrapped(graphite.NewRenderHandler(h.storage)).ServeHTTP,
+		wrapped(graphite.NewRenderHandler(h.storage,
+			h.engine.GlobalEnforcer())).ServeHTTP,
 	).Methods(graphite.ReadHTTPMethods...)
 
 	h.router.HandleFunc(graphite.FindURL,
 		wrapped(graphite.NewFindHandler(h.storage)).ServeHTTP,
 	).Methods(graphite.FindHTTPMethods...)
 
+	h.router.HandleFunc(graphite.TagsURL,
+		wrapped(graphite.NewTagsHandler(h.storage)).ServeHTTP,
+	).Methods(graphite.TagsHTTPMethods...)
+
+	h.router.HandleFunc(graphite.TagsAutoCompleteTagsURL,
+		wrapped(graphite.NewAutoCompleteTagsHandler(h.storage)).ServeHTTP,
+	).Methods(graphite.TagsHTTPMethods...)
+
+	h.router.HandleFunc(graphite.TagsAutoCompleteValuesURL,
+		wrapped(graphite.NewAutoCompleteValuesHandler(h.storage)).ServeHTTP,
+	).Methods(graphite.TagsHTTPMethods...)
+
 	if h.clusterClient != nil {
 		placementOpts := placement.HandlerOptions{
 			ClusterClient:       h.clusterClient,
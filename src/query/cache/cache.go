@@ -0,0 +1,273 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xsync "github.com/m3db/m3x/sync"
+
+	"github.com/uber-go/tally"
+)
+
+type resultsCache struct {
+	store            Store
+	splitInterval    time.Duration
+	bufferPast       time.Duration
+	fetchConcurrency int
+	metrics          resultsCacheMetrics
+}
+
+type resultsCacheMetrics struct {
+	hits      tally.Counter
+	misses    tally.Counter
+	uncached  tally.Counter
+	unaligned tally.Counter
+}
+
+func newResultsCacheMetrics(scope tally.Scope) resultsCacheMetrics {
+	return resultsCacheMetrics{
+		hits:      scope.Counter("hits"),
+		misses:    scope.Counter("misses"),
+		uncached:  scope.Counter("uncached"),
+		unaligned: scope.Counter("unaligned"),
+	}
+}
+
+// NewResultsCache returns a new results cache.
+func NewResultsCache(opts Options) (ResultsCache, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	scope := opts.InstrumentOptions().MetricsScope().SubScope("results-cache")
+	return &resultsCache{
+		store:            opts.Store(),
+		splitInterval:    opts.SplitInterval(),
+		bufferPast:       opts.BufferPast(),
+		fetchConcurrency: opts.FetchConcurrency(),
+		metrics:          newResultsCacheMetrics(scope),
+	}, nil
+}
+
+// interval is a step aligned, end exclusive section of a query.
+type interval struct {
+	start time.Time
+	end   time.Time
+}
+
+func (c *resultsCache) Fetch(
+	ctx context.Context,
+	query Query,
+	params models.RequestParams,
+	fetchFn FetchFn,
+) ([]*ts.Series, error) {
+	step := params.Step
+	if step <= 0 {
+		return fetchFn(ctx, params)
+	}
+
+	// Only step aligned queries are served from the cache, the cached
+	// intervals are aligned to the step and serving an unaligned query from
+	// them would shift the timestamps of its results.
+	start := params.Start.Truncate(step)
+	if !start.Equal(params.Start) {
+		c.metrics.unaligned.Inc(1)
+		return fetchFn(ctx, params)
+	}
+
+	var (
+		end       = alignUp(params.ExclusiveEnd(), step)
+		horizon   = params.Now.Add(-c.bufferPast)
+		intervals = c.split(start, end, step)
+		results   = make([][]*ts.Series, len(intervals))
+		missing   = make([]int, 0, len(intervals))
+	)
+
+	for idx, i := range intervals {
+		if i.end.After(horizon) {
+			c.metrics.uncached.Inc(1)
+			missing = append(missing, idx)
+			continue
+		}
+
+		if series, ok := c.store.Get(cacheKey(query, params, i)); ok {
+			c.metrics.hits.Inc(1)
+			results[idx] = series
+			continue
+		}
+
+		c.metrics.misses.Inc(1)
+		missing = append(missing, idx)
+	}
+
+	err := c.fetchIntervals(ctx, query, params, horizon, intervals, missing,
+		results, fetchFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return merge(start, end, step, results), nil
+}
+
+// fetchIntervals concurrently computes the missing intervals of a query,
+// caching those which can no longer change. The first error cancels the
+// computation of the remaining intervals.
+func (c *resultsCache) fetchIntervals(
+	ctx context.Context,
+	query Query,
+	params models.RequestParams,
+	horizon time.Time,
+	intervals []interval,
+	missing []int,
+	results [][]*ts.Series,
+	fetchFn FetchFn,
+) error {
+	if len(missing) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errLock  sync.Mutex
+		firstErr error
+		workers  = xsync.NewWorkerPool(c.fetchConcurrency)
+	)
+
+	workers.Init()
+	for _, idx := range missing {
+		idx := idx // Capture var
+		wg.Add(1)
+		workers.Go(func() {
+			defer wg.Done()
+
+			i := intervals[idx]
+			intervalParams := params
+			intervalParams.Start = i.start
+			intervalParams.End = i.end
+			intervalParams.IncludeEnd = false
+			series, err := fetchFn(ctx, intervalParams)
+			if err != nil {
+				errLock.Lock()
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				errLock.Unlock()
+				return
+			}
+
+			if !i.end.After(horizon) {
+				c.store.Set(cacheKey(query, params, i), series)
+			}
+			// NB: each interval has its own slot, no lock is required.
+			results[idx] = series
+		})
+	}
+
+	wg.Wait()
+	return firstErr
+}
+
+// split splits the range into intervals on multiples of the split interval,
+// which is rounded down to a multiple of the step so that every interval
+// shares the step grid of the query.
+func (c *resultsCache) split(start, end time.Time, step time.Duration) []interval {
+	splitInterval := c.splitInterval - c.splitInterval%step
+	if splitInterval <= 0 {
+		splitInterval = step
+	}
+
+	var intervals []interval
+	for curr := start; curr.Before(end); {
+		next := curr.Truncate(splitInterval).Add(splitInterval)
+		if next.After(end) {
+			next = end
+		}
+		intervals = append(intervals, interval{start: curr, end: next})
+		curr = next
+	}
+	return intervals
+}
+
+func alignUp(t time.Time, step time.Duration) time.Time {
+	aligned := t.Truncate(step)
+	if aligned.Before(t) {
+		aligned = aligned.Add(step)
+	}
+	return aligned
+}
+
+// cacheKey returns the key of an interval of a query, which includes every
+// request param that changes the computed results other than the range.
+func cacheKey(query Query, params models.RequestParams, i interval) string {
+	return fmt.Sprintf("%s:%d:%d:%d:%d:%d", query.Normalized,
+		int64(query.LookbackDuration), int64(params.Step), int(params.BlockType),
+		i.start.UnixNano(), i.end.UnixNano())
+}
+
+// merge combines the series of each interval into series spanning the whole
+// range, series missing from an interval are filled with NaNs.
+func merge(
+	start, end time.Time,
+	step time.Duration,
+	results [][]*ts.Series,
+) []*ts.Series {
+	var (
+		numSteps = int(end.Sub(start) / step)
+		merged   []*ts.Series
+		values   []ts.FixedResolutionMutableValues
+		indices  = make(map[string]int)
+	)
+	for _, seriesList := range results {
+		for _, series := range seriesList {
+			id := string(series.Name()) + string(series.Tags.ID())
+			idx, ok := indices[id]
+			if !ok {
+				idx = len(merged)
+				indices[id] = idx
+				vals := ts.NewFixedStepValues(step, numSteps, math.NaN(), start)
+				values = append(values, vals)
+				merged = append(merged, ts.NewSeries(series.Name(), vals, series.Tags))
+			}
+
+			vals := values[idx]
+			seriesValues := series.Values()
+			for i := 0; i < seriesValues.Len(); i++ {
+				dp := seriesValues.DatapointAt(i)
+				if dp.Timestamp.Before(start) || !dp.Timestamp.Before(end) {
+					continue
+				}
+				vals.SetValueAt(int(dp.Timestamp.Sub(start)/step), dp.Value)
+			}
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,363 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package cache
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// localStore is a stand-in store which records the keys that were set.
+type localStore struct {
+	sync.Mutex
+	entries map[string][]*ts.Series
+}
+
+func newLocalStore() *localStore {
+	return &localStore{entries: make(map[string][]*ts.Series)}
+}
+
+func (s *localStore) Get(key string) ([]*ts.Series, bool) {
+	s.Lock()
+	defer s.Unlock()
+	series, ok := s.entries[key]
+	return series, ok
+}
+
+func (s *localStore) Set(key string, series []*ts.Series) {
+	s.Lock()
+	defer s.Unlock()
+	s.entries[key] = series
+}
+
+// requestRecorder records the requested intervals of concurrent fetches.
+type requestRecorder struct {
+	sync.Mutex
+	requested []models.RequestParams
+}
+
+// sorted returns the requested intervals ordered by start and resets them.
+func (r *requestRecorder) sorted() []models.RequestParams {
+	r.Lock()
+	defer r.Unlock()
+	requested := r.requested
+	r.requested = nil
+	sort.Slice(requested, func(i, j int) bool {
+		return requested[i].Start.Before(requested[j].Start)
+	})
+	return requested
+}
+
+// stepValueFetchFn returns a single series whose value at each step is the
+// number of seconds since the epoch, recording the requested intervals.
+func stepValueFetchFn(recorder *requestRecorder) FetchFn {
+	return func(_ context.Context, params models.RequestParams) ([]*ts.Series, error) {
+		recorder.Lock()
+		recorder.requested = append(recorder.requested, params)
+		recorder.Unlock()
+		numSteps := int(params.ExclusiveEnd().Sub(params.Start) / params.Step)
+		vals := ts.NewFixedStepValues(params.Step, numSteps, math.NaN(), params.Start)
+		for i := 0; i < numSteps; i++ {
+			t := params.Start.Add(time.Duration(i) * params.Step)
+			vals.SetValueAt(i, float64(t.Unix()))
+		}
+		tags := models.NewTags(1, models.NewTagOptions()).AddTag(models.Tag{
+			Name:  []byte("foo"),
+			Value: []byte("bar"),
+		})
+		return []*ts.Series{ts.NewSeries([]byte("foo"), vals, tags)}, nil
+	}
+}
+
+func newTestResultsCache(t *testing.T, store Store) ResultsCache {
+	c, err := NewResultsCache(NewOptions().
+		SetStore(store).
+		SetSplitInterval(time.Hour).
+		SetBufferPast(10 * time.Minute))
+	require.NoError(t, err)
+	return c
+}
+
+func requireStepValues(t *testing.T, start time.Time, step time.Duration, series *ts.Series) {
+	vals := series.Values()
+	for i := 0; i < vals.Len(); i++ {
+		dp := vals.DatapointAt(i)
+		expected := start.Add(time.Duration(i) * step)
+		require.Equal(t, expected, dp.Timestamp)
+		require.Equal(t, float64(expected.Unix()), dp.Value)
+	}
+}
+
+func TestResultsCacheSplitsAndCachesImmutableIntervals(t *testing.T) {
+	var (
+		store    = newLocalStore()
+		cache    = newTestResultsCache(t, store)
+		now      = time.Unix(0, 0).Add(1000 * time.Hour)
+		step     = time.Minute
+		recorder requestRecorder
+	)
+
+	params := models.RequestParams{
+		Start: now.Add(-3*time.Hour - 30*time.Minute),
+		End:   now,
+		Now:   now,
+		Step:  step,
+	}
+
+	series, err := cache.Fetch(context.Background(), Query{Normalized: "foo"}, params,
+		stepValueFetchFn(&recorder))
+	require.NoError(t, err)
+	require.Len(t, series, 1)
+
+	// The range is split on hours.
+	requested := recorder.sorted()
+	require.Equal(t, 4, len(requested))
+	assert.Equal(t, params.Start, requested[0].Start)
+	assert.Equal(t, now.Add(-3*time.Hour), requested[0].End)
+	assert.Equal(t, now.Add(-time.Hour), requested[3].Start)
+	assert.Equal(t, now, requested[3].End)
+
+	// The final hour falls within the buffer past horizon and is not cached.
+	assert.Equal(t, 3, len(store.entries))
+
+	require.Equal(t, 210, series[0].Len())
+	requireStepValues(t, params.Start, step, series[0])
+
+	// A refresh only recomputes the interval within the buffer past horizon.
+	series, err = cache.Fetch(context.Background(), Query{Normalized: "foo"}, params,
+		stepValueFetchFn(&recorder))
+	require.NoError(t, err)
+	require.Len(t, series, 1)
+	requested = recorder.sorted()
+	require.Equal(t, 1, len(requested))
+	assert.Equal(t, now.Add(-time.Hour), requested[0].Start)
+	requireStepValues(t, params.Start, step, series[0])
+}
+
+func TestResultsCacheBypassesUnalignedQueries(t *testing.T) {
+	var (
+		store    = newLocalStore()
+		cache    = newTestResultsCache(t, store)
+		now      = time.Unix(0, 0).Add(1000 * time.Hour)
+		step     = time.Minute
+		recorder requestRecorder
+	)
+
+	params := models.RequestParams{
+		Start: now.Add(-3*time.Hour - 30*time.Second),
+		End:   now,
+		Now:   now,
+		Step:  step,
+	}
+
+	series, err := cache.Fetch(context.Background(), Query{Normalized: "foo"}, params,
+		stepValueFetchFn(&recorder))
+	require.NoError(t, err)
+	require.Len(t, series, 1)
+
+	// The query is fetched as is and its timestamps are left untouched.
+	require.Equal(t, []models.RequestParams{params}, recorder.sorted())
+	assert.Equal(t, 0, len(store.entries))
+	requireStepValues(t, params.Start, step, series[0])
+}
+
+func TestResultsCacheKeysByQueryAndParams(t *testing.T) {
+	var (
+		store    = newLocalStore()
+		cache    = newTestResultsCache(t, store)
+		now      = time.Unix(0, 0).Add(1000 * time.Hour)
+		query    = Query{Normalized: "foo", LookbackDuration: 5 * time.Minute}
+		recorder requestRecorder
+	)
+
+	params := models.RequestParams{
+		Start: now.Add(-3 * time.Hour),
+		End:   now.Add(-2 * time.Hour),
+		Now:   now,
+		Step:  time.Minute,
+	}
+
+	fetch := func(query Query, params models.RequestParams) int {
+		_, err := cache.Fetch(context.Background(), query, params,
+			stepValueFetchFn(&recorder))
+		require.NoError(t, err)
+		return len(recorder.sorted())
+	}
+
+	require.Equal(t, 1, fetch(query, params))
+	require.Equal(t, 0, fetch(query, params))
+
+	otherQuery := query
+	otherQuery.Normalized = "bar"
+	require.Equal(t, 1, fetch(otherQuery, params))
+
+	otherLookback := query
+	otherLookback.LookbackDuration = time.Minute
+	require.Equal(t, 1, fetch(otherLookback, params))
+
+	otherStep := params
+	otherStep.Step = 30 * time.Second
+	require.Equal(t, 1, fetch(query, otherStep))
+
+	otherBlockType := params
+	otherBlockType.BlockType = models.TypeMultiBlock
+	require.Equal(t, 1, fetch(query, otherBlockType))
+
+	// Params that do not change the results share the cached intervals.
+	otherTimeout := params
+	otherTimeout.Timeout = time.Second
+	require.Equal(t, 0, fetch(query, otherTimeout))
+}
+
+func TestResultsCacheFetchesIntervalsConcurrently(t *testing.T) {
+	cache, err := NewResultsCache(NewOptions().
+		SetStore(newLocalStore()).
+		SetSplitInterval(time.Hour).
+		SetFetchConcurrency(4))
+	require.NoError(t, err)
+
+	now := time.Unix(0, 0).Add(1000 * time.Hour)
+	params := models.RequestParams{
+		Start: now.Add(-4 * time.Hour),
+		End:   now,
+		Now:   now,
+		Step:  time.Minute,
+	}
+
+	// Each interval waits for every other interval to start, which only
+	// completes if the intervals are fetched concurrently.
+	var (
+		started  sync.WaitGroup
+		allReady = make(chan struct{})
+		recorder requestRecorder
+	)
+	started.Add(4)
+	go func() {
+		started.Wait()
+		close(allReady)
+	}()
+
+	fetchFn := stepValueFetchFn(&recorder)
+	series, err := cache.Fetch(context.Background(), Query{Normalized: "foo"}, params,
+		func(ctx context.Context, p models.RequestParams) ([]*ts.Series, error) {
+			started.Done()
+			select {
+			case <-allReady:
+			case <-time.After(10 * time.Second):
+				return nil, errors.New("intervals not fetched concurrently")
+			}
+			return fetchFn(ctx, p)
+		})
+	require.NoError(t, err)
+	require.Len(t, series, 1)
+	require.Equal(t, 4, len(recorder.sorted()))
+	requireStepValues(t, params.Start, params.Step, series[0])
+}
+
+func TestResultsCacheFetchErrorCancelsIntervals(t *testing.T) {
+	var (
+		store    = newLocalStore()
+		now      = time.Unix(0, 0).Add(1000 * time.Hour)
+		fetchErr = errors.New("fetch error")
+	)
+
+	cache, err := NewResultsCache(NewOptions().
+		SetStore(store).
+		SetSplitInterval(time.Hour).
+		SetFetchConcurrency(4))
+	require.NoError(t, err)
+
+	params := models.RequestParams{
+		Start: now.Add(-4 * time.Hour),
+		End:   now.Add(-time.Hour),
+		Now:   now,
+		Step:  time.Minute,
+	}
+
+	_, err = cache.Fetch(context.Background(), Query{Normalized: "foo"}, params,
+		func(ctx context.Context, p models.RequestParams) ([]*ts.Series, error) {
+			if p.Start.Equal(params.Start) {
+				return nil, fetchErr
+			}
+			// The remaining intervals are cancelled by the failed interval.
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+	require.Equal(t, fetchErr, err)
+	assert.Equal(t, 0, len(store.entries))
+}
+
+func TestResultsCacheMergesMissingSeriesWithNaNs(t *testing.T) {
+	var (
+		cache = newTestResultsCache(t, newLocalStore())
+		now   = time.Unix(0, 0).Add(1000 * time.Hour)
+		step  = time.Hour
+	)
+
+	params := models.RequestParams{
+		Start: now.Add(-2 * time.Hour),
+		End:   now,
+		Now:   now,
+		Step:  step,
+	}
+
+	fetchFn := func(_ context.Context, p models.RequestParams) ([]*ts.Series, error) {
+		value, name := 1.0, []byte("first")
+		if p.Start.After(params.Start) {
+			value, name = 2.0, []byte("second")
+		}
+		vals := ts.NewFixedStepValues(step, 1, value, p.Start)
+		tags := models.NewTags(1, models.NewTagOptions()).AddTag(models.Tag{Name: []byte("n"), Value: name})
+		return []*ts.Series{ts.NewSeries(name, vals, tags)}, nil
+	}
+
+	series, err := cache.Fetch(context.Background(), Query{Normalized: "foo"}, params, fetchFn)
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+
+	assert.Equal(t, []byte("first"), series[0].Name())
+	assert.Equal(t, 1.0, series[0].Values().ValueAt(0))
+	assert.True(t, math.IsNaN(series[0].Values().ValueAt(1)))
+
+	assert.Equal(t, []byte("second"), series[1].Name())
+	assert.True(t, math.IsNaN(series[1].Values().ValueAt(0)))
+	assert.Equal(t, 2.0, series[1].Values().ValueAt(1))
+}
+
+func TestResultsCacheOptionsValidate(t *testing.T) {
+	_, err := NewResultsCache(NewOptions())
+	require.Error(t, err)
+
+	_, err = NewResultsCache(NewOptions().
+		SetStore(newLocalStore()).
+		SetSplitInterval(0))
+	require.Error(t, err)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+
+	"github.com/m3db/m3/src/query/ts"
+)
+
+type lruStore struct {
+	sync.Mutex
+
+	size      int
+	evictList *list.List
+	items     map[string]*list.Element
+}
+
+type lruEntry struct {
+	key    string
+	series []*ts.Series
+}
+
+// NewLRUStore returns an in-memory store which holds at most size entries,
+// evicting the least recently used entry once full.
+func NewLRUStore(size int) (Store, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("must provide a positive size, instead got: %d", size)
+	}
+
+	return &lruStore{
+		size:      size,
+		evictList: list.New(),
+		items:     make(map[string]*list.Element, size),
+	}, nil
+}
+
+func (s *lruStore) Get(key string) ([]*ts.Series, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	elem, ok := s.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	s.evictList.MoveToFront(elem)
+	return elem.Value.(*lruEntry).series, true
+}
+
+func (s *lruStore) Set(key string, series []*ts.Series) {
+	s.Lock()
+	defer s.Unlock()
+
+	if elem, ok := s.items[key]; ok {
+		s.evictList.MoveToFront(elem)
+		elem.Value.(*lruEntry).series = series
+		return
+	}
+
+	s.items[key] = s.evictList.PushFront(&lruEntry{key: key, series: series})
+	if s.evictList.Len() > s.size {
+		oldest := s.evictList.Back()
+		s.evictList.Remove(oldest)
+		delete(s.items, oldest.Value.(*lruEntry).key)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package cache
+
+import (
+	"testing"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUStoreInvalidSize(t *testing.T) {
+	_, err := NewLRUStore(0)
+	require.Error(t, err)
+}
+
+func TestLRUStoreEvictsLeastRecentlyUsed(t *testing.T) {
+	store, err := NewLRUStore(2)
+	require.NoError(t, err)
+
+	a := []*ts.Series{ts.NewSeries([]byte("a"), nil, models.Tags{})}
+	b := []*ts.Series{ts.NewSeries([]byte("b"), nil, models.Tags{})}
+	c := []*ts.Series{ts.NewSeries([]byte("c"), nil, models.Tags{})}
+
+	store.Set("a", a)
+	store.Set("b", b)
+
+	// Touch a so that b is the least recently used.
+	actual, ok := store.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, a, actual)
+
+	store.Set("c", c)
+
+	_, ok = store.Get("b")
+	assert.False(t, ok)
+
+	actual, ok = store.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, a, actual)
+
+	actual, ok = store.Get("c")
+	require.True(t, ok)
+	assert.Equal(t, c, actual)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package cache
+
+import (
+	"errors"
+	"runtime"
+	"time"
+
+	"github.com/m3db/m3x/instrument"
+)
+
+const (
+	defaultSplitInterval = time.Hour
+	// NB: matches the default buffer past of dbnode namespaces.
+	defaultBufferPast = 10 * time.Minute
+)
+
+var (
+	defaultFetchConcurrency = runtime.NumCPU()
+)
+
+var (
+	errNoStore                 = errors.New("no cache store set")
+	errInvalidSplitInterval    = errors.New("split interval must be positive")
+	errInvalidBufferPast       = errors.New("buffer past must not be negative")
+	errInvalidFetchConcurrency = errors.New("fetch concurrency must be positive")
+)
+
+type options struct {
+	store            Store
+	splitInterval    time.Duration
+	bufferPast       time.Duration
+	fetchConcurrency int
+	instrumentOpts   instrument.Options
+}
+
+// NewOptions returns a new set of results cache options.
+func NewOptions() Options {
+	return &options{
+		splitInterval:    defaultSplitInterval,
+		bufferPast:       defaultBufferPast,
+		fetchConcurrency: defaultFetchConcurrency,
+		instrumentOpts:   instrument.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	if o.store == nil {
+		return errNoStore
+	}
+	if o.splitInterval <= 0 {
+		return errInvalidSplitInterval
+	}
+	if o.bufferPast < 0 {
+		return errInvalidBufferPast
+	}
+	if o.fetchConcurrency <= 0 {
+		return errInvalidFetchConcurrency
+	}
+	return nil
+}
+
+func (o *options) SetStore(value Store) Options {
+	opts := *o
+	opts.store = value
+	return &opts
+}
+
+func (o *options) Store() Store {
+	return o.store
+}
+
+func (o *options) SetSplitInterval(value time.Duration) Options {
+	opts := *o
+	opts.splitInterval = value
+	return &opts
+}
+
+func (o *options) SplitInterval() time.Duration {
+	return o.splitInterval
+}
+
+func (o *options) SetBufferPast(value time.Duration) Options {
+	opts := *o
+	opts.bufferPast = value
+	return &opts
+}
+
+func (o *options) BufferPast() time.Duration {
+	return o.bufferPast
+}
+
+func (o *options) SetFetchConcurrency(value int) Options {
+	opts := *o
+	opts.fetchConcurrency = value
+	return &opts
+}
+
+func (o *options) FetchConcurrency() int {
+	return o.fetchConcurrency
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.instrumentOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.instrumentOpts
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+// Package cache provides a results cache for range queries that splits each
+// query into step aligned intervals and reuses the computed results of
+// intervals that can no longer change.
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3x/instrument"
+)
+
+// Store is a backend for cached results, keyed by the query, the result
+// affecting request params and the interval. Implementations must be safe
+// for concurrent use.
+type Store interface {
+	// Get returns the cached series for the given key, if present.
+	Get(key string) ([]*ts.Series, bool)
+
+	// Set caches the series for the given key.
+	Set(key string, series []*ts.Series)
+}
+
+// Query identifies the results of a range query independently of the range
+// it is executed over.
+type Query struct {
+	// Normalized is the normalized query, equivalent queries share results.
+	Normalized string
+
+	// LookbackDuration is the lookback duration the query is executed with.
+	LookbackDuration time.Duration
+}
+
+// FetchFn computes the series for the given request params, it may be
+// called concurrently for different intervals of the same query.
+type FetchFn func(ctx context.Context, params models.RequestParams) ([]*ts.Series, error)
+
+// ResultsCache serves range queries from cached intervals where possible,
+// only computing the intervals that are missing from the cache or that are
+// still within the buffer past horizon.
+type ResultsCache interface {
+	// Fetch returns the series for the request params, using fetchFn to
+	// compute any intervals which cannot be served from the cache. The query,
+	// step and block type of the request params are used as the cache key.
+	Fetch(
+		ctx context.Context,
+		query Query,
+		params models.RequestParams,
+		fetchFn FetchFn,
+	) ([]*ts.Series, error)
+}
+
+// Options is the options for the results cache.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetStore sets the cache store.
+	SetStore(value Store) Options
+
+	// Store returns the cache store.
+	Store() Store
+
+	// SetSplitInterval sets the interval queries are split by, intervals are
+	// rounded down to a multiple of the query step.
+	SetSplitInterval(value time.Duration) Options
+
+	// SplitInterval returns the interval queries are split by.
+	SplitInterval() time.Duration
+
+	// SetBufferPast sets the buffer past horizon, results for intervals
+	// ending after now minus buffer past are never cached since writes may
+	// still arrive for them.
+	SetBufferPast(value time.Duration) Options
+
+	// BufferPast returns the buffer past horizon.
+	BufferPast() time.Duration
+
+	// SetFetchConcurrency sets the number of intervals of a query which are
+	// computed concurrently.
+	SetFetchConcurrency(value int) Options
+
+	// FetchConcurrency returns the number of intervals of a query which are
+	// computed concurrently.
+	FetchConcurrency() int
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) Options
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+}
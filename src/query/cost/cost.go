@@ -0,0 +1,264 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package cost enforces limits on the resources consumed by queries.
+package cost
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	xcost "github.com/m3db/m3/src/x/cost"
+	"github.com/m3db/m3x/instrument"
+)
+
+// Resource is a resource consumed by queries whose usage can be limited.
+type Resource uint
+
+const (
+	// FetchedSeries is the number of series fetched from storage.
+	FetchedSeries Resource = iota
+	// DecodedDatapoints is the number of datapoints decoded from fetched series.
+	DecodedDatapoints
+	// BlockBytes is the number of bytes of values materialized into blocks.
+	BlockBytes
+
+	numResources = iota
+)
+
+// Resources is the list of all resources tracked by enforcers.
+var Resources = []Resource{FetchedSeries, DecodedDatapoints, BlockBytes}
+
+func (r Resource) String() string {
+	switch r {
+	case FetchedSeries:
+		return "fetched-series"
+	case DecodedDatapoints:
+		return "decoded-datapoints"
+	case BlockBytes:
+		return "block-bytes"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// BytesPerValue is the size of a single value charged to BlockBytes.
+	BytesPerValue = 8
+
+	globalScope   = "global"
+	perQueryScope = "per-query"
+)
+
+var (
+	noopEnforcerInstance       Enforcer       = noopEnforcer{}
+	noopGlobalEnforcerInstance GlobalEnforcer = noopGlobalEnforcer{}
+)
+
+// Enforcer enforces the resource limits of a single query. Every cost is
+// charged both against the limit of the query itself and against the global
+// limit shared by all in-flight queries.
+type Enforcer interface {
+	// Add charges the cost of a resource to the query, returning an error
+	// if either the per-query or the global limit of the resource has been
+	// exceeded.
+	Add(r Resource, c xcost.Cost) error
+
+	// Close releases the costs charged by the query from the global totals,
+	// costs added after the enforcer is closed are ignored.
+	Close()
+}
+
+// GlobalEnforcer enforces resource limits across all in-flight queries.
+type GlobalEnforcer interface {
+	// QueryEnforcer returns a new enforcer for a single query.
+	QueryEnforcer() Enforcer
+
+	// Current returns the total cost of a resource across all in-flight queries.
+	Current(r Resource) xcost.Cost
+
+	// SetLimitManagers replaces the global and per-query limit managers,
+	// keeping the costs already charged by in-flight queries.
+	SetLimitManagers(global, perQuery LimitManagers)
+}
+
+type globalEnforcer struct {
+	sync.RWMutex
+
+	trackers [numResources]xcost.Tracker
+	global   [numResources]xcost.Enforcer
+	perQuery [numResources]xcost.Enforcer
+	iOpts    instrument.Options
+}
+
+// NewGlobalEnforcer returns a new global enforcer which limits the resources
+// used by all in-flight queries with the global limit managers, and the
+// resources used by each query with the per-query limit managers.
+func NewGlobalEnforcer(
+	global, perQuery LimitManagers,
+	iOpts instrument.Options,
+) GlobalEnforcer {
+	if iOpts == nil {
+		iOpts = instrument.NewOptions()
+	}
+
+	e := &globalEnforcer{iOpts: iOpts}
+	for _, r := range Resources {
+		e.trackers[r] = xcost.NewTracker()
+	}
+
+	e.SetLimitManagers(global, perQuery)
+	return e
+}
+
+func (e *globalEnforcer) SetLimitManagers(global, perQuery LimitManagers) {
+	var globalEnforcers, perQueryEnforcers [numResources]xcost.Enforcer
+	for _, r := range Resources {
+		globalEnforcers[r] = xcost.NewEnforcer(global.manager(r),
+			e.trackers[r], e.enforcerOptions(globalScope, r))
+		// NB: the per-query enforcer is only used as a template which is cloned
+		// with an independent tracker for every query.
+		perQueryEnforcers[r] = xcost.NewEnforcer(perQuery.manager(r),
+			xcost.NewNoopTracker(), e.enforcerOptions(perQueryScope, r))
+	}
+
+	e.Lock()
+	e.global = globalEnforcers
+	e.perQuery = perQueryEnforcers
+	e.Unlock()
+}
+
+func (e *globalEnforcer) enforcerOptions(
+	scope string,
+	r Resource,
+) xcost.EnforcerOptions {
+	metricsScope := e.iOpts.MetricsScope().Tagged(map[string]string{
+		"limit":    scope,
+		"resource": r.String(),
+	})
+
+	return xcost.NewEnforcerOptions().
+		SetCostExceededMessage(fmt.Sprintf("query exceeded %s %s limit", scope, r)).
+		SetInstrumentOptions(e.iOpts.SetMetricsScope(metricsScope))
+}
+
+func (e *globalEnforcer) QueryEnforcer() Enforcer {
+	e.RLock()
+	defer e.RUnlock()
+
+	q := &queryEnforcer{global: e.global}
+	for _, r := range Resources {
+		q.local[r] = e.perQuery[r].Clone()
+	}
+
+	return q
+}
+
+func (e *globalEnforcer) Current(r Resource) xcost.Cost {
+	if r >= numResources {
+		return 0
+	}
+
+	return e.trackers[r].Current()
+}
+
+type queryEnforcer struct {
+	sync.Mutex
+
+	global [numResources]xcost.Enforcer
+	local  [numResources]xcost.Enforcer
+	closed bool
+}
+
+func (e *queryEnforcer) Add(r Resource, c xcost.Cost) error {
+	if r >= numResources {
+		return fmt.Errorf("unknown query resource: %d", r)
+	}
+
+	// NB: costs are charged under the same lock as Close so that a cost
+	// added concurrently with Close is never left in the global totals.
+	e.Lock()
+	defer e.Unlock()
+	if e.closed {
+		return nil
+	}
+
+	localReport := e.local[r].Add(c)
+	globalReport := e.global[r].Add(c)
+	if localReport.Error != nil {
+		return localReport.Error
+	}
+
+	return globalReport.Error
+}
+
+func (e *queryEnforcer) Close() {
+	e.Lock()
+	defer e.Unlock()
+	if e.closed {
+		return
+	}
+
+	e.closed = true
+
+	for _, r := range Resources {
+		report, _ := e.local[r].State()
+		e.global[r].Add(-report.Cost)
+	}
+}
+
+type noopEnforcer struct{}
+
+// NoopEnforcer returns an enforcer which never limits a query.
+func NoopEnforcer() Enforcer { return noopEnforcerInstance }
+
+func (noopEnforcer) Add(Resource, xcost.Cost) error { return nil }
+func (noopEnforcer) Close()                         {}
+
+type noopGlobalEnforcer struct{}
+
+// NoopGlobalEnforcer returns a global enforcer which never limits queries.
+func NoopGlobalEnforcer() GlobalEnforcer { return noopGlobalEnforcerInstance }
+
+func (noopGlobalEnforcer) QueryEnforcer() Enforcer                       { return noopEnforcerInstance }
+func (noopGlobalEnforcer) Current(Resource) xcost.Cost                   { return 0 }
+func (noopGlobalEnforcer) SetLimitManagers(LimitManagers, LimitManagers) {}
+
+type enforcerContextKey struct{}
+
+// NewContext returns a copy of the context which carries the query enforcer.
+func NewContext(ctx context.Context, e Enforcer) context.Context {
+	return context.WithValue(ctx, enforcerContextKey{}, e)
+}
+
+// FromContext returns the query enforcer carried by the context, or a no-op
+// enforcer if the context does not carry one.
+func FromContext(ctx context.Context) Enforcer {
+	if ctx == nil {
+		return noopEnforcerInstance
+	}
+
+	if e, ok := ctx.Value(enforcerContextKey{}).(Enforcer); ok && e != nil {
+		return e
+	}
+
+	return noopEnforcerInstance
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cost
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/generated/proto/commonpb"
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	xcost "github.com/m3db/m3/src/x/cost"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGlobalEnforcer(global, perQuery Thresholds) GlobalEnforcer {
+	return NewGlobalEnforcer(
+		NewStaticLimitManagers(global, nil),
+		NewStaticLimitManagers(perQuery, nil),
+		nil,
+	)
+}
+
+func TestQueryEnforcerPerQueryLimit(t *testing.T) {
+	global := newTestGlobalEnforcer(nil, Thresholds{FetchedSeries: 10})
+
+	first := global.QueryEnforcer()
+	require.NoError(t, first.Add(FetchedSeries, 9))
+	// Unlimited resources are never exceeded.
+	require.NoError(t, first.Add(DecodedDatapoints, 1000))
+
+	// A separate query has its own per-query total.
+	second := global.QueryEnforcer()
+	require.NoError(t, second.Add(FetchedSeries, 9))
+
+	err := first.Add(FetchedSeries, 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "per-query fetched-series limit")
+	assert.Equal(t, xcost.Cost(19), global.Current(FetchedSeries))
+}
+
+func TestQueryEnforcerGlobalLimit(t *testing.T) {
+	global := newTestGlobalEnforcer(Thresholds{BlockBytes: 100}, nil)
+
+	first := global.QueryEnforcer()
+	require.NoError(t, first.Add(BlockBytes, 60))
+
+	second := global.QueryEnforcer()
+	err := second.Add(BlockBytes, 40)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "global block-bytes limit")
+
+	// Closing a query releases its costs from the global total.
+	first.Close()
+	second.Close()
+	assert.Equal(t, xcost.Cost(0), global.Current(BlockBytes))
+
+	third := global.QueryEnforcer()
+	require.NoError(t, third.Add(BlockBytes, 60))
+	third.Close()
+	third.Close()
+	assert.Equal(t, xcost.Cost(0), global.Current(BlockBytes))
+
+	// Costs added after a query is closed are ignored.
+	require.NoError(t, third.Add(BlockBytes, 1000))
+	assert.Equal(t, xcost.Cost(0), global.Current(BlockBytes))
+}
+
+func TestQueryEnforcerConcurrentAddAndClose(t *testing.T) {
+	global := newTestGlobalEnforcer(nil, nil)
+
+	for i := 0; i < 100; i++ {
+		query := global.QueryEnforcer()
+
+		var wg sync.WaitGroup
+		for j := 0; j < 4; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for k := 0; k < 10; k++ {
+					require.NoError(t, query.Add(BlockBytes, 1))
+				}
+			}()
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			query.Close()
+		}()
+
+		wg.Wait()
+		assert.Equal(t, xcost.Cost(0), global.Current(BlockBytes))
+	}
+}
+
+func TestGlobalEnforcerSetLimitManagers(t *testing.T) {
+	global := newTestGlobalEnforcer(nil, nil)
+
+	query := global.QueryEnforcer()
+	require.NoError(t, query.Add(DecodedDatapoints, 50))
+
+	global.SetLimitManagers(
+		NewStaticLimitManagers(Thresholds{DecodedDatapoints: 60}, nil),
+		nil,
+	)
+
+	// Costs charged before the update count towards the new limit.
+	err := global.QueryEnforcer().Add(DecodedDatapoints, 10)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "global decoded-datapoints limit")
+}
+
+func TestDynamicLimitManagers(t *testing.T) {
+	store := mem.NewStore()
+	managers, err := NewDynamicLimitManagers(store, "test",
+		Thresholds{FetchedSeries: 10}, nil)
+	require.NoError(t, err)
+	defer managers.Close()
+
+	limit := managers[FetchedSeries].Limit()
+	assert.True(t, limit.Enabled)
+	assert.Equal(t, xcost.Cost(10), limit.Threshold)
+	assert.False(t, managers[BlockBytes].Limit().Enabled)
+
+	limitKey, enabledKey := LimitKeys("test", BlockBytes)
+	assert.Equal(t, "test.block-bytes.limit", limitKey)
+	assert.Equal(t, "test.block-bytes.enabled", enabledKey)
+
+	_, err = store.Set(limitKey, &commonpb.Float64Proto{Value: 20})
+	require.NoError(t, err)
+	_, err = store.Set(enabledKey, &commonpb.BoolProto{Value: true})
+	require.NoError(t, err)
+
+	for !managers[BlockBytes].Limit().Enabled ||
+		managers[BlockBytes].Limit().Threshold != 20 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	enforcer := NewGlobalEnforcer(managers, nil, nil).QueryEnforcer()
+	require.Error(t, enforcer.Add(BlockBytes, 20))
+}
+
+func TestEnforcerContext(t *testing.T) {
+	assert.Equal(t, NoopEnforcer(), FromContext(context.Background()))
+
+	enforcer := newTestGlobalEnforcer(nil, nil).QueryEnforcer()
+	ctx := NewContext(context.Background(), enforcer)
+	assert.True(t, enforcer == FromContext(ctx))
+}
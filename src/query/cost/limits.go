@@ -0,0 +1,127 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cost
+
+import (
+	"fmt"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	xcost "github.com/m3db/m3/src/x/cost"
+	"github.com/m3db/m3x/instrument"
+)
+
+var noLimitManager = xcost.NewStaticLimitManager(xcost.NewLimitManagerOptions())
+
+// LimitManagers are the limit managers for each resource, resources without
+// a limit manager are not limited.
+type LimitManagers map[Resource]xcost.LimitManager
+
+// Thresholds are the limits of each resource, a resource without a positive
+// threshold is not limited.
+type Thresholds map[Resource]int64
+
+func (l LimitManagers) manager(r Resource) xcost.LimitManager {
+	if m, ok := l[r]; ok && m != nil {
+		return m
+	}
+
+	return noLimitManager
+}
+
+// Close closes all the limit managers.
+func (l LimitManagers) Close() {
+	for _, m := range l {
+		m.Close()
+	}
+}
+
+// NewStaticLimitManagers returns limit managers with fixed thresholds.
+func NewStaticLimitManagers(
+	thresholds Thresholds,
+	iOpts instrument.Options,
+) LimitManagers {
+	managers := make(LimitManagers, len(Resources))
+	for _, r := range Resources {
+		opts := limitManagerOptions(r, thresholds[r], iOpts)
+		managers[r] = xcost.NewStaticLimitManager(opts)
+	}
+
+	return managers
+}
+
+// NewDynamicLimitManagers returns limit managers which default to the given
+// thresholds and watch KV for updates to them. The threshold of a resource
+// is stored at the key "<keyPrefix>.<resource>.limit" and whether the limit
+// is enabled at the key "<keyPrefix>.<resource>.enabled".
+func NewDynamicLimitManagers(
+	store kv.Store,
+	keyPrefix string,
+	thresholds Thresholds,
+	iOpts instrument.Options,
+) (LimitManagers, error) {
+	managers := make(LimitManagers, len(Resources))
+	for _, r := range Resources {
+		var (
+			limitKey, enabledKey = LimitKeys(keyPrefix, r)
+			opts                 = limitManagerOptions(r, thresholds[r], iOpts)
+		)
+		m, err := xcost.NewDynamicLimitManager(store, limitKey, enabledKey, opts)
+		if err != nil {
+			managers.Close()
+			return nil, fmt.Errorf("unable to watch %s limit: %v", r, err)
+		}
+
+		managers[r] = m
+		go m.Report()
+	}
+
+	return managers, nil
+}
+
+// LimitKeys returns the KV keys of the threshold of a resource and whether
+// its limit is enabled.
+func LimitKeys(keyPrefix string, r Resource) (limitKey, enabledKey string) {
+	key := fmt.Sprintf("%s.%s", keyPrefix, r)
+	return key + ".limit", key + ".enabled"
+}
+
+func limitManagerOptions(
+	r Resource,
+	threshold int64,
+	iOpts instrument.Options,
+) xcost.LimitManagerOptions {
+	opts := xcost.NewLimitManagerOptions()
+	if iOpts != nil {
+		scope := iOpts.MetricsScope().Tagged(map[string]string{
+			"resource": r.String(),
+		})
+		opts = opts.SetInstrumentOptions(iOpts.SetMetricsScope(scope))
+	}
+
+	if threshold <= 0 {
+		return opts
+	}
+
+	return opts.SetDefaultLimit(xcost.Limit{
+		Threshold: xcost.Cost(threshold),
+		Enabled:   true,
+	})
+}
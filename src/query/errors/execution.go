@@ -48,6 +48,9 @@ var (
 
 	// ErrNoClientAddresses is an error when there are no addresses passed to the remote client
 	ErrNoClientAddresses = errors.New("no client addresses given")
+
+	// ErrRemoteClientClosed is an error when writing with a closed remote client
+	ErrRemoteClientClosed = errors.New("remote client closed")
 )
 
 // ErrMaxConcurrentQueriesLimitExceeded is an error when the query cannot be run
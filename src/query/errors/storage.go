@@ -28,9 +28,6 @@ var (
 	// ErrNilWriteQuery is returned when trying to write a nil query
 	ErrNilWriteQuery = errors.New("nil write query")
 
-	// ErrRemoteWriteQuery is returned when trying to write to a remote endpoint query
-	ErrRemoteWriteQuery = errors.New("cannot write to remote endpoint")
-
 	// ErrNotImplemented is returned when the storage endpoint is not implemented
 	ErrNotImplemented = errors.New("not implemented")
 
@@ -69,4 +66,13 @@ var (
 
 	// ErrUnexpectedGRPCResponseType is an error returned when rpc response type is unhandled
 	ErrUnexpectedGRPCResponseType = errors.New("unexpected grpc response type")
+
+	// ErrUnexpectedGRPCRequestType is an error returned when rpc request type is unhandled
+	ErrUnexpectedGRPCRequestType = errors.New("unexpected grpc request type")
+
+	// ErrInvalidMetricsType is an error returned when a write has an unknown metrics type
+	ErrInvalidMetricsType = errors.New("invalid metrics type")
+
+	// ErrInvalidWriteUnit is an error returned when a write has an invalid time unit
+	ErrInvalidWriteUnit = errors.New("invalid write unit")
 )
@@ -24,6 +24,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
 	"github.com/m3db/m3/src/query/storage"
@@ -37,6 +38,7 @@ type Engine struct {
 	metrics          *engineMetrics
 	store            storage.Storage
 	lookbackDuration time.Duration
+	globalEnforcer   cost.GlobalEnforcer
 }
 
 // EngineOptions can be used to pass custom flags to engine
@@ -50,14 +52,29 @@ type Query struct {
 }
 
 // NewEngine returns a new instance of QueryExecutor.
-func NewEngine(store storage.Storage, scope tally.Scope, lookbackDuration time.Duration) *Engine {
+func NewEngine(
+	store storage.Storage,
+	scope tally.Scope,
+	lookbackDuration time.Duration,
+	globalEnforcer cost.GlobalEnforcer,
+) *Engine {
+	if globalEnforcer == nil {
+		globalEnforcer = cost.NoopGlobalEnforcer()
+	}
+
 	return &Engine{
 		metrics:          newEngineMetrics(scope),
 		store:            store,
 		lookbackDuration: lookbackDuration,
+		globalEnforcer:   globalEnforcer,
 	}
 }
 
+// LookbackDuration returns the lookback duration queries are executed with.
+func (e *Engine) LookbackDuration() time.Duration {
+	return e.lookbackDuration
+}
+
 type engineMetrics struct {
 	all       *counterWithDecrement
 	compiling *counterWithDecrement
@@ -112,8 +129,12 @@ func (e *Engine) Execute(
 	results chan *storage.QueryResult,
 ) {
 	defer close(results)
+	enforcer := e.globalEnforcer.QueryEnforcer()
+	defer enforcer.Close()
+
 	fetchOpts := storage.NewFetchOptions()
 	fetchOpts.Limit = 0
+	fetchOpts.Enforcer = enforcer
 	result, err := e.store.Fetch(ctx, query, fetchOpts)
 	if err != nil {
 		results <- &storage.QueryResult{Err: err}
@@ -158,16 +179,26 @@ func (e *Engine) ExecuteExpr(
 	sp, ctx := opentracingutil.StartSpanFromContext(ctx, "executing")
 	defer sp.Finish()
 
+	enforcer := e.globalEnforcer.QueryEnforcer()
+	defer enforcer.Close()
+
 	result := state.resultNode
 	results <- Query{Result: result}
 
-	if err := state.Execute(models.NewQueryContext(ctx, tally.NoopScope)); err != nil {
+	queryCtx := models.NewQueryContext(ctx, tally.NoopScope, enforcer)
+	if err := state.Execute(queryCtx); err != nil {
 		result.abort(err)
 	} else {
 		result.done()
 	}
 }
 
+// GlobalEnforcer returns the enforcer which limits the resources used by
+// queries.
+func (e *Engine) GlobalEnforcer() cost.GlobalEnforcer {
+	return e.globalEnforcer
+}
+
 // Close kills all running queries and prevents new queries from being attached.
 func (e *Engine) Close() error {
 	return nil
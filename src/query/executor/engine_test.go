@@ -44,7 +44,7 @@ func TestEngine_Execute(t *testing.T) {
 
 	// Results is closed by execute
 	results := make(chan *storage.QueryResult)
-	engine := NewEngine(store, tally.NewTestScope("test", nil), time.Minute)
+	engine := NewEngine(store, tally.NewTestScope("test", nil), time.Minute, nil)
 	go engine.Execute(context.TODO(), &storage.FetchQuery{}, &EngineOptions{}, results)
 	res := <-results
 	assert.NotNil(t, res.Err)
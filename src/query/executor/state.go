@@ -119,9 +119,10 @@ func GenerateExecutionState(
 	}
 
 	options := transform.Options{
-		TimeSpec:  pplan.TimeSpec,
-		Debug:     pplan.Debug,
-		BlockType: pplan.BlockType,
+		TimeSpec:         pplan.TimeSpec,
+		Debug:            pplan.Debug,
+		BlockType:        pplan.BlockType,
+		LookbackDuration: pplan.LookbackDuration,
 	}
 
 	controller, err := state.createNode(step, options)
@@ -0,0 +1,285 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executor
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/plan"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/query/util/opentracing"
+)
+
+// SubqueryType re-evaluates an expression over a range at a given step.
+const SubqueryType = "subquery"
+
+// SubqueryOp stores required properties for a subquery, the inner expression
+// is held as its own DAG since it is evaluated at the subquery step rather
+// than at the step of the enclosing query.
+type SubqueryOp struct {
+	Nodes parser.Nodes
+	Edges parser.Edges
+	Range time.Duration
+	// Step is the resolution the inner expression is evaluated at, if zero
+	// the step of the enclosing query is used.
+	Step time.Duration
+}
+
+// OpType for the operator
+func (o SubqueryOp) OpType() string {
+	return SubqueryType
+}
+
+// Bounds returns the bounds for the spec
+func (o SubqueryOp) Bounds() transform.BoundSpec {
+	return transform.BoundSpec{
+		Range: o.Range,
+	}
+}
+
+// String representation
+func (o SubqueryOp) String() string {
+	return fmt.Sprintf("type: %s, range: %v, step: %v, nodes: %v, edges: %v",
+		o.OpType(), o.Range, o.Step, o.Nodes, o.Edges)
+}
+
+// Node creates an execution node
+func (o SubqueryOp) Node(
+	controller *transform.Controller,
+	storage storage.Storage,
+	options transform.Options,
+) parser.Source {
+	return &subqueryNode{
+		op:         o,
+		controller: controller,
+		storage:    storage,
+		options:    options,
+	}
+}
+
+type subqueryNode struct {
+	op         SubqueryOp
+	controller *transform.Controller
+	storage    storage.Storage
+	options    transform.Options
+}
+
+// Execute evaluates the inner expression at the subquery step and emits its
+// results as a single unconsolidated block at the step of the enclosing query,
+// so that temporal functions can process them as they would a range selector.
+func (n *subqueryNode) Execute(queryCtx *models.QueryContext) error {
+	sp, _ := opentracingutil.StartSpanFromContext(queryCtx.Ctx, SubqueryType)
+	defer sp.Finish()
+
+	timeSpec := n.options.TimeSpec
+	seriesList, err := n.evaluate(queryCtx)
+	if err != nil {
+		return err
+	}
+
+	// NB: each value is placed in the first step at or after its timestamp,
+	// a lookback of a single step ensures values are never dropped as stale.
+	unconsolidated, err := storage.NewMultiSeriesBlock(seriesList,
+		&storage.FetchQuery{
+			Start:    timeSpec.Start,
+			End:      timeSpec.End,
+			Interval: timeSpec.Step,
+		}, timeSpec.Step)
+	if err != nil {
+		return err
+	}
+
+	return n.controller.Process(queryCtx,
+		storage.NewMultiBlockWrapper(unconsolidated))
+}
+
+func (n *subqueryNode) evaluate(queryCtx *models.QueryContext) (ts.SeriesList, error) {
+	var (
+		timeSpec = n.options.TimeSpec
+		step     = n.op.Step
+	)
+	if step <= 0 {
+		step = timeSpec.Step
+	}
+
+	lp, err := plan.NewLogicalPlan(n.op.Nodes, n.op.Edges)
+	if err != nil {
+		return nil, err
+	}
+
+	// Subquery evaluation times are aligned to multiples of the subquery step
+	// rather than to the start of the enclosing query.
+	params := models.RequestParams{
+		Start:     alignUp(timeSpec.Start, step),
+		End:       timeSpec.End,
+		Now:       timeSpec.Now,
+		Step:      step,
+		Debug:     n.options.Debug,
+		BlockType: n.options.BlockType,
+	}
+
+	pp, err := plan.NewPhysicalPlan(lp, n.storage, params,
+		n.options.LookbackDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := GenerateExecutionState(pp, n.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	result := state.resultNode
+	go func() {
+		if err := state.Execute(queryCtx); err != nil {
+			result.abort(err)
+		} else {
+			result.done()
+		}
+	}()
+
+	var (
+		series   = newSubquerySeries(params.Start)
+		firstErr error
+	)
+	// NB: the result channel must be drained even on error so that the
+	// inner execution is never blocked.
+	for r := range result.ResultChan() {
+		if r.Err != nil {
+			if firstErr == nil {
+				firstErr = r.Err
+			}
+			continue
+		}
+
+		if firstErr == nil {
+			firstErr = series.add(r.Block)
+		}
+
+		r.Block.Close()
+	}
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return series.seriesList(), nil
+}
+
+// subquerySeries accumulates the series of each block emitted by the inner
+// expression, merging series spread across multiple blocks by their ID.
+type subquerySeries struct {
+	start   time.Time
+	metas   []block.SeriesMeta
+	values  []ts.Datapoints
+	indices map[string]int
+}
+
+func newSubquerySeries(start time.Time) *subquerySeries {
+	return &subquerySeries{
+		start:   start,
+		indices: make(map[string]int),
+	}
+}
+
+func (s *subquerySeries) add(b block.Block) error {
+	iter, err := b.SeriesIter()
+	if err != nil {
+		return err
+	}
+
+	defer iter.Close()
+	var (
+		bounds = iter.Meta().Bounds
+		metas  = iter.SeriesMeta()
+	)
+	for i := 0; iter.Next(); i++ {
+		if i >= len(metas) {
+			return fmt.Errorf("missing series metadata for series: %d", i)
+		}
+
+		meta := metas[i]
+		id := string(meta.Tags.ID())
+		idx, ok := s.indices[id]
+		if !ok {
+			idx = len(s.metas)
+			s.indices[id] = idx
+			s.metas = append(s.metas, meta)
+			s.values = append(s.values, nil)
+		}
+
+		for step, v := range iter.Current().Values() {
+			if math.IsNaN(v) {
+				continue
+			}
+
+			t, err := bounds.TimeForIndex(step)
+			if err != nil {
+				return err
+			}
+
+			// Values before the subquery start are only used to evaluate the
+			// inner expression and are not part of the subquery.
+			if t.Before(s.start) {
+				continue
+			}
+
+			s.values[idx] = append(s.values[idx], ts.Datapoint{
+				Timestamp: t,
+				Value:     v,
+			})
+		}
+	}
+
+	return iter.Err()
+}
+
+func (s *subquerySeries) seriesList() ts.SeriesList {
+	seriesList := make(ts.SeriesList, 0, len(s.metas))
+	for i, meta := range s.metas {
+		// NB: blocks are not guaranteed to be emitted in time order.
+		values := s.values[i]
+		sort.Slice(values, func(i, j int) bool {
+			return values[i].Timestamp.Before(values[j].Timestamp)
+		})
+
+		seriesList = append(seriesList, ts.NewSeries(meta.Name, values, meta.Tags))
+	}
+
+	return seriesList
+}
+
+func alignUp(t time.Time, step time.Duration) time.Time {
+	aligned := t.Truncate(step)
+	if aligned.Before(t) {
+		aligned = aligned.Add(step)
+	}
+
+	return aligned
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/storage/mock"
+	"github.com/m3db/m3/src/query/test"
+	"github.com/m3db/m3/src/query/ts"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blockSink struct {
+	blocks []block.Block
+}
+
+func (s *blockSink) Process(_ *models.QueryContext, _ parser.NodeID, b block.Block) error {
+	s.blocks = append(s.blocks, b)
+	return nil
+}
+
+func newSubqueryNode(
+	store mock.Storage,
+	timeSpec transform.TimeSpec,
+	step time.Duration,
+) (parser.Source, *blockSink) {
+	op := SubqueryOp{
+		Nodes: parser.Nodes{
+			parser.NewTransformFromOperation(functions.FetchOp{}, 0),
+		},
+		Range: 2 * time.Minute,
+		Step:  step,
+	}
+
+	sink := &blockSink{}
+	controller := &transform.Controller{ID: parser.NodeID("1")}
+	controller.AddTransform(sink)
+	return op.Node(controller, store, transform.Options{TimeSpec: timeSpec}), sink
+}
+
+func TestSubqueryEmitsUnconsolidatedBlock(t *testing.T) {
+	now := time.Now().Truncate(time.Hour)
+	store := mock.NewMockStorage()
+	store.SetFetchBlocksResult(block.Result{
+		Blocks: []block.Block{test.NewBlockFromValues(models.Bounds{
+			Start:    now,
+			Duration: 6 * time.Minute,
+			StepSize: time.Minute,
+		}, [][]float64{{1, 2, 3, 4, 5, 6}})},
+	}, nil)
+
+	timeSpec := transform.TimeSpec{
+		Start: now,
+		End:   now.Add(6 * time.Minute),
+		Now:   now.Add(time.Hour),
+		Step:  2 * time.Minute,
+	}
+	source, sink := newSubqueryNode(store, timeSpec, time.Minute)
+	require.NoError(t, source.Execute(models.NoopQueryContext()))
+	require.Len(t, sink.blocks, 1)
+
+	unconsolidated, err := sink.blocks[0].Unconsolidated()
+	require.NoError(t, err)
+	iter, err := unconsolidated.SeriesIter()
+	require.NoError(t, err)
+	assert.Equal(t, timeSpec.Bounds(), iter.Meta().Bounds)
+
+	require.True(t, iter.Next())
+	series := iter.Current()
+	require.Equal(t, 3, series.Len())
+	expected := [][]float64{{1}, {2, 3}, {4, 5}}
+	for i, values := range expected {
+		dps := series.DatapointsAtStep(i)
+		require.Len(t, dps, len(values))
+		for j, v := range values {
+			assert.Equal(t, v, dps[j].Value)
+			assert.Equal(t, now.Add(time.Duration(v-1)*time.Minute), dps[j].Timestamp)
+		}
+	}
+
+	assert.False(t, iter.Next())
+	require.NoError(t, iter.Err())
+}
+
+func TestSubqueryDefaultsToQueryStep(t *testing.T) {
+	now := time.Now().Truncate(time.Hour)
+	store := mock.NewMockStorage()
+	store.SetFetchBlocksResult(block.Result{
+		Blocks: []block.Block{test.NewBlockFromValues(models.Bounds{
+			Start:    now,
+			Duration: 3 * time.Minute,
+			StepSize: time.Minute,
+		}, [][]float64{{1, 2, 3}})},
+	}, nil)
+
+	timeSpec := transform.TimeSpec{
+		Start: now,
+		End:   now.Add(3 * time.Minute),
+		Now:   now.Add(time.Hour),
+		Step:  time.Minute,
+	}
+	source, sink := newSubqueryNode(store, timeSpec, 0)
+	require.NoError(t, source.Execute(models.NoopQueryContext()))
+	require.Len(t, sink.blocks, 1)
+
+	iter, err := sink.blocks[0].SeriesIter()
+	require.NoError(t, err)
+	require.True(t, iter.Next())
+	assert.Equal(t, []float64{1, 2, 3}, iter.Current().Values())
+}
+
+func TestSubqueryPropagatesErrors(t *testing.T) {
+	store := mock.NewMockStorage()
+	store.SetFetchBlocksResult(block.Result{}, errors.New("fetch error"))
+
+	now := time.Now().Truncate(time.Hour)
+	source, sink := newSubqueryNode(store, transform.TimeSpec{
+		Start: now,
+		End:   now.Add(time.Hour),
+		Now:   now.Add(time.Hour),
+		Step:  time.Minute,
+	}, time.Minute)
+	assert.Error(t, source.Execute(models.NoopQueryContext()))
+	assert.Empty(t, sink.blocks)
+}
+
+func TestSubqueryMergesSeriesAcrossBlocks(t *testing.T) {
+	now := time.Now().Truncate(time.Hour)
+	series := newSubquerySeries(now)
+
+	bounds := models.Bounds{
+		Start:    now.Add(2 * time.Minute),
+		Duration: 2 * time.Minute,
+		StepSize: time.Minute,
+	}
+	require.NoError(t, series.add(test.NewBlockFromValues(bounds, [][]float64{{3, 4}})))
+
+	bounds.Start = now.Add(-time.Minute)
+	bounds.Duration = 3 * time.Minute
+	require.NoError(t, series.add(test.NewBlockFromValues(bounds, [][]float64{{0, 1, 2}})))
+
+	seriesList := series.seriesList()
+	require.Len(t, seriesList, 1)
+	values := seriesList[0].Values()
+	require.Equal(t, 4, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		assert.Equal(t, ts.Datapoint{
+			Timestamp: now.Add(time.Duration(i) * time.Minute),
+			Value:     float64(i + 1),
+		}, values.DatapointAt(i))
+	}
+}
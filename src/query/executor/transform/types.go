@@ -30,9 +30,10 @@ import (
 
 // Options to create transform nodes
 type Options struct {
-	TimeSpec  TimeSpec
-	Debug     bool
-	BlockType models.FetchedBlockType
+	TimeSpec         TimeSpec
+	Debug            bool
+	BlockType        models.FetchedBlockType
+	LookbackDuration time.Duration
 }
 
 // OpNode represents the execution node
@@ -100,6 +100,9 @@ func (n *FetchNode) fetch(queryCtx *models.QueryContext) (block.Result, error) {
 
 	opts := storage.NewFetchOptions()
 	opts.BlockType = n.blockType
+	if queryCtx.Enforcer != nil {
+		opts.Enforcer = queryCtx.Enforcer
+	}
 
 	return n.storage.FetchBlocks(ctx, &storage.FetchQuery{
 		Start:       startTime,
@@ -239,46 +239,53 @@ d8dUBmQZxiF/+uI1I7E8TMF6pCyWxDpY7WPA8pmKZsl6ouawOaOS+Sj+BQAA//8by2IcfAIAAA==
 
 	"/spec.yml": {
 		local:   "openapi/spec.yml",
-		size:    23348,
+		size:    27077,
 		modtime: 12345,
 		compressed: `
-H4sIAAAAAAAC/+xcW2/jNvZ/96dgNf+Hf4FNlElmu4DfnDjNGMhkjCQosC0WKC0eyWwlUkMeJeMp9rsv
-KMmyZMm62I6TcZWXJOLh4bn8zoXU5R25+/x4PST3kSC/B/RPIFRrwBMPxMmXCNTid8JdspARSQbFgjhz
-KjzQBCXBOdfE5T78MNDP1PNADYl1fnpmDbhw5XBACHL0YUisTxfjS2tACAPtKB4il2JIrBFhXKPiswiB
-EeQBEA2KgyaMIp1RDSTSXHjk08Xjw6/E9SXFnz4QRwahAq25FKfk3zIiDhXE5YIRGSEJpAJCZ+ZPsyqh
-SH6bI4ZD2w4u2OzU4ziPZqdc2sGF/Z//3zj0I5GKSEF+u+H4MZollHpo2ymVI4N4lh1c/HhqdHsCpRO9
-3p+eGSMQ4kiB1EFjCUIEDRJTXI7JjZSeD+RGySi04tFI+UNiZWuYAX3qxWTxUq5UUWC/+yH5bRY283zu
-gNBQWGAUUmcO5DYZIueJKKUVSlrYM1/O7IBqBGXfTq6u7x6urcFcajTTpMaY/7/Oz95bA+ObKcX5kFg2
-Dbn99N4aIPX0cHCy0nN8Se5oADqkDpSdfyWFy71IJf4dX8bzYlptrXGZ+tSBAAS24BIuaYtcRp6nwKMo
-VXtuuTkbuI4vyThFaplZYfjkmTMgbiQcM6qtgXbmEEBssNgn1iCkONfGk7YG9cQd0IlnMrskXvYgxRMh
-icVJ+nNSZfPC0PKCjoKAqsWQWDeAZeMnRDIERY2wEzYkVjZ+A7ikcKTQUaxDtgoNQ5878TT7Dy3FkjRU
-kkVOK1IFOpRCQ06z87Oz1T/rZrZyI7FRaZ6WkP9T4A6J9c5m4HLBY/Pbdzl17tMFV4w+nH3Y83o3IEBx
-51opqVYM/rl3vcrrhCZ+K/CyES0bsTJijFCxBpcGtIwYe1m0hFTRABBUjjgNz5lki5URuShdKlu1Hisj
-xu7hSwQa3xRWz44Eq5vSnv1X9udk/N+EMQMfEPaD63HMqzO0k2mvhu6cTdZAburI6pKCLxFXwIYEVQTZ
-ZVyEhovpvoR3UDgndosrrQpilQ8P5uNJ8GtBk/Uptb3CSVVf1dgn4BzWWqzqCMmGj6NVmObUKafft1DC
-27sxLeFcaKTCgWQPB60degzVfJpT5jWqeT2cjqeat6nR7YGb1ujOKSiZN/L9I0hEdYXzsIXGkVIxLszO
-uEvFuVpN2+D6HEVdDcoz6ovRGypGO3q4L099eXor5WlHKBcK1jb5qq9cL1G5aHak26VwbTo8riCoK1sj
-z2tyf2CI+pp1yJq1k3OzM1Hj2451q+jrvnj1xWtvxWsnTBdKV9ucNe3r1qGO9mwzY7jnk6GJkYL6/NsW
-m2wz93hyl9GmT16vAGsF8d/7RvZ9wja7v5NVaS467S5TPscD9FShHuuveGjWNpfvuBstZfdtdqRHm+Yr
-jdfHwUHjoH3y3zEUCuWggrLP/j3qD3Tw1Db577SbK6X+zju6vr3vQb9H0LfP9DvhvpDny4R1gO9zfQ/7
-ve1q/1ruNzs8u9j5uYjS3tZVMui0u33lpxlXRvq+HmbsG/itcb6fG6zrbXwfAn0IvFJj0zkC9nGXpnzz
-sS3uYy2mPfh78Hdvb5avZ9qOAootj+zzb8rVNjXLt/BAJ13NM8c5CaRGImOlNGHg0shHYNXQXop3FUv3
-3ffx44I6r9HFr0vwNwR6jEl7JiVqVDQMM4fvDfbjhaABd6jvL4h8AqU4S85sCosSZxkdjPCk7ddESAYn
-PjyBnw0XbldvCI+Y9AHwMr/A8YRLrF6lbocNms1yHNdDKQ1vG7UOhHtAxeEpAT/LRUUO+sWYWIbLPwh3
-CRWLNri/OSju/36Iq33HTkgkrowEMw4z/+jVo47fAdpzI2ZuxRvCCcu0MZWzP8BJ9QuVASXyFRLi9Fff
-y6Z9z4qq/kXhz2H6kYGcaJ/zLFrJlYXYtaAzH1hJxpmUPtAM4q4f6XlL2mfFEfSjvJJBwPFWek0THPNP
-1FYUBSHlqjUxgjDG+dzKyvdr5Fn+EjTUc4ktV+WCwdd2K05ypGb6faXArXya6ToFxSW7o0LqkqRcIHiQ
-CydXqoBiMvLTh+X1mS+dPx/4N9iNS+S6oH6OMFL7YDSlGnfXyiTS668hV4smN66Rj1wEdSdx5Dig9Y5G
-npQg0srH0A6Au7uv6sMEnbDocY15E9dntfuUvrD0fYFJ63ybfOqkpHR+ovmhjMVSUH9aYtMpDZefJO0g
-cHKMVOvQqnOIDiusvQvRfNyxNNHym0ed0HNxXhC5g5zLY6IX8twkZZ8rI6aZ+5k6KFWTjiIKHuZUscZQ
-4jqma45QJ0LT2D7yiv6gZTbj+hM3LUvzYgH9Gov1ADhhdcstjdTFbayhv+Fa+nFUxB/EaiD+JkVTv/QM
-3Jtjk9FAsFBygQ3MdLVXqVI0v19FCJoRFpu4wLjR3uYn+/pWvaShVNjGdd171O/bgy9jvsJ9p33asoV5
-Dqt4jNrdNFxznEaK0FBsknSEuRNcLSPlwKTJfmne3KkNNDxcd2sWK9kLZsvLCSIKksETYk3uJo+T0e3k
-18ndjbW8OPplNLkdXd5eZ1dur0e/pBQV71rtpZBuldbWIiPbEUrlQKu2JfdU1pvTonVhr+pycm2EWaRd
-K1HbLhUf5ulgLR/oExfeJLvV1t1s1eFGBeOM4lsE09Y5+sWRlT9Q6rK5W9FXOqTyXtE2m6O75rIRX6wn
-yae5NIf50qF+4dOUjh9p3KKRftF4K5yWVLbgRU2z7XRDVbtc0uVr9J5g9lEm2LosytLK8disInwNwUFg
-D/E3eg3S4h5ET0F9lJHapk5+lPttQyljCrTesd95xYZ2/yauvne7TUpoe1BT8SxE5wOGNR41dzY6aPJE
-/Qh2rnr/CwAA///HYqvpNFsAAA==
+H4sIAAAAAAACA+1dW2/jNhZ+z69gPfuwBRI7zcy2QN6cSzPGZhzDyQ7QFguUlmiZrUSqJJWMp9j/vofU
+xZIl62IrTuJRHhJbOjzkOec7F1IU8w6N7x6uz9E0YOh3D/9JEJaSqBOHsJO/AiKWvyM6R0seoPAmWyJr
+gZlDJFIcqQWVaE5d8t2RfMKOQ8Q56p31T3tHlM35+RFCiiqXwMVP768uevDdJtIS1FeUM7g6RDaVStBZ
+oIgNtB5BkggKzG2s8AxLggJJmYM+vX+4/xXNXY7Vjx+QxT1fECmBSR/9AmOzMINhMBvxQCGPCxjoTH/U
+vSKs0G8LpfzzwcB7b8/6DlWLYNanHL4O/vvPjbe+R1wgztBvN1R9DGYhpQTSiApGYVrBr+/7WrZHImQo
+1w/9U60EBCNlCltKawIhhr1QFRdX6IZzxyXoRvDA75m7gXDhZtKHviH7jiEzXc25CLzBu+/Cv7pj3c6l
+FmGSZDoY+thaEHQb3kJn4VByPeSkGMxcDn+xVEQMbkeX1+P7697Rgkulm8Efw/+ns9MfekfaNhOsFnBn
+gH06eIRrCjvy/OhkJScofwyfJYyH5I1/ydmcOoEI7Qu0LKaVvTUuExeueoSpGlz8mDbLZeg4gjhYgU1r
+c0u12cAV+ruKkJpnlrl98kRtguYBs/Rd4CLBRiCvVpixSe/IB31KbckBOMEjWE+Glkn0ElrZIRGewLuM
+xlH0c1Kk88yt+IIMPA+LJYzxhqi88kMi7hOB9WBHNhAm96FFTAHoBk4kGQL0gn0fIGmaDf6QnMWkvuB2
+YNUiBc/2gTFJSXZ2err6sq7mXuqOUSpO0yL0D0HmQPZuYBOIEdSofzBOiTONOlwx+nD6oeX+bgiDwGZd
+C8HFisG/Wpcr34+v/bcALxvRshErQ9uGBLAGlwq0QJvnRYuPBfQFEStFHLnnjNvLlRIpy13Ka7UcKyDM
+lEBWlOpVYfX0cLAatATV//hQQJA8WgEFYSg/hszuLqGAIWBDBXEdRoQAwpTbUOks0QwqD8MjgW9W4Dvd
+Oml5Zy7LfnJhYjiNMeMyZgdVVR9F8JH6m9JJRhdUHEYBlYbhcQxCWAsowvLMZ8F8TsQE0rPhC5QM+3LB
+1TXDMxfqJ0hTUAORL1GDY4SFlu4PYunqCuookN8Ub7pj6NODm38FVOhaDvSwUhJUdjPd0hJEa6Bf4eOh
+sg/HzUN5vl1PP5zst6mQGvydfBxd/S9kbBMX8NVO+LkyvBony7DZizlSSidr/qQr09WlKGrA0JUISHJZ
+LX3NRc/nmLNXtwn1Zmp34RmRO6dpzWmSmU/p7OOkaKZWOfPQaSc7aSv2kOT2YUw+Jilx8mH+NUwK6psx
+mhRQJhVmYe2grVrXoIcwP5ikhHmJqqEcToc0P6jO0fWBG+XoxiEobDd03QMIRGWJc7+JxuJc2JTptbYm
+Gedy1WyD6VMUZTkozahLRq8oGe1o4S49denptaSnHaGcSVjbxKsucz1H5sLJQ6ImiWvT46gCgrK0BVRV
+5vc0UZez9pmzdjJu8pRF27Zh3sraukteXfJqLXnthOlM6qobsyZd3trX0t5AtzhveWVopEeBXfp1i0m2
+bns4sUtL0wWvF4C1IOZz28iehmyT5ztJlqas0ewy4nM4QI8E6rD+gotmdWP5jrPRXHTfZkZ6sGG+UHmd
+H+zVD+oH/x1dIZMOCii76N+hfk8LT3WD/06zuVzobzyj68r7DvQtgr5+pN8J95k4nycsA3wX6zvYtzar
+/TuebzbYu9h4X0RubjsX3Gs0u33h3YwrJb2tzYxdAb81ztt5wLpexncu0LnACxU2jT2gjac0+YePdXFv
+pJh04O/A37y8iV/4HoQvHdVbsk+/e1ta1MTv9RIZVjVPVC2QB9yj164kSDjHgZt63ysL7Xh4l2Z0b76O
+v8qI8xJV/PoIvkGgG0wOZpwrCDMAhsTgrcH+agm4AYi57hLxRyKEfgddB/JMp8iKvcPWT7A0K4kYt8mJ
+Sx6Jm9zOPK7e4B6G9J6oi3QHh+MuRrxC2fbrNJvHcVibUireNqrtCFMCiRzAbMBvp7wiBf2sT8TucqzP
+P8FsWQf3N3vF/beHuNJ37BhXaM4DZmuD6S9ytdXxLeYJETB9IM4+MkLUVVILNckHCFuCy/AN8qcFdwmy
+3ECfIFPuMNOwy3uiDiY5RCJFb9+/iJcWD6FLCdumhHXHiD1GHqMAbLqaPeiTFliw2qFYCvrD2INdhbnX
+8T5gAzh4YF0ECNhsdSS5iYP6cA64pqJd2VRsWS1HqssulDyjne/+/eb2rKbu6LYFR/GELKP1Gj7Tp51E
+W/SF1rqiK/WYwF++xBPZfEVVfk5HCvXFx4e8utHdpVnUGldSF0fnzOTGCAQuwQko5pD/FzVpnwRVRD7w
+S+55VN1yp6qBpb8EdYciiI+pqE2cPXCnQsvTNfIkpGRP5anqNX1iT0WPoxSpbj4tHHAtmxYdVJQbKWWK
+OCSV3udc+39458cP8fWZy60/7+lXshsXc7rRz4GCxNsCo+SYpJ2k0tnj+otPIUNUmHGNfDiHknPM1dCC
+SC53VPIoB5FaNib1ALi7+YpOLWqERUcfArqsG9WmEX2m62mGSe14G554mBM63VD/YNs2o8DuJMemURjO
+p9IGAw6rnVKDFj08aNDD2guM1c8oYhXFR582Qs/7s8yQG4wzfrbzTJYbRexTaUTXXz9jS3FRJSMLvPsF
+FnalK1Fp6Ko91AqULkEfaEF9UDOaUfmJ6oKqujMPfzHDgpn5yC7rLlZSE7PZFfUNldw1XmHOxa0g/spZ
+Vb30RKizUFVKI8z2OWWqgpkstioWAqfXERTxqhFmVJxhXKlv/ZMcwls+Up8LVcd0zWvUt23B51FfZrNI
+m7qsoZ79Cm5Qu5uEa4YDRSpSkWzCcKRSj10lD4RFRlX6i+LmTmWg5jGfb81iNfaM2tLjJJAwwpsw0x+N
+Rw+j4e3o19H4phdfHH4ejm6HF7fXyZXb6+HniKLgBelWEulWYW3NM5IZIRcWqVW2pLZSvzopaif2oion
+VUboTuqVEqXlUnYHbgNtgdIfAX2jZH9Mc7UVuxtmNtVLH68QTFvH6GdHVnq5q8nkbkVfaJDCDR7bTI7G
+1WnDXCwnSYe5KIbBtBO7mRPq1x4cvQ5/y6yWFJbgWUmT6XRFVruI6dI5uiWYfeQhti6yY6lleFUtIvni
+myOl782/6tBIMzWIXub4CJl5mzz5kbdbhsK0T/+DkB3rnRcsaNtXcfGGq21CQt2FmoINjI0XGNZ4lGxH
+aCDJI3YD0kLWK34E1mS9RS+bSDUFg9xSj+bXjItDB+S6z1qE3gY2n2Z+1VryFQ+gpwyXKPyOyVOIOkhW
+MFrDsHJhGjCW4WXW9RNOQ7lkVhOhki9NjVpWF6RE3pkt5KZZobvZppNwlhgrZefe1nPpunP/HzPsMV/F
+aQAA
 `,
 	},
 
@@ -35,8 +35,10 @@
 		BlockSize
 		Host
 		DatabaseCreateResponse
+		DatabaseRuntimeOptions
 		NamespaceGetResponse
 		NamespaceAddRequest
+		NamespaceUpdateRequest
 		PlacementInitRequest
 		PlacementGetResponse
 		PlacementAddRequest
@@ -44,12 +46,14 @@
 		TopicGetResponse
 		TopicInitRequest
 		TopicAddRequest
+		TopicUpdateRequest
 */
 package admin
 
 import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import commonpb "github.com/m3db/m3/src/cluster/generated/proto/commonpb"
 
 import io "io"
 
@@ -249,11 +253,57 @@ func (m *DatabaseCreateResponse) GetPlacement() *PlacementGetResponse {
 	return nil
 }
 
+type DatabaseRuntimeOptions struct {
+	// (Optional) Whether the rate limit applied to flushes and snapshots is enabled
+	PersistRateLimitEnabled *commonpb.BoolProto `protobuf:"bytes,1,opt,name=persist_rate_limit_enabled,json=persistRateLimitEnabled" json:"persist_rate_limit_enabled,omitempty"`
+	// (Optional) Rate limit applied to flushes and snapshots in megabits per second
+	PersistRateLimitMbps *commonpb.Float64Proto `protobuf:"bytes,2,opt,name=persist_rate_limit_mbps,json=persistRateLimitMbps" json:"persist_rate_limit_mbps,omitempty"`
+	// (Optional) Limit of new series inserted per second across the whole cluster,
+	// zero disables the limit
+	ClusterNewSeriesInsertLimit *commonpb.Int64Proto `protobuf:"bytes,3,opt,name=cluster_new_series_insert_limit,json=clusterNewSeriesInsertLimit" json:"cluster_new_series_insert_limit,omitempty"`
+	// (Optional) Whether new series are inserted asynchronously
+	WriteNewSeriesAsync *commonpb.BoolProto `protobuf:"bytes,4,opt,name=write_new_series_async,json=writeNewSeriesAsync" json:"write_new_series_async,omitempty"`
+}
+
+func (m *DatabaseRuntimeOptions) Reset()                    { *m = DatabaseRuntimeOptions{} }
+func (m *DatabaseRuntimeOptions) String() string            { return proto.CompactTextString(m) }
+func (*DatabaseRuntimeOptions) ProtoMessage()               {}
+func (*DatabaseRuntimeOptions) Descriptor() ([]byte, []int) { return fileDescriptorDatabase, []int{4} }
+
+func (m *DatabaseRuntimeOptions) GetPersistRateLimitEnabled() *commonpb.BoolProto {
+	if m != nil {
+		return m.PersistRateLimitEnabled
+	}
+	return nil
+}
+
+func (m *DatabaseRuntimeOptions) GetPersistRateLimitMbps() *commonpb.Float64Proto {
+	if m != nil {
+		return m.PersistRateLimitMbps
+	}
+	return nil
+}
+
+func (m *DatabaseRuntimeOptions) GetClusterNewSeriesInsertLimit() *commonpb.Int64Proto {
+	if m != nil {
+		return m.ClusterNewSeriesInsertLimit
+	}
+	return nil
+}
+
+func (m *DatabaseRuntimeOptions) GetWriteNewSeriesAsync() *commonpb.BoolProto {
+	if m != nil {
+		return m.WriteNewSeriesAsync
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DatabaseCreateRequest)(nil), "admin.DatabaseCreateRequest")
 	proto.RegisterType((*BlockSize)(nil), "admin.BlockSize")
 	proto.RegisterType((*Host)(nil), "admin.Host")
 	proto.RegisterType((*DatabaseCreateResponse)(nil), "admin.DatabaseCreateResponse")
+	proto.RegisterType((*DatabaseRuntimeOptions)(nil), "admin.DatabaseRuntimeOptions")
 }
 func (m *DatabaseCreateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -442,6 +492,64 @@ func (m *DatabaseCreateResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *DatabaseRuntimeOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatabaseRuntimeOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PersistRateLimitEnabled != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDatabase(dAtA, i, uint64(m.PersistRateLimitEnabled.Size()))
+		n4, err := m.PersistRateLimitEnabled.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.PersistRateLimitMbps != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDatabase(dAtA, i, uint64(m.PersistRateLimitMbps.Size()))
+		n5, err := m.PersistRateLimitMbps.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.ClusterNewSeriesInsertLimit != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDatabase(dAtA, i, uint64(m.ClusterNewSeriesInsertLimit.Size()))
+		n6, err := m.ClusterNewSeriesInsertLimit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.WriteNewSeriesAsync != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintDatabase(dAtA, i, uint64(m.WriteNewSeriesAsync.Size()))
+		n7, err := m.WriteNewSeriesAsync.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
 func encodeVarintDatabase(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -540,6 +648,28 @@ func (m *DatabaseCreateResponse) Size() (n int) {
 	return n
 }
 
+func (m *DatabaseRuntimeOptions) Size() (n int) {
+	var l int
+	_ = l
+	if m.PersistRateLimitEnabled != nil {
+		l = m.PersistRateLimitEnabled.Size()
+		n += 1 + l + sovDatabase(uint64(l))
+	}
+	if m.PersistRateLimitMbps != nil {
+		l = m.PersistRateLimitMbps.Size()
+		n += 1 + l + sovDatabase(uint64(l))
+	}
+	if m.ClusterNewSeriesInsertLimit != nil {
+		l = m.ClusterNewSeriesInsertLimit.Size()
+		n += 1 + l + sovDatabase(uint64(l))
+	}
+	if m.WriteNewSeriesAsync != nil {
+		l = m.WriteNewSeriesAsync.Size()
+		n += 1 + l + sovDatabase(uint64(l))
+	}
+	return n
+}
+
 func sovDatabase(x uint64) (n int) {
 	for {
 		n++
@@ -1210,6 +1340,188 @@ func (m *DatabaseCreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DatabaseRuntimeOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDatabase
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatabaseRuntimeOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatabaseRuntimeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistRateLimitEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatabase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PersistRateLimitEnabled == nil {
+				m.PersistRateLimitEnabled = &commonpb.BoolProto{}
+			}
+			if err := m.PersistRateLimitEnabled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistRateLimitMbps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatabase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PersistRateLimitMbps == nil {
+				m.PersistRateLimitMbps = &commonpb.Float64Proto{}
+			}
+			if err := m.PersistRateLimitMbps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterNewSeriesInsertLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatabase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterNewSeriesInsertLimit == nil {
+				m.ClusterNewSeriesInsertLimit = &commonpb.Int64Proto{}
+			}
+			if err := m.ClusterNewSeriesInsertLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteNewSeriesAsync", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDatabase
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WriteNewSeriesAsync == nil {
+				m.WriteNewSeriesAsync = &commonpb.BoolProto{}
+			}
+			if err := m.WriteNewSeriesAsync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDatabase(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDatabase
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDatabase(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1320,37 +1632,48 @@ func init() {
 }
 
 var fileDescriptorDatabase = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xc5, 0x79, 0x55, 0x9e, 0x28, 0xa1, 0x8c, 0x44, 0x65, 0x81, 0x08, 0x21, 0x08, 0x91, 0x0d,
-	0xb1, 0x94, 0xac, 0x58, 0x12, 0x2a, 0xda, 0x05, 0xaa, 0x2a, 0x87, 0xbd, 0x35, 0xb6, 0x2f, 0xc9,
-	0x88, 0xcc, 0xa3, 0x33, 0x63, 0x41, 0xf3, 0x11, 0x88, 0x2d, 0xe2, 0x87, 0x58, 0xf2, 0x09, 0x28,
-	0xfc, 0x08, 0xf2, 0x8d, 0x3d, 0x85, 0x2e, 0xbb, 0x3b, 0x73, 0xee, 0xb9, 0xaf, 0x33, 0x97, 0xbc,
-	0x59, 0x73, 0xb7, 0x29, 0xb3, 0x59, 0xae, 0x44, 0x2c, 0x16, 0x45, 0x16, 0x8b, 0x45, 0x6c, 0x4d,
-	0x1e, 0x5f, 0x95, 0x60, 0xae, 0xe3, 0x35, 0x48, 0x30, 0xcc, 0x41, 0x11, 0x6b, 0xa3, 0x9c, 0x8a,
-	0x59, 0x21, 0xb8, 0x8c, 0x0b, 0xe6, 0x58, 0xc6, 0x2c, 0xcc, 0x90, 0xa4, 0x5d, 0x64, 0x1f, 0x2d,
-	0xef, 0x50, 0x49, 0x32, 0x01, 0x56, 0xb3, 0xbc, 0x2e, 0x75, 0xa7, 0x1a, 0x7a, 0xcb, 0x72, 0x10,
-	0x20, 0xdd, 0xa1, 0xc6, 0xe4, 0x47, 0x8b, 0x3c, 0x3c, 0xad, 0x27, 0x7c, 0x6b, 0x80, 0x39, 0x48,
-	0xe0, 0xaa, 0x04, 0xeb, 0xe8, 0x0b, 0x32, 0xf4, 0x0d, 0xd3, 0x0a, 0x45, 0xc1, 0x38, 0x98, 0x86,
-	0xc9, 0xc0, 0xb3, 0x17, 0x4c, 0x00, 0xa5, 0xa4, 0xe3, 0xae, 0x35, 0x44, 0x2d, 0x0c, 0x22, 0xa6,
-	0x4f, 0x08, 0x91, 0xa5, 0x48, 0xed, 0x86, 0x99, 0xc2, 0x46, 0xed, 0x71, 0x30, 0xed, 0x26, 0xa1,
-	0x2c, 0xc5, 0x0a, 0x09, 0xfa, 0x8a, 0x50, 0x03, 0x7a, 0xcb, 0x73, 0xe6, 0xb8, 0x92, 0xe9, 0x47,
-	0x96, 0x3b, 0x65, 0xa2, 0x0e, 0xca, 0x1e, 0xfc, 0x13, 0x79, 0x87, 0x81, 0x6a, 0x10, 0x03, 0x0e,
-	0x24, 0x8a, 0x1d, 0x17, 0x10, 0x75, 0x0f, 0x83, 0x78, 0xf6, 0x03, 0x17, 0x40, 0x63, 0x42, 0xb2,
-	0xad, 0xca, 0x3f, 0xa5, 0x96, 0xef, 0x20, 0xea, 0x8d, 0x83, 0x69, 0x7f, 0x7e, 0x3c, 0xc3, 0xad,
-	0x67, 0xcb, 0x2a, 0xb0, 0xe2, 0x3b, 0x48, 0xc2, 0xac, 0x81, 0xf4, 0x19, 0xe9, 0x6e, 0x94, 0x75,
-	0x36, 0x3a, 0x1a, 0xb7, 0xa7, 0xfd, 0x79, 0xbf, 0xd6, 0x9e, 0x2b, 0xeb, 0x92, 0x43, 0x64, 0x22,
-	0x48, 0xe8, 0x53, 0x71, 0x53, 0xee, 0x6d, 0x40, 0x4c, 0xdf, 0x93, 0xe7, 0xf0, 0x45, 0x43, 0xee,
-	0xa0, 0x48, 0x2d, 0x18, 0x0e, 0x36, 0xad, 0xfe, 0x5b, 0x2b, 0x2e, 0x9d, 0x4d, 0x35, 0x98, 0x74,
-	0xa3, 0x4a, 0x83, 0xe6, 0xb4, 0x93, 0xa7, 0x8d, 0x74, 0x85, 0xca, 0x53, 0x2f, 0xbc, 0x04, 0x73,
-	0xae, 0x4a, 0x33, 0xf9, 0x1e, 0x90, 0x4e, 0xd5, 0x9e, 0x0e, 0x49, 0x8b, 0x17, 0x75, 0xa3, 0x16,
-	0x2f, 0x68, 0x44, 0x8e, 0x58, 0x51, 0x18, 0xb0, 0xb6, 0xf6, 0xb9, 0x79, 0x56, 0x43, 0x69, 0x65,
-	0x1c, 0x9a, 0x3c, 0x48, 0x10, 0xd3, 0x97, 0xe4, 0x3e, 0xb7, 0x6a, 0x7b, 0x70, 0x77, 0x6d, 0x54,
-	0xa9, 0xd1, 0xdc, 0x30, 0x19, 0x7a, 0xfa, 0xac, 0x62, 0xab, 0xe4, 0x9d, 0x92, 0x8d, 0x9f, 0x88,
-	0xe9, 0x09, 0xe9, 0x7d, 0x06, 0xbe, 0xde, 0x38, 0xb4, 0x70, 0x90, 0xd4, 0xaf, 0xc9, 0xd7, 0x80,
-	0x9c, 0xdc, 0x3e, 0x14, 0xab, 0x95, 0xb4, 0x40, 0x5f, 0x93, 0xd0, 0xdf, 0x04, 0x0e, 0xdd, 0x9f,
-	0x3f, 0xae, 0xcd, 0xbc, 0x68, 0xf8, 0x33, 0x70, 0x8d, 0x3e, 0xb9, 0x51, 0x57, 0xa9, 0xfe, 0x22,
-	0x71, 0xb5, 0x9b, 0xd4, 0xcb, 0x86, 0xff, 0x2f, 0xd5, 0xab, 0x97, 0xc7, 0x3f, 0xf7, 0xa3, 0xe0,
-	0xd7, 0x7e, 0x14, 0xfc, 0xde, 0x8f, 0x82, 0x6f, 0x7f, 0x46, 0xf7, 0xb2, 0x1e, 0x9e, 0xf4, 0xe2,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xec, 0x9c, 0x42, 0xa6, 0x03, 0x00, 0x00,
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xfd, 0x9c, 0x26, 0xad, 0x32, 0x51, 0xfb, 0x95, 0x69, 0x49, 0xad, 0x56, 0xa4, 0x21, 0x08,
+	0x91, 0x0d, 0xb1, 0x94, 0x22, 0x24, 0x96, 0x0d, 0xa5, 0x3f, 0x52, 0x5b, 0x22, 0x97, 0x15, 0x1b,
+	0x6b, 0x6c, 0x5f, 0x92, 0x11, 0x9e, 0x9f, 0xce, 0x8c, 0x15, 0xda, 0x87, 0x40, 0x6c, 0x11, 0x2f,
+	0xc4, 0x92, 0x37, 0x00, 0x95, 0x17, 0x41, 0x1e, 0xff, 0xa4, 0x2d, 0x74, 0xd3, 0xdd, 0xf5, 0xb9,
+	0xe7, 0x1c, 0xdf, 0x39, 0xbe, 0x63, 0xb4, 0x3b, 0xa1, 0x66, 0x9a, 0x86, 0x83, 0x48, 0x30, 0x8f,
+	0xed, 0xc4, 0xa1, 0xc7, 0x76, 0x3c, 0xad, 0x22, 0xef, 0x3c, 0x05, 0x75, 0xe1, 0x4d, 0x80, 0x83,
+	0x22, 0x06, 0x62, 0x4f, 0x2a, 0x61, 0x84, 0x47, 0x62, 0x46, 0xb9, 0x17, 0x13, 0x43, 0x42, 0xa2,
+	0x61, 0x60, 0x41, 0xdc, 0xb0, 0xe8, 0xe6, 0xe8, 0x1e, 0x4e, 0x9c, 0x30, 0xd0, 0x92, 0x44, 0x85,
+	0xd5, 0xbd, 0x3c, 0x64, 0x42, 0x22, 0x60, 0xc0, 0x4d, 0xe1, 0xb1, 0x77, 0x87, 0x47, 0x94, 0xa4,
+	0xda, 0x80, 0xfa, 0xcb, 0x25, 0x12, 0x8c, 0x09, 0x2e, 0xc3, 0xa2, 0xc8, 0x5d, 0x7a, 0xdf, 0x6a,
+	0xe8, 0xe1, 0x5e, 0x71, 0xce, 0xd7, 0x0a, 0x88, 0x01, 0x1f, 0xce, 0x53, 0xd0, 0x06, 0x3f, 0x45,
+	0x2b, 0xd5, 0xd8, 0x41, 0x56, 0xb9, 0x4e, 0xd7, 0xe9, 0x37, 0xfd, 0xe5, 0x0a, 0x3d, 0x25, 0x0c,
+	0x30, 0x46, 0x75, 0x73, 0x21, 0xc1, 0xad, 0xd9, 0xa6, 0xad, 0xf1, 0x23, 0x84, 0x78, 0xca, 0x02,
+	0x3d, 0x25, 0x2a, 0xd6, 0xee, 0x42, 0xd7, 0xe9, 0x37, 0xfc, 0x26, 0x4f, 0xd9, 0x99, 0x05, 0xf0,
+	0x73, 0x84, 0x15, 0xc8, 0x84, 0x46, 0xc4, 0x50, 0xc1, 0x83, 0x0f, 0x24, 0x32, 0x42, 0xb9, 0x75,
+	0x4b, 0x7b, 0x70, 0xad, 0xb3, 0x6f, 0x1b, 0xd9, 0x20, 0x0a, 0x0c, 0x70, 0x4b, 0x36, 0x94, 0x81,
+	0xdb, 0xc8, 0x07, 0xa9, 0xd0, 0x77, 0x94, 0x01, 0xf6, 0x10, 0x0a, 0x13, 0x11, 0x7d, 0x0c, 0x34,
+	0xbd, 0x04, 0x77, 0xb1, 0xeb, 0xf4, 0x5b, 0xc3, 0xd5, 0x81, 0xcd, 0x6e, 0x30, 0xca, 0x1a, 0x67,
+	0xf4, 0x12, 0xfc, 0x66, 0x58, 0x96, 0xf8, 0x31, 0x6a, 0x4c, 0x85, 0x36, 0xda, 0x5d, 0xea, 0x2e,
+	0xf4, 0x5b, 0xc3, 0x56, 0xc1, 0x3d, 0x14, 0xda, 0xf8, 0x79, 0xa7, 0xc7, 0x50, 0xb3, 0x92, 0xda,
+	0x93, 0xd2, 0x2a, 0x06, 0x5b, 0xe3, 0x63, 0xf4, 0x04, 0x3e, 0x49, 0x88, 0x0c, 0xc4, 0x81, 0x06,
+	0x45, 0x41, 0x07, 0xd9, 0xd6, 0x48, 0x41, 0xb9, 0xd1, 0x81, 0x04, 0x15, 0x4c, 0x45, 0xaa, 0x6c,
+	0x38, 0x0b, 0xfe, 0x76, 0x49, 0x3d, 0xb3, 0xcc, 0xbd, 0x8a, 0x38, 0x06, 0x75, 0x28, 0x52, 0xd5,
+	0xfb, 0xea, 0xa0, 0x7a, 0xf6, 0x7a, 0xbc, 0x82, 0x6a, 0x34, 0x2e, 0x5e, 0x54, 0xa3, 0x31, 0x76,
+	0xd1, 0x12, 0x89, 0x63, 0x05, 0x5a, 0x17, 0x39, 0x97, 0x8f, 0xd9, 0x50, 0x52, 0x28, 0x63, 0x43,
+	0x5e, 0xf6, 0x6d, 0x8d, 0x9f, 0xa1, 0xff, 0xa9, 0x16, 0x49, 0x9e, 0xee, 0x44, 0x89, 0x54, 0xda,
+	0x70, 0x9b, 0xfe, 0x4a, 0x05, 0x1f, 0x64, 0x68, 0x26, 0xbe, 0x14, 0xbc, 0xcc, 0xd3, 0xd6, 0xb8,
+	0x8d, 0x16, 0x67, 0x40, 0x27, 0x53, 0x63, 0x23, 0x5c, 0xf6, 0x8b, 0xa7, 0xde, 0x67, 0x07, 0xb5,
+	0x6f, 0x2f, 0x8a, 0x96, 0x82, 0x6b, 0xc0, 0xaf, 0x50, 0xb3, 0xda, 0x09, 0x3b, 0x74, 0x6b, 0xb8,
+	0x55, 0x84, 0x79, 0x5a, 0xe2, 0x07, 0x60, 0x4a, 0xbe, 0x3f, 0x67, 0x67, 0xd2, 0x6a, 0xaf, 0xdd,
+	0xda, 0x0d, 0xe9, 0xb8, 0xc4, 0x6f, 0x48, 0x2b, 0x76, 0xef, 0x67, 0x6d, 0x3e, 0x90, 0x9f, 0xf2,
+	0xec, 0x73, 0xbc, 0x95, 0xd9, 0xd9, 0x34, 0x1e, 0xa3, 0x4d, 0x09, 0x4a, 0x53, 0x6d, 0x82, 0xec,
+	0x06, 0x04, 0x09, 0x65, 0xd4, 0x04, 0xc0, 0x49, 0x98, 0x40, 0x5c, 0x4c, 0xb8, 0x36, 0x28, 0x2f,
+	0xc4, 0x60, 0x24, 0x44, 0x32, 0xce, 0x6e, 0x83, 0xbf, 0x51, 0xc8, 0x7c, 0x62, 0xe0, 0x38, 0x13,
+	0xbd, 0xc9, 0x35, 0xf8, 0x04, 0x6d, 0xfc, 0xc3, 0x91, 0x85, 0x52, 0x17, 0x53, 0xb7, 0xe7, 0x76,
+	0xfb, 0x89, 0x20, 0xe6, 0xe5, 0x8b, 0xdc, 0x71, 0xfd, 0xb6, 0xe3, 0x49, 0x28, 0x35, 0x7e, 0x8f,
+	0xb6, 0x8b, 0x6b, 0x1a, 0x70, 0x98, 0x95, 0x9b, 0x43, 0xb9, 0x06, 0x65, 0x72, 0x6f, 0xfb, 0x41,
+	0x5b, 0xc3, 0xf5, 0xb9, 0xed, 0x11, 0xaf, 0x4c, 0xb7, 0x0a, 0xf1, 0x29, 0xcc, 0xf2, 0x55, 0x3a,
+	0xb2, 0x4a, 0xeb, 0x8f, 0x0f, 0x51, 0x7b, 0xa6, 0xa8, 0x81, 0xeb, 0xce, 0x44, 0x5f, 0xf0, 0xc8,
+	0xad, 0xdf, 0x7d, 0xf0, 0x35, 0x2b, 0xa9, 0xfc, 0x76, 0x33, 0xfe, 0x68, 0xf5, 0xfb, 0x55, 0xc7,
+	0xf9, 0x71, 0xd5, 0x71, 0x7e, 0x5d, 0x75, 0x9c, 0x2f, 0xbf, 0x3b, 0xff, 0x85, 0x8b, 0xf6, 0xa7,
+	0xb1, 0xf3, 0x67, 0x00, 0x45, 0xc6, 0x74, 0x58, 0x4e, 0x05, 0x00, 0x00,
 }
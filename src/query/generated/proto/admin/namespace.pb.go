@@ -75,9 +75,34 @@ func (m *NamespaceAddRequest) GetOptions() *namespace.NamespaceOptions {
 	return nil
 }
 
+type NamespaceUpdateRequest struct {
+	Name    string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Options *namespace.NamespaceOptions `protobuf:"bytes,2,opt,name=options" json:"options,omitempty"`
+}
+
+func (m *NamespaceUpdateRequest) Reset()                    { *m = NamespaceUpdateRequest{} }
+func (m *NamespaceUpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*NamespaceUpdateRequest) ProtoMessage()               {}
+func (*NamespaceUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorNamespace, []int{2} }
+
+func (m *NamespaceUpdateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamespaceUpdateRequest) GetOptions() *namespace.NamespaceOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NamespaceGetResponse)(nil), "admin.NamespaceGetResponse")
 	proto.RegisterType((*NamespaceAddRequest)(nil), "admin.NamespaceAddRequest")
+	proto.RegisterType((*NamespaceUpdateRequest)(nil), "admin.NamespaceUpdateRequest")
 }
 func (m *NamespaceGetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -141,6 +166,40 @@ func (m *NamespaceAddRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *NamespaceUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Options != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.Options.Size()))
+		n3, err := m.Options.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
 func encodeVarintNamespace(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -174,6 +233,20 @@ func (m *NamespaceAddRequest) Size() (n int) {
 	return n
 }
 
+func (m *NamespaceUpdateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	return n
+}
+
 func sovNamespace(x uint64) (n int) {
 	for {
 		n++
@@ -382,6 +455,118 @@ func (m *NamespaceAddRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NamespaceUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &namespace.NamespaceOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNamespace(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -492,20 +677,21 @@ func init() {
 }
 
 var fileDescriptorNamespace = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0xcf, 0x3f, 0x4a, 0x04, 0x31,
-	0x14, 0x06, 0x70, 0x23, 0xfe, 0x8d, 0x8d, 0x64, 0x2d, 0x16, 0x85, 0x20, 0x5b, 0x59, 0xcd, 0x03,
-	0x07, 0x0f, 0xe0, 0x36, 0xd3, 0x29, 0xe4, 0x04, 0x26, 0x93, 0xc7, 0x98, 0x22, 0x7f, 0x36, 0xc9,
-	0x14, 0x7b, 0x0b, 0x8f, 0x65, 0xe9, 0x11, 0x64, 0xbc, 0x88, 0x18, 0x9d, 0x28, 0x8a, 0x5d, 0xf8,
-	0xf2, 0x7d, 0x3f, 0x78, 0x74, 0x3d, 0x98, 0xfc, 0x38, 0xaa, 0xa6, 0xf7, 0x16, 0x6c, 0xab, 0x15,
-	0xd8, 0x16, 0x52, 0xec, 0x61, 0x33, 0x62, 0xdc, 0xc2, 0x80, 0x0e, 0xa3, 0xcc, 0xa8, 0x21, 0x44,
-	0x9f, 0x3d, 0x48, 0x6d, 0x8d, 0x03, 0x27, 0x2d, 0xa6, 0x20, 0x7b, 0x6c, 0x4a, 0xca, 0xf6, 0x4b,
-	0x7c, 0xde, 0xfd, 0x43, 0x69, 0xe5, 0xbc, 0xc6, 0x3f, 0x56, 0x55, 0x7e, 0x7b, 0xab, 0x8e, 0x9e,
-	0xdd, 0xcd, 0x51, 0x87, 0x59, 0x60, 0x0a, 0xde, 0x25, 0x64, 0x40, 0x8f, 0x22, 0x0e, 0x26, 0xe5,
-	0xb8, 0x5d, 0x92, 0x4b, 0x72, 0x75, 0x72, 0xbd, 0x68, 0xbe, 0xb7, 0xe2, 0xeb, 0x4b, 0xd4, 0xd2,
-	0xea, 0x81, 0x2e, 0x2a, 0x74, 0xab, 0xb5, 0xc0, 0xcd, 0x88, 0x29, 0x33, 0x46, 0xf7, 0x3e, 0x66,
-	0xc5, 0x38, 0x16, 0xe5, 0xcd, 0x6e, 0xe8, 0xa1, 0x0f, 0xd9, 0x78, 0x97, 0x96, 0xbb, 0x85, 0xbe,
-	0xf8, 0x41, 0x57, 0xe4, 0xfe, 0xb3, 0x22, 0xe6, 0xee, 0xfa, 0xf4, 0x79, 0xe2, 0xe4, 0x65, 0xe2,
-	0xe4, 0x75, 0xe2, 0xe4, 0xe9, 0x8d, 0xef, 0xa8, 0x83, 0x72, 0x43, 0xfb, 0x1e, 0x00, 0x00, 0xff,
-	0xff, 0xa1, 0x66, 0xec, 0x92, 0x59, 0x01, 0x00, 0x00,
+	// 245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0xd0, 0xbd, 0x4a, 0x04, 0x31,
+	0x10, 0x07, 0x70, 0x23, 0x7e, 0xc6, 0x46, 0x72, 0x22, 0x87, 0xc2, 0x22, 0x5b, 0x59, 0xed, 0x80,
+	0x8b, 0x0f, 0xe0, 0x35, 0xdb, 0x29, 0x04, 0xec, 0xcd, 0x6e, 0x86, 0x75, 0x8b, 0x7c, 0x5c, 0x32,
+	0x5b, 0xdc, 0x5b, 0xf8, 0x58, 0x96, 0x3e, 0x82, 0xac, 0x2f, 0x22, 0x46, 0x2f, 0x8a, 0x62, 0x77,
+	0x5d, 0xf8, 0xcf, 0x7f, 0x7e, 0x81, 0xe1, 0x8b, 0x7e, 0xa0, 0xc7, 0xb1, 0xad, 0x3a, 0x67, 0xc0,
+	0xd4, 0xba, 0x05, 0x53, 0x43, 0x0c, 0x1d, 0x2c, 0x47, 0x0c, 0x2b, 0xe8, 0xd1, 0x62, 0x50, 0x84,
+	0x1a, 0x7c, 0x70, 0xe4, 0x40, 0x69, 0x33, 0x58, 0xb0, 0xca, 0x60, 0xf4, 0xaa, 0xc3, 0x2a, 0xa5,
+	0x62, 0x37, 0xc5, 0x67, 0xcd, 0x3f, 0x94, 0x6e, 0xad, 0xd3, 0xf8, 0xc7, 0xca, 0xca, 0x6f, 0xaf,
+	0x6c, 0xf8, 0xc9, 0xed, 0x3a, 0x6a, 0x90, 0x24, 0x46, 0xef, 0x6c, 0x44, 0x01, 0xfc, 0x20, 0x60,
+	0x3f, 0x44, 0x0a, 0xab, 0x39, 0xbb, 0x60, 0x97, 0x47, 0x57, 0xb3, 0xea, 0x7b, 0x57, 0x7e, 0x8d,
+	0x64, 0x2e, 0x95, 0x0f, 0x7c, 0x96, 0xa1, 0x1b, 0xad, 0x25, 0x2e, 0x47, 0x8c, 0x24, 0x04, 0xdf,
+	0xf9, 0x58, 0x4b, 0xc6, 0xa1, 0x4c, 0x6f, 0x71, 0xcd, 0xf7, 0x9d, 0xa7, 0xc1, 0xd9, 0x38, 0xdf,
+	0x4e, 0xf4, 0xf9, 0x0f, 0x3a, 0x23, 0x77, 0x9f, 0x15, 0xb9, 0xee, 0x96, 0x1d, 0x3f, 0xcd, 0xc3,
+	0x7b, 0xaf, 0x15, 0xe1, 0xe6, 0x3f, 0x59, 0x1c, 0x3f, 0x4f, 0x05, 0x7b, 0x99, 0x0a, 0xf6, 0x3a,
+	0x15, 0xec, 0xe9, 0xad, 0xd8, 0x6a, 0xf7, 0xd2, 0xa1, 0xea, 0xf7, 0x01, 0x00, 0x9b, 0x6f, 0x3a,
+	0x51, 0xbe, 0x01, 0x00, 0x00,
 }
@@ -91,10 +91,27 @@ func (m *TopicAddRequest) GetConsumerService() *topicpb.ConsumerService {
 	return nil
 }
 
+type TopicUpdateRequest struct {
+	ConsumerService *topicpb.ConsumerService `protobuf:"bytes,1,opt,name=consumer_service,json=consumerService" json:"consumer_service,omitempty"`
+}
+
+func (m *TopicUpdateRequest) Reset()                    { *m = TopicUpdateRequest{} }
+func (m *TopicUpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*TopicUpdateRequest) ProtoMessage()               {}
+func (*TopicUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorTopic, []int{3} }
+
+func (m *TopicUpdateRequest) GetConsumerService() *topicpb.ConsumerService {
+	if m != nil {
+		return m.ConsumerService
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TopicGetResponse)(nil), "admin.TopicGetResponse")
 	proto.RegisterType((*TopicInitRequest)(nil), "admin.TopicInitRequest")
 	proto.RegisterType((*TopicAddRequest)(nil), "admin.TopicAddRequest")
+	proto.RegisterType((*TopicUpdateRequest)(nil), "admin.TopicUpdateRequest")
 }
 func (m *TopicGetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -180,6 +197,34 @@ func (m *TopicAddRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TopicUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerService != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTopic(dAtA, i, uint64(m.ConsumerService.Size()))
+		n3, err := m.ConsumerService.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
 func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -221,6 +266,16 @@ func (m *TopicAddRequest) Size() (n int) {
 	return n
 }
 
+func (m *TopicUpdateRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ConsumerService != nil {
+		l = m.ConsumerService.Size()
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	return n
+}
+
 func sovTopic(x uint64) (n int) {
 	for {
 		n++
@@ -488,6 +543,89 @@ func (m *TopicAddRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TopicUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerService", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerService == nil {
+				m.ConsumerService = &topicpb.ConsumerService{}
+			}
+			if err := m.ConsumerService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTopic(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -598,23 +736,23 @@ func init() {
 }
 
 var fileDescriptorTopic = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x86, 0x5d, 0xa1, 0x0a, 0x2b, 0x6d, 0x43, 0x4e, 0xc1, 0x43, 0x28, 0xc5, 0x43, 0x4e, 0x59,
-	0x30, 0x57, 0x11, 0xb4, 0x07, 0xf1, 0x24, 0x6c, 0xc5, 0x6b, 0x48, 0x76, 0xa7, 0xe9, 0x1e, 0x76,
-	0x37, 0xdd, 0xd9, 0x14, 0x7c, 0x0b, 0x1f, 0xcb, 0xa3, 0x8f, 0x20, 0xf1, 0x45, 0xc4, 0x4d, 0x2a,
-	0x8a, 0x78, 0x9c, 0x6f, 0xfe, 0xff, 0x63, 0x18, 0x7a, 0xdd, 0x28, 0xbf, 0xed, 0xea, 0x5c, 0x58,
-	0xcd, 0x74, 0x21, 0x6b, 0xa6, 0x0b, 0x86, 0x4e, 0xb0, 0x5d, 0x07, 0xee, 0x99, 0x35, 0x60, 0xc0,
-	0x55, 0x1e, 0x24, 0x6b, 0x9d, 0xf5, 0x96, 0x55, 0x52, 0x2b, 0xc3, 0xbc, 0x6d, 0x95, 0xc8, 0x03,
-	0x89, 0x27, 0x01, 0x9d, 0xff, 0xa7, 0xd1, 0xd8, 0xfc, 0x91, 0x84, 0x7a, 0x5b, 0xff, 0xd4, 0x2c,
-	0x39, 0x8d, 0x1e, 0xbf, 0xc6, 0x3b, 0xf0, 0x1c, 0xb0, 0xb5, 0x06, 0x21, 0xbe, 0xa0, 0x93, 0x10,
-	0x49, 0xc8, 0x82, 0x64, 0x67, 0x97, 0xb3, 0x7c, 0x2c, 0xe6, 0x21, 0xc9, 0x87, 0x65, 0x9c, 0xd0,
-	0xd3, 0x3d, 0x38, 0x54, 0xd6, 0x24, 0xc7, 0x0b, 0x92, 0x4d, 0xf9, 0x61, 0x5c, 0x5e, 0x8d, 0xce,
-	0x7b, 0xa3, 0x3c, 0x87, 0x5d, 0x07, 0xe8, 0xe3, 0x8c, 0x46, 0xa6, 0xd3, 0x35, 0xb8, 0xd2, 0x6e,
-	0x4a, 0xdc, 0x56, 0x4e, 0x62, 0xd0, 0x4f, 0xf9, 0x6c, 0xe0, 0x0f, 0x9b, 0x75, 0xa0, 0xcb, 0x27,
-	0x3a, 0x0f, 0xed, 0x1b, 0x29, 0x0f, 0xe5, 0x15, 0x8d, 0x84, 0x35, 0xd8, 0x69, 0x70, 0x25, 0x82,
-	0xdb, 0x2b, 0x01, 0xe3, 0x6d, 0xc9, 0xf7, 0x6d, 0xab, 0x31, 0xb0, 0x1e, 0xf6, 0x7c, 0x2e, 0x7e,
-	0x83, 0xdb, 0xe8, 0xb5, 0x4f, 0xc9, 0x5b, 0x9f, 0x92, 0xf7, 0x3e, 0x25, 0x2f, 0x1f, 0xe9, 0x51,
-	0x7d, 0x12, 0x5e, 0x50, 0x7c, 0x06, 0x00, 0x00, 0xff, 0xff, 0x30, 0x35, 0xc2, 0x23, 0x8b, 0x01,
-	0x00, 0x00,
+	// 286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x90, 0xbd, 0x4a, 0xf4, 0x40,
+	0x14, 0x86, 0xbf, 0xf9, 0x60, 0x15, 0x46, 0x76, 0x37, 0xa4, 0x0a, 0x16, 0x61, 0x09, 0x16, 0xa9,
+	0x32, 0x60, 0x5a, 0x11, 0x74, 0x0b, 0xb1, 0x12, 0x66, 0x55, 0xb0, 0x0a, 0xc9, 0xcc, 0xd9, 0xec,
+	0x14, 0x33, 0x93, 0x9d, 0x9f, 0x05, 0xef, 0xc2, 0xcb, 0xb2, 0xf4, 0x12, 0x24, 0xde, 0x88, 0x38,
+	0xc9, 0x8a, 0x22, 0x76, 0x96, 0xe7, 0x39, 0xef, 0xfb, 0x70, 0x38, 0xf8, 0xbc, 0x15, 0x6e, 0xe3,
+	0x9b, 0x82, 0x69, 0x49, 0x64, 0xc9, 0x1b, 0x22, 0x4b, 0x62, 0x0d, 0x23, 0x5b, 0x0f, 0xe6, 0x91,
+	0xb4, 0xa0, 0xc0, 0xd4, 0x0e, 0x38, 0xe9, 0x8c, 0x76, 0x9a, 0xd4, 0x5c, 0x0a, 0x45, 0x9c, 0xee,
+	0x04, 0x2b, 0x02, 0x89, 0x27, 0x01, 0x1d, 0xff, 0xa6, 0x91, 0xb6, 0xfd, 0x21, 0x09, 0xf5, 0xae,
+	0xf9, 0xaa, 0xc9, 0x28, 0x8e, 0x6e, 0x3f, 0xc6, 0x2b, 0x70, 0x14, 0x6c, 0xa7, 0x95, 0x85, 0xf8,
+	0x04, 0x4f, 0x42, 0x24, 0x41, 0x0b, 0x94, 0x1f, 0x9d, 0xce, 0x8a, 0xb1, 0x58, 0x84, 0x24, 0x1d,
+	0x96, 0x71, 0x82, 0x0f, 0x77, 0x60, 0xac, 0xd0, 0x2a, 0xf9, 0xbf, 0x40, 0xf9, 0x94, 0xee, 0xc7,
+	0xec, 0x6c, 0x74, 0x5e, 0x2b, 0xe1, 0x28, 0x6c, 0x3d, 0x58, 0x17, 0xe7, 0x38, 0x52, 0x5e, 0x36,
+	0x60, 0x2a, 0xbd, 0xae, 0xec, 0xa6, 0x36, 0xdc, 0x06, 0xfd, 0x94, 0xce, 0x06, 0x7e, 0xb3, 0x5e,
+	0x05, 0x9a, 0xdd, 0xe3, 0x79, 0x68, 0x5f, 0x70, 0xbe, 0x2f, 0x2f, 0x71, 0xc4, 0xb4, 0xb2, 0x5e,
+	0x82, 0xa9, 0x2c, 0x98, 0x9d, 0x60, 0x30, 0xde, 0x96, 0x7c, 0xde, 0xb6, 0x1c, 0x03, 0xab, 0x61,
+	0x4f, 0xe7, 0xec, 0x3b, 0xc8, 0x1e, 0x70, 0x1c, 0xbc, 0x77, 0x1d, 0xaf, 0x1d, 0xfc, 0xa5, 0xfa,
+	0x32, 0x7a, 0xee, 0x53, 0xf4, 0xd2, 0xa7, 0xe8, 0xb5, 0x4f, 0xd1, 0xd3, 0x5b, 0xfa, 0xaf, 0x39,
+	0x08, 0xdf, 0x2d, 0xdf, 0x07, 0x00, 0x2f, 0x54, 0xdb, 0x8f, 0xe6, 0x01, 0x00, 0x00,
 }
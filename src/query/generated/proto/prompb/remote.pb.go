@@ -34,8 +34,11 @@
 		ReadResponse
 		Query
 		QueryResult
+		ChunkedReadResponse
 		Sample
 		TimeSeries
+		Chunk
+		ChunkedSeries
 		Label
 		Labels
 		LabelMatcher
@@ -59,6 +62,33 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type ReadRequest_ResponseType int32
+
+const (
+	// Server will return a single ReadResponse message with matched series
+	// that includes list of raw samples.
+	ReadRequest_SAMPLES ReadRequest_ResponseType = 0
+	// Server will stream a delimited ChunkedReadResponse message that contains
+	// XOR encoded chunks for a single series.
+	ReadRequest_STREAMED_XOR_CHUNKS ReadRequest_ResponseType = 1
+)
+
+var ReadRequest_ResponseType_name = map[int32]string{
+	0: "SAMPLES",
+	1: "STREAMED_XOR_CHUNKS",
+}
+var ReadRequest_ResponseType_value = map[string]int32{
+	"SAMPLES":             0,
+	"STREAMED_XOR_CHUNKS": 1,
+}
+
+func (x ReadRequest_ResponseType) String() string {
+	return proto.EnumName(ReadRequest_ResponseType_name, int32(x))
+}
+func (ReadRequest_ResponseType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorRemote, []int{1, 0}
+}
+
 type WriteRequest struct {
 	Timeseries []*TimeSeries `protobuf:"bytes,1,rep,name=timeseries" json:"timeseries,omitempty"`
 }
@@ -77,6 +107,9 @@ func (m *WriteRequest) GetTimeseries() []*TimeSeries {
 
 type ReadRequest struct {
 	Queries []*Query `protobuf:"bytes,1,rep,name=queries" json:"queries,omitempty"`
+	// Accepted response types in order of preference, SAMPLES is assumed if
+	// none are specified.
+	AcceptedResponseTypes []ReadRequest_ResponseType `protobuf:"varint,2,rep,packed,name=accepted_response_types,json=acceptedResponseTypes,enum=prometheus.ReadRequest_ResponseType" json:"accepted_response_types,omitempty"`
 }
 
 func (m *ReadRequest) Reset()                    { *m = ReadRequest{} }
@@ -91,6 +124,13 @@ func (m *ReadRequest) GetQueries() []*Query {
 	return nil
 }
 
+func (m *ReadRequest) GetAcceptedResponseTypes() []ReadRequest_ResponseType {
+	if m != nil {
+		return m.AcceptedResponseTypes
+	}
+	return nil
+}
+
 type ReadResponse struct {
 	// In same order as the request's queries.
 	Results []*QueryResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
@@ -156,12 +196,43 @@ func (m *QueryResult) GetTimeseries() []*TimeSeries {
 	return nil
 }
 
+// ChunkedReadResponse is a response when response_type equals STREAMED_XOR_CHUNKS.
+// Each message is prefixed by its uvarint length and the big endian CRC32
+// (Castagnoli) checksum of the message.
+type ChunkedReadResponse struct {
+	ChunkedSeries []*ChunkedSeries `protobuf:"bytes,1,rep,name=chunked_series,json=chunkedSeries" json:"chunked_series,omitempty"`
+	// Query index is the index of the query from the read request this
+	// response belongs to.
+	QueryIndex int64 `protobuf:"varint,2,opt,name=query_index,json=queryIndex,proto3" json:"query_index,omitempty"`
+}
+
+func (m *ChunkedReadResponse) Reset()                    { *m = ChunkedReadResponse{} }
+func (m *ChunkedReadResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChunkedReadResponse) ProtoMessage()               {}
+func (*ChunkedReadResponse) Descriptor() ([]byte, []int) { return fileDescriptorRemote, []int{5} }
+
+func (m *ChunkedReadResponse) GetChunkedSeries() []*ChunkedSeries {
+	if m != nil {
+		return m.ChunkedSeries
+	}
+	return nil
+}
+
+func (m *ChunkedReadResponse) GetQueryIndex() int64 {
+	if m != nil {
+		return m.QueryIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*WriteRequest)(nil), "prometheus.WriteRequest")
 	proto.RegisterType((*ReadRequest)(nil), "prometheus.ReadRequest")
 	proto.RegisterType((*ReadResponse)(nil), "prometheus.ReadResponse")
 	proto.RegisterType((*Query)(nil), "prometheus.Query")
 	proto.RegisterType((*QueryResult)(nil), "prometheus.QueryResult")
+	proto.RegisterType((*ChunkedReadResponse)(nil), "prometheus.ChunkedReadResponse")
+	proto.RegisterEnum("prometheus.ReadRequest_ResponseType", ReadRequest_ResponseType_name, ReadRequest_ResponseType_value)
 }
 func (m *WriteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -220,6 +291,23 @@ func (m *ReadRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.AcceptedResponseTypes) > 0 {
+		dAtA2 := make([]byte, len(m.AcceptedResponseTypes)*10)
+		var j1 int
+		for _, num := range m.AcceptedResponseTypes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(j1))
+		i += copy(dAtA[i:], dAtA2[:j1])
+	}
 	return i, nil
 }
 
@@ -323,6 +411,41 @@ func (m *QueryResult) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ChunkedReadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkedReadResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChunkedSeries) > 0 {
+		for _, msg := range m.ChunkedSeries {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRemote(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.QueryIndex != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRemote(dAtA, i, uint64(m.QueryIndex))
+	}
+	return i, nil
+}
+
 func encodeVarintRemote(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -353,6 +476,13 @@ func (m *ReadRequest) Size() (n int) {
 			n += 1 + l + sovRemote(uint64(l))
 		}
 	}
+	if len(m.AcceptedResponseTypes) > 0 {
+		l = 0
+		for _, e := range m.AcceptedResponseTypes {
+			l += sovRemote(uint64(e))
+		}
+		n += 1 + sovRemote(uint64(l)) + l
+	}
 	return n
 }
 
@@ -398,6 +528,21 @@ func (m *QueryResult) Size() (n int) {
 	return n
 }
 
+func (m *ChunkedReadResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ChunkedSeries) > 0 {
+		for _, e := range m.ChunkedSeries {
+			l = e.Size()
+			n += 1 + l + sovRemote(uint64(l))
+		}
+	}
+	if m.QueryIndex != 0 {
+		n += 1 + sovRemote(uint64(m.QueryIndex))
+	}
+	return n
+}
+
 func sovRemote(x uint64) (n int) {
 	for {
 		n++
@@ -552,6 +697,68 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v ReadRequest_ResponseType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRemote
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (ReadRequest_ResponseType(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AcceptedResponseTypes = append(m.AcceptedResponseTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRemote
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRemote
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v ReadRequest_ResponseType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRemote
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (ReadRequest_ResponseType(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AcceptedResponseTypes = append(m.AcceptedResponseTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedResponseTypes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRemote(dAtA[iNdEx:])
@@ -854,6 +1061,106 @@ func (m *QueryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChunkedReadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemote
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkedReadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkedReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkedSeries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemote
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChunkedSeries = append(m.ChunkedSeries, &ChunkedSeries{})
+			if err := m.ChunkedSeries[len(m.ChunkedSeries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryIndex", wireType)
+			}
+			m.QueryIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemote
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryIndex |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemote(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRemote
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRemote(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -964,26 +1271,34 @@ func init() {
 }
 
 var fileDescriptorRemote = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xbf, 0x4a, 0xc3, 0x50,
-	0x14, 0xc6, 0x8d, 0xc5, 0x56, 0x4e, 0x8b, 0xd4, 0x0c, 0x1a, 0x1c, 0x82, 0x64, 0x2a, 0x28, 0xb9,
-	0x68, 0xc5, 0xc1, 0xc5, 0x3f, 0xa0, 0x93, 0x1d, 0xbc, 0x16, 0x04, 0x97, 0x92, 0x34, 0x87, 0x36,
-	0xd0, 0x9b, 0xa4, 0xf7, 0x9c, 0x0c, 0x7d, 0x0b, 0x17, 0xdf, 0xc9, 0xd1, 0x47, 0x90, 0xf8, 0x22,
-	0x92, 0x1b, 0x62, 0x23, 0x6e, 0x5d, 0x32, 0x9c, 0xef, 0x77, 0xbe, 0xfc, 0xb8, 0x07, 0x6e, 0x66,
-	0x31, 0xcf, 0xf3, 0xd0, 0x9f, 0xa6, 0x4a, 0xa8, 0x61, 0x14, 0x0a, 0x35, 0x14, 0xa4, 0xa7, 0x62,
-	0x99, 0xa3, 0x5e, 0x89, 0x19, 0x26, 0xa8, 0x03, 0xc6, 0x48, 0x64, 0x3a, 0xe5, 0xb4, 0xfc, 0xaa,
-	0x2c, 0x14, 0x1a, 0x55, 0xca, 0xe8, 0x9b, 0x99, 0x0d, 0xe5, 0x10, 0x79, 0x8e, 0x39, 0x1d, 0x5d,
-	0x6f, 0xd2, 0xc6, 0xab, 0x0c, 0xa9, 0x2a, 0xf3, 0x1e, 0xa0, 0xf7, 0xa2, 0x63, 0x46, 0x89, 0xcb,
-	0x1c, 0x89, 0xed, 0x4b, 0x00, 0x8e, 0x15, 0x12, 0xea, 0x18, 0xc9, 0xb1, 0x8e, 0x5b, 0x83, 0xee,
-	0xf9, 0x81, 0xbf, 0xfe, 0xa3, 0x3f, 0x8e, 0x15, 0x3e, 0x9b, 0x54, 0x36, 0x48, 0xef, 0x0a, 0xba,
-	0x12, 0x83, 0xa8, 0xae, 0x39, 0x81, 0x4e, 0xa9, 0xb0, 0xee, 0xd8, 0x6f, 0x76, 0x3c, 0x95, 0x76,
-	0xb2, 0x26, 0xbc, 0x5b, 0xe8, 0x55, 0xbb, 0x94, 0xa5, 0x09, 0xa1, 0x7d, 0x06, 0x1d, 0x8d, 0x94,
-	0x2f, 0xb8, 0x5e, 0x3e, 0xfc, 0xbf, 0x6c, 0x72, 0x59, 0x73, 0xde, 0xbb, 0x05, 0x3b, 0x26, 0xb0,
-	0x4f, 0xc1, 0x26, 0x0e, 0x34, 0x4f, 0x8c, 0x1c, 0x07, 0x2a, 0x9b, 0xa8, 0xb2, 0xc7, 0x1a, 0xb4,
-	0x64, 0xdf, 0x24, 0xe3, 0x3a, 0x18, 0x91, 0x3d, 0x80, 0x3e, 0x26, 0xd1, 0x5f, 0x76, 0xdb, 0xb0,
-	0x7b, 0x98, 0x44, 0x4d, 0xf2, 0x02, 0x76, 0x55, 0xc0, 0xd3, 0x39, 0x6a, 0x72, 0x5a, 0xc6, 0xca,
-	0x69, 0x5a, 0x3d, 0x06, 0x21, 0x2e, 0x46, 0x15, 0x20, 0x7f, 0x49, 0xef, 0x1e, 0xba, 0x0d, 0xdf,
-	0x4d, 0x5f, 0xf7, 0xce, 0xf9, 0x28, 0x5c, 0xeb, 0xb3, 0x70, 0xad, 0xaf, 0xc2, 0xb5, 0xde, 0xbe,
-	0xdd, 0xad, 0xd7, 0x76, 0x75, 0xcb, 0xb0, 0x6d, 0xce, 0x38, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff,
-	0x6c, 0xdc, 0xb6, 0x36, 0x57, 0x02, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xdf, 0x8a, 0xd3, 0x40,
+	0x14, 0xc6, 0x37, 0x5b, 0xdc, 0xca, 0x49, 0x2d, 0x75, 0x16, 0x6d, 0xf4, 0xa2, 0x2e, 0xc1, 0x8b,
+	0x82, 0x92, 0xe0, 0x76, 0xf1, 0xd6, 0xad, 0x6b, 0x45, 0x71, 0xeb, 0x9f, 0x49, 0x45, 0x11, 0x21,
+	0x24, 0x99, 0xc3, 0x36, 0xb8, 0x93, 0xa4, 0x33, 0x13, 0xd8, 0xbe, 0x85, 0x37, 0xbe, 0x93, 0x57,
+	0xe2, 0x23, 0x48, 0x7d, 0x11, 0xc9, 0x24, 0xd1, 0x29, 0xde, 0xf5, 0xa6, 0xd0, 0xef, 0x7c, 0xe7,
+	0x77, 0xbe, 0x33, 0x39, 0x70, 0x7a, 0x91, 0xaa, 0x65, 0x19, 0x7b, 0x49, 0xce, 0x7d, 0x3e, 0x61,
+	0xb1, 0xcf, 0x27, 0xbe, 0x14, 0x89, 0xbf, 0x2a, 0x51, 0xac, 0xfd, 0x0b, 0xcc, 0x50, 0x44, 0x0a,
+	0x99, 0x5f, 0x88, 0x5c, 0xe5, 0xd5, 0x2f, 0x2f, 0x62, 0x5f, 0x20, 0xcf, 0x15, 0x7a, 0x5a, 0x23,
+	0x50, 0x89, 0xa8, 0x96, 0x58, 0xca, 0xbb, 0x4f, 0x76, 0xa1, 0xa9, 0x75, 0x81, 0xb2, 0x86, 0xb9,
+	0xcf, 0xa1, 0xf7, 0x41, 0xa4, 0x0a, 0x29, 0xae, 0x4a, 0x94, 0x8a, 0x3c, 0x06, 0x50, 0x29, 0x47,
+	0x89, 0x22, 0x45, 0xe9, 0x58, 0x47, 0x9d, 0xb1, 0x7d, 0x7c, 0xdb, 0xfb, 0x37, 0xd1, 0x5b, 0xa4,
+	0x1c, 0x03, 0x5d, 0xa5, 0x86, 0xd3, 0xfd, 0x61, 0x81, 0x4d, 0x31, 0x62, 0x2d, 0xe7, 0x01, 0x74,
+	0x57, 0xa5, 0x09, 0xb9, 0x69, 0x42, 0xde, 0x55, 0xf1, 0x68, 0xeb, 0x20, 0x9f, 0x61, 0x18, 0x25,
+	0x09, 0x16, 0x0a, 0x59, 0x28, 0x50, 0x16, 0x79, 0x26, 0x31, 0xd4, 0x29, 0x9d, 0xfd, 0xa3, 0xce,
+	0xb8, 0x7f, 0x7c, 0xdf, 0x6c, 0x36, 0xc6, 0x78, 0xb4, 0x71, 0x2f, 0xd6, 0x05, 0xd2, 0x5b, 0x2d,
+	0xc4, 0x54, 0xa5, 0x7b, 0x02, 0x3d, 0x53, 0x20, 0x36, 0x74, 0x83, 0xe9, 0xfc, 0xed, 0xf9, 0x2c,
+	0x18, 0xec, 0x91, 0x21, 0x1c, 0x06, 0x0b, 0x3a, 0x9b, 0xce, 0x67, 0xcf, 0xc2, 0x8f, 0x6f, 0x68,
+	0x78, 0xf6, 0xe2, 0xfd, 0xeb, 0x57, 0xc1, 0xc0, 0x72, 0xa7, 0xd0, 0xab, 0x07, 0xd5, 0x9d, 0xe4,
+	0x11, 0x74, 0x05, 0xca, 0xf2, 0x52, 0xb5, 0x0b, 0x0d, 0xff, 0x5f, 0x48, 0xd7, 0x69, 0xeb, 0x73,
+	0xbf, 0x59, 0x70, 0x4d, 0x17, 0xc8, 0x43, 0x20, 0x52, 0x45, 0x42, 0x85, 0xfa, 0xc5, 0x54, 0xc4,
+	0x8b, 0x90, 0x57, 0x1c, 0x6b, 0xdc, 0xa1, 0x03, 0x5d, 0x59, 0xb4, 0x85, 0xb9, 0x24, 0x63, 0x18,
+	0x60, 0xc6, 0xb6, 0xbd, 0xfb, 0xda, 0xdb, 0xc7, 0x8c, 0x99, 0xce, 0x13, 0xb8, 0xce, 0x23, 0x95,
+	0x2c, 0x51, 0x48, 0xa7, 0xa3, 0x53, 0x39, 0x66, 0xaa, 0xf3, 0x28, 0xc6, 0xcb, 0x79, 0x6d, 0xa0,
+	0x7f, 0x9d, 0xee, 0x0c, 0x6c, 0x23, 0xef, 0xce, 0x9f, 0xfc, 0x0a, 0x0e, 0xcf, 0x96, 0x65, 0xf6,
+	0x05, 0xd9, 0xd6, 0x43, 0x9d, 0x42, 0x3f, 0xa9, 0xe5, 0x70, 0x0b, 0x79, 0xc7, 0x44, 0x36, 0x8d,
+	0x0d, 0xf5, 0x46, 0x62, 0xfe, 0x25, 0xf7, 0xc0, 0xd6, 0xf7, 0x1b, 0xa6, 0x19, 0xc3, 0xab, 0x66,
+	0x75, 0xd0, 0xd2, 0xcb, 0x4a, 0x79, 0xea, 0x7c, 0xdf, 0x8c, 0xac, 0x9f, 0x9b, 0x91, 0xf5, 0x6b,
+	0x33, 0xb2, 0xbe, 0xfe, 0x1e, 0xed, 0x7d, 0x3a, 0xa8, 0x4f, 0x3b, 0x3e, 0xd0, 0x57, 0x3d, 0xf9,
+	0x33, 0x00, 0x76, 0x35, 0x7d, 0x4a, 0x66, 0x03, 0x00, 0x00,
 }
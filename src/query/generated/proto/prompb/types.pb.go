@@ -37,6 +37,27 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type Chunk_Encoding int32
+
+const (
+	Chunk_UNKNOWN Chunk_Encoding = 0
+	Chunk_XOR     Chunk_Encoding = 1
+)
+
+var Chunk_Encoding_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "XOR",
+}
+var Chunk_Encoding_value = map[string]int32{
+	"UNKNOWN": 0,
+	"XOR":     1,
+}
+
+func (x Chunk_Encoding) String() string {
+	return proto.EnumName(Chunk_Encoding_name, int32(x))
+}
+func (Chunk_Encoding) EnumDescriptor() ([]byte, []int) { return fileDescriptorTypes, []int{2, 0} }
+
 type LabelMatcher_Type int32
 
 const (
@@ -62,7 +83,7 @@ var LabelMatcher_Type_value = map[string]int32{
 func (x LabelMatcher_Type) String() string {
 	return proto.EnumName(LabelMatcher_Type_name, int32(x))
 }
-func (LabelMatcher_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorTypes, []int{4, 0} }
+func (LabelMatcher_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorTypes, []int{6, 0} }
 
 type Sample struct {
 	Value     float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -112,6 +133,72 @@ func (m *TimeSeries) GetSamples() []*Sample {
 	return nil
 }
 
+// Chunk represents a chunk of encoded samples for a single series.
+type Chunk struct {
+	MinTimeMs int64          `protobuf:"varint,1,opt,name=min_time_ms,json=minTimeMs,proto3" json:"min_time_ms,omitempty"`
+	MaxTimeMs int64          `protobuf:"varint,2,opt,name=max_time_ms,json=maxTimeMs,proto3" json:"max_time_ms,omitempty"`
+	Type      Chunk_Encoding `protobuf:"varint,3,opt,name=type,proto3,enum=prometheus.Chunk_Encoding" json:"type,omitempty"`
+	Data      []byte         `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *Chunk) Reset()                    { *m = Chunk{} }
+func (m *Chunk) String() string            { return proto.CompactTextString(m) }
+func (*Chunk) ProtoMessage()               {}
+func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{2} }
+
+func (m *Chunk) GetMinTimeMs() int64 {
+	if m != nil {
+		return m.MinTimeMs
+	}
+	return 0
+}
+
+func (m *Chunk) GetMaxTimeMs() int64 {
+	if m != nil {
+		return m.MaxTimeMs
+	}
+	return 0
+}
+
+func (m *Chunk) GetType() Chunk_Encoding {
+	if m != nil {
+		return m.Type
+	}
+	return Chunk_UNKNOWN
+}
+
+func (m *Chunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// ChunkedSeries represents a single, encoded time series.
+type ChunkedSeries struct {
+	Labels []*Label `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty"`
+	Chunks []*Chunk `protobuf:"bytes,2,rep,name=chunks" json:"chunks,omitempty"`
+}
+
+func (m *ChunkedSeries) Reset()                    { *m = ChunkedSeries{} }
+func (m *ChunkedSeries) String() string            { return proto.CompactTextString(m) }
+func (*ChunkedSeries) ProtoMessage()               {}
+func (*ChunkedSeries) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{3} }
+
+func (m *ChunkedSeries) GetLabels() []*Label {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ChunkedSeries) GetChunks() []*Chunk {
+	if m != nil {
+		return m.Chunks
+	}
+	return nil
+}
+
 type Label struct {
 	Name  []byte `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -120,7 +207,7 @@ type Label struct {
 func (m *Label) Reset()                    { *m = Label{} }
 func (m *Label) String() string            { return proto.CompactTextString(m) }
 func (*Label) ProtoMessage()               {}
-func (*Label) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{2} }
+func (*Label) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{4} }
 
 func (m *Label) GetName() []byte {
 	if m != nil {
@@ -143,7 +230,7 @@ type Labels struct {
 func (m *Labels) Reset()                    { *m = Labels{} }
 func (m *Labels) String() string            { return proto.CompactTextString(m) }
 func (*Labels) ProtoMessage()               {}
-func (*Labels) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{3} }
+func (*Labels) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{5} }
 
 func (m *Labels) GetLabels() []Label {
 	if m != nil {
@@ -162,7 +249,7 @@ type LabelMatcher struct {
 func (m *LabelMatcher) Reset()                    { *m = LabelMatcher{} }
 func (m *LabelMatcher) String() string            { return proto.CompactTextString(m) }
 func (*LabelMatcher) ProtoMessage()               {}
-func (*LabelMatcher) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{4} }
+func (*LabelMatcher) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{6} }
 
 func (m *LabelMatcher) GetType() LabelMatcher_Type {
 	if m != nil {
@@ -188,9 +275,12 @@ func (m *LabelMatcher) GetValue() []byte {
 func init() {
 	proto.RegisterType((*Sample)(nil), "prometheus.Sample")
 	proto.RegisterType((*TimeSeries)(nil), "prometheus.TimeSeries")
+	proto.RegisterType((*Chunk)(nil), "prometheus.Chunk")
+	proto.RegisterType((*ChunkedSeries)(nil), "prometheus.ChunkedSeries")
 	proto.RegisterType((*Label)(nil), "prometheus.Label")
 	proto.RegisterType((*Labels)(nil), "prometheus.Labels")
 	proto.RegisterType((*LabelMatcher)(nil), "prometheus.LabelMatcher")
+	proto.RegisterEnum("prometheus.Chunk_Encoding", Chunk_Encoding_name, Chunk_Encoding_value)
 	proto.RegisterEnum("prometheus.LabelMatcher_Type", LabelMatcher_Type_name, LabelMatcher_Type_value)
 }
 func (m *Sample) Marshal() (dAtA []byte, err error) {
@@ -264,6 +354,87 @@ func (m *TimeSeries) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Chunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Chunk) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MinTimeMs != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinTimeMs))
+	}
+	if m.MaxTimeMs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxTimeMs))
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *ChunkedSeries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkedSeries) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, msg := range m.Labels {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Chunks) > 0 {
+		for _, msg := range m.Chunks {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func (m *Label) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -398,6 +569,43 @@ func (m *TimeSeries) Size() (n int) {
 	return n
 }
 
+func (m *Chunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.MinTimeMs != 0 {
+		n += 1 + sovTypes(uint64(m.MinTimeMs))
+	}
+	if m.MaxTimeMs != 0 {
+		n += 1 + sovTypes(uint64(m.MaxTimeMs))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ChunkedSeries) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Chunks) > 0 {
+		for _, e := range m.Chunks {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Label) Size() (n int) {
 	var l int
 	_ = l
@@ -646,6 +854,256 @@ func (m *TimeSeries) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Chunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTimeMs", wireType)
+			}
+			m.MinTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTimeMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeMs", wireType)
+			}
+			m.MaxTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTimeMs |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (Chunk_Encoding(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChunkedSeries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChunkedSeries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChunkedSeries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, &Label{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunks = append(m.Chunks, &Chunk{})
+			if err := m.Chunks[len(m.Chunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Label) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1080,28 +1538,36 @@ func init() {
 }
 
 var fileDescriptorTypes = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x8a, 0xdb, 0x30,
-	0x10, 0x86, 0x23, 0xdb, 0x71, 0xe8, 0x34, 0x14, 0x57, 0xf4, 0x60, 0x4a, 0xeb, 0x06, 0x9f, 0x5c,
-	0x68, 0x2d, 0x92, 0x9c, 0x0a, 0x85, 0x42, 0xc0, 0xb7, 0xb4, 0x10, 0x25, 0xa7, 0xde, 0xec, 0x64,
-	0xea, 0x18, 0xac, 0xd8, 0xb5, 0xe4, 0x42, 0xde, 0xa2, 0x97, 0xbe, 0x53, 0x8e, 0x7d, 0x82, 0x65,
-	0xc9, 0xbe, 0xc8, 0x62, 0x29, 0xd9, 0x04, 0x76, 0x61, 0x2f, 0x66, 0xe6, 0xf7, 0xff, 0xcf, 0x7c,
-	0x48, 0x82, 0x6f, 0x79, 0xa1, 0xb6, 0x6d, 0x16, 0xaf, 0x2b, 0xc1, 0xc4, 0x74, 0x93, 0x31, 0x31,
-	0x65, 0xb2, 0x59, 0xb3, 0xdf, 0x2d, 0x36, 0x7b, 0x96, 0xe3, 0x0e, 0x9b, 0x54, 0xe1, 0x86, 0xd5,
-	0x4d, 0xa5, 0xaa, 0xee, 0x2b, 0xea, 0x8c, 0xa9, 0x7d, 0x8d, 0x32, 0xd6, 0x12, 0x85, 0x4e, 0x43,
-	0xb5, 0xc5, 0x56, 0xbe, 0xfd, 0x7c, 0x35, 0x2c, 0xaf, 0xf2, 0xca, 0xa4, 0xb2, 0xf6, 0x97, 0xee,
-	0xcc, 0x88, 0xae, 0x32, 0xd1, 0xf0, 0x2b, 0xb8, 0xcb, 0x54, 0xd4, 0x25, 0xd2, 0x37, 0xd0, 0xff,
-	0x93, 0x96, 0x2d, 0xfa, 0x64, 0x44, 0x22, 0xc2, 0x4d, 0x43, 0xdf, 0xc1, 0x0b, 0x55, 0x08, 0x94,
-	0x2a, 0x15, 0xb5, 0x6f, 0x8d, 0x48, 0x64, 0xf3, 0x8b, 0x10, 0x22, 0xc0, 0xaa, 0x10, 0xb8, 0xc4,
-	0xa6, 0x40, 0x49, 0x3f, 0x82, 0x5b, 0xa6, 0x19, 0x96, 0xd2, 0x27, 0x23, 0x3b, 0x7a, 0x39, 0x79,
-	0x1d, 0x5f, 0xb8, 0xe2, 0x79, 0xf7, 0x87, 0x9f, 0x0c, 0xf4, 0x13, 0x0c, 0xa4, 0x5e, 0x2b, 0x7d,
-	0x4b, 0x7b, 0xe9, 0xb5, 0xd7, 0x10, 0xf1, 0xb3, 0x25, 0x1c, 0x43, 0x5f, 0xc7, 0x29, 0x05, 0x67,
-	0x97, 0x0a, 0x83, 0x38, 0xe4, 0xba, 0xbe, 0x70, 0x5b, 0x5a, 0x34, 0x4d, 0xf8, 0x05, 0xdc, 0xb9,
-	0x59, 0xc5, 0x9e, 0xa5, 0x9a, 0x39, 0x87, 0x9b, 0x0f, 0xbd, 0x33, 0x5b, 0xf8, 0x8f, 0xc0, 0x50,
-	0xeb, 0xdf, 0x53, 0xb5, 0xde, 0x62, 0x43, 0xc7, 0xe0, 0x74, 0xa7, 0xad, 0xb7, 0xbe, 0x9a, 0xbc,
-	0x7f, 0x94, 0x3f, 0xf9, 0xe2, 0xd5, 0xbe, 0x46, 0xae, 0xad, 0x0f, 0xa0, 0xd6, 0x53, 0xa0, 0xf6,
-	0x35, 0x68, 0x04, 0x4e, 0x97, 0xa3, 0x2e, 0x58, 0xc9, 0xc2, 0xeb, 0xd1, 0x01, 0xd8, 0x3f, 0x92,
-	0x85, 0x47, 0x3a, 0x81, 0x27, 0x9e, 0xa5, 0x05, 0x9e, 0x78, 0xf6, 0xcc, 0x3f, 0x1c, 0x03, 0xf2,
-	0xff, 0x18, 0x90, 0xdb, 0x63, 0x40, 0xfe, 0xde, 0x05, 0xbd, 0x9f, 0xae, 0x79, 0x0b, 0x99, 0xab,
-	0xef, 0x72, 0x7a, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x4a, 0x7b, 0x68, 0x49, 0x02, 0x00, 0x00,
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xd1, 0x6a, 0xd4, 0x40,
+	0x14, 0x86, 0x77, 0x92, 0xdd, 0xac, 0x3d, 0xbb, 0x4a, 0x3a, 0x78, 0x11, 0x8a, 0xc6, 0x90, 0xab,
+	0x14, 0x34, 0xa1, 0xdd, 0x2b, 0x41, 0x10, 0x2a, 0xb9, 0xb2, 0xdd, 0xd2, 0x69, 0x45, 0xf1, 0xa6,
+	0x4c, 0x92, 0x31, 0x1b, 0xdc, 0x49, 0x62, 0x26, 0x91, 0xee, 0x5b, 0x78, 0xe3, 0x63, 0xf8, 0x1e,
+	0xbd, 0xf4, 0x09, 0x44, 0xd6, 0x17, 0x91, 0x99, 0xc9, 0xba, 0x0b, 0x15, 0xc4, 0x9b, 0x65, 0xe6,
+	0x3f, 0xff, 0x39, 0xe7, 0xe3, 0x9f, 0x0d, 0xbc, 0xcc, 0x8b, 0x76, 0xd1, 0x25, 0x61, 0x5a, 0xf1,
+	0x88, 0xcf, 0xb2, 0x24, 0xe2, 0xb3, 0x48, 0x34, 0x69, 0xf4, 0xa9, 0x63, 0xcd, 0x2a, 0xca, 0x59,
+	0xc9, 0x1a, 0xda, 0xb2, 0x2c, 0xaa, 0x9b, 0xaa, 0xad, 0xe4, 0x2f, 0xaf, 0x93, 0xa8, 0x5d, 0xd5,
+	0x4c, 0x84, 0x4a, 0xc2, 0x20, 0x35, 0xd6, 0x2e, 0x58, 0x27, 0x0e, 0x9e, 0xed, 0x0c, 0xcb, 0xab,
+	0xbc, 0xd2, 0x5d, 0x49, 0xf7, 0x41, 0xdd, 0xf4, 0x08, 0x79, 0xd2, 0xad, 0xfe, 0x0b, 0xb0, 0x2e,
+	0x29, 0xaf, 0x97, 0x0c, 0x3f, 0x84, 0xd1, 0x67, 0xba, 0xec, 0x98, 0x83, 0x3c, 0x14, 0x20, 0xa2,
+	0x2f, 0xf8, 0x11, 0xec, 0xb5, 0x05, 0x67, 0xa2, 0xa5, 0xbc, 0x76, 0x0c, 0x0f, 0x05, 0x26, 0xd9,
+	0x0a, 0x3e, 0x03, 0xb8, 0x2a, 0x38, 0xbb, 0x64, 0x4d, 0xc1, 0x04, 0x3e, 0x04, 0x6b, 0x49, 0x13,
+	0xb6, 0x14, 0x0e, 0xf2, 0xcc, 0x60, 0x72, 0xbc, 0x1f, 0x6e, 0xb9, 0xc2, 0x53, 0x59, 0x21, 0xbd,
+	0x01, 0x3f, 0x85, 0xb1, 0x50, 0x6b, 0x85, 0x63, 0x28, 0x2f, 0xde, 0xf5, 0x6a, 0x22, 0xb2, 0xb1,
+	0xf8, 0xdf, 0x10, 0x8c, 0x5e, 0x2d, 0xba, 0xf2, 0x23, 0x76, 0x61, 0xc2, 0x8b, 0xf2, 0x5a, 0x12,
+	0x5c, 0x73, 0xa1, 0x50, 0x4d, 0xb2, 0xc7, 0x8b, 0x52, 0x62, 0x9c, 0x09, 0x55, 0xa7, 0x37, 0x7f,
+	0xea, 0x3d, 0x30, 0xa7, 0x37, 0x7d, 0x3d, 0x84, 0xa1, 0x0c, 0xce, 0x31, 0x3d, 0x14, 0x3c, 0x38,
+	0x3e, 0xd8, 0x5d, 0xaa, 0x16, 0x84, 0x71, 0x99, 0x56, 0x59, 0x51, 0xe6, 0x44, 0xf9, 0x30, 0x86,
+	0x61, 0x46, 0x5b, 0xea, 0x0c, 0x3d, 0x14, 0x4c, 0x89, 0x3a, 0xfb, 0x1e, 0xdc, 0xdb, 0xb8, 0xf0,
+	0x04, 0xc6, 0x6f, 0xe6, 0xaf, 0xe7, 0xe7, 0x6f, 0xe7, 0xf6, 0x00, 0x8f, 0xc1, 0x7c, 0x77, 0x4e,
+	0x6c, 0xe4, 0x33, 0xb8, 0xaf, 0xa6, 0xb1, 0xec, 0xff, 0x93, 0x39, 0x04, 0x2b, 0x95, 0xbd, 0x9b,
+	0x60, 0xf6, 0xef, 0x30, 0x92, 0xde, 0xe0, 0x1f, 0xc1, 0x48, 0xf5, 0x4a, 0xca, 0x92, 0x72, 0xfd,
+	0x72, 0x53, 0xa2, 0xce, 0xdb, 0xe7, 0x34, 0x94, 0xa8, 0x2f, 0xfe, 0x73, 0xb0, 0x4e, 0xf5, 0x9e,
+	0xe8, 0x9f, 0x48, 0x27, 0xc3, 0xdb, 0x1f, 0x4f, 0x06, 0x1b, 0x30, 0xff, 0x2b, 0x82, 0xa9, 0xd2,
+	0xcf, 0x68, 0x9b, 0x2e, 0x58, 0x83, 0x8f, 0xfa, 0x2c, 0x91, 0xca, 0xf2, 0xf1, 0x9d, 0xfe, 0xde,
+	0x17, 0x5e, 0xad, 0x6a, 0xb6, 0x8d, 0x53, 0x81, 0x1a, 0x7f, 0x03, 0x35, 0x77, 0x41, 0x03, 0x18,
+	0xca, 0x3e, 0x6c, 0x81, 0x11, 0x5f, 0xe8, 0x6c, 0xe7, 0xf1, 0x85, 0x8d, 0xa4, 0x40, 0x62, 0xdb,
+	0x50, 0x02, 0x89, 0x6d, 0xf3, 0xc4, 0xb9, 0x5d, 0xbb, 0xe8, 0xfb, 0xda, 0x45, 0x3f, 0xd7, 0x2e,
+	0xfa, 0xf2, 0xcb, 0x1d, 0xbc, 0xb7, 0xf4, 0x27, 0x92, 0x58, 0xea, 0x2f, 0x3e, 0xfb, 0x3d, 0x00,
+	0xc5, 0xb6, 0xd6, 0xbe, 0x60, 0x03, 0x00, 0x00,
 }
@@ -53,6 +53,10 @@
 		TagValue
 		TagValues
 		CompleteTagsResponse
+		WriteAttributes
+		WriteRequest
+		WriteBatchRequest
+		WriteResponse
 */
 package rpcpb
 
@@ -136,6 +140,27 @@ func (x CompleteTagsType) String() string {
 }
 func (CompleteTagsType) EnumDescriptor() ([]byte, []int) { return fileDescriptorQuery, []int{1} }
 
+type MetricsType int32
+
+const (
+	MetricsType_UNAGGREGATED MetricsType = 0
+	MetricsType_AGGREGATED   MetricsType = 1
+)
+
+var MetricsType_name = map[int32]string{
+	0: "UNAGGREGATED",
+	1: "AGGREGATED",
+}
+var MetricsType_value = map[string]int32{
+	"UNAGGREGATED": 0,
+	"AGGREGATED":   1,
+}
+
+func (x MetricsType) String() string {
+	return proto.EnumName(MetricsType_name, int32(x))
+}
+func (MetricsType) EnumDescriptor() ([]byte, []int) { return fileDescriptorQuery, []int{2} }
+
 type FetchRequest struct {
 	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
 	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
@@ -945,12 +970,16 @@ func _SearchResponse_OneofSizer(msg proto.Message) (n int) {
 type CompleteTagsRequestOptions struct {
 	Type           CompleteTagsType `protobuf:"varint,1,opt,name=type,proto3,enum=rpc.CompleteTagsType" json:"type,omitempty"`
 	FilterNameTags [][]byte         `protobuf:"bytes,2,rep,name=filterNameTags" json:"filterNameTags,omitempty"`
+	Start          int64            `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End            int64            `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (m *CompleteTagsRequestOptions) Reset()                    { *m = CompleteTagsRequestOptions{} }
-func (m *CompleteTagsRequestOptions) String() string            { return proto.CompactTextString(m) }
-func (*CompleteTagsRequestOptions) ProtoMessage()               {}
-func (*CompleteTagsRequestOptions) Descriptor() ([]byte, []int) { return fileDescriptorQuery, []int{19} }
+func (m *CompleteTagsRequestOptions) Reset()         { *m = CompleteTagsRequestOptions{} }
+func (m *CompleteTagsRequestOptions) String() string { return proto.CompactTextString(m) }
+func (*CompleteTagsRequestOptions) ProtoMessage()    {}
+func (*CompleteTagsRequestOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptorQuery, []int{19}
+}
 
 func (m *CompleteTagsRequestOptions) GetType() CompleteTagsType {
 	if m != nil {
@@ -966,6 +995,20 @@ func (m *CompleteTagsRequestOptions) GetFilterNameTags() [][]byte {
 	return nil
 }
 
+func (m *CompleteTagsRequestOptions) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *CompleteTagsRequestOptions) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
 type CompleteTagsRequest struct {
 	// Types that are valid to be assigned to Matchers:
 	//	*CompleteTagsRequest_TagMatchers
@@ -1245,6 +1288,118 @@ func _CompleteTagsResponse_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type WriteAttributes struct {
+	MetricsType MetricsType `protobuf:"varint,1,opt,name=metricsType,proto3,enum=rpc.MetricsType" json:"metricsType,omitempty"`
+	Retention   int64       `protobuf:"varint,2,opt,name=retention,proto3" json:"retention,omitempty"`
+	Resolution  int64       `protobuf:"varint,3,opt,name=resolution,proto3" json:"resolution,omitempty"`
+}
+
+func (m *WriteAttributes) Reset()                    { *m = WriteAttributes{} }
+func (m *WriteAttributes) String() string            { return proto.CompactTextString(m) }
+func (*WriteAttributes) ProtoMessage()               {}
+func (*WriteAttributes) Descriptor() ([]byte, []int) { return fileDescriptorQuery, []int{25} }
+
+func (m *WriteAttributes) GetMetricsType() MetricsType {
+	if m != nil {
+		return m.MetricsType
+	}
+	return MetricsType_UNAGGREGATED
+}
+
+func (m *WriteAttributes) GetRetention() int64 {
+	if m != nil {
+		return m.Retention
+	}
+	return 0
+}
+
+func (m *WriteAttributes) GetResolution() int64 {
+	if m != nil {
+		return m.Resolution
+	}
+	return 0
+}
+
+type WriteRequest struct {
+	CompressedTags []byte           `protobuf:"bytes,1,opt,name=compressedTags,proto3" json:"compressedTags,omitempty"`
+	Segment        *M3Segment       `protobuf:"bytes,2,opt,name=segment" json:"segment,omitempty"`
+	Unit           int32            `protobuf:"varint,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	Annotation     []byte           `protobuf:"bytes,4,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	Attributes     *WriteAttributes `protobuf:"bytes,5,opt,name=attributes" json:"attributes,omitempty"`
+}
+
+func (m *WriteRequest) Reset()                    { *m = WriteRequest{} }
+func (m *WriteRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteRequest) ProtoMessage()               {}
+func (*WriteRequest) Descriptor() ([]byte, []int) { return fileDescriptorQuery, []int{26} }
+
+func (m *WriteRequest) GetCompressedTags() []byte {
+	if m != nil {
+		return m.CompressedTags
+	}
+	return nil
+}
+
+func (m *WriteRequest) GetSegment() *M3Segment {
+	if m != nil {
+		return m.Segment
+	}
+	return nil
+}
+
+func (m *WriteRequest) GetUnit() int32 {
+	if m != nil {
+		return m.Unit
+	}
+	return 0
+}
+
+func (m *WriteRequest) GetAnnotation() []byte {
+	if m != nil {
+		return m.Annotation
+	}
+	return nil
+}
+
+func (m *WriteRequest) GetAttributes() *WriteAttributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+type WriteBatchRequest struct {
+	Writes []*WriteRequest `protobuf:"bytes,1,rep,name=writes" json:"writes,omitempty"`
+}
+
+func (m *WriteBatchRequest) Reset()                    { *m = WriteBatchRequest{} }
+func (m *WriteBatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*WriteBatchRequest) ProtoMessage()               {}
+func (*WriteBatchRequest) Descriptor() ([]byte, []int) { return fileDescriptorQuery, []int{27} }
+
+func (m *WriteBatchRequest) GetWrites() []*WriteRequest {
+	if m != nil {
+		return m.Writes
+	}
+	return nil
+}
+
+type WriteResponse struct {
+	Written int64 `protobuf:"varint,1,opt,name=written,proto3" json:"written,omitempty"`
+}
+
+func (m *WriteResponse) Reset()                    { *m = WriteResponse{} }
+func (m *WriteResponse) String() string            { return proto.CompactTextString(m) }
+func (*WriteResponse) ProtoMessage()               {}
+func (*WriteResponse) Descriptor() ([]byte, []int) { return fileDescriptorQuery, []int{28} }
+
+func (m *WriteResponse) GetWritten() int64 {
+	if m != nil {
+		return m.Written
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*FetchRequest)(nil), "rpc.FetchRequest")
 	proto.RegisterType((*TagMatchers)(nil), "rpc.TagMatchers")
@@ -1271,8 +1426,13 @@ func init() {
 	proto.RegisterType((*TagValue)(nil), "rpc.TagValue")
 	proto.RegisterType((*TagValues)(nil), "rpc.TagValues")
 	proto.RegisterType((*CompleteTagsResponse)(nil), "rpc.CompleteTagsResponse")
+	proto.RegisterType((*WriteAttributes)(nil), "rpc.WriteAttributes")
+	proto.RegisterType((*WriteRequest)(nil), "rpc.WriteRequest")
+	proto.RegisterType((*WriteBatchRequest)(nil), "rpc.WriteBatchRequest")
+	proto.RegisterType((*WriteResponse)(nil), "rpc.WriteResponse")
 	proto.RegisterEnum("rpc.MatcherType", MatcherType_name, MatcherType_value)
 	proto.RegisterEnum("rpc.CompleteTagsType", CompleteTagsType_name, CompleteTagsType_value)
+	proto.RegisterEnum("rpc.MetricsType", MetricsType_name, MetricsType_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1289,6 +1449,8 @@ type QueryClient interface {
 	Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (Query_FetchClient, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Query_SearchClient, error)
 	CompleteTags(ctx context.Context, in *CompleteTagsRequest, opts ...grpc.CallOption) (Query_CompleteTagsClient, error)
+	Write(ctx context.Context, opts ...grpc.CallOption) (Query_WriteClient, error)
+	WriteBatch(ctx context.Context, opts ...grpc.CallOption) (Query_WriteBatchClient, error)
 }
 
 type queryClient struct {
@@ -1395,12 +1557,82 @@ func (x *queryCompleteTagsClient) Recv() (*CompleteTagsResponse, error) {
 	return m, nil
 }
 
+func (c *queryClient) Write(ctx context.Context, opts ...grpc.CallOption) (Query_WriteClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Query_serviceDesc.Streams[3], c.cc, "/rpc.Query/Write", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryWriteClient{stream}
+	return x, nil
+}
+
+type Query_WriteClient interface {
+	Send(*WriteRequest) error
+	CloseAndRecv() (*WriteResponse, error)
+	grpc.ClientStream
+}
+
+type queryWriteClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryWriteClient) Send(m *WriteRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *queryWriteClient) CloseAndRecv() (*WriteResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(WriteResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) WriteBatch(ctx context.Context, opts ...grpc.CallOption) (Query_WriteBatchClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Query_serviceDesc.Streams[4], c.cc, "/rpc.Query/WriteBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryWriteBatchClient{stream}
+	return x, nil
+}
+
+type Query_WriteBatchClient interface {
+	Send(*WriteBatchRequest) error
+	CloseAndRecv() (*WriteResponse, error)
+	grpc.ClientStream
+}
+
+type queryWriteBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryWriteBatchClient) Send(m *WriteBatchRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *queryWriteBatchClient) CloseAndRecv() (*WriteResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(WriteResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Query service
 
 type QueryServer interface {
 	Fetch(*FetchRequest, Query_FetchServer) error
 	Search(*SearchRequest, Query_SearchServer) error
 	CompleteTags(*CompleteTagsRequest, Query_CompleteTagsServer) error
+	Write(Query_WriteServer) error
+	WriteBatch(Query_WriteBatchServer) error
 }
 
 func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
@@ -1470,6 +1702,58 @@ func (x *queryCompleteTagsServer) Send(m *CompleteTagsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_Write_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(QueryServer).Write(&queryWriteServer{stream})
+}
+
+type Query_WriteServer interface {
+	SendAndClose(*WriteResponse) error
+	Recv() (*WriteRequest, error)
+	grpc.ServerStream
+}
+
+type queryWriteServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryWriteServer) SendAndClose(m *WriteResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *queryWriteServer) Recv() (*WriteRequest, error) {
+	m := new(WriteRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Query_WriteBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(QueryServer).WriteBatch(&queryWriteBatchServer{stream})
+}
+
+type Query_WriteBatchServer interface {
+	SendAndClose(*WriteResponse) error
+	Recv() (*WriteBatchRequest, error)
+	grpc.ServerStream
+}
+
+type queryWriteBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryWriteBatchServer) SendAndClose(m *WriteResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *queryWriteBatchServer) Recv() (*WriteBatchRequest, error) {
+	m := new(WriteBatchRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpc.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1490,6 +1774,16 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Query_CompleteTags_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Write",
+			Handler:       _Query_Write_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WriteBatch",
+			Handler:       _Query_WriteBatch_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "github.com/m3db/m3/src/query/generated/proto/rpcpb/query.proto",
 }
@@ -2222,6 +2516,16 @@ func (m *CompleteTagsRequestOptions) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], b)
 		}
 	}
+	if m.Start != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(m.Start))
+	}
+	if m.End != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(m.End))
+	}
 	return i, nil
 }
 
@@ -2415,67 +2719,208 @@ func (m *CompleteTagsResponse_NamesOnly) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WriteAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *FetchRequest) Size() (n int) {
+
+func (m *WriteAttributes) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Start != 0 {
-		n += 1 + sovQuery(uint64(m.Start))
+	if m.MetricsType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(m.MetricsType))
 	}
-	if m.End != 0 {
-		n += 1 + sovQuery(uint64(m.End))
+	if m.Retention != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(m.Retention))
 	}
-	if m.Matchers != nil {
-		n += m.Matchers.Size()
+	if m.Resolution != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(m.Resolution))
 	}
-	return n
+	return i, nil
 }
 
-func (m *FetchRequest_TagMatchers) Size() (n int) {
-	var l int
-	_ = l
-	if m.TagMatchers != nil {
-		l = m.TagMatchers.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-func (m *TagMatchers) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.TagMatchers) > 0 {
-		for _, e := range m.TagMatchers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *WriteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TagMatcher) Size() (n int) {
+func (m *WriteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.CompressedTags) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CompressedTags)))
+		i += copy(dAtA[i:], m.CompressedTags)
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Segment != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(m.Segment.Size()))
+		n19, err := m.Segment.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
 	}
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
+	if m.Unit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(m.Unit))
 	}
-	return n
-}
+	if len(m.Annotation) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Annotation)))
+		i += copy(dAtA[i:], m.Annotation)
+	}
+	if m.Attributes != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(m.Attributes.Size()))
+		n20, err := m.Attributes.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	return i, nil
+}
+
+func (m *WriteBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Writes) > 0 {
+		for _, msg := range m.Writes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintQuery(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *WriteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WriteResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Written != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintQuery(dAtA, i, uint64(m.Written))
+	}
+	return i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *FetchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
+	if m.Matchers != nil {
+		n += m.Matchers.Size()
+	}
+	return n
+}
+
+func (m *FetchRequest_TagMatchers) Size() (n int) {
+	var l int
+	_ = l
+	if m.TagMatchers != nil {
+		l = m.TagMatchers.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *TagMatchers) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.TagMatchers) > 0 {
+		for _, e := range m.TagMatchers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TagMatcher) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	return n
+}
 
 func (m *FetchResponse) Size() (n int) {
 	var l int
@@ -2753,6 +3198,12 @@ func (m *CompleteTagsRequestOptions) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Start != 0 {
+		n += 1 + sovQuery(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovQuery(uint64(m.End))
+	}
 	return n
 }
 
@@ -2845,6 +3296,66 @@ func (m *CompleteTagsResponse_NamesOnly) Size() (n int) {
 	}
 	return n
 }
+func (m *WriteAttributes) Size() (n int) {
+	var l int
+	_ = l
+	if m.MetricsType != 0 {
+		n += 1 + sovQuery(uint64(m.MetricsType))
+	}
+	if m.Retention != 0 {
+		n += 1 + sovQuery(uint64(m.Retention))
+	}
+	if m.Resolution != 0 {
+		n += 1 + sovQuery(uint64(m.Resolution))
+	}
+	return n
+}
+
+func (m *WriteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.CompressedTags)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Segment != nil {
+		l = m.Segment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Unit != 0 {
+		n += 1 + sovQuery(uint64(m.Unit))
+	}
+	l = len(m.Annotation)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Attributes != nil {
+		l = m.Attributes.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *WriteBatchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Writes) > 0 {
+		for _, e := range m.Writes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WriteResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Written != 0 {
+		n += 1 + sovQuery(uint64(m.Written))
+	}
+	return n
+}
 
 func sovQuery(x uint64) (n int) {
 	for {
@@ -4958,6 +5469,44 @@ func (m *CompleteTagsRequestOptions) Unmarshal(dAtA []byte) error {
 			m.FilterNameTags = append(m.FilterNameTags, make([]byte, postIndex-iNdEx))
 			copy(m.FilterNameTags[len(m.FilterNameTags)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5478,6 +6027,460 @@ func (m *CompleteTagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WriteAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsType", wireType)
+			}
+			m.MetricsType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetricsType |= (MetricsType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			m.Retention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retention |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			m.Resolution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resolution |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedTags", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressedTags = append(m.CompressedTags[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompressedTags == nil {
+				m.CompressedTags = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Segment == nil {
+				m.Segment = &M3Segment{}
+			}
+			if err := m.Segment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotation = append(m.Annotation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Annotation == nil {
+				m.Annotation = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = &WriteAttributes{}
+			}
+			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Writes = append(m.Writes, &WriteRequest{})
+			if err := m.Writes[len(m.Writes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WriteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WriteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Written", wireType)
+			}
+			m.Written = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Written |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5588,73 +6591,86 @@ func init() {
 }
 
 var fileDescriptorQuery = []byte{
-	// 1075 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x6d, 0x6f, 0x1b, 0x45,
-	0x10, 0xf6, 0xf9, 0x6c, 0xc7, 0x1e, 0xbf, 0xd4, 0x4c, 0x02, 0x75, 0xa3, 0x28, 0x44, 0x07, 0x94,
-	0x10, 0x20, 0x0e, 0x76, 0x04, 0x14, 0x89, 0x97, 0x84, 0xba, 0x09, 0x52, 0x93, 0x34, 0xeb, 0x2b,
-	0x8a, 0x50, 0x3f, 0xb0, 0xbe, 0xdb, 0x3a, 0xa7, 0xfa, 0x7c, 0xd7, 0xbb, 0x35, 0xaa, 0xfb, 0x17,
-	0xf8, 0x82, 0xf8, 0x2d, 0xf0, 0x1f, 0xf8, 0xc8, 0x4f, 0x40, 0xe1, 0x8f, 0xa0, 0xdd, 0xdb, 0x7b,
-	0xb3, 0x5d, 0xde, 0xbe, 0xdd, 0xcc, 0x3c, 0x33, 0x3b, 0x33, 0xfb, 0xf8, 0xf1, 0xc2, 0x17, 0x63,
-	0x87, 0x5f, 0xcf, 0x46, 0xfb, 0x96, 0xe7, 0x76, 0xdd, 0xbe, 0x3d, 0xea, 0xba, 0xfd, 0x6e, 0x18,
-	0x58, 0xdd, 0xe7, 0x33, 0x16, 0xcc, 0xbb, 0x63, 0x36, 0x65, 0x01, 0xe5, 0xcc, 0xee, 0xfa, 0x81,
-	0xc7, 0xbd, 0x6e, 0xe0, 0x5b, 0xfe, 0x28, 0x8a, 0xed, 0x4b, 0x0f, 0xea, 0x81, 0x6f, 0x19, 0x2f,
-	0xa0, 0xf1, 0x80, 0x71, 0xeb, 0x9a, 0xb0, 0xe7, 0x33, 0x16, 0x72, 0xdc, 0x80, 0x72, 0xc8, 0x69,
-	0xc0, 0x3b, 0xda, 0x8e, 0xb6, 0xab, 0x93, 0xc8, 0xc0, 0x36, 0xe8, 0x6c, 0x6a, 0x77, 0x8a, 0xd2,
-	0x27, 0x3e, 0xf1, 0x10, 0xea, 0x9c, 0x8e, 0xcf, 0x28, 0xb7, 0xae, 0x59, 0x10, 0x76, 0xf4, 0x1d,
-	0x6d, 0xb7, 0xde, 0x6b, 0xef, 0x07, 0xbe, 0xb5, 0x6f, 0xa6, 0xfe, 0xd3, 0x02, 0xc9, 0xc2, 0x8e,
-	0x01, 0xaa, 0xae, 0xfa, 0x36, 0xbe, 0x82, 0x7a, 0x06, 0x89, 0x1f, 0xe5, 0x0b, 0x6a, 0x3b, 0xfa,
-	0x6e, 0xbd, 0x77, 0x6b, 0xa1, 0x60, 0xae, 0x9a, 0xf1, 0x04, 0x20, 0x0d, 0x21, 0x42, 0x69, 0x4a,
-	0x5d, 0x26, 0x1b, 0x6f, 0x10, 0xf9, 0x2d, 0xa6, 0xf9, 0x81, 0x4e, 0x66, 0x4c, 0x76, 0xde, 0x20,
-	0x91, 0x81, 0x6f, 0x43, 0x89, 0xcf, 0x7d, 0x26, 0x9b, 0x6e, 0xa9, 0xa6, 0x55, 0x15, 0x73, 0xee,
-	0x33, 0x22, 0xa3, 0xc6, 0x21, 0x34, 0xd5, 0x66, 0x42, 0xdf, 0x9b, 0x86, 0x0c, 0xdf, 0x82, 0x4a,
-	0xc8, 0x02, 0x87, 0xc5, 0xcd, 0xd5, 0x65, 0xe2, 0x50, 0xba, 0x88, 0x0a, 0x19, 0xbf, 0x68, 0x50,
-	0x89, 0x5c, 0xf8, 0x2e, 0x94, 0x5c, 0xc6, 0xa9, 0x6c, 0xa8, 0xde, 0x5b, 0xcf, 0xa0, 0xcf, 0x18,
-	0xa7, 0x36, 0xe5, 0x94, 0x48, 0x00, 0x7e, 0x0e, 0x0d, 0x9b, 0x59, 0x9e, 0xeb, 0x07, 0x2c, 0x0c,
-	0x59, 0xb4, 0xe6, 0x7a, 0xef, 0xb6, 0x4c, 0xb8, 0x9f, 0x09, 0x44, 0xc9, 0xa7, 0x05, 0x92, 0x83,
-	0xe3, 0x3d, 0x80, 0x4c, 0xb2, 0x9e, 0x49, 0x3e, 0xeb, 0x7f, 0xbd, 0x9c, 0x9c, 0x01, 0x1f, 0xaf,
-	0xa9, 0xfd, 0x18, 0x57, 0xd0, 0xca, 0xb7, 0x86, 0x2d, 0x28, 0x3a, 0xb6, 0x5a, 0x66, 0xd1, 0xb1,
-	0x71, 0x0b, 0x6a, 0x92, 0x0b, 0xa6, 0xe3, 0x32, 0x45, 0x84, 0xd4, 0x81, 0x1d, 0x58, 0x63, 0x53,
-	0x5b, 0xc6, 0x74, 0x19, 0x8b, 0x4d, 0x63, 0x04, 0xb8, 0x3c, 0x03, 0xee, 0x03, 0x88, 0x53, 0x7c,
-	0xcf, 0x99, 0xf2, 0x78, 0x9f, 0xad, 0x68, 0xe0, 0xd8, 0x4d, 0x32, 0x08, 0xdc, 0x82, 0x12, 0xa7,
-	0xe3, 0xb0, 0x53, 0x94, 0xc8, 0x6a, 0x4c, 0x0b, 0x22, 0xbd, 0xc6, 0x97, 0x50, 0x4b, 0xd2, 0x44,
-	0xa3, 0xdc, 0x71, 0x59, 0xc8, 0xa9, 0xeb, 0x2b, 0x16, 0xa7, 0x8e, 0x3c, 0x23, 0x34, 0xc5, 0x08,
-	0xa3, 0x0b, 0xba, 0x49, 0xc7, 0xff, 0x9e, 0x42, 0xc6, 0x0b, 0xc0, 0xe5, 0xe5, 0xe2, 0x5d, 0x68,
-	0xa5, 0x93, 0x9a, 0xa2, 0xdf, 0xa8, 0xd2, 0x82, 0x17, 0x3f, 0x83, 0x6a, 0xc0, 0xfc, 0x89, 0x63,
-	0xd1, 0x78, 0xa2, 0xed, 0xa5, 0xfb, 0xfa, 0x56, 0x9c, 0x13, 0x92, 0x08, 0x46, 0x12, 0xbc, 0x71,
-	0x0a, 0x77, 0x5e, 0x09, 0xc3, 0xf7, 0xa1, 0x1a, 0xb2, 0xb1, 0xcb, 0xd2, 0xa5, 0xde, 0x52, 0x85,
-	0x87, 0xca, 0x4d, 0x12, 0x80, 0xf1, 0x3d, 0x40, 0xea, 0xc7, 0xbb, 0x50, 0x71, 0x59, 0x30, 0x66,
-	0xb6, 0xe2, 0x6b, 0x2b, 0x9f, 0x48, 0x54, 0x14, 0xf7, 0xa0, 0x3a, 0x9b, 0x2a, 0x64, 0x31, 0x73,
-	0x6f, 0x29, 0x32, 0x89, 0x1b, 0x1e, 0xd4, 0x12, 0xb7, 0x58, 0xee, 0x35, 0xa3, 0x31, 0xa5, 0xe4,
-	0xb7, 0xf0, 0x71, 0xea, 0x4c, 0xd4, 0x6e, 0xe5, 0x77, 0x9e, 0x68, 0xfa, 0x22, 0xd1, 0xb6, 0xa0,
-	0x36, 0x9a, 0x78, 0xd6, 0xb3, 0xa1, 0xf3, 0x92, 0x75, 0x4a, 0x51, 0x34, 0x71, 0x18, 0x97, 0xd0,
-	0x1c, 0x32, 0x1a, 0xa4, 0x72, 0x76, 0xb8, 0xa8, 0x2a, 0xff, 0x59, 0xa6, 0x4e, 0xa0, 0x79, 0xd6,
-	0x37, 0xe9, 0xf8, 0x51, 0xe0, 0xf9, 0x2c, 0xe0, 0xf3, 0xa5, 0x1f, 0xc6, 0xf2, 0xa5, 0x17, 0x57,
-	0x5d, 0xba, 0x31, 0x80, 0x5b, 0xd9, 0x42, 0x82, 0x2f, 0x3d, 0x00, 0x3f, 0xb1, 0xd4, 0x85, 0xa1,
-	0xda, 0x66, 0xe6, 0x48, 0x92, 0x41, 0x19, 0x9f, 0x48, 0xd9, 0x4c, 0xba, 0x69, 0x83, 0xfe, 0x8c,
-	0xcd, 0x55, 0x3b, 0xe2, 0x13, 0xdf, 0x80, 0x8a, 0xe4, 0x68, 0xdc, 0x87, 0xb2, 0x8c, 0x23, 0x68,
-	0xe6, 0x4f, 0x3f, 0x58, 0x71, 0x7a, 0xb2, 0x9a, 0x95, 0x67, 0xff, 0xa8, 0x09, 0x99, 0x88, 0xf6,
-	0xab, 0x44, 0xf1, 0xd3, 0x05, 0xed, 0x8a, 0x36, 0x8c, 0x0b, 0x65, 0x56, 0xc9, 0xd6, 0xc7, 0x39,
-	0xd9, 0x8a, 0x34, 0x6f, 0x63, 0x69, 0xf8, 0xbf, 0xd1, 0x2c, 0x0f, 0x36, 0xc5, 0xef, 0x60, 0xc2,
-	0x38, 0x13, 0x0b, 0x56, 0x57, 0x7e, 0xe1, 0x73, 0xc7, 0x9b, 0x86, 0xf8, 0x9e, 0x12, 0x79, 0x4d,
-	0x8a, 0xfc, 0xeb, 0xb2, 0x70, 0x16, 0x9e, 0x2a, 0xbd, 0xb8, 0xc1, 0xa7, 0xce, 0x84, 0xb3, 0xe0,
-	0x9c, 0xba, 0xcc, 0x8c, 0x65, 0xa6, 0x41, 0x16, 0xbc, 0xc6, 0xcf, 0x1a, 0xac, 0xaf, 0x38, 0xf1,
-	0xff, 0x91, 0x0c, 0xef, 0xc1, 0x9a, 0x17, 0xf5, 0xaa, 0x86, 0x7f, 0x73, 0xa9, 0xc7, 0xfc, 0x48,
-	0x24, 0xc6, 0xe7, 0xf8, 0xb9, 0x03, 0x55, 0x93, 0x8e, 0x45, 0x8f, 0xa1, 0xd0, 0x2a, 0xa1, 0x59,
-	0xd1, 0x65, 0x36, 0x48, 0x64, 0x18, 0x87, 0x12, 0x21, 0x85, 0xe2, 0x1f, 0xe8, 0xa2, 0x67, 0xe8,
-	0xd2, 0x83, 0x5a, 0x9c, 0x15, 0xe2, 0x3b, 0x09, 0x28, 0xa2, 0x49, 0x33, 0x1e, 0x4e, 0xc6, 0x93,
-	0x9c, 0x97, 0xb0, 0x91, 0x6f, 0x5f, 0x91, 0x64, 0x0f, 0xd6, 0x6c, 0xf6, 0x94, 0xce, 0x26, 0x3c,
-	0x27, 0x2e, 0x49, 0xfd, 0xd3, 0x02, 0x89, 0x01, 0xf8, 0x21, 0xd4, 0x64, 0xdb, 0x17, 0xd3, 0xc9,
-	0x5c, 0x2d, 0x26, 0x39, 0x4d, 0x4e, 0x79, 0x5a, 0x20, 0x29, 0x22, 0x61, 0xc3, 0xde, 0x13, 0xa8,
-	0x67, 0xfe, 0xc3, 0xb1, 0x06, 0xe5, 0xc1, 0xe5, 0xe3, 0xa3, 0x87, 0xed, 0x02, 0x36, 0xa0, 0x7a,
-	0x7e, 0x61, 0x46, 0x96, 0x86, 0x00, 0x15, 0x32, 0x38, 0x19, 0x5c, 0x3d, 0x6a, 0x17, 0xb1, 0x09,
-	0xb5, 0xf3, 0x0b, 0x53, 0x99, 0xba, 0x08, 0x0d, 0xae, 0xbe, 0x19, 0x9a, 0xc3, 0x76, 0x49, 0x85,
-	0x94, 0x59, 0xde, 0xfb, 0x00, 0xda, 0x8b, 0xe4, 0xc1, 0x3a, 0xac, 0xdd, 0x1f, 0x3c, 0x38, 0x7a,
-	0xfc, 0xd0, 0x6c, 0x17, 0x84, 0x61, 0x1e, 0x9d, 0x9c, 0x1f, 0x9d, 0x0d, 0xda, 0x5a, 0xef, 0x57,
-	0x0d, 0xca, 0x97, 0xe2, 0xa5, 0x85, 0x07, 0x50, 0x96, 0x8f, 0x08, 0x7c, 0x4d, 0xce, 0x90, 0x7d,
-	0x6a, 0x6d, 0x62, 0xd6, 0x15, 0x6d, 0xea, 0x40, 0xc3, 0xbe, 0x78, 0x3f, 0x88, 0x9f, 0x18, 0xa2,
-	0x7a, 0x31, 0x64, 0xf4, 0x6c, 0x73, 0x3d, 0xe7, 0x4b, 0x92, 0x06, 0xd0, 0xc8, 0xb6, 0x87, 0x9d,
-	0x57, 0x51, 0x69, 0xf3, 0xce, 0x8a, 0x48, 0x5c, 0xe6, 0xf8, 0xf6, 0x6f, 0x37, 0xdb, 0xda, 0xef,
-	0x37, 0xdb, 0xda, 0x1f, 0x37, 0xdb, 0xda, 0x4f, 0x7f, 0x6e, 0x17, 0xbe, 0x2b, 0xcb, 0x67, 0xe3,
-	0xa8, 0x22, 0x5f, 0x8c, 0xfd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x41, 0x85, 0x94, 0x73,
-	0x0a, 0x00, 0x00,
+	// 1295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xdb, 0x72, 0x1b, 0x45,
+	0x13, 0xd6, 0xea, 0xac, 0xd6, 0x21, 0x4a, 0x27, 0x7f, 0xa2, 0xb8, 0x52, 0xfe, 0x5d, 0x0b, 0x04,
+	0xc7, 0x80, 0x65, 0x24, 0x17, 0x90, 0x54, 0x11, 0x90, 0x89, 0x62, 0x53, 0x15, 0xdb, 0xc9, 0x78,
+	0x03, 0x29, 0x2a, 0x17, 0x8c, 0xa4, 0x89, 0xbc, 0x15, 0xed, 0x21, 0xbb, 0x23, 0x88, 0x73, 0xcb,
+	0x25, 0x37, 0x14, 0x37, 0x3c, 0x00, 0xaf, 0xc0, 0x43, 0x50, 0x5c, 0xf1, 0x08, 0x94, 0x79, 0x11,
+	0x6a, 0x66, 0x67, 0x77, 0x67, 0x25, 0x05, 0x02, 0x77, 0x33, 0x5f, 0x7f, 0x3d, 0xd3, 0xdd, 0xf3,
+	0x6d, 0xcf, 0x2c, 0xdc, 0x99, 0xda, 0xfc, 0x74, 0x3e, 0xda, 0x1e, 0x7b, 0x4e, 0xd7, 0xe9, 0x4f,
+	0x46, 0x5d, 0xa7, 0xdf, 0x0d, 0x83, 0x71, 0xf7, 0xf9, 0x9c, 0x05, 0x67, 0xdd, 0x29, 0x73, 0x59,
+	0x40, 0x39, 0x9b, 0x74, 0xfd, 0xc0, 0xe3, 0x5e, 0x37, 0xf0, 0xc7, 0xfe, 0x28, 0xb2, 0x6d, 0x4b,
+	0x04, 0x0b, 0x81, 0x3f, 0x36, 0x5f, 0x40, 0xe3, 0x1e, 0xe3, 0xe3, 0x53, 0xc2, 0x9e, 0xcf, 0x59,
+	0xc8, 0xf1, 0x32, 0x94, 0x42, 0x4e, 0x03, 0xde, 0x31, 0x36, 0x8c, 0xcd, 0x02, 0x89, 0x26, 0xd8,
+	0x86, 0x02, 0x73, 0x27, 0x9d, 0xbc, 0xc4, 0xc4, 0x10, 0x77, 0xa1, 0xce, 0xe9, 0xf4, 0x90, 0xf2,
+	0xf1, 0x29, 0x0b, 0xc2, 0x4e, 0x61, 0xc3, 0xd8, 0xac, 0xf7, 0xda, 0xdb, 0x81, 0x3f, 0xde, 0xb6,
+	0x52, 0xfc, 0x20, 0x47, 0x74, 0xda, 0x1e, 0x40, 0xd5, 0x51, 0x63, 0xf3, 0x53, 0xa8, 0x6b, 0x4c,
+	0x7c, 0x3f, 0xbb, 0xa0, 0xb1, 0x51, 0xd8, 0xac, 0xf7, 0x2e, 0x2c, 0x2c, 0x98, 0x59, 0xcd, 0x7c,
+	0x02, 0x90, 0x9a, 0x10, 0xa1, 0xe8, 0x52, 0x87, 0xc9, 0xc0, 0x1b, 0x44, 0x8e, 0x45, 0x36, 0xdf,
+	0xd0, 0xd9, 0x9c, 0xc9, 0xc8, 0x1b, 0x24, 0x9a, 0xe0, 0x9b, 0x50, 0xe4, 0x67, 0x3e, 0x93, 0x41,
+	0xb7, 0x54, 0xd0, 0x6a, 0x15, 0xeb, 0xcc, 0x67, 0x44, 0x5a, 0xcd, 0x5d, 0x68, 0xaa, 0xca, 0x84,
+	0xbe, 0xe7, 0x86, 0x0c, 0xdf, 0x80, 0x72, 0xc8, 0x02, 0x9b, 0xc5, 0xc1, 0xd5, 0xa5, 0xe3, 0x89,
+	0x84, 0x88, 0x32, 0x99, 0xbf, 0x18, 0x50, 0x8e, 0x20, 0x7c, 0x1b, 0x8a, 0x0e, 0xe3, 0x54, 0x06,
+	0x54, 0xef, 0x5d, 0xd2, 0xd8, 0x87, 0x8c, 0xd3, 0x09, 0xe5, 0x94, 0x48, 0x02, 0x7e, 0x0c, 0x8d,
+	0x09, 0x1b, 0x7b, 0x8e, 0x1f, 0xb0, 0x30, 0x64, 0x51, 0x99, 0xeb, 0xbd, 0xab, 0xd2, 0xe1, 0xae,
+	0x66, 0x88, 0x9c, 0x0f, 0x72, 0x24, 0x43, 0xc7, 0x5b, 0x00, 0x9a, 0x73, 0x41, 0x73, 0x3e, 0xec,
+	0x7f, 0xb6, 0xec, 0xac, 0x91, 0xf7, 0x2a, 0xaa, 0x3e, 0xe6, 0x63, 0x68, 0x65, 0x43, 0xc3, 0x16,
+	0xe4, 0xed, 0x89, 0x2a, 0x66, 0xde, 0x9e, 0xe0, 0x75, 0xa8, 0x49, 0x2d, 0x58, 0xb6, 0xc3, 0x94,
+	0x10, 0x52, 0x00, 0x3b, 0x50, 0x61, 0xee, 0x44, 0xda, 0x0a, 0xd2, 0x16, 0x4f, 0xcd, 0x11, 0xe0,
+	0x72, 0x0e, 0xb8, 0x0d, 0x20, 0x76, 0xf1, 0x3d, 0xdb, 0xe5, 0x71, 0x3d, 0x5b, 0x51, 0xc2, 0x31,
+	0x4c, 0x34, 0x06, 0x5e, 0x87, 0x22, 0xa7, 0xd3, 0xb0, 0x93, 0x97, 0xcc, 0x6a, 0x2c, 0x0b, 0x22,
+	0x51, 0xf3, 0x13, 0xa8, 0x25, 0x6e, 0x22, 0x50, 0x6e, 0x3b, 0x2c, 0xe4, 0xd4, 0xf1, 0x95, 0x8a,
+	0x53, 0x20, 0xab, 0x08, 0x43, 0x29, 0xc2, 0xec, 0x42, 0xc1, 0xa2, 0xd3, 0xd7, 0x97, 0x90, 0xf9,
+	0x02, 0x70, 0xb9, 0xb8, 0x78, 0x03, 0x5a, 0x69, 0xa6, 0x96, 0x88, 0x37, 0x5a, 0x69, 0x01, 0xc5,
+	0xdb, 0x50, 0x0d, 0x98, 0x3f, 0xb3, 0xc7, 0x34, 0xce, 0x68, 0x7d, 0xe9, 0xbc, 0xbe, 0x10, 0xfb,
+	0x84, 0x24, 0xa2, 0x91, 0x84, 0x6f, 0x1e, 0xc0, 0xb5, 0x57, 0xd2, 0xf0, 0x1d, 0xa8, 0x86, 0x6c,
+	0xea, 0x30, 0x97, 0x67, 0xbf, 0xa0, 0xc3, 0xfe, 0x89, 0x82, 0x49, 0x42, 0x30, 0xbf, 0x06, 0x48,
+	0x71, 0xbc, 0x01, 0x65, 0x87, 0x05, 0x53, 0x36, 0x51, 0x7a, 0x6d, 0x65, 0x1d, 0x89, 0xb2, 0xe2,
+	0x16, 0x54, 0xe7, 0xae, 0x62, 0xe6, 0x37, 0x0a, 0x2b, 0x98, 0x89, 0xdd, 0xf4, 0xa0, 0x96, 0xc0,
+	0xa2, 0xb8, 0xa7, 0x8c, 0xc6, 0x92, 0x92, 0x63, 0x81, 0x71, 0x6a, 0xcf, 0x54, 0x6d, 0xe5, 0x38,
+	0x2b, 0xb4, 0xc2, 0xa2, 0xd0, 0xae, 0x43, 0x6d, 0x34, 0xf3, 0xc6, 0xcf, 0x4e, 0xec, 0x97, 0xac,
+	0x53, 0x8c, 0xac, 0x09, 0x60, 0x3e, 0x84, 0xe6, 0x09, 0xa3, 0x41, 0xda, 0xce, 0x76, 0x17, 0xbb,
+	0xca, 0xbf, 0x6e, 0x53, 0xfb, 0xd0, 0x3c, 0xec, 0x5b, 0x74, 0xfa, 0x20, 0xf0, 0x7c, 0x16, 0xf0,
+	0xb3, 0xa5, 0x0f, 0x63, 0xf9, 0xd0, 0xf3, 0xab, 0x0e, 0xdd, 0x1c, 0xc2, 0x05, 0x7d, 0x21, 0xa1,
+	0x97, 0x1e, 0x80, 0x9f, 0xcc, 0xd4, 0x81, 0xa1, 0xaa, 0xa6, 0xb6, 0x25, 0xd1, 0x58, 0xe6, 0x87,
+	0x50, 0xd7, 0x4c, 0xa2, 0x33, 0x3f, 0x63, 0x67, 0x2a, 0x1c, 0x31, 0xc4, 0x2b, 0x50, 0x96, 0x1a,
+	0x8d, 0xe3, 0x50, 0x33, 0x73, 0x00, 0xcd, 0xec, 0xee, 0x3b, 0x2b, 0x76, 0x4f, 0x4a, 0xb3, 0x72,
+	0xef, 0xef, 0x0d, 0x68, 0xc5, 0xf5, 0x55, 0x4d, 0xf1, 0xa3, 0x85, 0xde, 0x15, 0x55, 0x18, 0x17,
+	0x96, 0x59, 0xd5, 0xb6, 0x3e, 0xc8, 0xb4, 0xad, 0xa8, 0xe7, 0x5d, 0x5e, 0x4a, 0xfe, 0x6f, 0x7a,
+	0xd6, 0x4f, 0x06, 0xac, 0x89, 0x0f, 0x61, 0xc6, 0x38, 0x13, 0x15, 0x56, 0x67, 0x7e, 0xec, 0x73,
+	0xdb, 0x73, 0x43, 0xbc, 0xa9, 0xba, 0xbc, 0x21, 0xbb, 0xfc, 0xff, 0xe4, 0xca, 0x3a, 0x3d, 0x6d,
+	0xf5, 0xe2, 0x08, 0x9f, 0xda, 0x33, 0xce, 0x82, 0x23, 0xea, 0x30, 0x2b, 0xee, 0x33, 0x0d, 0xb2,
+	0x80, 0xa6, 0x97, 0x63, 0x61, 0xc5, 0xe5, 0x58, 0x4c, 0x2e, 0x47, 0xf3, 0x47, 0x03, 0x2e, 0xad,
+	0x88, 0xec, 0xbf, 0xa9, 0x11, 0x6f, 0x41, 0xc5, 0x8b, 0x72, 0x52, 0x55, 0xfa, 0xff, 0x52, 0x2e,
+	0xd9, 0xd4, 0x49, 0xcc, 0xcf, 0x08, 0x79, 0x03, 0xaa, 0x16, 0x9d, 0x8a, 0x5c, 0x64, 0x22, 0xa2,
+	0xb9, 0x45, 0xa7, 0xde, 0x20, 0xd1, 0xc4, 0xdc, 0x95, 0x0c, 0xd9, 0x51, 0xfe, 0x41, 0x57, 0x05,
+	0x4d, 0x57, 0x3d, 0xa8, 0xc5, 0x5e, 0x21, 0xbe, 0x95, 0x90, 0x22, 0x3d, 0x35, 0xe3, 0xe4, 0xa4,
+	0x3d, 0xf1, 0x79, 0x09, 0x97, 0xb3, 0xe1, 0x2b, 0x35, 0x6d, 0x41, 0x65, 0xc2, 0x9e, 0xd2, 0xf9,
+	0x8c, 0x67, 0xba, 0x50, 0xb2, 0xfe, 0x41, 0x8e, 0xc4, 0x04, 0x7c, 0x0f, 0x6a, 0x32, 0xec, 0x63,
+	0x77, 0x76, 0xa6, 0x0a, 0x93, 0xec, 0x26, 0xb3, 0x3c, 0xc8, 0x91, 0x94, 0x91, 0xca, 0xe6, 0x3b,
+	0x03, 0x2e, 0x7c, 0x19, 0xd8, 0x9c, 0x0d, 0x38, 0x0f, 0xec, 0xd1, 0x9c, 0xcb, 0x0f, 0xb1, 0xee,
+	0x30, 0x1e, 0xd8, 0x63, 0xa9, 0x0a, 0x25, 0x19, 0xf5, 0x30, 0x48, 0x71, 0xa2, 0x93, 0x44, 0x27,
+	0x0a, 0x18, 0x67, 0xae, 0xa8, 0x74, 0x7c, 0x21, 0x26, 0x00, 0xae, 0x03, 0x04, 0x2c, 0xf4, 0x66,
+	0x73, 0x69, 0x8e, 0xf4, 0xa2, 0x21, 0xe6, 0x6f, 0x06, 0x34, 0x64, 0x14, 0xb1, 0x36, 0x5e, 0xf7,
+	0xee, 0xd8, 0x84, 0x8a, 0xea, 0xe0, 0x9d, 0xbc, 0x56, 0xa2, 0xb4, 0xfd, 0xc6, 0x66, 0xd1, 0x5c,
+	0xe7, 0xae, 0x1d, 0x89, 0xb5, 0x44, 0xe4, 0x58, 0x84, 0x45, 0x5d, 0xd7, 0xe3, 0x54, 0x86, 0x55,
+	0x94, 0x3b, 0x68, 0x08, 0xee, 0x02, 0xd0, 0xa4, 0x2c, 0x9d, 0x92, 0xf6, 0x51, 0x2e, 0x94, 0x8c,
+	0x68, 0x3c, 0xf3, 0x0e, 0x5c, 0x94, 0xe6, 0x3d, 0xaa, 0xbd, 0x24, 0x6f, 0x42, 0xf9, 0x5b, 0x01,
+	0xc6, 0x52, 0xb8, 0x98, 0x2e, 0xa3, 0x28, 0x44, 0x11, 0xcc, 0x9b, 0xd0, 0x54, 0xb8, 0xd2, 0x41,
+	0x07, 0x2a, 0xc2, 0xc4, 0x99, 0xab, 0x6e, 0xf0, 0x78, 0xba, 0xf5, 0x04, 0xea, 0xda, 0x53, 0x0d,
+	0x6b, 0x50, 0x1a, 0x3e, 0x7c, 0x34, 0xb8, 0xdf, 0xce, 0x61, 0x03, 0xaa, 0x47, 0xc7, 0x56, 0x34,
+	0x33, 0x10, 0xa0, 0x4c, 0x86, 0xfb, 0xc3, 0xc7, 0x0f, 0xda, 0x79, 0x6c, 0x42, 0xed, 0xe8, 0xd8,
+	0x52, 0xd3, 0x82, 0x30, 0x0d, 0x1f, 0x7f, 0x7e, 0x62, 0x9d, 0xb4, 0x8b, 0xca, 0xa4, 0xa6, 0xa5,
+	0xad, 0x77, 0xa1, 0xbd, 0xd8, 0x22, 0xb0, 0x0e, 0x95, 0xbb, 0xc3, 0x7b, 0x83, 0x47, 0xf7, 0xad,
+	0x76, 0x4e, 0x4c, 0xac, 0xc1, 0xfe, 0xd1, 0xe0, 0x70, 0xd8, 0x36, 0xb6, 0xba, 0x50, 0xd7, 0xd4,
+	0x81, 0x6d, 0x68, 0x3c, 0x3a, 0x1a, 0xec, 0xef, 0x93, 0xe1, 0xfe, 0xc0, 0x1a, 0xde, 0x6d, 0xe7,
+	0xb0, 0x05, 0xa0, 0xcd, 0x8d, 0xde, 0xcf, 0x79, 0x28, 0x3d, 0x14, 0x2f, 0x70, 0xdc, 0x81, 0x92,
+	0x7c, 0x5c, 0x62, 0x54, 0x15, 0xfd, 0x09, 0xbe, 0x86, 0x3a, 0x14, 0x15, 0x64, 0xc7, 0xc0, 0x3e,
+	0x94, 0xa3, 0xd6, 0x8b, 0xa8, 0x5e, 0x92, 0xda, 0x3d, 0xb7, 0x76, 0x29, 0x83, 0x25, 0x4e, 0x43,
+	0x68, 0xe8, 0xf9, 0x60, 0xe7, 0x55, 0x9d, 0x63, 0xed, 0xda, 0x0a, 0x4b, 0xb2, 0xcc, 0x0e, 0x94,
+	0xe4, 0xf9, 0xe0, 0xf2, 0x19, 0xae, 0xa1, 0x0e, 0x45, 0x1e, 0x9b, 0x06, 0xde, 0x06, 0x48, 0x15,
+	0x81, 0x57, 0x52, 0x8e, 0x2e, 0x91, 0xd5, 0xbe, 0x7b, 0x57, 0x7f, 0x3d, 0x5f, 0x37, 0x7e, 0x3f,
+	0x5f, 0x37, 0xfe, 0x38, 0x5f, 0x37, 0x7e, 0xf8, 0x73, 0x3d, 0xf7, 0x55, 0x49, 0xfe, 0xbc, 0x8c,
+	0xca, 0xf2, 0xbf, 0xa5, 0xff, 0xd7, 0x00, 0x16, 0x1b, 0x24, 0x14, 0xf9, 0x0c, 0x00, 0x00,
 }
@@ -27,6 +27,7 @@ import (
 	"strings"
 
 	"github.com/m3db/m3/src/query/graphite/errors"
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 )
 
@@ -63,14 +64,7 @@ func AliasByMetric(ctx *Context, series ts.SeriesList) (ts.SeriesList, error) {
 func AliasByNode(_ *Context, seriesList ts.SeriesList, nodes ...int) (ts.SeriesList, error) {
 	renamed := make([]*ts.Series, 0, seriesList.Len())
 	for _, series := range seriesList.Values {
-		name := series.Name()
-		left := strings.LastIndex(name, "(") + 1
-		name = name[left:]
-		right := strings.IndexAny(name, ",)")
-		if right == -1 {
-			right = len(name)
-		}
-		nameParts := strings.Split(name[0:right], ".")
+		nameParts := strings.Split(innermostName(series.Name()), ".")
 		newNameParts := make([]string, 0, len(nodes))
 		for _, node := range nodes {
 			// NB(jayp): graphite supports negative indexing, so we need to also!
@@ -90,6 +84,51 @@ func AliasByNode(_ *Context, seriesList ts.SeriesList, nodes ...int) (ts.SeriesL
 	return seriesList, nil
 }
 
+// AliasByTags renames a time series result according to the given tags of a
+// graphite tagged series. Tags which are integers refer to the nodes of the
+// series name, as with AliasByNode.
+func AliasByTags(_ *Context, seriesList ts.SeriesList, tags ...string) (ts.SeriesList, error) {
+	renamed := make([]*ts.Series, 0, seriesList.Len())
+	for _, series := range seriesList.Values {
+		seriesTags := graphite.ParseTaggedName(innermostName(series.Name()))
+		nameParts := strings.Split(seriesTags[graphite.TaggedNameTag], ".")
+		newNameParts := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			if node, err := strconv.Atoi(tag); err == nil {
+				if node < 0 {
+					node += len(nameParts)
+				}
+				if node < 0 || node >= len(nameParts) {
+					continue
+				}
+				newNameParts = append(newNameParts, nameParts[node])
+				continue
+			}
+
+			if value, ok := seriesTags[tag]; ok {
+				newNameParts = append(newNameParts, value)
+			}
+		}
+		newName := strings.Join(newNameParts, ".")
+		newSeries := series.RenamedTo(newName)
+		renamed = append(renamed, newSeries)
+	}
+	seriesList.Values = renamed
+	return seriesList, nil
+}
+
+// innermostName returns the innermost series name of a series which may have
+// been renamed by wrapping functions, i.e. a.b for sumSeries(a.b).
+func innermostName(name string) string {
+	left := strings.LastIndex(name, "(") + 1
+	name = name[left:]
+	right := strings.IndexAny(name, ",)")
+	if right == -1 {
+		right = len(name)
+	}
+	return name[0:right]
+}
+
 // AliasSub runs series names through a regex search/replace.
 func AliasSub(_ *Context, input ts.SeriesList, search, replace string) (ts.SeriesList, error) {
 	regex, err := regexp.Compile(search)
@@ -35,6 +35,13 @@ type QueryEngine interface {
 		start, end time.Time,
 		timeout time.Duration,
 	) (*storage.FetchResult, error)
+
+	FetchByTags(
+		ctx context.Context,
+		tagExpressions []string,
+		start, end time.Time,
+		timeout time.Duration,
+	) (*storage.FetchResult, error)
 }
 
 // The Engine for running queries
@@ -68,3 +75,23 @@ func (e *Engine) FetchByQuery(
 		},
 	)
 }
+
+// FetchByTags retrieves one or more time series based on tag expressions
+func (e *Engine) FetchByTags(
+	ctx context.Context,
+	tagExpressions []string,
+	start, end time.Time,
+	timeout time.Duration,
+) (*storage.FetchResult, error) {
+	return e.storage.FetchByTags(
+		ctx,
+		tagExpressions,
+		storage.FetchOptions{
+			StartTime: start,
+			EndTime:   end,
+			DataOptions: storage.DataOptions{
+				Timeout: timeout,
+			},
+		},
+	)
+}
@@ -20,7 +20,10 @@
 
 package graphite
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 const (
 	// graphiteFormat is the format for graphite metric tag names, which will be
@@ -38,6 +41,17 @@ const (
 
 	// MatchAllPattern that is used to match all metrics.
 	MatchAllPattern = ".*"
+
+	// TaggedNameTag is the tag name under which the name of a graphite tagged
+	// series is stored, i.e. a.b for the series a.b;dc=east.
+	TaggedNameTag = "name"
+
+	// TaggedSeparator separates the name and tags of a graphite tagged series.
+	TaggedSeparator = ";"
+
+	// TaggedValueSeparator separates the tag name and value of the tags of a
+	// graphite tagged series.
+	TaggedValueSeparator = "="
 )
 
 var (
@@ -65,3 +79,28 @@ func TagName(idx int) []byte {
 func generateTagName(idx int) []byte {
 	return []byte(fmt.Sprintf(graphiteFormat, idx))
 }
+
+// IsTaggedName returns whether the given series name is the name of a graphite
+// tagged series, i.e. is of the form name;tag1=value1;tag2=value2.
+func IsTaggedName(name string) bool {
+	return strings.Contains(name, TaggedSeparator)
+}
+
+// ParseTaggedName returns the tags of the given series name, including the
+// name tag. The name of a series which is not tagged is returned as the name
+// tag, and tags which are missing a value are ignored.
+func ParseTaggedName(name string) map[string]string {
+	parts := strings.Split(name, TaggedSeparator)
+	tags := make(map[string]string, len(parts))
+	tags[TaggedNameTag] = parts[0]
+	for _, part := range parts[1:] {
+		idx := strings.Index(part, TaggedValueSeparator)
+		if idx <= 0 || idx == len(part)-1 {
+			continue
+		}
+
+		tags[part[:idx]] = part[idx+1:]
+	}
+
+	return tags
+}
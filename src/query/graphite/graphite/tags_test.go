@@ -33,3 +33,38 @@ func TestTagName(t *testing.T) {
 		require.Equal(t, expected, TagName(i))
 	}
 }
+
+func TestIsTaggedName(t *testing.T) {
+	require.False(t, IsTaggedName("foo.bar.baz"))
+	require.True(t, IsTaggedName("foo.bar;dc=east"))
+}
+
+func TestParseTaggedName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected map[string]string
+	}{
+		{
+			name:     "foo.bar.baz",
+			expected: map[string]string{"name": "foo.bar.baz"},
+		},
+		{
+			name: "foo.bar;dc=east;host=a=b",
+			expected: map[string]string{
+				"name": "foo.bar",
+				"dc":   "east",
+				"host": "a=b",
+			},
+		},
+		{
+			name:     "foo.bar;dc=;=east;host",
+			expected: map[string]string{"name": "foo.bar"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, ParseTaggedName(test.name))
+		})
+	}
+}
@@ -23,10 +23,12 @@ package native
 import (
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 
 	"github.com/m3db/m3/src/query/graphite/common"
 	"github.com/m3db/m3/src/query/graphite/errors"
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 )
 
@@ -274,6 +276,79 @@ func groupByNode(ctx *common.Context, series singlePathSpec, node int, fname str
 	return r, nil
 }
 
+// groupByTags takes a serieslist of graphite tagged series and maps a callback
+// to subgroups within as defined by the values of the given tags
+//
+//    &target=groupByTags(seriesByTag('name=cpu','dc=east'),"sumSeries","host")
+//
+//  Would return multiple series which are each the result of applying the
+//  "sumSeries" function to the series sharing the same host tag, resulting in
+//  a list of series named like sumSeries;host=a,sumSeries;host=b,...
+//  If the name tag is one of the given tags then the series are named after
+//  their name tag value rather than the function.
+func groupByTags(ctx *common.Context, series singlePathSpec, fname string, tags ...string) (ts.SeriesList, error) {
+	if len(tags) == 0 {
+		return ts.SeriesList{}, errors.NewInvalidParamsError(fmt.Errorf("no tags specified"))
+	}
+
+	if fname == "" {
+		fname = "sum"
+	}
+
+	f, fexists := summarizeFuncs[fname]
+	if !fexists {
+		return ts.SeriesList{}, errors.NewInvalidParamsError(fmt.Errorf("invalid func %s", fname))
+	}
+
+	sortedTags := make([]string, 0, len(tags))
+	groupByName := false
+	for _, tag := range tags {
+		if tag == graphite.TaggedNameTag {
+			groupByName = true
+			continue
+		}
+		sortedTags = append(sortedTags, tag)
+	}
+	sort.Strings(sortedTags)
+
+	metaSeries := make(map[string][]*ts.Series)
+	for _, s := range series.Values {
+		seriesTags := graphite.ParseTaggedName(s.Name())
+
+		key := fname
+		if groupByName {
+			key = seriesTags[graphite.TaggedNameTag]
+		}
+		for _, tag := range sortedTags {
+			key += graphite.TaggedSeparator + tag +
+				graphite.TaggedValueSeparator + seriesTags[tag]
+		}
+
+		metaSeries[key] = append(metaSeries[key], s)
+	}
+
+	newSeries := make([]*ts.Series, 0, len(metaSeries))
+	for key, series := range metaSeries {
+		seriesList := ts.SeriesList{Values: series}
+		output, err := combineSeries(ctx, multiplePathSpecs(seriesList), key, f.consolidationFunc)
+		if err != nil {
+			return ts.SeriesList{}, err
+		}
+		output.Values[0].Specification = f.specificationFunc(seriesList)
+		newSeries = append(newSeries, output.Values...)
+	}
+
+	r := ts.SeriesList(series)
+
+	r.Values = newSeries
+
+	// Ranging over hash map to create results destroys
+	// any sort order on the incoming series list
+	r.SortApplied = false
+
+	return r, nil
+}
+
 // combineSeries combines multiple series into a single series using a
 // consolidation func.  If the series use different time intervals, the
 // coarsest time will apply.
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -151,6 +152,15 @@ func (e mockEngine) FetchByQuery(
 	return e.fn(ctx, query, start, end, timeout)
 }
 
+func (e mockEngine) FetchByTags(
+	ctx context.Context,
+	tagExpressions []string,
+	start, end time.Time,
+	timeout time.Duration,
+) (*storage.FetchResult, error) {
+	return e.fn(ctx, strings.Join(tagExpressions, ","), start, end, timeout)
+}
+
 func TestVariadicSumSeries(t *testing.T) {
 	expr, err := compile("sumSeries(foo.bar.*, foo.baz.*)")
 	require.NoError(t, err)
@@ -184,6 +194,46 @@ func TestVariadicSumSeries(t *testing.T) {
 	assert.Equal(t, []float64{10, 10, 10}, r.Values[0].SafeValues())
 }
 
+func TestSeriesByTag(t *testing.T) {
+	ctx := common.NewTestContext()
+	ctx.Engine = mockEngine{fn: func(
+		ctx context.Context,
+		query string,
+		start, end time.Time,
+		timeout time.Duration,
+	) (*storage.FetchResult, error) {
+		switch query {
+		case "name=foo,dc=~east|west":
+			return storage.NewFetchResult(ctx, []*ts.Series{
+				ts.NewSeries(ctx, "foo;dc=east", start, ts.NewConstantValues(ctx, 1, 3, 1000)),
+				ts.NewSeries(ctx, "foo;dc=west", start, ts.NewConstantValues(ctx, 2, 3, 1000)),
+			}), nil
+		}
+		return nil, fmt.Errorf("unexpected query: %s", query)
+	}}
+	defer ctx.Close()
+
+	expr, err := compile("sumSeries(seriesByTag('name=foo', 'dc=~east|west'))")
+	require.NoError(t, err)
+
+	series, err := expr.Execute(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(series.Values))
+	assert.Equal(t, []float64{3, 3, 3}, series.Values[0].SafeValues())
+
+	result, err := seriesByTag(ctx, "name=foo", "dc=~east|west")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Values))
+	assert.Equal(t, "seriesByTag('name=foo','dc=~east|west')",
+		result.Values[0].Specification)
+
+	_, err = seriesByTag(ctx, "dc!=east")
+	require.Error(t, err)
+
+	_, err = seriesByTag(ctx)
+	require.Error(t, err)
+}
+
 func TestDiffSeries(t *testing.T) {
 	testAggregatedSeries(t, diffSeries, -15.0, -8.0, -10.0, -17.0, "invalid diff value for step %d")
 }
@@ -395,6 +445,77 @@ func TestGroupByNode(t *testing.T) {
 	}
 }
 
+func TestGroupByTags(t *testing.T) {
+	var (
+		start, _ = time.Parse(time.RFC1123, "Mon, 27 Jul 2015 19:41:19 GMT")
+		end, _   = time.Parse(time.RFC1123, "Mon, 27 Jul 2015 19:43:19 GMT")
+		ctx      = common.NewContext(common.ContextOptions{Start: start, End: end})
+		inputs   = []*ts.Series{
+			ts.NewSeries(ctx, "status;code=500;host=foo-1;pod=pod1", start,
+				ts.NewConstantValues(ctx, 2, 12, 10000)),
+			ts.NewSeries(ctx, "status;code=500;host=foo-2;pod=pod1", start,
+				ts.NewConstantValues(ctx, 4, 12, 10000)),
+			ts.NewSeries(ctx, "status;code=500;host=foo-1;pod=pod2", start,
+				ts.NewConstantValues(ctx, 6, 12, 10000)),
+			ts.NewSeries(ctx, "status;code=400;host=foo-1;pod=pod1", start,
+				ts.NewConstantValues(ctx, 20, 12, 10000)),
+			ts.NewSeries(ctx, "status;code=400;host=foo-3;pod=pod2", start,
+				ts.NewConstantValues(ctx, 40, 12, 10000)),
+		}
+	)
+	defer ctx.Close()
+
+	type result struct {
+		name      string
+		sumOfVals float64
+	}
+
+	tests := []struct {
+		fname           string
+		tags            []string
+		expectedResults []result
+	}{
+		{"avg", []string{"code"}, []result{
+			{"avg;code=400", ((20 + 40) / 2) * 12},
+			{"avg;code=500", ((2 + 4 + 6) / 3) * 12},
+		}},
+		{"max", []string{"pod", "name"}, []result{
+			{"status;pod=pod1", 20 * 12},
+			{"status;pod=pod2", 40 * 12},
+		}},
+		{"sum", []string{"pod", "code"}, []result{
+			{"sum;code=400;pod=pod1", 20 * 12},
+			{"sum;code=400;pod=pod2", 40 * 12},
+			{"sum;code=500;pod=pod1", (2 + 4) * 12},
+			{"sum;code=500;pod=pod2", 6 * 12},
+		}},
+	}
+
+	for _, test := range tests {
+		outSeries, err := groupByTags(ctx, singlePathSpec{
+			Values: inputs,
+		}, test.fname, test.tags...)
+		require.NoError(t, err)
+		require.Equal(t, len(test.expectedResults), len(outSeries.Values))
+
+		outSeries, _ = sortByName(ctx, singlePathSpec(outSeries))
+
+		for i, expected := range test.expectedResults {
+			series := outSeries.Values[i]
+			assert.Equal(t, expected.name, series.Name(),
+				"wrong name for %v %s (%d)", test.tags, test.fname, i)
+			assert.Equal(t, expected.sumOfVals, series.SafeSum(),
+				"wrong result for %v %s (%d)", test.tags, test.fname, i)
+		}
+	}
+
+	_, err := groupByTags(ctx, singlePathSpec{Values: inputs}, "sum")
+	require.Error(t, err)
+
+	_, err = groupByTags(ctx, singlePathSpec{Values: inputs}, "unknown", "code")
+	require.Error(t, err)
+}
+
 func TestWeightedAverage(t *testing.T) {
 	ctx, _ := newConsolidationTestSeries()
 	defer ctx.Close()
@@ -217,3 +217,35 @@ func TestAliasByNodeWithComposition(t *testing.T) {
 	assert.Equal(t, "~~~", results.Values[2].Name())
 	assert.Equal(t, "", results.Values[3].Name())
 }
+
+func TestAliasByTags(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	now := time.Now()
+	values := ts.NewConstantValues(ctx, 10.0, 1000, 10)
+	series := []*ts.Series{
+		ts.NewSeries(ctx, "foo.bar.cpu;dc=east;host=a", now, values),
+		ts.NewSeries(ctx, "derivative(foo.baz.cpu;dc=west;host=b)", now, values),
+		ts.NewSeries(ctx, "foo.qux.cpu;dc=east", now, values),
+	}
+
+	results, err := aliasByTags(ctx, singlePathSpec{
+		Values: series,
+	}, "host", "1", "-1", "name")
+	require.Nil(t, err)
+	require.NotNil(t, results)
+	require.Equal(t, len(series), results.Len())
+	assert.Equal(t, "a.bar.cpu.foo.bar.cpu", results.Values[0].Name())
+	assert.Equal(t, "b.baz.cpu.foo.baz.cpu", results.Values[1].Name())
+	assert.Equal(t, "qux.cpu.foo.qux.cpu", results.Values[2].Name())
+
+	results, err = aliasByTags(ctx, singlePathSpec{
+		Values: series,
+	}, "dc")
+	require.Nil(t, err)
+	require.Equal(t, len(series), results.Len())
+	assert.Equal(t, "east", results.Values[0].Name())
+	assert.Equal(t, "west", results.Values[1].Name())
+	assert.Equal(t, "east", results.Values[2].Name())
+}
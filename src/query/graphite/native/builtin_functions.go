@@ -32,6 +32,7 @@ import (
 
 	"github.com/m3db/m3/src/query/graphite/common"
 	"github.com/m3db/m3/src/query/graphite/errors"
+	"github.com/m3db/m3/src/query/graphite/storage"
 	"github.com/m3db/m3/src/query/graphite/ts"
 )
 
@@ -472,6 +473,31 @@ func removeEmptySeries(ctx *common.Context, input singlePathSpec) (ts.SeriesList
 	return common.RemoveEmpty(ctx, ts.SeriesList(input))
 }
 
+// seriesByTag returns the graphite tagged series matching all of the given tag
+// expressions, i.e. seriesByTag('name=cpu.load', 'dc=~east|west', 'host!=a').
+func seriesByTag(ctx *common.Context, tagExpressions ...string) (ts.SeriesList, error) {
+	if _, err := storage.TranslateTagExpressionsToMatchers(tagExpressions); err != nil {
+		return ts.SeriesList{}, errors.NewInvalidParamsError(err)
+	}
+
+	result, err := ctx.Engine.FetchByTags(ctx, tagExpressions, ctx.StartTime,
+		ctx.EndTime, ctx.Timeout)
+	if err != nil {
+		return ts.SeriesList{}, err
+	}
+
+	quoted := make([]string, 0, len(tagExpressions))
+	for _, expr := range tagExpressions {
+		quoted = append(quoted, fmt.Sprintf("'%s'", expr))
+	}
+
+	spec := fmt.Sprintf("seriesByTag(%s)", strings.Join(quoted, ","))
+	for _, r := range result.SeriesList {
+		r.Specification = spec
+	}
+	return ts.SeriesList{Values: result.SeriesList}, nil
+}
+
 func takeByFunction(input singlePathSpec, n int, sr ts.SeriesReducer, sort ts.Direction) (ts.SeriesList, error) {
 	series, err := ts.SortSeries(input.Values, sr, sort)
 	if err != nil {
@@ -1826,6 +1852,7 @@ func init() {
 	MustRegisterFunction(alias)
 	MustRegisterFunction(aliasByMetric)
 	MustRegisterFunction(aliasByNode)
+	MustRegisterFunction(aliasByTags)
 	MustRegisterFunction(aliasSub)
 	MustRegisterFunction(asPercent).WithDefaultParams(map[uint8]interface{}{
 		2: []*ts.Series(nil), // total
@@ -1850,6 +1877,7 @@ func init() {
 	MustRegisterFunction(fallbackSeries)
 	MustRegisterFunction(group)
 	MustRegisterFunction(groupByNode)
+	MustRegisterFunction(groupByTags)
 	MustRegisterFunction(highestAverage)
 	MustRegisterFunction(highestCurrent)
 	MustRegisterFunction(highestMax)
@@ -1901,6 +1929,7 @@ func init() {
 	MustRegisterFunction(removeEmptySeries)
 	MustRegisterFunction(scale)
 	MustRegisterFunction(scaleToSeconds)
+	MustRegisterFunction(seriesByTag)
 	MustRegisterFunction(sortByMaxima)
 	MustRegisterFunction(sortByName)
 	MustRegisterFunction(sortByTotal)
@@ -1960,6 +1960,12 @@ func (*mockStorage) FetchByQuery(
 	return storage.NewFetchResult(ctx, nil), nil
 }
 
+func (*mockStorage) FetchByTags(
+	ctx xctx.Context, tagExpressions []string, opts storage.FetchOptions,
+) (*storage.FetchResult, error) {
+	return storage.NewFetchResult(ctx, nil), nil
+}
+
 func TestHoltWintersForecast(t *testing.T) {
 	ctx := common.NewTestContext()
 	ctx.Engine = NewEngine(
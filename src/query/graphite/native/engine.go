@@ -59,6 +59,26 @@ func (e *Engine) FetchByQuery(
 	)
 }
 
+// FetchByTags retrieves one or more time series based on tag expressions.
+func (e *Engine) FetchByTags(
+	ctx context.Context,
+	tagExpressions []string,
+	start, end time.Time,
+	timeout time.Duration,
+) (*storage.FetchResult, error) {
+	return e.storage.FetchByTags(
+		ctx,
+		tagExpressions,
+		storage.FetchOptions{
+			StartTime: start,
+			EndTime:   end,
+			DataOptions: storage.DataOptions{
+				Timeout: timeout,
+			},
+		},
+	)
+}
+
 // Compile compiles an expression from an expression string
 func (e *Engine) Compile(s string) (Expression, error) {
 	return compile(s)
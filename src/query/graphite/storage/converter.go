@@ -21,6 +21,8 @@
 package storage
 
 import (
+	"regexp"
+
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/models"
 )
@@ -54,6 +56,16 @@ func glob(metric string) []byte {
 }
 
 func convertMetricPartToMatcher(count int, metric string) models.Matcher {
+	if regexp.QuoteMeta(metric) == metric {
+		// NB: metric parts without any glob or regexp characters can be matched
+		// exactly, which is much cheaper than evaluating a regexp.
+		return models.Matcher{
+			Type:  models.MatchEqual,
+			Name:  graphite.TagName(count),
+			Value: []byte(metric),
+		}
+	}
+
 	return models.Matcher{
 		Type:  models.MatchRegexp,
 		Name:  graphite.TagName(count),
@@ -68,3 +80,11 @@ func matcherTerminator(count int) models.Matcher {
 		Value: []byte(".*"),
 	}
 }
+
+func matcherChild(count int) models.Matcher {
+	return models.Matcher{
+		Type:  models.MatchRegexp,
+		Name:  graphite.TagName(count),
+		Value: []byte(".*"),
+	}
+}
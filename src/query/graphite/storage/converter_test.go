@@ -61,6 +61,19 @@ func TestConvertMetricPartToMatcher(t *testing.T) {
 	}
 }
 
+func TestConvertMetricPartToMatcherNoGlob(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		expected := models.Matcher{
+			Type:  models.MatchEqual,
+			Name:  graphite.TagName(i),
+			Value: []byte("foo_bar-baz"),
+		}
+
+		actual := convertMetricPartToMatcher(i, "foo_bar-baz")
+		assert.Equal(t, expected, actual)
+	}
+}
+
 func TestGetMatcherTerminator(t *testing.T) {
 	for i := 0; i < 100; i++ {
 		expected := models.Matcher{
@@ -73,3 +86,16 @@ func TestGetMatcherTerminator(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	}
 }
+
+func TestGetMatcherChild(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		expected := models.Matcher{
+			Type:  models.MatchRegexp,
+			Name:  graphite.TagName(i),
+			Value: []byte(".*"),
+		}
+
+		actual := matcherChild(i)
+		assert.Equal(t, expected, actual)
+	}
+}
@@ -23,18 +23,25 @@ package storage
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/m3db/m3/src/query/cost"
 	xctx "github.com/m3db/m3/src/query/graphite/context"
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	m3ts "github.com/m3db/m3/src/query/ts"
+	xcost "github.com/m3db/m3/src/x/cost"
 )
 
 var (
 	errSeriesNoResolution = errors.New("series has no resolution set")
+	errNoTagExpressions   = errors.New("no tag expressions specified")
+	errNoPositiveTagMatch = errors.New(
+		"at least one tag expression must match a non-empty value")
 )
 
 type m3WrappedStore struct {
@@ -61,6 +68,103 @@ func TranslateQueryToMatchers(query string) models.Matchers {
 	return matchers
 }
 
+// TranslateFindQueryToMatchers converts a graphite find query to the tag
+// matcher pairs selecting the series which terminate at the last given metric
+// part, i.e. leaf nodes, and the series which have further metric parts,
+// i.e. branch nodes.
+func TranslateFindQueryToMatchers(query string) (leaves, branches models.Matchers) {
+	matchers := TranslateQueryToMatchers(query)
+	metricLength := len(matchers)
+
+	leaves = make(models.Matchers, 0, metricLength+1)
+	leaves = append(leaves, matchers...)
+	leaves = append(leaves, matcherTerminator(metricLength))
+
+	branches = make(models.Matchers, 0, metricLength+1)
+	branches = append(branches, matchers...)
+	branches = append(branches, matcherChild(metricLength))
+
+	return leaves, branches
+}
+
+// TranslateTagExpressionsToMatchers converts graphite tag expressions, as
+// used by seriesByTag, to tag matcher pairs. Supported expressions are:
+//      tag=value   tag value is exactly value
+//      tag!=value  tag value is not value
+//      tag=~re     tag value matches the regular expression re
+//      tag!=~re    tag value does not match the regular expression re
+// NB: regular expressions are anchored at both ends, and an empty value
+// matches series which do not have the tag at all.
+func TranslateTagExpressionsToMatchers(
+	exprs []string,
+) (models.Matchers, error) {
+	if len(exprs) == 0 {
+		return nil, errNoTagExpressions
+	}
+
+	matchers := make(models.Matchers, 0, len(exprs))
+	hasPositiveMatch := false
+	for _, expr := range exprs {
+		matcher, err := convertTagExpressionToMatcher(expr)
+		if err != nil {
+			return nil, err
+		}
+
+		switch matcher.Type {
+		case models.MatchEqual, models.MatchRegexp:
+			if len(matcher.Value) > 0 {
+				hasPositiveMatch = true
+			}
+		}
+
+		matchers = append(matchers, matcher)
+	}
+
+	if !hasPositiveMatch {
+		return nil, errNoPositiveTagMatch
+	}
+
+	return matchers, nil
+}
+
+func convertTagExpressionToMatcher(expr string) (models.Matcher, error) {
+	idx := strings.IndexByte(expr, '=')
+	if idx < 0 {
+		return models.Matcher{},
+			fmt.Errorf("invalid tag expression: %s", expr)
+	}
+
+	name, value := expr[:idx], expr[idx+1:]
+	negated := strings.HasSuffix(name, "!")
+	if negated {
+		name = name[:len(name)-1]
+	}
+
+	regex := strings.HasPrefix(value, "~")
+	if regex {
+		value = value[1:]
+	}
+
+	if len(name) == 0 {
+		return models.Matcher{},
+			fmt.Errorf("invalid tag expression, no tag name: %s", expr)
+	}
+
+	var matchType models.MatchType
+	switch {
+	case negated && regex:
+		matchType = models.MatchNotRegexp
+	case negated:
+		matchType = models.MatchNotEqual
+	case regex:
+		matchType = models.MatchRegexp
+	default:
+		matchType = models.MatchEqual
+	}
+
+	return models.NewMatcher(matchType, []byte(name), []byte(value))
+}
+
 // GetQueryTerminatorTagName will return the name for the terminator matcher in
 // the given pattern. This is useful for filtering out any additional results.
 func GetQueryTerminatorTagName(query string) []byte {
@@ -123,10 +227,44 @@ func translateTimeseries(
 	return series, nil
 }
 
+func translateTagExpressions(
+	tagExpressions []string,
+	opts FetchOptions,
+) (*storage.FetchQuery, error) {
+	matchers, err := TranslateTagExpressionsToMatchers(tagExpressions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.FetchQuery{
+		Raw:         strings.Join(tagExpressions, ","),
+		TagMatchers: matchers,
+		Start:       opts.StartTime,
+		End:         opts.EndTime,
+		Interval:    time.Duration(0),
+	}, nil
+}
+
 func (s *m3WrappedStore) FetchByQuery(
 	ctx xctx.Context, query string, opts FetchOptions,
 ) (*FetchResult, error) {
-	m3query := translateQuery(query, opts)
+	return s.fetch(ctx, translateQuery(query, opts), opts)
+}
+
+func (s *m3WrappedStore) FetchByTags(
+	ctx xctx.Context, tagExpressions []string, opts FetchOptions,
+) (*FetchResult, error) {
+	m3query, err := translateTagExpressions(tagExpressions, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fetch(ctx, m3query, opts)
+}
+
+func (s *m3WrappedStore) fetch(
+	ctx xctx.Context, m3query *storage.FetchQuery, opts FetchOptions,
+) (*FetchResult, error) {
 	m3ctx, cancel := context.WithTimeout(ctx.RequestContext(), opts.Timeout)
 	defer cancel()
 	fetchOptions := storage.NewFetchOptions()
@@ -135,6 +273,7 @@ func (s *m3WrappedStore) FetchByQuery(
 		FanoutAggregated:          storage.FanoutDefault,
 		FanoutAggregatedOptimized: storage.FanoutForceDisable,
 	}
+	fetchOptions.Enforcer = cost.FromContext(ctx.RequestContext())
 
 	m3result, err := s.m3.Fetch(m3ctx, m3query, fetchOptions)
 	if err != nil {
@@ -147,5 +286,16 @@ func (s *m3WrappedStore) FetchByQuery(
 		return nil, err
 	}
 
+	numValues := 0
+	for _, translated := range series {
+		numValues += translated.Len()
+	}
+
+	err = fetchOptions.Enforcer.Add(cost.BlockBytes,
+		xcost.Cost(numValues*cost.BytesPerValue))
+	if err != nil {
+		return nil, err
+	}
+
 	return NewFetchResult(ctx, series), nil
 }
@@ -56,12 +56,12 @@ func TestTranslateQuery(t *testing.T) {
 	assert.Equal(t, query, translated.Raw)
 	matchers := translated.TagMatchers
 	expected := models.Matchers{
-		{Type: models.MatchRegexp, Name: graphite.TagName(0), Value: []byte("foo")},
+		{Type: models.MatchEqual, Name: graphite.TagName(0), Value: []byte("foo")},
 		{Type: models.MatchRegexp, Name: graphite.TagName(1), Value: []byte("ba[rz]")},
 		{Type: models.MatchRegexp, Name: graphite.TagName(2), Value: []byte("q.*x")},
-		{Type: models.MatchRegexp, Name: graphite.TagName(3), Value: []byte("terminator")},
-		{Type: models.MatchRegexp, Name: graphite.TagName(4), Value: []byte("will")},
-		{Type: models.MatchRegexp, Name: graphite.TagName(5), Value: []byte("be")},
+		{Type: models.MatchEqual, Name: graphite.TagName(3), Value: []byte("terminator")},
+		{Type: models.MatchEqual, Name: graphite.TagName(4), Value: []byte("will")},
+		{Type: models.MatchEqual, Name: graphite.TagName(5), Value: []byte("be")},
 		{Type: models.MatchRegexp, Name: graphite.TagName(6), Value: []byte("back?")},
 		{Type: models.MatchNotRegexp, Name: graphite.TagName(7), Value: []byte(".*")},
 	}
@@ -69,6 +69,58 @@ func TestTranslateQuery(t *testing.T) {
 	assert.Equal(t, expected, matchers)
 }
 
+func TestTranslateFindQueryToMatchers(t *testing.T) {
+	leaves, branches := TranslateFindQueryToMatchers("foo.b*")
+	expected := models.Matchers{
+		{Type: models.MatchEqual, Name: graphite.TagName(0), Value: []byte("foo")},
+		{Type: models.MatchRegexp, Name: graphite.TagName(1), Value: []byte("b.*")},
+		{Type: models.MatchNotRegexp, Name: graphite.TagName(2), Value: []byte(".*")},
+	}
+	assert.Equal(t, expected, leaves)
+
+	expected[2] = models.Matcher{
+		Type: models.MatchRegexp, Name: graphite.TagName(2), Value: []byte(".*"),
+	}
+	assert.Equal(t, expected, branches)
+}
+
+func TestTranslateTagExpressionsToMatchers(t *testing.T) {
+	matchers, err := TranslateTagExpressionsToMatchers([]string{
+		"name=foo.bar", "dc!=east", "host=~a|b", "pod!=~c.*", "env=",
+	})
+	require.NoError(t, err)
+
+	expected := models.Matchers{
+		{Type: models.MatchEqual, Name: []byte("name"), Value: []byte("foo.bar")},
+		{Type: models.MatchNotEqual, Name: []byte("dc"), Value: []byte("east")},
+		{Type: models.MatchRegexp, Name: []byte("host"), Value: []byte("a|b")},
+		{Type: models.MatchNotRegexp, Name: []byte("pod"), Value: []byte("c.*")},
+		{Type: models.MatchEqual, Name: []byte("env"), Value: []byte("")},
+	}
+
+	require.Equal(t, len(expected), len(matchers))
+	for i, matcher := range matchers {
+		assert.Equal(t, expected[i].Type, matcher.Type)
+		assert.Equal(t, expected[i].Name, matcher.Name)
+		assert.Equal(t, expected[i].Value, matcher.Value)
+	}
+}
+
+func TestTranslateTagExpressionsToMatchersErrors(t *testing.T) {
+	for _, exprs := range [][]string{
+		nil,
+		{"name"},
+		{"=foo"},
+		{"!=foo", "name=bar"},
+		{"name=~(foo"},
+		{"dc!=east"},
+		{"name="},
+	} {
+		_, err := TranslateTagExpressionsToMatchers(exprs)
+		assert.Error(t, err, fmt.Sprintf("expressions: %v", exprs))
+	}
+}
+
 func TestTranslateTimeseries(t *testing.T) {
 	ctx := xctx.New()
 	resolution := 10 * time.Second
@@ -157,3 +209,40 @@ func TestFetchByQuery(t *testing.T) {
 	assert.Equal(t, "a", series.Name())
 	assert.Equal(t, []float64{3, 3, 3}, series.SafeValues())
 }
+
+func TestFetchByTags(t *testing.T) {
+	store := mock.NewMockStorage()
+	start := time.Now().Add(time.Hour * -1)
+	resolution := 10 * time.Second
+	steps := 3
+	vals := m3ts.NewFixedStepValues(resolution, steps, 3, start)
+	seriesList := m3ts.SeriesList{
+		m3ts.NewSeries([]byte("a;dc=east"), vals, models.NewTags(0, nil)),
+	}
+	for _, series := range seriesList {
+		series.SetResolution(resolution)
+	}
+
+	store.SetFetchResult(&storage.FetchResult{SeriesList: seriesList}, nil)
+	wrapper := NewM3WrappedStorage(store)
+	ctx := xctx.New()
+	ctx.SetRequestContext(context.TODO())
+	end := time.Now()
+	opts := FetchOptions{
+		StartTime: start,
+		EndTime:   end,
+		DataOptions: DataOptions{
+			Timeout: time.Minute,
+		},
+	}
+
+	result, err := wrapper.FetchByTags(ctx, []string{"name=a", "dc=east"}, opts)
+	assert.NoError(t, err)
+	require.Equal(t, 1, len(result.SeriesList))
+	series := result.SeriesList[0]
+	assert.Equal(t, "a;dc=east", series.Name())
+	assert.Equal(t, []float64{3, 3, 3}, series.SafeValues())
+
+	_, err = wrapper.FetchByTags(ctx, []string{"dc!=east"}, opts)
+	assert.Error(t, err)
+}
@@ -47,6 +47,11 @@ type Storage interface {
 	FetchByQuery(
 		ctx context.Context, query string, opts FetchOptions,
 	) (*FetchResult, error)
+
+	// FetchByTags fetches timeseries data based on graphite tag expressions
+	FetchByTags(
+		ctx context.Context, tagExpressions []string, opts FetchOptions,
+	) (*FetchResult, error)
 }
 
 // FetchResult provides a fetch result and meta information
@@ -250,6 +250,10 @@ func (t Tags) prependMetaLen() (int, []int) {
 }
 
 func (t Tags) graphiteID() []byte {
+	if nameIdx, ok := t.graphiteTaggedNameIndex(); ok {
+		return t.graphiteTaggedID(nameIdx)
+	}
+
 	// TODO: pool these bytes.
 	id := make([]byte, t.idLenGraphite())
 	idx := 0
@@ -264,6 +268,47 @@ func (t Tags) graphiteID() []byte {
 	return id
 }
 
+// graphiteTaggedNameIndex returns the index of the name tag of a graphite
+// tagged series, and whether the tags are those of a tagged series.
+func (t Tags) graphiteTaggedNameIndex() (int, bool) {
+	for i, tag := range t.Tags {
+		if bytes.Equal(tag.Name, graphiteTaggedNameTag) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// graphiteTaggedID returns the ID of a graphite tagged series, which is of the
+// form name;tag1=value1;tag2=value2 with the tags ordered lexically.
+func (t Tags) graphiteTaggedID(nameIdx int) []byte {
+	others := make([]Tag, 0, len(t.Tags)-1)
+	idLen := len(t.Tags[nameIdx].Value)
+	for i, tag := range t.Tags {
+		if i == nameIdx {
+			continue
+		}
+
+		others = append(others, tag)
+		idLen += len(tag.Name) + len(tag.Value) + 2 // account for separators
+	}
+
+	sort.Sort(Tags{Tags: others})
+
+	// TODO: pool these bytes.
+	id := make([]byte, 0, idLen)
+	id = append(id, t.Tags[nameIdx].Value...)
+	for _, tag := range others {
+		id = append(id, graphiteTaggedSep)
+		id = append(id, tag.Name...)
+		id = append(id, eq)
+		id = append(id, tag.Value...)
+	}
+
+	return id
+}
+
 func (t Tags) idLenGraphite() int {
 	idLen := t.Len() - 1 // account for separators
 	for _, tag := range t.Tags {
@@ -82,6 +82,22 @@ func TestLongTagNewIDOutOfOrderGraphite(t *testing.T) {
 	assert.Equal(t, []byte("v0.v1.v2.v3.v4.v5.v6.v7.v8.v9.v10.v11.v12"), actual)
 }
 
+func TestTaggedNewIDGraphite(t *testing.T) {
+	opts := NewTagOptions().SetIDSchemeType(TypeGraphite)
+	tags := NewTags(3, opts).AddTags([]Tag{
+		{Name: []byte("host"), Value: []byte("h1")},
+		{Name: []byte("name"), Value: []byte("foo.bar")},
+		{Name: []byte("dc"), Value: []byte("east")},
+		{Name: []byte("az"), Value: []byte("1")},
+	})
+
+	actual := tags.ID()
+	assert.Equal(t, []byte("foo.bar;az=1;dc=east;host=h1"), actual)
+
+	tags = NewTags(1, opts).AddTag(Tag{Name: []byte("name"), Value: []byte("foo.bar")})
+	assert.Equal(t, []byte("foo.bar"), tags.ID())
+}
+
 func TestHashedID(t *testing.T) {
 	tags := testLongTagIDOutOfOrder(t, TypeLegacy)
 	actual := tags.HashedID()
@@ -26,14 +26,19 @@ import (
 
 // Separators for tags.
 const (
-	graphiteSep  = byte('.')
-	sep          = byte(',')
-	finish       = byte('!')
-	eq           = byte('=')
-	leftBracket  = byte('{')
-	rightBracket = byte('}')
+	graphiteSep       = byte('.')
+	graphiteTaggedSep = byte(';')
+	sep               = byte(',')
+	finish            = byte('!')
+	eq                = byte('=')
+	leftBracket       = byte('{')
+	rightBracket      = byte('}')
 )
 
+// graphiteTaggedNameTag is the tag name under which the name of a graphite
+// tagged series is stored.
+var graphiteTaggedNameTag = []byte("name")
+
 // IDSchemeType determines the scheme for generating
 // series IDs based on their tags.
 type IDSchemeType uint16
@@ -0,0 +1,571 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3ql
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/functions/aggregation"
+	"github.com/m3db/m3/src/query/functions/binary"
+	"github.com/m3db/m3/src/query/functions/linear"
+	"github.com/m3db/m3/src/query/functions/scalar"
+	"github.com/m3db/m3/src/query/functions/temporal"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+)
+
+const (
+	// nameKeyword is the fetch keyword which matches the metric name.
+	nameKeyword = "name"
+
+	// maxMacroDepth bounds macro expansion to guard against recursive macros.
+	maxMacroDepth = 16
+)
+
+type m3qlParser struct {
+	query   string
+	script  *script
+	tagOpts models.TagOptions
+}
+
+// Parse takes an M3QL string and parses it into a DAG
+func Parse(q string, tagOpts models.TagOptions) (parser.Parser, error) {
+	builder := newASTBuilder()
+	p := &m3ql{
+		Buffer:        q,
+		scriptBuilder: builder,
+	}
+
+	p.Init()
+	if err := p.Parse(); err != nil {
+		return nil, err
+	}
+
+	p.Execute()
+	s, err := builder.build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &m3qlParser{
+		query:   q,
+		script:  s,
+		tagOpts: tagOpts,
+	}, nil
+}
+
+func (p *m3qlParser) DAG() (parser.Nodes, parser.Edges, error) {
+	state := &parseState{
+		tagOpts: p.tagOpts,
+		macros:  p.script.macros,
+	}
+
+	if _, err := state.walkPipeline(p.script.pipeline, noInput); err != nil {
+		return nil, nil, err
+	}
+
+	return state.transforms, state.edges, nil
+}
+
+func (p *m3qlParser) String() string {
+	return p.query
+}
+
+// input is the node feeding an expression, unset for the
+// first expression of a pipeline which must source data.
+type input struct {
+	id  parser.NodeID
+	set bool
+}
+
+var noInput = input{}
+
+type parseState struct {
+	edges      parser.Edges
+	transforms parser.Nodes
+	tagOpts    models.TagOptions
+	macros     map[string]*pipeline
+	macroDepth int
+}
+
+func (p *parseState) transformLen() int {
+	return len(p.transforms)
+}
+
+func (p *parseState) addTransform(op parser.Params, parents ...parser.NodeID) parser.NodeID {
+	opTransform := parser.NewTransformFromOperation(op, p.transformLen())
+	for _, parent := range parents {
+		p.edges = append(p.edges, parser.Edge{
+			ParentID: parent,
+			ChildID:  opTransform.ID,
+		})
+	}
+
+	p.transforms = append(p.transforms, opTransform)
+	return opTransform.ID
+}
+
+func (p *parseState) walkPipeline(pl *pipeline, in input) (input, error) {
+	var err error
+	for _, expr := range pl.expressions {
+		in, err = p.walkExpression(expr, in)
+		if err != nil {
+			return noInput, err
+		}
+	}
+
+	return in, nil
+}
+
+func (p *parseState) walkExpression(expr *expression, in input) (input, error) {
+	if expr.nested != nil {
+		return p.walkPipeline(expr.nested, in)
+	}
+
+	if macro, ok := p.macros[expr.name]; ok && len(expr.args) == 0 {
+		if p.macroDepth >= maxMacroDepth {
+			return noInput, fmt.Errorf("m3ql macro %s exceeds max expansion depth %d",
+				expr.name, maxMacroDepth)
+		}
+
+		p.macroDepth++
+		out, err := p.walkPipeline(macro, in)
+		p.macroDepth--
+		return out, err
+	}
+
+	if expr.name == functions.FetchType {
+		if in.set {
+			return noInput, fmt.Errorf("m3ql %s must be the first expression in a pipeline",
+				functions.FetchType)
+		}
+
+		op, err := newFetchOp(expr.args, p.tagOpts)
+		if err != nil {
+			return noInput, err
+		}
+
+		return input{id: p.addTransform(op), set: true}, nil
+	}
+
+	if !in.set {
+		return noInput, fmt.Errorf("m3ql function %s has no input, pipelines must begin with %s",
+			expr.name, functions.FetchType)
+	}
+
+	return p.walkFunction(expr, in.id)
+}
+
+func (p *parseState) walkFunction(expr *expression, in parser.NodeID) (input, error) {
+	name := expr.name
+	if alias, ok := functionAliases[name]; ok {
+		name = alias
+	}
+
+	switch name {
+	case aggregation.SumType, aggregation.MinType, aggregation.MaxType,
+		aggregation.AverageType, aggregation.StandardDeviationType,
+		aggregation.StandardVarianceType, aggregation.CountType:
+		tags, err := tagArguments(name, expr.args)
+		if err != nil {
+			return noInput, err
+		}
+
+		op, err := aggregation.NewAggregationOp(name, aggregation.NodeParams{
+			MatchingTags: tags,
+		})
+		if err != nil {
+			return noInput, err
+		}
+
+		return p.output(op, in), nil
+
+	case aggregation.TopKType, aggregation.BottomKType:
+		if len(expr.args) == 0 {
+			return noInput, fmt.Errorf("m3ql %s requires a count argument", name)
+		}
+
+		k, err := numericArgumentValue(name, expr.args[0])
+		if err != nil {
+			return noInput, err
+		}
+
+		tags, err := tagArguments(name, expr.args[1:])
+		if err != nil {
+			return noInput, err
+		}
+
+		op, err := aggregation.NewTakeOp(name, aggregation.NodeParams{
+			MatchingTags: tags,
+			Parameter:    k,
+		})
+		if err != nil {
+			return noInput, err
+		}
+
+		return p.output(op, in), nil
+
+	case linear.AbsType, linear.CeilType, linear.ExpType, linear.FloorType, linear.LnType,
+		linear.Log10Type, linear.Log2Type, linear.SqrtType:
+		if err := expectArguments(name, expr.args, 0); err != nil {
+			return noInput, err
+		}
+
+		op, err := linear.NewMathOp(name)
+		if err != nil {
+			return noInput, err
+		}
+
+		return p.output(op, in), nil
+
+	case linear.ClampMinType, linear.ClampMaxType:
+		args, err := scalarArguments(name, expr.args, 1)
+		if err != nil {
+			return noInput, err
+		}
+
+		op, err := linear.NewClampOp(args, name)
+		if err != nil {
+			return noInput, err
+		}
+
+		return p.output(op, in), nil
+
+	case temporal.AvgType, temporal.CountType, temporal.MinType,
+		temporal.MaxType, temporal.SumType, temporal.StdDevType,
+		temporal.StdVarType:
+		return p.walkTemporal(name, expr.args, in, temporal.NewAggOp)
+
+	case temporal.QuantileType:
+		return p.walkTemporal(name, expr.args, in, temporal.NewQuantileOp)
+
+	case temporal.IRateType, temporal.IDeltaType, temporal.RateType,
+		temporal.IncreaseType, temporal.DeltaType:
+		return p.walkTemporal(name, expr.args, in, temporal.NewRateOp)
+
+	case temporal.ResetsType, temporal.ChangesType:
+		return p.walkTemporal(name, expr.args, in, temporal.NewFunctionOp)
+
+	case binary.EqType, binary.NotEqType, binary.GreaterType,
+		binary.LesserType, binary.GreaterEqType, binary.LesserEqType,
+		binary.PlusType, binary.MinusType, binary.MultiplyType,
+		binary.DivType:
+		return p.walkBinary(name, expr.args, in)
+
+	default:
+		return noInput, fmt.Errorf("m3ql function not supported: %s", expr.name)
+	}
+}
+
+// output adds a transform consuming the input node and returns it as
+// the input to the next expression in the pipeline.
+func (p *parseState) output(op parser.Params, in parser.NodeID) input {
+	return input{id: p.addTransform(op, in), set: true}
+}
+
+type temporalOpFn func(args []interface{}, optype string) (transform.Params, error)
+
+func (p *parseState) walkTemporal(
+	name string,
+	args []argument,
+	in parser.NodeID,
+	opFn temporalOpFn,
+) (input, error) {
+	// Temporal functions operate over a range of raw datapoints, so the range
+	// is applied to the fetch directly feeding the function.
+	idx := p.transformIndex(in)
+	if idx < 0 {
+		return noInput, fmt.Errorf("m3ql unable to find input for %s", name)
+	}
+
+	fetch, ok := p.transforms[idx].Op.(functions.FetchOp)
+	if !ok {
+		return noInput, fmt.Errorf("m3ql %s must directly follow %s", name, functions.FetchType)
+	}
+
+	var (
+		argValues = make([]interface{}, 0, len(args))
+		rangeDur  time.Duration
+	)
+	for _, arg := range args {
+		switch arg.argType {
+		case numericArgument:
+			v, err := numericArgumentValue(name, arg)
+			if err != nil {
+				return noInput, err
+			}
+
+			argValues = append(argValues, v)
+		case patternArgument, stringLiteralArgument:
+			d, err := time.ParseDuration(arg.value)
+			if err != nil {
+				return noInput, fmt.Errorf("m3ql %s invalid duration %s: %v", name, arg.value, err)
+			}
+
+			rangeDur = d
+			argValues = append(argValues, d)
+		default:
+			return noInput, fmt.Errorf("m3ql %s invalid argument: %s", name, arg.value)
+		}
+	}
+
+	if rangeDur <= 0 {
+		return noInput, fmt.Errorf("m3ql %s requires a duration argument", name)
+	}
+
+	op, err := opFn(argValues, name)
+	if err != nil {
+		return noInput, err
+	}
+
+	fetch.Range = rangeDur
+	p.transforms[idx].Op = fetch
+	return p.output(op, in), nil
+}
+
+func (p *parseState) walkBinary(
+	name string,
+	args []argument,
+	in parser.NodeID,
+) (input, error) {
+	if err := expectArguments(name, args, 1); err != nil {
+		return noInput, err
+	}
+
+	arg := args[0]
+	switch arg.argType {
+	case numericArgument:
+		val, err := numericArgumentValue(name, arg)
+		if err != nil {
+			return noInput, err
+		}
+
+		scalarOp, err := scalar.NewScalarOp(
+			func(_ time.Time) float64 { return val },
+			scalar.ScalarType,
+		)
+		if err != nil {
+			return noInput, err
+		}
+
+		rhs := p.addTransform(scalarOp)
+		op, err := binary.NewOp(name, binary.NodeParams{
+			LNode:     in,
+			RNode:     rhs,
+			RIsScalar: true,
+		})
+		if err != nil {
+			return noInput, err
+		}
+
+		return input{id: p.addTransform(op, in, rhs), set: true}, nil
+
+	case pipelineArgument:
+		rhs, err := p.walkPipeline(arg.pipeline, noInput)
+		if err != nil {
+			return noInput, err
+		}
+
+		op, err := binary.NewOp(name, binary.NodeParams{
+			LNode: in,
+			RNode: rhs.id,
+			VectorMatching: &binary.VectorMatching{
+				Card: binary.CardOneToOne,
+			},
+		})
+		if err != nil {
+			return noInput, err
+		}
+
+		return input{id: p.addTransform(op, in, rhs.id), set: true}, nil
+
+	default:
+		return noInput, fmt.Errorf("m3ql %s requires a number or pipeline argument", name)
+	}
+}
+
+func (p *parseState) transformIndex(id parser.NodeID) int {
+	for i, t := range p.transforms {
+		if t.ID == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// functionAliases maps M3QL function names onto their query function types.
+var functionAliases = map[string]string{
+	"average":  aggregation.AverageType,
+	"add":      binary.PlusType,
+	"offset":   binary.PlusType,
+	"subtract": binary.MinusType,
+	"multiply": binary.MultiplyType,
+	"scale":    binary.MultiplyType,
+	"divide":   binary.DivType,
+	"clampMin": linear.ClampMinType,
+	"clampMax": linear.ClampMaxType,
+}
+
+func newFetchOp(args []argument, tagOpts models.TagOptions) (parser.Params, error) {
+	var (
+		name     string
+		matchers = make(models.Matchers, 0, len(args))
+	)
+	for _, arg := range args {
+		if arg.keyword == "" {
+			return nil, fmt.Errorf("m3ql %s arguments must be of the form tag:value, got: %s",
+				functions.FetchType, arg.value)
+		}
+
+		if arg.argType == pipelineArgument {
+			return nil, fmt.Errorf("m3ql %s does not accept pipeline arguments", functions.FetchType)
+		}
+
+		tagName := []byte(arg.keyword)
+		if arg.keyword == nameKeyword {
+			name = arg.value
+			tagName = tagOpts.MetricName()
+		}
+
+		matchType := models.MatchEqual
+		value := arg.value
+		if arg.argType == patternArgument && isGlob(value) {
+			matchType = models.MatchRegexp
+			value = globToRegex(value)
+		}
+
+		matcher, err := models.NewMatcher(matchType, tagName, []byte(value))
+		if err != nil {
+			return nil, err
+		}
+
+		matchers = append(matchers, matcher)
+	}
+
+	if len(matchers) == 0 {
+		return nil, fmt.Errorf("m3ql %s requires at least one tag:value argument",
+			functions.FetchType)
+	}
+
+	return functions.FetchOp{
+		Name:     name,
+		Matchers: matchers,
+	}, nil
+}
+
+const globSymbols = "*?[]{}"
+
+func isGlob(value string) bool {
+	return strings.ContainsAny(value, globSymbols)
+}
+
+// globToRegex converts an M3QL glob into an anchored regular expression.
+func globToRegex(glob string) string {
+	var (
+		sb      bytes.Buffer
+		inGroup bool
+		inClass bool
+	)
+	sb.WriteString("^")
+	for _, r := range glob {
+		switch {
+		case inClass:
+			if r == ']' {
+				inClass = false
+			}
+			sb.WriteRune(r)
+		case r == '*':
+			sb.WriteString(".*")
+		case r == '?':
+			sb.WriteString(".")
+		case r == '[':
+			inClass = true
+			sb.WriteRune(r)
+		case r == '{':
+			inGroup = true
+			sb.WriteString("(")
+		case r == '}' && inGroup:
+			inGroup = false
+			sb.WriteString(")")
+		case r == ',' && inGroup:
+			sb.WriteString("|")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return sb.String()
+}
+
+func tagArguments(name string, args []argument) ([][]byte, error) {
+	tags := make([][]byte, 0, len(args))
+	for _, arg := range args {
+		if arg.argType != patternArgument && arg.argType != stringLiteralArgument {
+			return nil, fmt.Errorf("m3ql %s expects tag name arguments, got: %s", name, arg.value)
+		}
+
+		tags = append(tags, []byte(arg.value))
+	}
+
+	return tags, nil
+}
+
+func scalarArguments(name string, args []argument, n int) ([]interface{}, error) {
+	if err := expectArguments(name, args, n); err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, 0, n)
+	for _, arg := range args {
+		v, err := numericArgumentValue(name, arg)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, v)
+	}
+
+	return values, nil
+}
+
+func numericArgumentValue(name string, arg argument) (float64, error) {
+	if arg.argType != numericArgument {
+		return 0, fmt.Errorf("m3ql %s expects a numeric argument, got: %s", name, arg.value)
+	}
+
+	return strconv.ParseFloat(arg.value, 64)
+}
+
+func expectArguments(name string, args []argument, n int) error {
+	if len(args) != n {
+		return fmt.Errorf("m3ql %s expects %d arguments, got: %d", name, n, len(args))
+	}
+
+	return nil
+}
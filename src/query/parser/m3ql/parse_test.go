@@ -0,0 +1,202 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3ql
+
+import (
+	"testing"
+
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/functions/aggregation"
+	"github.com/m3db/m3/src/query/functions/binary"
+	"github.com/m3db/m3/src/query/functions/linear"
+	"github.com/m3db/m3/src/query/functions/scalar"
+	"github.com/m3db/m3/src/query/functions/temporal"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAGWithFetch(t *testing.T) {
+	q := "fetch name:http.requests city:sf*"
+	p, err := Parse(q, models.NewTagOptions())
+	require.NoError(t, err)
+	assert.Equal(t, q, p.String())
+
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 1)
+	assert.Len(t, edges, 0)
+
+	fetch, ok := transforms[0].Op.(functions.FetchOp)
+	require.True(t, ok)
+	assert.Equal(t, "http.requests", fetch.Name)
+	require.Len(t, fetch.Matchers, 2)
+	assert.Equal(t, models.MatchEqual, fetch.Matchers[0].Type)
+	assert.Equal(t, "__name__", string(fetch.Matchers[0].Name))
+	assert.Equal(t, "http.requests", string(fetch.Matchers[0].Value))
+	assert.Equal(t, models.MatchRegexp, fetch.Matchers[1].Type)
+	assert.Equal(t, "city", string(fetch.Matchers[1].Name))
+	assert.Equal(t, "^sf.*$", string(fetch.Matchers[1].Value))
+}
+
+func TestDAGWithEmptyExpression(t *testing.T) {
+	_, err := Parse("", models.NewTagOptions())
+	require.Error(t, err)
+}
+
+func TestDAGWithUnknownFunction(t *testing.T) {
+	p, err := Parse("fetch name:foo | fake", models.NewTagOptions())
+	require.NoError(t, err)
+	_, _, err = p.DAG()
+	require.Error(t, err)
+}
+
+func TestDAGWithoutFetch(t *testing.T) {
+	p, err := Parse("sum", models.NewTagOptions())
+	require.NoError(t, err)
+	_, _, err = p.DAG()
+	require.Error(t, err)
+}
+
+var pipelineParseTests = []struct {
+	q            string
+	expectedType string
+}{
+	{"fetch name:up | sum", aggregation.SumType},
+	{"fetch name:up | sum city dc", aggregation.SumType},
+	{"fetch name:up | average", aggregation.AverageType},
+	{"fetch name:up | count", aggregation.CountType},
+	{"fetch name:up | topk 3", aggregation.TopKType},
+	{"fetch name:up | abs", linear.AbsType},
+	{"fetch name:up | clampMin 1", linear.ClampMinType},
+	{"fetch name:up | rate 5m", temporal.RateType},
+	{"fetch name:up | avg_over_time 5m", temporal.AvgType},
+	{"fetch name:up | quantile_over_time 0.9 5m", temporal.QuantileType},
+}
+
+func TestPipelineParses(t *testing.T) {
+	for _, tt := range pipelineParseTests {
+		t.Run(tt.q, func(t *testing.T) {
+			p, err := Parse(tt.q, models.NewTagOptions())
+			require.NoError(t, err)
+			transforms, edges, err := p.DAG()
+			require.NoError(t, err)
+			assert.Len(t, transforms, 2)
+			assert.Equal(t, functions.FetchType, transforms[0].Op.OpType())
+			assert.Equal(t, parser.NodeID("0"), transforms[0].ID)
+			assert.Equal(t, tt.expectedType, transforms[1].Op.OpType())
+			assert.Equal(t, parser.NodeID("1"), transforms[1].ID)
+			assert.Len(t, edges, 1)
+			assert.Equal(t, parser.NodeID("0"), edges[0].ParentID)
+			assert.Equal(t, parser.NodeID("1"), edges[0].ChildID)
+		})
+	}
+}
+
+func TestTemporalSetsFetchRange(t *testing.T) {
+	p, err := Parse("fetch name:up | rate 5m | sum", models.NewTagOptions())
+	require.NoError(t, err)
+	transforms, _, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 3)
+
+	fetch, ok := transforms[0].Op.(functions.FetchOp)
+	require.True(t, ok)
+	assert.Equal(t, "5m0s", fetch.Range.String())
+
+	p, err = Parse("fetch name:up | sum | rate 5m", models.NewTagOptions())
+	require.NoError(t, err)
+	_, _, err = p.DAG()
+	require.Error(t, err)
+}
+
+func TestComparisonWithScalar(t *testing.T) {
+	p, err := Parse("fetch name:up | >= 5", models.NewTagOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 3)
+	assert.Equal(t, functions.FetchType, transforms[0].Op.OpType())
+	assert.Equal(t, scalar.ScalarType, transforms[1].Op.OpType())
+	assert.Equal(t, binary.GreaterEqType, transforms[2].Op.OpType())
+	assert.Equal(t, parser.Edges{
+		{ParentID: "0", ChildID: "2"},
+		{ParentID: "1", ChildID: "2"},
+	}, edges)
+}
+
+func TestBinaryWithNestedPipeline(t *testing.T) {
+	q := "fetch name:errors | sum | divide (fetch name:requests | sum)"
+	p, err := Parse(q, models.NewTagOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 5)
+	assert.Equal(t, functions.FetchType, transforms[0].Op.OpType())
+	assert.Equal(t, aggregation.SumType, transforms[1].Op.OpType())
+	assert.Equal(t, functions.FetchType, transforms[2].Op.OpType())
+	assert.Equal(t, aggregation.SumType, transforms[3].Op.OpType())
+	assert.Equal(t, binary.DivType, transforms[4].Op.OpType())
+	assert.Equal(t, parser.Edges{
+		{ParentID: "0", ChildID: "1"},
+		{ParentID: "2", ChildID: "3"},
+		{ParentID: "1", ChildID: "4"},
+		{ParentID: "3", ChildID: "4"},
+	}, edges)
+}
+
+func TestMacroExpansion(t *testing.T) {
+	q := "requests = fetch name:requests | sum dc; requests | abs"
+	p, err := Parse(q, models.NewTagOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 3)
+	assert.Equal(t, functions.FetchType, transforms[0].Op.OpType())
+	assert.Equal(t, aggregation.SumType, transforms[1].Op.OpType())
+	assert.Equal(t, linear.AbsType, transforms[2].Op.OpType())
+	assert.Len(t, edges, 2)
+}
+
+func TestRecursiveMacro(t *testing.T) {
+	p, err := Parse("loop = loop; fetch name:up | loop", models.NewTagOptions())
+	require.NoError(t, err)
+	_, _, err = p.DAG()
+	require.Error(t, err)
+}
+
+func TestGlobToRegex(t *testing.T) {
+	tests := []struct {
+		glob     string
+		expected string
+	}{
+		{"foo*", "^foo.*$"},
+		{"f?o", "^f.o$"},
+		{"foo.{bar,baz}", "^foo\\.(bar|baz)$"},
+		{"foo[0-9]", "^foo[0-9]$"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, globToRegex(tt.glob))
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3ql
+
+import (
+	"errors"
+)
+
+var errEmptyScript = errors.New("m3ql script has no pipeline")
+
+type argumentType int
+
+const (
+	booleanArgument argumentType = iota
+	numericArgument
+	patternArgument
+	stringLiteralArgument
+	pipelineArgument
+)
+
+// script is a parsed M3QL script, a set of macro definitions
+// followed by the pipeline to evaluate.
+type script struct {
+	macros   map[string]*pipeline
+	pipeline *pipeline
+}
+
+// pipeline is a list of expressions where each expression
+// consumes the output of the previous expression.
+type pipeline struct {
+	expressions []*expression
+}
+
+// expression is either a function call or a nested pipeline.
+type expression struct {
+	name   string
+	args   []argument
+	nested *pipeline
+}
+
+// argument is a function call argument, optionally named by a keyword.
+type argument struct {
+	keyword  string
+	argType  argumentType
+	value    string
+	pipeline *pipeline
+}
+
+type pipelineFrame struct {
+	pipeline *pipeline
+	// exprDepth is the number of open expressions when the pipeline began,
+	// used to tell a pipeline passed as an argument from a nested expression.
+	exprDepth int
+	// keyword is the keyword specified before the pipeline, if any.
+	keyword string
+}
+
+// astBuilder implements scriptBuilder and builds the script AST
+// from the actions executed by the PEG parser.
+type astBuilder struct {
+	script      *script
+	pendingName string
+	keyword     string
+	pipelines   []pipelineFrame
+	expressions []*expression
+}
+
+func newASTBuilder() *astBuilder {
+	return &astBuilder{
+		script: &script{
+			macros: make(map[string]*pipeline),
+		},
+	}
+}
+
+func (b *astBuilder) newMacro(name string) {
+	b.pendingName = name
+}
+
+func (b *astBuilder) newPipeline() {
+	b.pipelines = append(b.pipelines, pipelineFrame{
+		pipeline:  &pipeline{},
+		exprDepth: len(b.expressions),
+		keyword:   b.keyword,
+	})
+	b.keyword = ""
+}
+
+func (b *astBuilder) endPipeline() {
+	if len(b.pipelines) == 0 {
+		return
+	}
+
+	frame := b.pipelines[len(b.pipelines)-1]
+	b.pipelines = b.pipelines[:len(b.pipelines)-1]
+
+	if len(b.pipelines) == 0 {
+		// Top level pipeline, either a macro definition or the script itself
+		if b.pendingName != "" {
+			b.script.macros[b.pendingName] = frame.pipeline
+			b.pendingName = ""
+			return
+		}
+
+		b.script.pipeline = frame.pipeline
+		return
+	}
+
+	parent := b.pipelines[len(b.pipelines)-1]
+	if len(b.expressions) > parent.exprDepth {
+		// An expression of the parent pipeline is open, this
+		// pipeline is an argument to that expression.
+		expr := b.expressions[len(b.expressions)-1]
+		expr.args = append(expr.args, argument{
+			keyword:  frame.keyword,
+			argType:  pipelineArgument,
+			pipeline: frame.pipeline,
+		})
+		return
+	}
+
+	parent.pipeline.expressions = append(parent.pipeline.expressions,
+		&expression{nested: frame.pipeline})
+}
+
+func (b *astBuilder) newExpression(name string) {
+	b.expressions = append(b.expressions, &expression{name: name})
+}
+
+func (b *astBuilder) endExpression() {
+	if len(b.expressions) == 0 || len(b.pipelines) == 0 {
+		return
+	}
+
+	expr := b.expressions[len(b.expressions)-1]
+	b.expressions = b.expressions[:len(b.expressions)-1]
+
+	current := b.pipelines[len(b.pipelines)-1].pipeline
+	current.expressions = append(current.expressions, expr)
+}
+
+func (b *astBuilder) newBooleanArgument(text string) {
+	b.addArgument(booleanArgument, text)
+}
+
+func (b *astBuilder) newNumericArgument(text string) {
+	b.addArgument(numericArgument, text)
+}
+
+func (b *astBuilder) newPatternArgument(text string) {
+	b.addArgument(patternArgument, text)
+}
+
+func (b *astBuilder) newStringLiteralArgument(text string) {
+	b.addArgument(stringLiteralArgument, text)
+}
+
+func (b *astBuilder) newKeywordArgument(text string) {
+	b.keyword = text
+}
+
+func (b *astBuilder) addArgument(argType argumentType, text string) {
+	if len(b.expressions) == 0 {
+		return
+	}
+
+	expr := b.expressions[len(b.expressions)-1]
+	expr.args = append(expr.args, argument{
+		keyword: b.keyword,
+		argType: argType,
+		value:   text,
+	})
+	b.keyword = ""
+}
+
+func (b *astBuilder) build() (*script, error) {
+	if b.script.pipeline == nil || len(b.script.pipeline.expressions) == 0 {
+		return nil, errEmptyScript
+	}
+
+	return b.script, nil
+}
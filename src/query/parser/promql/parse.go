@@ -31,25 +31,35 @@ import (
 )
 
 type promParser struct {
-	expr    pql.Expr
-	tagOpts models.TagOptions
+	expr       pql.Expr
+	subqueries []subquery
+	tagOpts    models.TagOptions
 }
 
 // Parse takes a promQL string and converts parses it into a DAG
 func Parse(q string, tagOpts models.TagOptions) (parser.Parser, error) {
+	q, subqueries, err := extractSubqueries(q)
+	if err != nil {
+		return nil, err
+	}
+
 	expr, err := pql.ParseExpr(q)
 	if err != nil {
 		return nil, err
 	}
 
 	return &promParser{
-		expr:    expr,
-		tagOpts: tagOpts,
+		expr:       expr,
+		subqueries: subqueries,
+		tagOpts:    tagOpts,
 	}, nil
 }
 
 func (p *promParser) DAG() (parser.Nodes, parser.Edges, error) {
-	state := &parseState{tagOpts: p.tagOpts}
+	state := &parseState{
+		subqueries: p.subqueries,
+		tagOpts:    p.tagOpts,
+	}
 	err := state.walk(p.expr)
 	if err != nil {
 		return nil, nil, err
@@ -59,15 +69,32 @@ func (p *promParser) DAG() (parser.Nodes, parser.Edges, error) {
 }
 
 func (p *promParser) String() string {
-	return p.expr.String()
+	s := p.expr.String()
+	// NB: later subqueries may contain the placeholders of earlier ones.
+	for i := len(p.subqueries) - 1; i >= 0; i-- {
+		s = p.subqueries[i].restore(s)
+	}
+
+	return s
 }
 
 type parseState struct {
 	edges      parser.Edges
 	transforms parser.Nodes
+	subqueries []subquery
 	tagOpts    models.TagOptions
 }
 
+func (p *parseState) subquery(name string) (subquery, bool) {
+	for _, s := range p.subqueries {
+		if s.placeholder == name {
+			return s, true
+		}
+	}
+
+	return subquery{}, false
+}
+
 func (p *parseState) lastTransformID() parser.NodeID {
 	if len(p.transforms) == 0 {
 		return parser.NodeID(-1)
@@ -107,6 +134,10 @@ func (p *parseState) walk(node pql.Node) error {
 		return nil
 
 	case *pql.MatrixSelector:
+		if s, ok := p.subquery(n.Name); ok {
+			return p.walkSubquery(s)
+		}
+
 		operation, err := NewSelectorFromMatrix(n, p.tagOpts)
 		if err != nil {
 			return err
@@ -227,3 +258,25 @@ func (p *parseState) walk(node pql.Node) error {
 		return fmt.Errorf("promql.Walk: unhandled node type %T, %v", node, node)
 	}
 }
+
+// walkSubquery builds the DAG of the inner expression of the subquery, which
+// is added as a single source node since it is evaluated at its own step.
+func (p *parseState) walkSubquery(s subquery) error {
+	if s.expr.Type() != pql.ValueTypeVector {
+		return fmt.Errorf("subquery is only allowed on instant vector, got %s in %q",
+			s.expr.Type(), s)
+	}
+
+	inner := &parseState{
+		subqueries: p.subqueries,
+		tagOpts:    p.tagOpts,
+	}
+	if err := inner.walk(s.expr); err != nil {
+		return err
+	}
+
+	op := NewSubqueryOperator(inner.transforms, inner.edges, s.rng, s.step)
+	p.transforms = append(p.transforms,
+		parser.NewTransformFromOperation(op, p.transformLen()))
+	return nil
+}
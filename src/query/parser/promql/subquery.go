@@ -0,0 +1,288 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	pql "github.com/prometheus/prometheus/promql"
+)
+
+// The vendored prometheus parser predates subqueries, so subqueries are
+// rewritten into matrix selectors over placeholder series before the query is
+// parsed. Each placeholder is swapped for the subquery when walking the AST.
+const subqueryPlaceholderFmt = "__subquery_%d__"
+
+type subquery struct {
+	placeholder string
+	expr        pql.Expr
+	rng         time.Duration
+	step        time.Duration
+}
+
+func (s subquery) String() string {
+	step := ""
+	if s.step > 0 {
+		step = model.Duration(s.step).String()
+	}
+
+	return fmt.Sprintf("%s[%s:%s]", s.expr, model.Duration(s.rng), step)
+}
+
+// restore replaces the placeholder matrix selector in q with the subquery.
+func (s subquery) restore(q string) string {
+	re := regexp.MustCompile(regexp.QuoteMeta(s.placeholder) + `\[[^\]]*\]`)
+	return re.ReplaceAllLiteralString(q, s.String())
+}
+
+// extractSubqueries rewrites every subquery in q, innermost first, into a
+// matrix selector over a placeholder series with the range of the subquery.
+func extractSubqueries(q string) (string, []subquery, error) {
+	var subqueries []subquery
+	for {
+		inString := stringPositions(q)
+		open, close, ok := nextSubqueryRange(q, inString)
+		if !ok {
+			return q, subqueries, nil
+		}
+
+		start, err := subqueryExprStart(q, open, inString)
+		if err != nil {
+			return "", nil, err
+		}
+
+		rng, step, err := parseSubqueryRange(q[open+1 : close])
+		if err != nil {
+			return "", nil, err
+		}
+
+		expr, err := pql.ParseExpr(q[start:open])
+		if err != nil {
+			return "", nil, err
+		}
+
+		placeholder := fmt.Sprintf(subqueryPlaceholderFmt, len(subqueries))
+		subqueries = append(subqueries, subquery{
+			placeholder: placeholder,
+			expr:        expr,
+			rng:         rng,
+			step:        step,
+		})
+
+		q = q[:start] + placeholder + "[" + model.Duration(rng).String() + "]" + q[close+1:]
+	}
+}
+
+// stringPositions marks the positions of q which are inside string literals.
+func stringPositions(q string) []bool {
+	var (
+		inString = make([]bool, len(q))
+		quote    byte
+	)
+	for i := 0; i < len(q); i++ {
+		c := q[i]
+		if quote == 0 {
+			if c == '"' || c == '\'' || c == '`' {
+				quote = c
+				inString[i] = true
+			}
+			continue
+		}
+
+		inString[i] = true
+		if c == '\\' && quote != '`' && i+1 < len(q) {
+			i++
+			inString[i] = true
+			continue
+		}
+
+		if c == quote {
+			quote = 0
+		}
+	}
+
+	return inString
+}
+
+// nextSubqueryRange returns the positions of the brackets around the first
+// subquery range in q, which unlike a range selector contains a colon.
+func nextSubqueryRange(q string, inString []bool) (int, int, bool) {
+	for i := 0; i < len(q); i++ {
+		if inString[i] || q[i] != '[' {
+			continue
+		}
+
+		close := strings.IndexByte(q[i:], ']')
+		if close < 0 {
+			return 0, 0, false
+		}
+
+		close += i
+		if strings.IndexByte(q[i:close], ':') >= 0 {
+			return i, close, true
+		}
+
+		i = close
+	}
+
+	return 0, 0, false
+}
+
+func parseSubqueryRange(s string) (time.Duration, time.Duration, error) {
+	parts := strings.SplitN(s, ":", 2)
+	rng, err := model.ParseDuration(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid subquery range %q: %v", s, err)
+	}
+
+	var step model.Duration
+	if stepStr := strings.TrimSpace(parts[1]); stepStr != "" {
+		step, err = model.ParseDuration(stepStr)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid subquery step %q: %v", s, err)
+		}
+	}
+
+	return time.Duration(rng), time.Duration(step), nil
+}
+
+// subqueryExprStart walks back from the opening bracket of a subquery range
+// to find the start of the expression the subquery applies to, which is a
+// selector, a function call, an aggregation or a parenthesized expression.
+func subqueryExprStart(q string, open int, inString []bool) (int, error) {
+	i := skipSpaceBack(q, open-1)
+	for i >= 0 {
+		switch c := q[i]; {
+		case c == ')':
+			start, err := matchBack(q, inString, i, '(', ')')
+			if err != nil {
+				return 0, err
+			}
+
+			prev := skipSpaceBack(q, start-1)
+			if prev < 0 {
+				return start, nil
+			}
+
+			if isIdentifierChar(q[prev]) {
+				ident := identifierStart(q, prev)
+				if !isGroupingKeyword(q[ident : prev+1]) {
+					// Function call or aggregation with trailing grouping.
+					return ident, nil
+				}
+
+				// Grouping after an aggregation, e.g. sum(x) by (y).
+				i = skipSpaceBack(q, ident-1)
+				continue
+			}
+
+			if q[prev] == ')' {
+				// Grouping before an aggregation, e.g. sum by (y) (x).
+				grouping, err := matchBack(q, inString, prev, '(', ')')
+				if err != nil {
+					return 0, err
+				}
+
+				keyword := skipSpaceBack(q, grouping-1)
+				if keyword >= 0 && isIdentifierChar(q[keyword]) {
+					ident := identifierStart(q, keyword)
+					if isGroupingKeyword(q[ident : keyword+1]) {
+						i = skipSpaceBack(q, ident-1)
+						continue
+					}
+				}
+			}
+
+			return start, nil
+
+		case c == '}':
+			start, err := matchBack(q, inString, i, '{', '}')
+			if err != nil {
+				return 0, err
+			}
+
+			prev := skipSpaceBack(q, start-1)
+			if prev >= 0 && isIdentifierChar(q[prev]) {
+				return identifierStart(q, prev), nil
+			}
+
+			return start, nil
+
+		case isIdentifierChar(c):
+			return identifierStart(q, i), nil
+
+		default:
+			return 0, fmt.Errorf("unexpected character %q before subquery range", c)
+		}
+	}
+
+	return 0, fmt.Errorf("missing expression for subquery at position %d", open)
+}
+
+func matchBack(q string, inString []bool, i int, open, close byte) (int, error) {
+	depth := 0
+	for ; i >= 0; i-- {
+		if inString[i] {
+			continue
+		}
+
+		switch q[i] {
+		case close:
+			depth++
+		case open:
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("unbalanced %q in query", close)
+}
+
+func skipSpaceBack(q string, i int) int {
+	for i >= 0 && (q[i] == ' ' || q[i] == '\t' || q[i] == '\n' || q[i] == '\r') {
+		i--
+	}
+
+	return i
+}
+
+func identifierStart(q string, i int) int {
+	for i > 0 && isIdentifierChar(q[i-1]) {
+		i--
+	}
+
+	return i
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c == ':' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func isGroupingKeyword(s string) bool {
+	return s == "by" || s == "without"
+}
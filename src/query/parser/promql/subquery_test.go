@@ -0,0 +1,151 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/functions/aggregation"
+	"github.com/m3db/m3/src/query/functions/temporal"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAGWithSubquery(t *testing.T) {
+	q := "max_over_time(rate(http_requests_total[5m])[1h:1m])"
+	p, err := Parse(q, models.NewTagOptions())
+	require.NoError(t, err)
+	assert.Equal(t, q, p.String())
+
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 2)
+	assert.Equal(t, executor.SubqueryType, transforms[0].Op.OpType())
+	assert.Equal(t, temporal.MaxType, transforms[1].Op.OpType())
+	require.Len(t, edges, 1)
+	assert.Equal(t, parser.NodeID("0"), edges[0].ParentID)
+	assert.Equal(t, parser.NodeID("1"), edges[0].ChildID)
+
+	op, ok := transforms[0].Op.(executor.SubqueryOp)
+	require.True(t, ok)
+	assert.Equal(t, time.Hour, op.Range)
+	assert.Equal(t, time.Minute, op.Step)
+	assert.Equal(t, time.Hour, op.Bounds().Range)
+
+	require.Len(t, op.Nodes, 2)
+	assert.Equal(t, functions.FetchType, op.Nodes[0].Op.OpType())
+	assert.Equal(t, temporal.RateType, op.Nodes[1].Op.OpType())
+	require.Len(t, op.Edges, 1)
+	assert.Equal(t, op.Nodes[0].ID, op.Edges[0].ParentID)
+	assert.Equal(t, op.Nodes[1].ID, op.Edges[0].ChildID)
+}
+
+var subqueryParseTests = []struct {
+	q            string
+	expectedRng  time.Duration
+	expectedStep time.Duration
+	expectedType string
+}{
+	{"avg_over_time(up[10m:])", 10 * time.Minute, 0, functions.FetchType},
+	{"avg_over_time(up{a=\"b\"}[10m:30s])", 10 * time.Minute, 30 * time.Second, functions.FetchType},
+	{"avg_over_time(sum(up) by (a)[1h:5m])", time.Hour, 5 * time.Minute, aggregation.SumType},
+	{"avg_over_time(sum by (a) (up)[1h:5m])", time.Hour, 5 * time.Minute, aggregation.SumType},
+	{"avg_over_time(sum without (a) (up) [1h : 5m])", time.Hour, 5 * time.Minute, aggregation.SumType},
+	{"avg_over_time((sum(up))[1h:5m])", time.Hour, 5 * time.Minute, aggregation.SumType},
+	{"avg_over_time(rate(up[1m])[5m:1m])", 5 * time.Minute, time.Minute, temporal.RateType},
+}
+
+func TestSubqueryParses(t *testing.T) {
+	for _, tt := range subqueryParseTests {
+		t.Run(tt.q, func(t *testing.T) {
+			p, err := Parse(tt.q, models.NewTagOptions())
+			require.NoError(t, err)
+			transforms, _, err := p.DAG()
+			require.NoError(t, err)
+			require.Len(t, transforms, 2)
+			assert.Equal(t, temporal.AvgType, transforms[1].Op.OpType())
+
+			op, ok := transforms[0].Op.(executor.SubqueryOp)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedRng, op.Range)
+			assert.Equal(t, tt.expectedStep, op.Step)
+			require.NotEmpty(t, op.Nodes)
+			assert.Equal(t, tt.expectedType, op.Nodes[len(op.Nodes)-1].Op.OpType())
+		})
+	}
+}
+
+func TestNestedSubqueryParses(t *testing.T) {
+	q := "max_over_time(deriv(rate(up[1m])[5m:1m])[1h:5m])"
+	p, err := Parse(q, models.NewTagOptions())
+	require.NoError(t, err)
+	assert.Equal(t, q, p.String())
+
+	transforms, _, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 2)
+
+	outer, ok := transforms[0].Op.(executor.SubqueryOp)
+	require.True(t, ok)
+	assert.Equal(t, time.Hour, outer.Range)
+	require.Len(t, outer.Nodes, 2)
+	assert.Equal(t, temporal.DerivType, outer.Nodes[1].Op.OpType())
+
+	inner, ok := outer.Nodes[0].Op.(executor.SubqueryOp)
+	require.True(t, ok)
+	assert.Equal(t, 5*time.Minute, inner.Range)
+	assert.Equal(t, time.Minute, inner.Step)
+	require.Len(t, inner.Nodes, 2)
+	assert.Equal(t, temporal.RateType, inner.Nodes[1].Op.OpType())
+}
+
+func TestSubqueryIgnoresStrings(t *testing.T) {
+	q := "sum(up{a=\"[1:2]\"})"
+	p, err := Parse(q, models.NewTagOptions())
+	require.NoError(t, err)
+	transforms, _, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 2)
+	assert.Equal(t, functions.FetchType, transforms[0].Op.OpType())
+}
+
+func TestFailedSubqueryParse(t *testing.T) {
+	for _, q := range []string{
+		"max_over_time(up[1h:1x])",
+		"max_over_time([1h:1m])",
+		"max_over_time(sum(up)[1h:1m)",
+		"max_over_time(up[5m][1h:1m])",
+	} {
+		_, err := Parse(q, models.NewTagOptions())
+		assert.Error(t, err, q)
+	}
+
+	p, err := Parse("max_over_time(scalar(up)[1h:1m])", models.NewTagOptions())
+	require.NoError(t, err)
+	_, _, err = p.DAG()
+	assert.Error(t, err)
+}
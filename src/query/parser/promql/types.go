@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/functions"
 	"github.com/m3db/m3/src/query/functions/aggregation"
 	"github.com/m3db/m3/src/query/functions/binary"
@@ -75,6 +76,22 @@ func NewSelectorFromMatrix(
 	}, nil
 }
 
+// NewSubqueryOperator creates a new subquery over the DAG of the inner
+// expression of the subquery.
+func NewSubqueryOperator(
+	nodes parser.Nodes,
+	edges parser.Edges,
+	rng time.Duration,
+	step time.Duration,
+) parser.Params {
+	return executor.SubqueryOp{
+		Nodes: nodes,
+		Edges: edges,
+		Range: rng,
+		Step:  step,
+	}
+}
+
 // NewAggregationOperator creates a new aggregation operator based on the type
 func NewAggregationOperator(expr *promql.AggregateExpr) (parser.Params, error) {
 	opType := expr.Op
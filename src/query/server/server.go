@@ -24,11 +24,13 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"sort"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -44,6 +46,7 @@ import (
 	"github.com/m3db/m3/src/metrics/policy"
 	"github.com/m3db/m3/src/query/api/v1/httpd"
 	m3dbcluster "github.com/m3db/m3/src/query/cluster/m3db"
+	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/executor"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/policy/filter"
@@ -73,6 +76,10 @@ import (
 
 const (
 	serviceName = "m3query"
+
+	globalCostLimitsKVKeyPrefix   = "m3query.limits.global"
+	perQueryCostLimitsKVKeyPrefix = "m3query.limits.per-query"
+	costLimitsKVRetryInterval     = 10 * time.Second
 )
 
 var (
@@ -176,6 +183,7 @@ func Run(runOpts RunOptions) {
 	defer buildReporter.Stop()
 	var (
 		backendStorage storage.Storage
+		localQuerier   m3.Querier
 		clusterClient  clusterclient.Client
 		downsampler    downsample.Downsampler
 		enabled        bool
@@ -231,7 +239,7 @@ func Run(runOpts RunOptions) {
 		}
 
 		var cleanup cleanupFn
-		backendStorage, clusterClient, downsampler, cleanup, err = newM3DBStorage(
+		backendStorage, localQuerier, clusterClient, downsampler, cleanup, err = newM3DBStorage(
 			runOpts,
 			cfg,
 			tagOptions,
@@ -248,7 +256,12 @@ func Run(runOpts RunOptions) {
 		defer cleanup()
 	}
 
-	engine := executor.NewEngine(backendStorage, scope.SubScope("engine"), *cfg.LookbackDuration)
+	globalEnforcer, stopCostLimits := newGlobalEnforcer(cfg.LimitsOrDefault(),
+		clusterClient, instrumentOptions, logger)
+	defer stopCostLimits()
+
+	engine := executor.NewEngine(backendStorage, scope.SubScope("engine"),
+		*cfg.LookbackDuration, globalEnforcer)
 
 	downsamplerAndWriter, err := newDownsamplerAndWriter(backendStorage, downsampler)
 	if err != nil {
@@ -256,7 +269,7 @@ func Run(runOpts RunOptions) {
 	}
 
 	handler, err := httpd.NewHandler(downsamplerAndWriter, tagOptions, engine,
-		m3dbClusters, clusterClient, cfg, runOpts.DBConfig, scope)
+		localQuerier, m3dbClusters, clusterClient, cfg, runOpts.DBConfig, scope)
 	if err != nil {
 		logger.Fatal("unable to set up handlers", zap.Error(err))
 	}
@@ -351,7 +364,7 @@ func newM3DBStorage(
 	instrumentOptions instrument.Options,
 	readWorkerPool xsync.PooledWorkerPool,
 	writeWorkerPool xsync.PooledWorkerPool,
-) (storage.Storage, clusterclient.Client, downsample.Downsampler, cleanupFn, error) {
+) (storage.Storage, m3.Querier, clusterclient.Client, downsample.Downsampler, cleanupFn, error) {
 	var (
 		clusterClient       clusterclient.Client
 		clusterClientWaitCh <-chan struct{}
@@ -384,12 +397,12 @@ func newM3DBStorage(
 			)
 			clusterClient, err = etcdclient.NewConfigServiceClient(clusterSvcClientOpts)
 			if err != nil {
-				return nil, nil, nil, nil, errors.Wrap(err, "unable to create cluster management etcd client")
+				return nil, nil, nil, nil, nil, errors.Wrap(err, "unable to create cluster management etcd client")
 			}
 		}
 	}
 
-	fanoutStorage, storageCleanup, err := newStorages(
+	fanoutStorage, localQuerier, storageCleanup, err := newStorages(
 		logger,
 		clusters,
 		cfg,
@@ -399,7 +412,7 @@ func newM3DBStorage(
 		writeWorkerPool,
 	)
 	if err != nil {
-		return nil, nil, nil, nil, errors.Wrap(err, "unable to set up storages")
+		return nil, nil, nil, nil, nil, errors.Wrap(err, "unable to set up storages")
 	}
 
 	var (
@@ -411,7 +424,7 @@ func newM3DBStorage(
 			zap.Int("numAggregatedClusterNamespaces", n))
 		autoMappingRules, err := newDownsamplerAutoMappingRules(namespaces)
 		if err != nil {
-			return nil, nil, nil, nil, err
+			return nil, nil, nil, nil, nil, err
 		}
 
 		newDownsamplerFn := func() (downsample.Downsampler, error) {
@@ -431,7 +444,7 @@ func newM3DBStorage(
 			// Otherwise we already have a client and can immediately construct the downsampler
 			downsampler, err = newDownsamplerFn()
 			if err != nil {
-				return nil, nil, nil, nil, err
+				return nil, nil, nil, nil, nil, err
 			}
 		}
 	}
@@ -452,7 +465,7 @@ func newM3DBStorage(
 		return lastErr
 	}
 
-	return fanoutStorage, clusterClient, downsampler, cleanup, nil
+	return fanoutStorage, localQuerier, clusterClient, downsampler, cleanup, nil
 }
 
 func newDownsampler(
@@ -505,6 +518,97 @@ func newDownsampler(
 	return downsampler, nil
 }
 
+// newGlobalEnforcer returns the enforcer which limits the resources used by
+// queries. The limits default to the configured values and, once the cluster
+// management client is available, are watched in KV so that they can be
+// adjusted at runtime.
+func newGlobalEnforcer(
+	limitsCfg *config.LimitsConfiguration,
+	clusterClient clusterclient.Client,
+	instrumentOpts instrument.Options,
+	logger *zap.Logger,
+) (cost.GlobalEnforcer, func()) {
+	var (
+		scope              = instrumentOpts.MetricsScope().SubScope("cost")
+		globalOpts         = instrumentOpts.SetMetricsScope(scope.SubScope("global"))
+		perQueryOpts       = instrumentOpts.SetMetricsScope(scope.SubScope("per-query"))
+		globalThresholds   = limitsCfg.Global.Thresholds()
+		perQueryThresholds = limitsCfg.PerQuery.Thresholds()
+		enforcer           = cost.NewGlobalEnforcer(
+			cost.NewStaticLimitManagers(globalThresholds, globalOpts),
+			cost.NewStaticLimitManagers(perQueryThresholds, perQueryOpts),
+			instrumentOpts.SetMetricsScope(scope),
+		)
+	)
+
+	if clusterClient == nil {
+		logger.Info("no cluster management client configured, " +
+			"query cost limits can not be adjusted at runtime")
+		return enforcer, func() {}
+	}
+
+	var (
+		global, perQuery cost.LimitManagers
+		doneCh           = make(chan struct{})
+		wg               sync.WaitGroup
+	)
+	watchLimits := func() error {
+		kvStore, err := clusterClient.KV()
+		if err != nil {
+			return err
+		}
+
+		global, err = cost.NewDynamicLimitManagers(kvStore,
+			globalCostLimitsKVKeyPrefix, globalThresholds, globalOpts)
+		if err != nil {
+			return err
+		}
+
+		perQuery, err = cost.NewDynamicLimitManagers(kvStore,
+			perQueryCostLimitsKVKeyPrefix, perQueryThresholds, perQueryOpts)
+		if err != nil {
+			global.Close()
+			global = nil
+			return err
+		}
+
+		enforcer.SetLimitManagers(global, perQuery)
+		return nil
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		// NB: the cluster client may be initialized asynchronously when
+		// running embedded, so keep retrying until the KV store is available.
+		ticker := time.NewTicker(costLimitsKVRetryInterval)
+		defer ticker.Stop()
+		for {
+			err := watchLimits()
+			if err == nil {
+				logger.Info("watching query cost limits in KV")
+				return
+			}
+
+			logger.Warn("unable to watch query cost limits in KV, retrying",
+				zap.Error(err))
+			select {
+			case <-ticker.C:
+			case <-doneCh:
+				return
+			}
+		}
+	}()
+
+	return enforcer, func() {
+		close(doneCh)
+		wg.Wait()
+		global.Close()
+		perQuery.Close()
+	}
+}
+
 func newDownsamplerAutoMappingRules(
 	namespaces []m3.ClusterNamespace,
 ) ([]downsample.MappingRule, error) {
@@ -605,19 +709,19 @@ func newStorages(
 	poolWrapper *pools.PoolWrapper,
 	readWorkerPool xsync.PooledWorkerPool,
 	writeWorkerPool xsync.PooledWorkerPool,
-) (storage.Storage, cleanupFn, error) {
+) (storage.Storage, m3.Querier, cleanupFn, error) {
 	cleanup := func() error { return nil }
 
 	// Setup query conversion cache.
 	conversionCacheConfig := cfg.Cache.QueryConversionCacheConfiguration()
 	if err := conversionCacheConfig.Validate(); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	conversionCacheSize := conversionCacheConfig.SizeOrDefault()
 	conversionLRU, err := storage.NewQueryConversionLRU(conversionCacheSize)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	localStorage, err := m3.NewStorage(
@@ -629,16 +733,17 @@ func newStorages(
 		storage.NewQueryConversionCache(conversionLRU),
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	stores := []storage.Storage{localStorage}
 	remoteEnabled := false
 	if cfg.RPC != nil && cfg.RPC.Enabled {
 		logger.Info("rpc enabled")
-		server, err := startGrpcServer(logger, localStorage, poolWrapper, cfg.RPC)
+		server, err := startGrpcServer(logger, localStorage, poolWrapper,
+			tagOptions, cfg.RPC)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		cleanup = func() error {
@@ -653,7 +758,7 @@ func newStorages(
 			readWorkerPool,
 		)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		if enabled {
@@ -662,6 +767,9 @@ func newStorages(
 		}
 	}
 
+	// Reads can only be served directly from the local storage's compressed
+	// results when it is the only store that reads fan out to.
+	localReadsOnly := !remoteEnabled
 	readFilter := filter.LocalOnly
 	writeFilter := filter.LocalOnly
 	completeTagsFilter := filter.CompleteTagsLocalOnly
@@ -675,12 +783,15 @@ func newStorages(
 	switch cfg.Filter.Read {
 	case config.FilterLocalOnly:
 		readFilter = filter.LocalOnly
+		localReadsOnly = true
 	case config.FilterRemoteOnly:
 		readFilter = filter.RemoteOnly
+		localReadsOnly = false
 	case config.FilterAllowAll:
 		readFilter = filter.AllowAll
 	case config.FilterAllowNone:
 		readFilter = filter.AllowNone
+		localReadsOnly = false
 	}
 
 	switch cfg.Filter.Write {
@@ -706,7 +817,12 @@ func newStorages(
 	}
 
 	fanoutStorage := fanout.NewStorage(stores, readFilter, writeFilter, completeTagsFilter)
-	return fanoutStorage, cleanup, nil
+	var localQuerier m3.Querier
+	if localReadsOnly {
+		localQuerier = localStorage
+	}
+
+	return fanoutStorage, localQuerier, cleanup, nil
 }
 
 func remoteClient(
@@ -742,10 +858,11 @@ func startGrpcServer(
 	logger *zap.Logger,
 	storage m3.Storage,
 	poolWrapper *pools.PoolWrapper,
+	tagOptions models.TagOptions,
 	cfg *config.RPCConfiguration,
 ) (*grpc.Server, error) {
 	logger.Info("creating gRPC server")
-	server := tsdbRemote.CreateNewGrpcServer(storage, poolWrapper)
+	server := tsdbRemote.CreateNewGrpcServer(storage, poolWrapper, tagOptions)
 	waitForStart := make(chan struct{})
 	var startErr error
 	go func() {
@@ -881,6 +998,28 @@ func startCarbonIngestion(
 			zap.String("listenAddress", carbonListenAddress), zap.Error(err))
 	}
 	logger.Info("started carbon ingestion server", zap.String("listenAddress", carbonListenAddress))
+
+	if pickleListenAddress := strings.TrimSpace(ingesterCfg.PickleListenAddress); pickleListenAddress != "" {
+		pickleServer := xserver.NewServer(pickleListenAddress, ingester.PickleHandler(), serverOpts)
+		logger.Info("starting carbon pickle ingestion server", zap.String("listenAddress", pickleListenAddress))
+		err = pickleServer.ListenAndServe()
+		if err != nil {
+			logger.Fatal("unable to start carbon pickle ingestion server at listen address",
+				zap.String("listenAddress", pickleListenAddress), zap.Error(err))
+		}
+		logger.Info("started carbon pickle ingestion server", zap.String("listenAddress", pickleListenAddress))
+	}
+
+	if udpListenAddress := strings.TrimSpace(ingesterCfg.UDPListenAddress); udpListenAddress != "" {
+		logger.Info("starting carbon udp ingestion server", zap.String("listenAddress", udpListenAddress))
+		udpConn, err := net.ListenPacket("udp", udpListenAddress)
+		if err != nil {
+			logger.Fatal("unable to start carbon udp ingestion server at listen address",
+				zap.String("listenAddress", udpListenAddress), zap.Error(err))
+		}
+		go ingester.HandlePackets(udpConn)
+		logger.Info("started carbon udp ingestion server", zap.String("listenAddress", udpListenAddress))
+	}
 }
 
 func newDownsamplerAndWriter(storage storage.Storage, downsampler downsample.Downsampler) (ingest.DownsamplerAndWriter, error) {
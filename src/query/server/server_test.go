@@ -230,6 +230,14 @@ func (s *queryServer) CompleteTags(
 	return nil
 }
 
+func (s *queryServer) Write(rpc.Query_WriteServer) error {
+	return nil
+}
+
+func (s *queryServer) WriteBatch(rpc.Query_WriteBatchServer) error {
+	return nil
+}
+
 func TestGRPCBackend(t *testing.T) {
 	var grpcConfigYAML = `
 listenAddress:
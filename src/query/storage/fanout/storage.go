@@ -144,15 +144,38 @@ func (s *fanoutStorage) CompleteTags(
 	query *storage.CompleteTagsQuery,
 	options *storage.FetchOptions,
 ) (*storage.CompleteTagsResult, error) {
-	accumulatedTags := storage.NewCompleteTagsResultBuilder(query.CompleteNameOnly)
 	stores := filterCompleteTagsStores(s.stores, s.completeTagsFilter, *query)
-	for _, store := range stores {
-		result, err := store.CompleteTags(ctx, query, options)
-		if err != nil {
-			return nil, err
+	requests := make([]execution.Request, len(stores))
+	for idx, store := range stores {
+		requests[idx] = newCompleteTagsRequest(store, query, options)
+	}
+
+	err := execution.ExecuteParallel(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	return handleCompleteTagsResponses(query, requests)
+}
+
+func handleCompleteTagsResponses(
+	query *storage.CompleteTagsQuery,
+	requests []execution.Request,
+) (*storage.CompleteTagsResult, error) {
+	accumulatedTags := storage.NewCompleteTagsResultBuilder(query.CompleteNameOnly)
+	for _, req := range requests {
+		completeTagsReq, ok := req.(*completeTagsRequest)
+		if !ok {
+			return nil, errors.ErrFetchRequestType
+		}
+
+		if completeTagsReq.result == nil {
+			return nil, errors.ErrInvalidFetchResult
 		}
 
-		accumulatedTags.Add(result)
+		if err := accumulatedTags.Add(completeTagsReq.result); err != nil {
+			return nil, err
+		}
 	}
 
 	built := accumulatedTags.Build()
@@ -252,6 +275,35 @@ func (f *fetchRequest) Process(ctx context.Context) error {
 	return nil
 }
 
+type completeTagsRequest struct {
+	store   storage.Storage
+	query   *storage.CompleteTagsQuery
+	options *storage.FetchOptions
+	result  *storage.CompleteTagsResult
+}
+
+func newCompleteTagsRequest(
+	store storage.Storage,
+	query *storage.CompleteTagsQuery,
+	options *storage.FetchOptions,
+) execution.Request {
+	return &completeTagsRequest{
+		store:   store,
+		query:   query,
+		options: options,
+	}
+}
+
+func (f *completeTagsRequest) Process(ctx context.Context) error {
+	result, err := f.store.CompleteTags(ctx, f.query, f.options)
+	if err != nil {
+		return err
+	}
+
+	f.result = result
+	return nil
+}
+
 type writeRequest struct {
 	store storage.Storage
 	query *storage.WriteQuery
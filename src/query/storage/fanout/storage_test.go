@@ -32,6 +32,7 @@ import (
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/policy/filter"
 	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/mock"
 	"github.com/m3db/m3/src/query/test/m3"
 	"github.com/m3db/m3/src/query/test/seriesiter"
 	"github.com/m3db/m3/src/query/ts"
@@ -114,7 +115,7 @@ func setupFanoutWrite(t *testing.T, output bool, errs ...error) storage.Storage
 		Return(errs[0])
 	session1.EXPECT().IteratorPools().
 		Return(nil, nil).AnyTimes()
-	session1.EXPECT().FetchTaggedIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+	session1.EXPECT().Aggregate(gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, true, errs[0]).AnyTimes()
 
 	session2.EXPECT().
@@ -122,6 +123,8 @@ func setupFanoutWrite(t *testing.T, output bool, errs ...error) storage.Storage
 		Return(errs[len(errs)-1])
 	session2.EXPECT().IteratorPools().
 		Return(nil, nil).AnyTimes()
+	session2.EXPECT().Aggregate(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, true, errs[len(errs)-1]).AnyTimes()
 
 	stores := []storage.Storage{
 		store1, store2,
@@ -216,3 +219,60 @@ func TestCompleteTagsFailure(t *testing.T) {
 	)
 	assert.Error(t, err)
 }
+
+func TestCompleteTagsMergesRemoteResults(t *testing.T) {
+	setup()
+	local := mock.NewMockStorage()
+	local.SetTypeResult(storage.TypeLocalDC)
+	local.SetCompleteTagsResult(&storage.CompleteTagsResult{
+		CompletedTags: []storage.CompletedTag{
+			{Name: []byte("a"), Values: [][]byte{[]byte("1"), []byte("2")}},
+			{Name: []byte("c"), Values: [][]byte{[]byte("3")}},
+		},
+	}, nil)
+
+	remote := mock.NewMockStorage()
+	remote.SetTypeResult(storage.TypeRemoteDC)
+	remote.SetCompleteTagsResult(&storage.CompleteTagsResult{
+		CompletedTags: []storage.CompletedTag{
+			{Name: []byte("b"), Values: [][]byte{[]byte("4")}},
+			{Name: []byte("a"), Values: [][]byte{[]byte("2"), []byte("5")}},
+		},
+	}, nil)
+
+	stores := []storage.Storage{local, remote}
+	store := NewStorage(stores, filterFunc(true), filterFunc(true),
+		filterCompleteTagsFunc(true))
+	result, err := store.CompleteTags(
+		context.TODO(),
+		&storage.CompleteTagsQuery{TagMatchers: models.Matchers{}},
+		storage.NewFetchOptions(),
+	)
+	require.NoError(t, err)
+
+	expected := []storage.CompletedTag{
+		{Name: []byte("a"), Values: [][]byte{[]byte("1"), []byte("2"), []byte("5")}},
+		{Name: []byte("b"), Values: [][]byte{[]byte("4")}},
+		{Name: []byte("c"), Values: [][]byte{[]byte("3")}},
+	}
+
+	assert.False(t, result.CompleteNameOnly)
+	assert.Equal(t, expected, result.CompletedTags)
+}
+
+func TestCompleteTagsMismatchedResultType(t *testing.T) {
+	setup()
+	remote := mock.NewMockStorage()
+	remote.SetCompleteTagsResult(&storage.CompleteTagsResult{
+		CompleteNameOnly: false,
+	}, nil)
+
+	store := NewStorage([]storage.Storage{remote}, filterFunc(true),
+		filterFunc(true), filterCompleteTagsFunc(true))
+	_, err := store.CompleteTags(
+		context.TODO(),
+		&storage.CompleteTagsQuery{CompleteNameOnly: true},
+		storage.NewFetchOptions(),
+	)
+	assert.Error(t, err)
+}
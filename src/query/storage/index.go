@@ -22,6 +22,7 @@ package storage
 
 import (
 	"fmt"
+	"regexp/syntax"
 	"sync"
 
 	"github.com/m3db/m3/src/dbnode/storage/index"
@@ -150,7 +151,7 @@ func FetchQueryToM3Query(fetchQuery *FetchQuery, cache *QueryConversionCache) (i
 
 	// Optimization for single matcher case.
 	if len(matchers) == 1 {
-		q, err := matcherToQuery(matchers[0])
+		q, _, err := matcherToQuery(matchers[0])
 		if err != nil {
 			return index.Query{}, err
 		}
@@ -159,13 +160,21 @@ func FetchQueryToM3Query(fetchQuery *FetchQuery, cache *QueryConversionCache) (i
 		return index.Query{Query: q}, nil
 	}
 
-	idxQueries := make([]idx.Query, len(matchers))
-	var err error
-	for i, matcher := range matchers {
-		idxQueries[i], err = matcherToQuery(matcher)
+	idxQueries := make([]idx.Query, 0, len(matchers)+1)
+	anyPositive := false
+	for _, matcher := range matchers {
+		q, negated, err := matcherToQuery(matcher)
 		if err != nil {
 			return index.Query{}, err
 		}
+		anyPositive = anyPositive || !negated
+		idxQueries = append(idxQueries, q)
+	}
+
+	if !anyPositive && len(idxQueries) > 0 {
+		// NB: a conjunction of negations needs a positive query to subtract the
+		// negations from, so match against all documents.
+		idxQueries = append([]idx.Query{idx.NewAllQuery()}, idxQueries...)
 	}
 
 	q := idx.NewConjunctionQuery(idxQueries...)
@@ -173,7 +182,9 @@ func FetchQueryToM3Query(fetchQuery *FetchQuery, cache *QueryConversionCache) (i
 	return index.Query{Query: q}, nil
 }
 
-func matcherToQuery(matcher models.Matcher) (idx.Query, error) {
+// matcherToQuery converts a matcher to an index query, returning
+// whether the resulting query is a negation.
+func matcherToQuery(matcher models.Matcher) (idx.Query, bool, error) {
 	negate := false
 	switch matcher.Type {
 	// Support for Regexp types
@@ -181,27 +192,92 @@ func matcherToQuery(matcher models.Matcher) (idx.Query, error) {
 		negate = true
 		fallthrough
 	case models.MatchRegexp:
-		query, err := idx.NewRegexpQuery(matcher.Name, matcher.Value)
-		if err != nil {
-			return idx.Query{}, err
+		var query idx.Query
+		if prefix, ok := regexpLiteralPrefix(matcher.Value); ok {
+			// NB: regexps only match documents which contain the field, so a
+			// regexp of the form "prefix.*" is equivalent to a prefix query, or
+			// a field query if the prefix is empty.
+			if len(prefix) == 0 {
+				query = idx.NewFieldQuery(matcher.Name)
+			} else {
+				query = idx.NewPrefixQuery(matcher.Name, prefix)
+			}
+		} else {
+			var err error
+			query, err = idx.NewRegexpQuery(matcher.Name, matcher.Value)
+			if err != nil {
+				return idx.Query{}, false, err
+			}
 		}
 		if negate {
 			query = idx.NewNegationQuery(query)
 		}
-		return query, nil
+		return query, negate, nil
 
 		// Support exact matches
 	case models.MatchNotEqual:
 		negate = true
 		fallthrough
 	case models.MatchEqual:
+		if len(matcher.Value) == 0 {
+			// An empty value matches documents which do not contain the field,
+			// i.e. {foo=""} is the negation of the field existing and {foo!=""}
+			// is the field existing.
+			query := idx.NewFieldQuery(matcher.Name)
+			if !negate {
+				return idx.NewNegationQuery(query), true, nil
+			}
+			return query, false, nil
+		}
+
 		query := idx.NewTermQuery(matcher.Name, matcher.Value)
 		if negate {
 			query = idx.NewNegationQuery(query)
 		}
-		return query, nil
+		return query, negate, nil
 
 	default:
-		return idx.Query{}, fmt.Errorf("unsupported query type: %v", matcher)
+		return idx.Query{}, false, fmt.Errorf("unsupported query type: %v", matcher)
+	}
+}
+
+// regexpLiteralPrefix returns the literal prefix of a regexp of the form
+// "prefix.*", and whether the regexp is of that form.
+func regexpLiteralPrefix(value []byte) ([]byte, bool) {
+	re, err := syntax.Parse(string(value), syntax.Perl)
+	if err != nil {
+		return nil, false
+	}
+
+	if isMatchAnyStar(re) {
+		return nil, true
 	}
+
+	if re.Op != syntax.OpConcat || len(re.Sub) != 2 {
+		return nil, false
+	}
+
+	literal, star := re.Sub[0], re.Sub[1]
+	if literal.Op != syntax.OpLiteral || literal.Flags&syntax.FoldCase != 0 {
+		return nil, false
+	}
+
+	if !isMatchAnyStar(star) {
+		return nil, false
+	}
+
+	return []byte(string(literal.Rune)), true
+}
+
+// isMatchAnyStar returns whether the regexp is ".*".
+func isMatchAnyStar(re *syntax.Regexp) bool {
+	if re.Op != syntax.OpStar || len(re.Sub) != 1 {
+		return false
+	}
+
+	// NB: "." does not match a newline without the "s" flag, tag values
+	// containing newlines are not expected so this is treated as matching any
+	// value.
+	op := re.Sub[0].Op
+	return op == syntax.OpAnyCharNotNL || op == syntax.OpAnyChar
 }
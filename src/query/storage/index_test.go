@@ -130,6 +130,120 @@ func TestFetchQueryToM3Query(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "exact match empty",
+			expected: "negation(field(t1))",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchEqual,
+					Name:  []byte("t1"),
+					Value: []byte(""),
+				},
+			},
+		},
+		{
+			name:     "exact match empty negated",
+			expected: "field(t1)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchNotEqual,
+					Name:  []byte("t1"),
+					Value: []byte(""),
+				},
+			},
+		},
+		{
+			name:     "regexp match all",
+			expected: "field(t1)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchRegexp,
+					Name:  []byte("t1"),
+					Value: []byte(".*"),
+				},
+			},
+		},
+		{
+			name:     "regexp match all negated",
+			expected: "negation(field(t1))",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchNotRegexp,
+					Name:  []byte("t1"),
+					Value: []byte(".*"),
+				},
+			},
+		},
+		{
+			name:     "regexp match prefix",
+			expected: "prefix(t1, v)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchRegexp,
+					Name:  []byte("t1"),
+					Value: []byte("v.*"),
+				},
+			},
+		},
+		{
+			name:     "regexp match prefix negated",
+			expected: "negation(prefix(t1, v))",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchNotRegexp,
+					Name:  []byte("t1"),
+					Value: []byte("v.*"),
+				},
+			},
+		},
+		{
+			name:     "regexp match case insensitive prefix",
+			expected: "regexp(t1, (?i)v.*)",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchRegexp,
+					Name:  []byte("t1"),
+					Value: []byte("(?i)v.*"),
+				},
+			},
+		},
+		{
+			name:     "conjunction",
+			expected: "conjunction(term(t1, v1),negation(regexp(t2, v2)))",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchEqual,
+					Name:  []byte("t1"),
+					Value: []byte("v1"),
+				},
+				{
+					Type:  models.MatchNotRegexp,
+					Name:  []byte("t2"),
+					Value: []byte("v2"),
+				},
+			},
+		},
+		{
+			name:     "conjunction of negations",
+			expected: "conjunction(all(),negation(term(t1, v1)), negation(regexp(t2, v2)))",
+			matchers: models.Matchers{
+				{
+					Type:  models.MatchNotEqual,
+					Name:  []byte("t1"),
+					Value: []byte("v1"),
+				},
+				{
+					Type:  models.MatchNotRegexp,
+					Name:  []byte("t2"),
+					Value: []byte("v2"),
+				},
+			},
+		},
+		{
+			name:     "no matchers",
+			expected: "conjunction()",
+			matchers: models.Matchers{},
+		},
 	}
 
 	lru, err := NewQueryConversionLRU(10)
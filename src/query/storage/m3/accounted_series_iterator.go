@@ -0,0 +1,127 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/cost"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	xcost "github.com/m3db/m3/src/x/cost"
+)
+
+const (
+	// accountedDatapointsBatchSize is the number of decoded datapoints which
+	// are accumulated before being charged to the enforcer, to avoid
+	// contending on the global totals for every datapoint.
+	accountedDatapointsBatchSize = 1024
+)
+
+func fetchEnforcer(options *storage.FetchOptions) cost.Enforcer {
+	if options == nil || options.Enforcer == nil {
+		return cost.NoopEnforcer()
+	}
+
+	return options.Enforcer
+}
+
+// chargeBlockBytes charges the size of the values materialized for a
+// number of series across the given bounds.
+func chargeBlockBytes(
+	enforcer cost.Enforcer,
+	numSeries int,
+	bounds models.Bounds,
+) error {
+	bytes := numSeries * bounds.Steps() * cost.BytesPerValue
+	return enforcer.Add(cost.BlockBytes, xcost.Cost(bytes))
+}
+
+// accountedSeriesIterator charges the datapoints decoded by a series iterator
+// to an enforcer, stopping iteration once a limit has been exceeded.
+type accountedSeriesIterator struct {
+	encoding.SeriesIterator
+
+	enforcer cost.Enforcer
+	pending  int
+	err      error
+}
+
+func newAccountedSeriesIterators(
+	iters encoding.SeriesIterators,
+	enforcer cost.Enforcer,
+) encoding.SeriesIterators {
+	// Wrap the iterators in place where possible so that closing the result
+	// returns the pooled container to its pool alongside the iterators.
+	if mutable, ok := iters.(encoding.MutableSeriesIterators); ok {
+		for i, iter := range mutable.Iters() {
+			mutable.SetAt(i, &accountedSeriesIterator{
+				SeriesIterator: iter,
+				enforcer:       enforcer,
+			})
+		}
+		return mutable
+	}
+
+	accounted := make([]encoding.SeriesIterator, 0, iters.Len())
+	for _, iter := range iters.Iters() {
+		accounted = append(accounted, &accountedSeriesIterator{
+			SeriesIterator: iter,
+			enforcer:       enforcer,
+		})
+	}
+
+	return encoding.NewSeriesIterators(accounted, nil)
+}
+
+func (it *accountedSeriesIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if !it.SeriesIterator.Next() {
+		it.charge()
+		return false
+	}
+
+	it.pending++
+	if it.pending >= accountedDatapointsBatchSize {
+		it.charge()
+	}
+
+	return it.err == nil
+}
+
+func (it *accountedSeriesIterator) charge() {
+	if it.pending == 0 {
+		return
+	}
+
+	it.err = it.enforcer.Add(cost.DecodedDatapoints, xcost.Cost(it.pending))
+	it.pending = 0
+}
+
+func (it *accountedSeriesIterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+
+	return it.SeriesIterator.Err()
+}
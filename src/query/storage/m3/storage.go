@@ -21,7 +21,6 @@
 package m3
 
 import (
-	"bytes"
 	"context"
 	goerrors "errors"
 	"fmt"
@@ -29,13 +28,16 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/errors"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/ts/m3db"
 	"github.com/m3db/m3/src/query/ts/m3db/consolidators"
+	xcost "github.com/m3db/m3/src/x/cost"
 	"github.com/m3db/m3x/ident"
 	xsync "github.com/m3db/m3x/sync"
 )
@@ -141,6 +143,12 @@ func (s *m3storage) FetchBlocks(
 			return block.Result{}, err
 		}
 
+		err = chargeBlockBytes(fetchEnforcer(options),
+			len(fetchResult.SeriesList), blockBounds(query))
+		if err != nil {
+			return block.Result{}, err
+		}
+
 		return storage.FetchResultToBlockResult(fetchResult, query, s.opts.LookbackDuration())
 	}
 
@@ -150,15 +158,16 @@ func (s *m3storage) FetchBlocks(
 			SetSplitSeriesByBlock(true)
 	}
 
-	raw, _, err := s.FetchCompressed(ctx, query, options)
+	raw, cleanup, err := s.FetchCompressed(ctx, query, options)
 	if err != nil {
 		return block.Result{}, err
 	}
 
-	bounds := models.Bounds{
-		Start:    query.Start,
-		Duration: query.End.Sub(query.Start),
-		StepSize: query.Interval,
+	bounds := blockBounds(query)
+	err = chargeBlockBytes(fetchEnforcer(options), raw.Len(), bounds)
+	if err != nil {
+		cleanup()
+		return block.Result{}, err
 	}
 
 	blocks, err := m3db.ConvertM3DBSeriesIterators(raw, bounds, opts)
@@ -237,7 +246,10 @@ func (s *m3storage) fetchCompressed(
 		return nil, fmt.Errorf("unable to retrieve iterator pools: %v", err)
 	}
 
-	result := newMultiFetchResult(fanout, pools)
+	var (
+		enforcer = fetchEnforcer(options)
+		result   = newMultiFetchResult(fanout, pools)
+	)
 	for _, namespace := range namespaces {
 		namespace := namespace // Capture var)
 
@@ -246,6 +258,10 @@ func (s *m3storage) fetchCompressed(
 			session := namespace.Session()
 			ns := namespace.NamespaceID()
 			iters, _, err := session.FetchTagged(ns, m3query, opts)
+			if err == nil {
+				iters, err = accountIterators(iters, enforcer)
+			}
+
 			// Ignore error from getting iterator pools, since operation
 			// will not be dramatically impacted if pools is nil
 			result.Add(namespace.Options().Attributes(), iters, err)
@@ -265,6 +281,29 @@ func (s *m3storage) fetchCompressed(
 	return result, err
 }
 
+// accountIterators charges the fetched series to the enforcer and wraps the
+// iterators so that every decoded datapoint is charged as well.
+func accountIterators(
+	iters encoding.SeriesIterators,
+	enforcer cost.Enforcer,
+) (encoding.SeriesIterators, error) {
+	err := enforcer.Add(cost.FetchedSeries, xcost.Cost(iters.Len()))
+	if err != nil {
+		iters.Close()
+		return nil, err
+	}
+
+	return newAccountedSeriesIterators(iters, enforcer), nil
+}
+
+func blockBounds(query *storage.FetchQuery) models.Bounds {
+	return models.Bounds{
+		Start:    query.Start,
+		Duration: query.End.Sub(query.Start),
+		StepSize: query.Interval,
+	}
+}
+
 func (s *m3storage) FetchTags(
 	ctx context.Context,
 	query *storage.FetchQuery,
@@ -303,58 +342,98 @@ func (s *m3storage) CompleteTags(
 	default:
 	}
 
-	// TODO: instead of aggregating locally, have the DB aggregate it before
-	// sending results back.
-	fetchQuery := &storage.FetchQuery{
+	m3query, err := storage.FetchQueryToM3Query(&storage.FetchQuery{
 		TagMatchers: query.TagMatchers,
-		// NB: complete tags matches every tag from the start of time until now
-		Start: time.Time{},
-		End:   time.Now(),
-	}
-
-	results, cleanup, err := s.SearchCompressed(ctx, fetchQuery, options)
-	defer cleanup()
+	}, s.conversionCache)
 	if err != nil {
 		return nil, err
 	}
 
-	accumulatedTags := storage.NewCompleteTagsResultBuilder(query.CompleteNameOnly)
-	// only filter if there are tags to filter on.
-	filtering := len(query.FilterNameTags) > 0
-	for _, elem := range results {
-		it := elem.Iter
-		tags := make([]storage.CompletedTag, 0, it.Len())
-		for i := 0; it.Next(); i++ {
-			tag := it.Current()
-			name := tag.Name.Bytes()
-			if filtering {
-				found := false
-				for _, filterName := range query.FilterNameTags {
-					if bytes.Equal(filterName, name) {
-						found = true
-						break
-					}
+	aggType := index.AggregateTagNamesAndValues
+	if query.CompleteNameOnly {
+		aggType = index.AggregateTagNames
+	}
+
+	// NB: complete tags matches every tag from the start of time until now
+	// unless a time range is specified.
+	end := query.End
+	if end.IsZero() {
+		end = time.Now()
+	}
+
+	var (
+		namespaces = s.clusters.ClusterNamespaces()
+		aggOpts    = index.AggregationOptions{
+			QueryOptions: index.QueryOptions{
+				Limit:          options.Limit,
+				StartInclusive: query.Start,
+				EndExclusive:   end,
+			},
+			FieldFilter: query.FilterNameTags,
+			Type:        aggType,
+		}
+
+		accumulatedTags = storage.NewCompleteTagsResultBuilder(query.CompleteNameOnly)
+		multiErr        syncMultiErrs
+		wg              sync.WaitGroup
+		mu              sync.Mutex
+	)
+
+	if len(namespaces) == 0 {
+		return nil, errNoNamespacesConfigured
+	}
+
+	wg.Add(len(namespaces))
+	for _, namespace := range namespaces {
+		namespace := namespace // Capture var
+		go func() {
+			defer wg.Done()
+			session := namespace.Session()
+			namespaceID := namespace.NamespaceID()
+			iter, _, err := session.Aggregate(namespaceID, m3query, aggOpts)
+			if err != nil {
+				multiErr.add(err)
+				return
+			}
+
+			defer iter.Finalize()
+			completedTags := make([]storage.CompletedTag, 0, iter.Remaining())
+			for iter.Next() {
+				tagName, tagValues := iter.Current()
+				values := make([][]byte, 0, tagValues.Remaining())
+				for tagValues.Next() {
+					values = append(values, tagValues.Current().Bytes())
 				}
 
-				if !found {
-					continue
+				if err := tagValues.Err(); err != nil {
+					multiErr.add(err)
+					return
 				}
+
+				completedTags = append(completedTags, storage.CompletedTag{
+					Name:   tagName.Bytes(),
+					Values: values,
+				})
 			}
 
-			tags = append(tags, storage.CompletedTag{
-				Name:   name,
-				Values: [][]byte{tag.Value.Bytes()},
-			})
-		}
+			if err := iter.Err(); err != nil {
+				multiErr.add(err)
+				return
+			}
 
-		if err := elem.Iter.Err(); err != nil {
-			return nil, err
-		}
+			mu.Lock()
+			err = accumulatedTags.Add(&storage.CompleteTagsResult{
+				CompleteNameOnly: query.CompleteNameOnly,
+				CompletedTags:    completedTags,
+			})
+			mu.Unlock()
+			multiErr.add(err)
+		}()
+	}
 
-		accumulatedTags.Add(&storage.CompleteTagsResult{
-			CompleteNameOnly: query.CompleteNameOnly,
-			CompletedTags:    tags,
-		})
+	wg.Wait()
+	if err := multiErr.lastError(); err != nil {
+		return nil, err
 	}
 
 	built := accumulatedTags.Build()
@@ -30,6 +30,8 @@ import (
 
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/test/seriesiter"
@@ -293,6 +295,70 @@ func TestLocalReadExceedsRetention(t *testing.T) {
 	assertFetchResult(t, results, testTag)
 }
 
+func buildEnforcedFetchOpts(thresholds cost.Thresholds) *storage.FetchOptions {
+	opts := buildFetchOpts()
+	opts.Enforcer = cost.NewGlobalEnforcer(
+		nil,
+		cost.NewStaticLimitManagers(thresholds, nil),
+		nil,
+	).QueryEnforcer()
+	return opts
+}
+
+func TestLocalReadExceedsFetchedSeriesLimit(t *testing.T) {
+	ctrl := gomock.NewController(xtest.Reporter{T: t})
+	defer ctrl.Finish()
+	store, sessions := setup(t, ctrl)
+	testTags := seriesiter.GenerateTag()
+
+	session := sessions.unaggregated1MonthRetention
+	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(seriesiter.NewMockSeriesIters(ctrl, testTags, 2, 2), true, nil)
+	session.EXPECT().IteratorPools().
+		Return(newTestIteratorPools(ctrl), nil).AnyTimes()
+
+	opts := buildEnforcedFetchOpts(cost.Thresholds{cost.FetchedSeries: 2})
+	_, err := store.Fetch(context.TODO(), newFetchReq(), opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "fetched-series limit")
+}
+
+func TestLocalReadExceedsDecodedDatapointsLimit(t *testing.T) {
+	ctrl := gomock.NewController(xtest.Reporter{T: t})
+	defer ctrl.Finish()
+	store, sessions := setup(t, ctrl)
+	testTags := seriesiter.GenerateTag()
+
+	session := sessions.unaggregated1MonthRetention
+	session.EXPECT().FetchTagged(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(seriesiter.NewMockSeriesIters(ctrl, testTags, 1, 3), true, nil)
+	session.EXPECT().IteratorPools().
+		Return(newTestIteratorPools(ctrl), nil).AnyTimes()
+
+	opts := buildEnforcedFetchOpts(cost.Thresholds{cost.DecodedDatapoints: 3})
+	_, err := store.Fetch(context.TODO(), newFetchReq(), opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "decoded-datapoints limit")
+}
+
+func TestAccountedSeriesIteratorsWrappedInPlace(t *testing.T) {
+	ctrl := gomock.NewController(xtest.Reporter{T: t})
+	defer ctrl.Finish()
+
+	iter := encoding.NewMockSeriesIterator(ctrl)
+	iters := encoding.NewSeriesIterators([]encoding.SeriesIterator{iter}, nil)
+
+	accounted := newAccountedSeriesIterators(iters, cost.NoopEnforcer())
+	require.True(t, accounted == iters)
+	require.Equal(t, 1, accounted.Len())
+	_, ok := accounted.Iters()[0].(*accountedSeriesIterator)
+	require.True(t, ok)
+
+	// Closing the original container closes the wrapped iterators.
+	iter.EXPECT().Close()
+	iters.Close()
+}
+
 func buildFetchOpts() *storage.FetchOptions {
 	opts := storage.NewFetchOptions()
 	opts.Limit = 100
@@ -600,36 +666,26 @@ func TestLocalCompleteTagsSuccess(t *testing.T) {
 	store, sessions := setup(t, ctrl)
 
 	type testFetchTaggedID struct {
-		id        string
-		namespace string
-		tagName   string
-		tagValue  string
+		tagName  string
+		tagValue string
 	}
 
 	fetches := []testFetchTaggedID{
 		{
-			id:        "foo",
-			namespace: "metrics_unaggregated",
-			tagName:   "qux",
-			tagValue:  "qaz",
+			tagName:  "qux",
+			tagValue: "qaz",
 		},
 		{
-			id:        "bar",
-			namespace: "metrics_aggregated_1m:30d",
-			tagName:   "qel",
-			tagValue:  "quz",
+			tagName:  "qux",
+			tagValue: "qaz2",
 		},
 		{
-			id:        "baz",
-			namespace: "metrics_aggregated_5m:90d",
-			tagName:   "qam",
-			tagValue:  "qak",
+			tagName:  "qux",
+			tagValue: "qaz",
 		},
 		{
-			id:        "qux",
-			namespace: "metrics_aggregated_10m:365d",
-			tagName:   "qux",
-			tagValue:  "qaz2",
+			tagName:  "qux",
+			tagValue: "qaz2",
 		},
 	}
 
@@ -646,40 +702,40 @@ func TestLocalCompleteTagsSuccess(t *testing.T) {
 			f = fetches[3]
 		default:
 			// Not expecting from other (partial) namespaces
-			iter := client.NewMockTaggedIDsIterator(ctrl)
+			iter := client.NewMockAggregatedTagsIterator(ctrl)
 			gomock.InOrder(
+				iter.EXPECT().Remaining().Return(0),
 				iter.EXPECT().Next().Return(false),
 				iter.EXPECT().Err().Return(nil),
 				iter.EXPECT().Finalize(),
 			)
-			session.EXPECT().FetchTaggedIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+			session.EXPECT().Aggregate(gomock.Any(), gomock.Any(), gomock.Any()).
 				Return(iter, true, nil)
-			session.EXPECT().IteratorPools().
-				Return(nil, nil).AnyTimes()
 			return
 		}
-		iter := client.NewMockTaggedIDsIterator(ctrl)
+		iter := client.NewMockAggregatedTagsIterator(ctrl)
 		gomock.InOrder(
+			iter.EXPECT().Remaining().Return(1),
 			iter.EXPECT().Next().Return(true),
 			iter.EXPECT().Current().Return(
-				ident.StringID(f.namespace),
-				ident.StringID(f.id),
-				ident.NewTagsIterator(ident.NewTags(
-					ident.Tag{
-						Name:  ident.StringID(f.tagName),
-						Value: ident.StringID(f.tagValue),
-					})),
+				ident.StringID(f.tagName),
+				ident.NewIDsIterator(ident.StringID(f.tagValue)),
 			),
 			iter.EXPECT().Next().Return(false),
 			iter.EXPECT().Err().Return(nil),
 			iter.EXPECT().Finalize(),
 		)
 
-		session.EXPECT().FetchTaggedIDs(gomock.Any(), gomock.Any(), gomock.Any()).
-			Return(iter, true, nil)
-
-		session.EXPECT().IteratorPools().
-			Return(nil, nil).AnyTimes()
+		session.EXPECT().Aggregate(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ ident.ID,
+				_ index.Query,
+				opts index.AggregationOptions,
+			) (client.AggregatedTagsIterator, bool, error) {
+				assert.Equal(t, index.AggregateTagNamesAndValues, opts.Type)
+				assert.Equal(t, [][]byte{[]byte("qux")}, opts.FieldFilter)
+				return iter, true, nil
+			})
 	})
 
 	req := newCompleteTagsReq()
@@ -24,7 +24,6 @@ import (
 	"context"
 
 	"github.com/m3db/m3/src/query/block"
-	"github.com/m3db/m3/src/query/errors"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/tsdb/remote"
 )
@@ -71,7 +70,7 @@ func (s *remoteStorage) CompleteTags(
 }
 
 func (s *remoteStorage) Write(ctx context.Context, query *storage.WriteQuery) error {
-	return errors.ErrRemoteWriteQuery
+	return s.client.Write(ctx, query)
 }
 
 func (s *remoteStorage) Type() storage.Type {
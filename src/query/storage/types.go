@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/cost"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/ts"
 	xtime "github.com/m3db/m3x/time"
@@ -86,6 +87,8 @@ type FetchOptions struct {
 	BlockType models.FetchedBlockType
 	// FanoutOptions are the options for the fetch namespace fanout.
 	FanoutOptions *FanoutOptions
+	// Enforcer enforces the resource limits of the query.
+	Enforcer cost.Enforcer
 }
 
 // FanoutOptions describes which namespaces should be fanned out to for
@@ -124,6 +127,7 @@ func NewFetchOptions() *FetchOptions {
 			FanoutAggregated:          FanoutDefault,
 			FanoutAggregatedOptimized: FanoutDefault,
 		},
+		Enforcer: cost.NoopEnforcer(),
 	}
 }
 
@@ -177,6 +181,10 @@ type CompleteTagsQuery struct {
 	CompleteNameOnly bool
 	FilterNameTags   [][]byte
 	TagMatchers      models.Matchers
+	// Start and End optionally restrict the time range the tags are completed
+	// over, if unset tags are completed from the start of time until now.
+	Start time.Time
+	End   time.Time
 }
 
 // SeriesMatchQuery represents a query that returns a set of series
@@ -42,6 +42,9 @@ import (
 // Client is the grpc client
 type Client interface {
 	storage.Querier
+	// Write writes a single query to the remote storage, concurrent writes
+	// are batched and sent to the remote storage over a single stream
+	Write(ctx context.Context, query *storage.WriteQuery) error
 	Close() error
 }
 
@@ -55,9 +58,24 @@ type grpcClient struct {
 	pools            encoding.IteratorPools
 	poolErr          error
 	lookbackDuration time.Duration
+	writeOnce        sync.Once
+	writeCh          chan pendingWrite
+	closeOnce        sync.Once
+	closeCh          chan struct{}
 }
 
-const initResultSize = 10
+type pendingWrite struct {
+	request *rpc.WriteRequest
+	done    chan error
+}
+
+const (
+	initResultSize = 10
+
+	// maxWriteBatchSize is the maximum number of writes sent in a single
+	// write batch stream
+	maxWriteBatchSize = 1024
+)
 
 // NewGRPCClient creates grpc client
 func NewGRPCClient(
@@ -89,6 +107,8 @@ func NewGRPCClient(
 		poolWrapper:      poolWrapper,
 		readWorkerPool:   readWorkerPool,
 		lookbackDuration: lookbackDuration,
+		writeCh:          make(chan pendingWrite),
+		closeCh:          make(chan struct{}),
 	}, nil
 }
 
@@ -279,7 +299,7 @@ func (c *grpcClient) CompleteTags(
 	}
 
 	defer completeTagsClient.CloseSend()
-	var accumulatedTags storage.CompleteTagsResultBuilder
+	accumulatedTags := storage.NewCompleteTagsResultBuilder(query.CompleteNameOnly)
 	for {
 		select {
 		// If query is killed during gRPC streaming, close the channel
@@ -302,10 +322,6 @@ func (c *grpcClient) CompleteTags(
 			return nil, err
 		}
 
-		if accumulatedTags == nil {
-			accumulatedTags = storage.NewCompleteTagsResultBuilder(result.CompleteNameOnly)
-		}
-
 		err = accumulatedTags.Add(result)
 		if err != nil {
 			return nil, err
@@ -317,7 +333,108 @@ func (c *grpcClient) CompleteTags(
 	return &built, nil
 }
 
+// Write writes a compressed query to the remote storage
+func (c *grpcClient) Write(
+	ctx context.Context,
+	query *storage.WriteQuery,
+) error {
+	pools, err := c.waitForPools()
+	if err != nil {
+		return err
+	}
+
+	request, err := encodeWriteRequest(query, pools)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-c.closeCh:
+		return errors.ErrRemoteClientClosed
+	default:
+	}
+
+	c.writeOnce.Do(func() {
+		go c.writeLoop()
+	})
+
+	write := pendingWrite{request: request, done: make(chan error, 1)}
+	select {
+	case c.writeCh <- write:
+	case <-c.closeCh:
+		return errors.ErrRemoteClientClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-write.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// writeLoop sends pending writes to the remote storage, writes that are
+// queued while a batch is being sent are gathered into the next batch.
+func (c *grpcClient) writeLoop() {
+	batch := make([]pendingWrite, 0, maxWriteBatchSize)
+	for {
+		batch = batch[:0]
+		select {
+		case write := <-c.writeCh:
+			batch = append(batch, write)
+		case <-c.closeCh:
+			return
+		}
+
+	gather:
+		for len(batch) < maxWriteBatchSize {
+			select {
+			case write := <-c.writeCh:
+				batch = append(batch, write)
+			default:
+				break gather
+			}
+		}
+
+		err := c.writeBatch(batch)
+		for _, write := range batch {
+			write.done <- err
+		}
+	}
+}
+
+// writeBatch writes a batch of compressed queries to the remote storage
+func (c *grpcClient) writeBatch(batch []pendingWrite) error {
+	request := &rpc.WriteBatchRequest{
+		Writes: make([]*rpc.WriteRequest, 0, len(batch)),
+	}
+	for _, write := range batch {
+		request.Writes = append(request.Writes, write.request)
+	}
+
+	// NB: the writes in a batch originate from several requests, so the
+	// stream is not bound to the context of any one of them.
+	writeBatchClient, err := c.client.WriteBatch(context.Background())
+	if err != nil {
+		return err
+	}
+
+	// NB: a failed send returns io.EOF, the actual error is surfaced
+	// by CloseAndRecv.
+	if err := writeBatchClient.Send(request); err != nil && err != io.EOF {
+		return err
+	}
+
+	_, err = writeBatchClient.CloseAndRecv()
+	return err
+}
+
 // Close closes the underlying connection
 func (c *grpcClient) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closeCh)
+	})
 	return c.connection.Close()
 }
@@ -0,0 +1,129 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	queryerrors "github.com/m3db/m3/src/query/errors"
+	rpc "github.com/m3db/m3/src/query/generated/proto/rpcpb"
+	"github.com/m3db/m3/src/query/pools"
+	"github.com/m3db/m3/src/query/test"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type testWriteBatchClient struct {
+	grpc.ClientStream
+
+	client  *testQueryClient
+	request *rpc.WriteBatchRequest
+}
+
+func (c *testWriteBatchClient) Send(request *rpc.WriteBatchRequest) error {
+	c.request = request
+	return nil
+}
+
+func (c *testWriteBatchClient) CloseAndRecv() (*rpc.WriteResponse, error) {
+	c.client.Lock()
+	defer c.client.Unlock()
+	c.client.batches = append(c.client.batches, c.request)
+	return &rpc.WriteResponse{}, c.client.err
+}
+
+type testQueryClient struct {
+	rpc.QueryClient
+	sync.Mutex
+
+	batches []*rpc.WriteBatchRequest
+	err     error
+}
+
+func (c *testQueryClient) WriteBatch(
+	ctx context.Context,
+	opts ...grpc.CallOption,
+) (rpc.Query_WriteBatchClient, error) {
+	return &testWriteBatchClient{client: c}, nil
+}
+
+func newTestWriteClient(t *testing.T, queryClient rpc.QueryClient) *grpcClient {
+	cc, err := grpc.Dial("localhost:0", grpc.WithInsecure())
+	require.NoError(t, err)
+
+	return &grpcClient{
+		client:      queryClient,
+		connection:  cc,
+		poolWrapper: pools.NewPoolsWrapper(test.MakeMockIteratorPool()),
+		writeCh:     make(chan pendingWrite),
+		closeCh:     make(chan struct{}),
+	}
+}
+
+func TestWriteBatchesConcurrentWrites(t *testing.T) {
+	queryClient := &testQueryClient{}
+	client := newTestWriteClient(t, queryClient)
+	defer client.Close()
+
+	numWrites := 10
+	var wg sync.WaitGroup
+	for i := 0; i < numWrites; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, client.Write(context.Background(), buildWriteQuery()))
+		}()
+	}
+
+	wg.Wait()
+
+	queryClient.Lock()
+	defer queryClient.Unlock()
+	written := 0
+	for _, batch := range queryClient.batches {
+		written += len(batch.GetWrites())
+	}
+
+	assert.Equal(t, numWrites, written)
+	assert.True(t, len(queryClient.batches) <= numWrites)
+}
+
+func TestWriteReturnsBatchError(t *testing.T) {
+	queryClient := &testQueryClient{err: errors.New("write error")}
+	client := newTestWriteClient(t, queryClient)
+	defer client.Close()
+
+	err := client.Write(context.Background(), buildWriteQuery())
+	assert.EqualError(t, err, "write error")
+}
+
+func TestWriteAfterClose(t *testing.T) {
+	client := newTestWriteClient(t, &testQueryClient{})
+	require.NoError(t, client.Close())
+
+	err := client.Write(context.Background(), buildWriteQuery())
+	assert.Equal(t, queryerrors.ErrRemoteClientClosed, err)
+}
@@ -23,6 +23,7 @@ package remote
 import (
 	"github.com/m3db/m3/src/query/errors"
 	rpc "github.com/m3db/m3/src/query/generated/proto/rpcpb"
+	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 )
 
@@ -86,13 +87,113 @@ func encodeCompleteTagsRequest(
 		return nil, err
 	}
 
+	opts := &rpc.CompleteTagsRequestOptions{
+		Type:           completionType,
+		FilterNameTags: query.FilterNameTags,
+	}
+	// Unset times are left as zero so the remote completes tags over the
+	// same default range as it would locally.
+	if !query.Start.IsZero() {
+		opts.Start = fromTime(query.Start)
+	}
+	if !query.End.IsZero() {
+		opts.End = fromTime(query.End)
+	}
+
 	return &rpc.CompleteTagsRequest{
 		Matchers: &rpc.CompleteTagsRequest_TagMatchers{
 			TagMatchers: matchers,
 		},
-		Options: &rpc.CompleteTagsRequestOptions{
-			Type:           completionType,
-			FilterNameTags: query.FilterNameTags,
-		},
+		Options: opts,
 	}, nil
 }
+
+func decodeCompleteTagsRequest(
+	request *rpc.CompleteTagsRequest,
+) (*storage.CompleteTagsQuery, error) {
+	var (
+		opts     = request.GetOptions()
+		matchers models.Matchers
+	)
+
+	if rpcMatchers := request.GetTagMatchers(); rpcMatchers != nil {
+		decoded, err := decodeTagMatchers(rpcMatchers)
+		if err != nil {
+			return nil, err
+		}
+
+		matchers = decoded
+	}
+
+	completeNameOnly := false
+	switch opts.GetType() {
+	case rpc.CompleteTagsType_DEFAULT:
+	case rpc.CompleteTagsType_TAGNAME:
+		completeNameOnly = true
+	default:
+		return nil, errors.ErrUnexpectedGRPCRequestType
+	}
+
+	query := &storage.CompleteTagsQuery{
+		CompleteNameOnly: completeNameOnly,
+		FilterNameTags:   opts.GetFilterNameTags(),
+		TagMatchers:      matchers,
+	}
+	if start := opts.GetStart(); start != 0 {
+		query.Start = toTime(start)
+	}
+	if end := opts.GetEnd(); end != 0 {
+		query.End = toTime(end)
+	}
+
+	return query, nil
+}
+
+func encodeTagNamesOnly(
+	tagResult *storage.CompleteTagsResult,
+) *rpc.TagNames {
+	tags := tagResult.CompletedTags
+	names := make([][]byte, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+
+	return &rpc.TagNames{
+		Names: names,
+	}
+}
+
+func encodeTagValues(
+	tagResult *storage.CompleteTagsResult,
+) *rpc.TagValues {
+	tags := tagResult.CompletedTags
+	values := make([]*rpc.TagValue, 0, len(tags))
+	for _, tag := range tags {
+		values = append(values, &rpc.TagValue{
+			Key:    tag.Name,
+			Values: tag.Values,
+		})
+	}
+
+	return &rpc.TagValues{
+		Values: values,
+	}
+}
+
+func encodeToCompleteTagsResponse(
+	tagResult *storage.CompleteTagsResult,
+) *rpc.CompleteTagsResponse {
+	if tagResult.CompleteNameOnly {
+		return &rpc.CompleteTagsResponse{
+			Value: &rpc.CompleteTagsResponse_NamesOnly{
+				NamesOnly: encodeTagNamesOnly(tagResult),
+			},
+		}
+	}
+
+	return &rpc.CompleteTagsResponse{
+		Value: &rpc.CompleteTagsResponse_Default{
+			Default: encodeTagValues(tagResult),
+		},
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"testing"
+	"time"
+
+	rpc "github.com/m3db/m3/src/query/generated/proto/rpcpb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeCompleteTagsQuery(t *testing.T) {
+	for _, nameOnly := range []bool{true, false} {
+		query := &storage.CompleteTagsQuery{
+			CompleteNameOnly: nameOnly,
+			FilterNameTags:   [][]byte{[]byte("filter")},
+			TagMatchers: models.Matchers{
+				{Type: models.MatchEqual, Name: []byte("a"), Value: []byte("b")},
+				{Type: models.MatchRegexp, Name: []byte("c"), Value: []byte("d.*")},
+			},
+		}
+
+		encoded, err := encodeCompleteTagsRequest(query)
+		require.NoError(t, err)
+
+		decoded, err := decodeCompleteTagsRequest(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, query.CompleteNameOnly, decoded.CompleteNameOnly)
+		assert.Equal(t, query.FilterNameTags, decoded.FilterNameTags)
+		require.Len(t, decoded.TagMatchers, 2)
+		for i, m := range query.TagMatchers {
+			assert.Equal(t, m.Type, decoded.TagMatchers[i].Type)
+			assert.Equal(t, m.Name, decoded.TagMatchers[i].Name)
+			assert.Equal(t, m.Value, decoded.TagMatchers[i].Value)
+		}
+	}
+}
+
+func TestEncodeDecodeCompleteTagsQueryTimeRange(t *testing.T) {
+	var (
+		start = time.Unix(1000, 0)
+		end   = time.Unix(2000, 0)
+	)
+	query := &storage.CompleteTagsQuery{
+		CompleteNameOnly: true,
+		TagMatchers: models.Matchers{
+			{Type: models.MatchEqual, Name: []byte("a"), Value: []byte("b")},
+		},
+		Start: start,
+		End:   end,
+	}
+
+	encoded, err := encodeCompleteTagsRequest(query)
+	require.NoError(t, err)
+
+	decoded, err := decodeCompleteTagsRequest(encoded)
+	require.NoError(t, err)
+	assert.True(t, start.Equal(decoded.Start))
+	assert.True(t, end.Equal(decoded.End))
+
+	// Unset times are left unset.
+	query.Start, query.End = time.Time{}, time.Time{}
+	encoded, err = encodeCompleteTagsRequest(query)
+	require.NoError(t, err)
+
+	decoded, err = decodeCompleteTagsRequest(encoded)
+	require.NoError(t, err)
+	assert.True(t, decoded.Start.IsZero())
+	assert.True(t, decoded.End.IsZero())
+}
+
+func TestDecodeCompleteTagsRequestInvalidType(t *testing.T) {
+	_, err := decodeCompleteTagsRequest(&rpc.CompleteTagsRequest{
+		Options: &rpc.CompleteTagsRequestOptions{
+			Type: rpc.CompleteTagsType(100),
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestEncodeDecodeCompleteTagsResult(t *testing.T) {
+	names := &storage.CompleteTagsResult{
+		CompleteNameOnly: true,
+		CompletedTags: []storage.CompletedTag{
+			{Name: []byte("a")},
+			{Name: []byte("b")},
+		},
+	}
+
+	decoded, err := decodeCompleteTagsResponse(encodeToCompleteTagsResponse(names))
+	require.NoError(t, err)
+	assert.True(t, decoded.CompleteNameOnly)
+	require.Len(t, decoded.CompletedTags, 2)
+	assert.Equal(t, []byte("a"), decoded.CompletedTags[0].Name)
+	assert.Equal(t, []byte("b"), decoded.CompletedTags[1].Name)
+
+	values := &storage.CompleteTagsResult{
+		CompleteNameOnly: false,
+		CompletedTags: []storage.CompletedTag{
+			{Name: []byte("a"), Values: [][]byte{[]byte("1"), []byte("2")}},
+		},
+	}
+
+	decoded, err = decodeCompleteTagsResponse(encodeToCompleteTagsResponse(values))
+	require.NoError(t, err)
+	assert.Equal(t, values, decoded)
+}
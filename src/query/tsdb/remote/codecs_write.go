@@ -0,0 +1,255 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"sort"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	dbts "github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xpool"
+	"github.com/m3db/m3/src/query/errors"
+	rpc "github.com/m3db/m3/src/query/generated/proto/rpcpb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3x/time"
+)
+
+type datapointsByTime ts.Datapoints
+
+func (d datapointsByTime) Len() int      { return len(d) }
+func (d datapointsByTime) Swap(i, j int) { d[i], d[j] = d[j], d[i] }
+func (d datapointsByTime) Less(i, j int) bool {
+	return d[i].Timestamp.Before(d[j].Timestamp)
+}
+
+// compressedSegmentFromDatapoints encodes datapoints into a single m3tsz
+// segment so that writes are sent across the wire compressed.
+func compressedSegmentFromDatapoints(
+	datapoints ts.Datapoints,
+	unit xtime.Unit,
+) (*rpc.M3Segment, error) {
+	if len(datapoints) == 0 {
+		return nil, nil
+	}
+
+	// NB: m3tsz expects datapoints in time order; sort a copy so that the
+	// caller's datapoints are left untouched.
+	sorted := make(ts.Datapoints, len(datapoints))
+	copy(sorted, datapoints)
+	sort.Stable(datapointsByTime(sorted))
+
+	initialize.Do(initializeVars)
+	start := sorted[0].Timestamp
+	encoder := encoderPool.Get()
+	encoder.Reset(start, 0)
+	for _, dp := range sorted {
+		err := encoder.Encode(dbts.Datapoint{
+			Timestamp: dp.Timestamp,
+			Value:     dp.Value,
+		}, unit, nil)
+		if err != nil {
+			encoder.Close()
+			return nil, err
+		}
+	}
+
+	segment := encoder.Discard()
+	var head, tail []byte
+	if segment.Head != nil {
+		head = segment.Head.Bytes()
+	}
+
+	if segment.Tail != nil {
+		tail = segment.Tail.Bytes()
+	}
+
+	end := sorted[len(sorted)-1].Timestamp
+	return &rpc.M3Segment{
+		Head:      head,
+		Tail:      tail,
+		StartTime: xtime.ToNanoseconds(start),
+		BlockSize: int64(end.Sub(start)),
+	}, nil
+}
+
+func datapointsFromCompressedSegment(
+	segment *rpc.M3Segment,
+	checkedBytesWrapperPool xpool.CheckedBytesWrapperPool,
+) (ts.Datapoints, error) {
+	if segment == nil {
+		return ts.Datapoints{}, nil
+	}
+
+	initialize.Do(initializeVars)
+	reader := blockReaderFromCompressedSegment(segment, opts, checkedBytesWrapperPool)
+	iter := iterAlloc(reader)
+	defer iter.Close()
+
+	datapoints := make(ts.Datapoints, 0, initResultSize)
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		datapoints = append(datapoints, ts.Datapoint{
+			Timestamp: dp.Timestamp,
+			Value:     dp.Value,
+		})
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	return datapoints, nil
+}
+
+func encodeWriteAttributes(attrs storage.Attributes) (*rpc.WriteAttributes, error) {
+	var metricsType rpc.MetricsType
+	switch attrs.MetricsType {
+	case storage.UnaggregatedMetricsType:
+		metricsType = rpc.MetricsType_UNAGGREGATED
+	case storage.AggregatedMetricsType:
+		metricsType = rpc.MetricsType_AGGREGATED
+	default:
+		return nil, errors.ErrInvalidMetricsType
+	}
+
+	return &rpc.WriteAttributes{
+		MetricsType: metricsType,
+		Retention:   int64(attrs.Retention),
+		Resolution:  int64(attrs.Resolution),
+	}, nil
+}
+
+func decodeWriteAttributes(attrs *rpc.WriteAttributes) (storage.Attributes, error) {
+	var metricsType storage.MetricsType
+	switch attrs.GetMetricsType() {
+	case rpc.MetricsType_UNAGGREGATED:
+		metricsType = storage.UnaggregatedMetricsType
+	case rpc.MetricsType_AGGREGATED:
+		metricsType = storage.AggregatedMetricsType
+	default:
+		return storage.Attributes{}, errors.ErrInvalidMetricsType
+	}
+
+	return storage.Attributes{
+		MetricsType: metricsType,
+		Retention:   time.Duration(attrs.GetRetention()),
+		Resolution:  time.Duration(attrs.GetResolution()),
+	}, nil
+}
+
+// encodeWriteRequest encodes a write query into a compressed write request
+func encodeWriteRequest(
+	query *storage.WriteQuery,
+	iterPools encoding.IteratorPools,
+) (*rpc.WriteRequest, error) {
+	tags, err := buildTags(storage.TagsToIdentTagIterator(query.Tags), iterPools)
+	if err != nil {
+		return nil, err
+	}
+
+	segment, err := compressedSegmentFromDatapoints(query.Datapoints, query.Unit)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := encodeWriteAttributes(query.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.WriteRequest{
+		CompressedTags: tags,
+		Segment:        segment,
+		Unit:           int32(query.Unit),
+		Annotation:     query.Annotation,
+		Attributes:     attrs,
+	}, nil
+}
+
+// encodeWriteBatchRequest encodes write queries into a compressed write batch request
+func encodeWriteBatchRequest(
+	queries []*storage.WriteQuery,
+	iterPools encoding.IteratorPools,
+) (*rpc.WriteBatchRequest, error) {
+	writes := make([]*rpc.WriteRequest, 0, len(queries))
+	for _, query := range queries {
+		write, err := encodeWriteRequest(query, iterPools)
+		if err != nil {
+			return nil, err
+		}
+
+		writes = append(writes, write)
+	}
+
+	return &rpc.WriteBatchRequest{
+		Writes: writes,
+	}, nil
+}
+
+// decodeWriteRequest decodes a compressed write request into a write query
+func decodeWriteRequest(
+	request *rpc.WriteRequest,
+	iterPools encoding.IteratorPools,
+	tagOptions models.TagOptions,
+) (*storage.WriteQuery, error) {
+	tagIter, err := tagIteratorFromCompressedTagsWithDecoder(
+		request.GetCompressedTags(),
+		iterPools,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := storage.FromIdentTagIteratorToTags(tagIter, tagOptions)
+	tagIter.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	unit := xtime.Unit(request.GetUnit())
+	if !unit.IsValid() {
+		return nil, errors.ErrInvalidWriteUnit
+	}
+
+	datapoints, err := datapointsFromCompressedSegment(
+		request.GetSegment(),
+		iterPools.CheckedBytesWrapper(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := decodeWriteAttributes(request.GetAttributes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.WriteQuery{
+		Tags:       tags,
+		Datapoints: datapoints,
+		Unit:       unit,
+		Annotation: request.GetAnnotation(),
+		Attributes: attrs,
+	}, nil
+}
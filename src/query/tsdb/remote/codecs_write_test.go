@@ -0,0 +1,138 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"testing"
+	"time"
+
+	rpc "github.com/m3db/m3/src/query/generated/proto/rpcpb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/test"
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildWriteQuery() *storage.WriteQuery {
+	now := time.Now().Truncate(time.Second)
+	tags := models.NewTags(2, models.NewTagOptions()).AddTags([]models.Tag{
+		{Name: []byte("a"), Value: []byte("b")},
+		{Name: []byte("c"), Value: []byte("d")},
+	})
+
+	return &storage.WriteQuery{
+		Tags: tags,
+		Datapoints: ts.Datapoints{
+			{Timestamp: now.Add(time.Minute), Value: 2.5},
+			{Timestamp: now, Value: 1},
+			{Timestamp: now.Add(2 * time.Minute), Value: 3},
+		},
+		Unit:       xtime.Second,
+		Annotation: []byte("annotation"),
+		Attributes: storage.Attributes{
+			MetricsType: storage.AggregatedMetricsType,
+			Retention:   48 * time.Hour,
+			Resolution:  time.Minute,
+		},
+	}
+}
+
+func validateWriteQuery(
+	t *testing.T,
+	expected *storage.WriteQuery,
+	actual *storage.WriteQuery,
+) {
+	assert.Equal(t, expected.Tags.Tags, actual.Tags.Tags)
+	assert.Equal(t, expected.Unit, actual.Unit)
+	assert.Equal(t, expected.Annotation, actual.Annotation)
+	assert.Equal(t, expected.Attributes, actual.Attributes)
+
+	// Datapoints are sent in time order.
+	require.Len(t, actual.Datapoints, len(expected.Datapoints))
+	assert.Equal(t, expected.Datapoints[1].Timestamp, actual.Datapoints[0].Timestamp)
+	assert.Equal(t, expected.Datapoints[1].Value, actual.Datapoints[0].Value)
+	assert.Equal(t, expected.Datapoints[0].Timestamp, actual.Datapoints[1].Timestamp)
+	assert.Equal(t, expected.Datapoints[0].Value, actual.Datapoints[1].Value)
+	assert.Equal(t, expected.Datapoints[2].Timestamp, actual.Datapoints[2].Timestamp)
+	assert.Equal(t, expected.Datapoints[2].Value, actual.Datapoints[2].Value)
+}
+
+func TestEncodeDecodeWriteRequest(t *testing.T) {
+	ip := test.MakeMockIteratorPool()
+	query := buildWriteQuery()
+	request, err := encodeWriteRequest(query, ip)
+	require.NoError(t, err)
+	require.NotNil(t, request.GetSegment())
+	assert.Equal(t, rpc.MetricsType_AGGREGATED, request.GetAttributes().GetMetricsType())
+
+	decoded, err := decodeWriteRequest(request, ip, models.NewTagOptions())
+	require.NoError(t, err)
+	validateWriteQuery(t, query, decoded)
+
+	// Ensure the original datapoints were not reordered.
+	assert.Equal(t, 2.5, query.Datapoints[0].Value)
+}
+
+func TestEncodeDecodeWriteBatchRequest(t *testing.T) {
+	ip := test.MakeMockIteratorPool()
+	queries := []*storage.WriteQuery{buildWriteQuery(), buildWriteQuery()}
+	request, err := encodeWriteBatchRequest(queries, ip)
+	require.NoError(t, err)
+	require.Len(t, request.GetWrites(), 2)
+
+	for i, write := range request.GetWrites() {
+		decoded, err := decodeWriteRequest(write, ip, models.NewTagOptions())
+		require.NoError(t, err)
+		validateWriteQuery(t, queries[i], decoded)
+	}
+}
+
+func TestEncodeDecodeWriteRequestNoDatapoints(t *testing.T) {
+	ip := test.MakeMockIteratorPool()
+	query := buildWriteQuery()
+	query.Datapoints = nil
+	request, err := encodeWriteRequest(query, ip)
+	require.NoError(t, err)
+	assert.Nil(t, request.GetSegment())
+
+	decoded, err := decodeWriteRequest(request, ip, models.NewTagOptions())
+	require.NoError(t, err)
+	assert.Len(t, decoded.Datapoints, 0)
+}
+
+func TestDecodeWriteRequestInvalidUnit(t *testing.T) {
+	ip := test.MakeMockIteratorPool()
+	request, err := encodeWriteRequest(buildWriteQuery(), ip)
+	require.NoError(t, err)
+
+	request.Unit = 0
+	_, err = decodeWriteRequest(request, ip, models.NewTagOptions())
+	assert.Error(t, err)
+}
+
+func TestEncodeWriteRequestWithoutPools(t *testing.T) {
+	_, err := encodeWriteRequest(buildWriteQuery(), nil)
+	assert.Error(t, err)
+}
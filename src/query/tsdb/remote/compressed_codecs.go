@@ -45,15 +45,23 @@ func initializeVars() {
 			b.Reset(nil)
 		}))
 
+	encoderPool = encoding.NewEncoderPool(nil)
+	encodingOpts = encoding.NewOptions().SetEncoderPool(encoderPool)
+	encoderPool.Init(func() encoding.Encoder {
+		return m3tsz.NewEncoder(time.Time{}, nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
+	})
+
 	iterAlloc = func(r io.Reader) encoding.ReaderIterator {
-		return m3tsz.NewReaderIterator(r, m3tsz.DefaultIntOptimizationEnabled, encoding.NewOptions())
+		return m3tsz.NewReaderIterator(r, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
 	}
 }
 
 var (
-	opts       checked.BytesOptions
-	iterAlloc  func(r io.Reader) encoding.ReaderIterator
-	initialize sync.Once
+	opts         checked.BytesOptions
+	encodingOpts encoding.Options
+	encoderPool  encoding.EncoderPool
+	iterAlloc    func(r io.Reader) encoding.ReaderIterator
+	initialize   sync.Once
 )
 
 func compressedSegmentFromBlockReader(br xio.BlockReader) (*rpc.M3Segment, error) {
@@ -21,13 +21,15 @@
 package remote
 
 import (
+	"context"
+	"io"
 	"net"
 	"sync"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/encoding"
-	"github.com/m3db/m3/src/query/errors"
 	rpc "github.com/m3db/m3/src/query/generated/proto/rpcpb"
+	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/pools"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/m3"
@@ -43,6 +45,7 @@ const poolTimeout = time.Second * 10
 type grpcServer struct {
 	storage     m3.Storage
 	poolWrapper *pools.PoolWrapper
+	tagOptions  models.TagOptions
 	once        sync.Once
 	pools       encoding.IteratorPools
 	poolErr     error
@@ -52,11 +55,13 @@ type grpcServer struct {
 func CreateNewGrpcServer(
 	store m3.Storage,
 	poolWrapper *pools.PoolWrapper,
+	tagOptions models.TagOptions,
 ) *grpc.Server {
 	server := grpc.NewServer()
 	grpcServer := &grpcServer{
 		storage:     store,
 		poolWrapper: poolWrapper,
+		tagOptions:  tagOptions,
 	}
 
 	rpc.RegisterQueryServer(server, grpcServer)
@@ -175,9 +180,115 @@ func (s *grpcServer) Search(
 	return err
 }
 
+// CompleteTags returns autocompleted tag names or values from m3 storage
 func (s *grpcServer) CompleteTags(
 	message *rpc.CompleteTagsRequest,
 	stream rpc.Query_CompleteTagsServer,
 ) error {
-	return errors.ErrNotImplemented
+	ctx := retrieveMetadata(stream.Context())
+	logger := logging.WithContext(ctx)
+	completeTagsQuery, err := decodeCompleteTagsRequest(message)
+	if err != nil {
+		logger.Error("unable to decode complete tags query", zap.Error(err))
+		return err
+	}
+
+	result, err := s.storage.CompleteTags(
+		ctx,
+		completeTagsQuery,
+		storage.NewFetchOptions(),
+	)
+	if err != nil {
+		logger.Error("unable to complete tags", zap.Error(err))
+		return err
+	}
+
+	response := encodeToCompleteTagsResponse(result)
+	err = stream.Send(response)
+	if err != nil {
+		logger.Error("unable to send complete tags result", zap.Error(err))
+	}
+
+	return err
+}
+
+// Write writes a stream of compressed series to m3 storage
+func (s *grpcServer) Write(stream rpc.Query_WriteServer) error {
+	ctx := retrieveMetadata(stream.Context())
+	logger := logging.WithContext(ctx)
+	pools, err := s.waitForPools()
+	if err != nil {
+		logger.Error("unable to get pools", zap.Error(err))
+		return err
+	}
+
+	var written int64
+	for {
+		message, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			logger.Error("unable to receive write", zap.Error(err))
+			return err
+		}
+
+		if err := s.write(ctx, message, pools); err != nil {
+			logger.Error("unable to write", zap.Error(err))
+			return err
+		}
+
+		written++
+	}
+
+	return stream.SendAndClose(&rpc.WriteResponse{Written: written})
+}
+
+// WriteBatch writes a stream of batches of compressed series to m3 storage
+func (s *grpcServer) WriteBatch(stream rpc.Query_WriteBatchServer) error {
+	ctx := retrieveMetadata(stream.Context())
+	logger := logging.WithContext(ctx)
+	pools, err := s.waitForPools()
+	if err != nil {
+		logger.Error("unable to get pools", zap.Error(err))
+		return err
+	}
+
+	var written int64
+	for {
+		message, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			logger.Error("unable to receive write batch", zap.Error(err))
+			return err
+		}
+
+		for _, write := range message.GetWrites() {
+			if err := s.write(ctx, write, pools); err != nil {
+				logger.Error("unable to write batch", zap.Error(err))
+				return err
+			}
+
+			written++
+		}
+	}
+
+	return stream.SendAndClose(&rpc.WriteResponse{Written: written})
+}
+
+func (s *grpcServer) write(
+	ctx context.Context,
+	message *rpc.WriteRequest,
+	pools encoding.IteratorPools,
+) error {
+	query, err := decodeWriteRequest(message, pools, s.tagOptions)
+	if err != nil {
+		return err
+	}
+
+	return s.storage.Write(ctx, query)
 }
@@ -0,0 +1,120 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package xtls provides TLS configuration for servers and clients, with
+// certificates that are reloaded from disk when they change.
+package xtls
+
+import (
+	"errors"
+	"time"
+
+	"github.com/m3db/m3x/instrument"
+)
+
+const (
+	defaultCertificatesReloadInterval = time.Minute
+)
+
+var (
+	errCertAndKeyRequired   = errors.New("tls certFile and keyFile must be set together")
+	errServerCertRequired   = errors.New("tls certFile and keyFile are required to serve tls")
+	errMutualTLSRequiresCA  = errors.New("tls mutual authentication requires a caFile")
+	errNegativeReloadPeriod = errors.New("tls certificatesReloadInterval must not be negative")
+)
+
+// Configuration is the configuration for TLS.
+type Configuration struct {
+	// Enabled enables TLS.
+	Enabled bool `yaml:"enabled"`
+
+	// CertFile is the path to the PEM encoded certificate presented to peers.
+	CertFile string `yaml:"certFile"`
+
+	// KeyFile is the path to the PEM encoded private key of the certificate.
+	KeyFile string `yaml:"keyFile"`
+
+	// CAFile is the path to the PEM encoded certificate authorities used to
+	// verify the certificates presented by peers, if not set the host's root
+	// certificate authorities are used.
+	CAFile string `yaml:"caFile"`
+
+	// MutualTLS requires servers to verify the certificates of clients.
+	MutualTLS bool `yaml:"mutualTLS"`
+
+	// ServerName is the name used by clients to verify the certificates of
+	// servers, if not set the host being dialed is used.
+	ServerName string `yaml:"serverName"`
+
+	// InsecureSkipVerify disables the verification of server certificates
+	// by clients, it should only be used for testing.
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify"`
+
+	// CertificatesReloadInterval is how often the certificates are checked
+	// for changes on disk, zero uses the default interval.
+	CertificatesReloadInterval time.Duration `yaml:"certificatesReloadInterval"`
+}
+
+// Validate validates the configuration.
+func (c Configuration) Validate() error {
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return errCertAndKeyRequired
+	}
+
+	if c.MutualTLS && c.CAFile == "" {
+		return errMutualTLSRequiresCA
+	}
+
+	if c.CertificatesReloadInterval < 0 {
+		return errNegativeReloadPeriod
+	}
+
+	return nil
+}
+
+// ValidateServer validates the configuration is usable by a server, which
+// must present a certificate when TLS is enabled.
+func (c Configuration) ValidateServer() error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	if c.Enabled && (c.CertFile == "" || c.KeyFile == "") {
+		return errServerCertRequired
+	}
+
+	return nil
+}
+
+// NewConfigProvider returns a new config provider from the configuration,
+// or nil if TLS is not enabled.
+func (c *Configuration) NewConfigProvider(
+	iOpts instrument.Options,
+) (ConfigProvider, error) {
+	if c == nil || !c.Enabled {
+		return nil, nil
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return NewConfigProvider(*c, iOpts)
+}
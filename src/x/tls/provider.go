@@ -0,0 +1,315 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package xtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3x/instrument"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+// ConfigProvider provides TLS configs built from certificates which are
+// loaded from disk and reloaded whenever they change.
+type ConfigProvider interface {
+	// ServerConfig returns a TLS config for servers which always presents
+	// the most recently loaded certificates.
+	ServerConfig() *tls.Config
+
+	// ClientConfig returns a TLS config for clients built from the most
+	// recently loaded certificates.
+	ClientConfig() *tls.Config
+
+	// DialContext dials the address and performs a TLS handshake with the
+	// most recently loaded certificates.
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+
+	// Close stops reloading the certificates.
+	Close()
+}
+
+type certificates struct {
+	cert     *tls.Certificate
+	certPool *x509.CertPool
+	modTimes [3]time.Time
+	loaded   bool
+}
+
+type configProvider struct {
+	sync.RWMutex
+
+	cfg       Configuration
+	current   certificates
+	logger    *zap.Logger
+	metrics   configProviderMetrics
+	closeOnce sync.Once
+	closeCh   chan struct{}
+	doneCh    chan struct{}
+}
+
+type configProviderMetrics struct {
+	reloads      tally.Counter
+	reloadErrors tally.Counter
+}
+
+func newConfigProviderMetrics(scope tally.Scope) configProviderMetrics {
+	return configProviderMetrics{
+		reloads:      scope.Counter("reloads"),
+		reloadErrors: scope.Counter("reload-errors"),
+	}
+}
+
+// NewConfigProvider returns a new config provider which loads the
+// certificates of the configuration and periodically reloads them.
+func NewConfigProvider(
+	cfg Configuration,
+	iOpts instrument.Options,
+) (ConfigProvider, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if iOpts == nil {
+		iOpts = instrument.NewOptions()
+	}
+
+	p := &configProvider{
+		cfg:     cfg,
+		logger:  iOpts.ZapLogger(),
+		metrics: newConfigProviderMetrics(iOpts.MetricsScope().SubScope("tls")),
+		closeCh: make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}
+
+	current, _, err := p.load(certificates{})
+	if err != nil {
+		return nil, err
+	}
+	p.current = current
+
+	reloadInterval := cfg.CertificatesReloadInterval
+	if reloadInterval == 0 {
+		reloadInterval = defaultCertificatesReloadInterval
+	}
+	go p.reloadEvery(reloadInterval)
+
+	return p, nil
+}
+
+// load loads the certificates if any of the files have been modified since
+// the previous certificates were loaded, returning whether they were loaded.
+func (p *configProvider) load(prev certificates) (certificates, bool, error) {
+	var (
+		files    = [3]string{p.cfg.CertFile, p.cfg.KeyFile, p.cfg.CAFile}
+		modTimes [3]time.Time
+	)
+	for i, file := range files {
+		if file == "" {
+			continue
+		}
+
+		info, err := os.Stat(file)
+		if err != nil {
+			return certificates{}, false, err
+		}
+		modTimes[i] = info.ModTime()
+	}
+
+	if prev.loaded && modTimes == prev.modTimes {
+		return prev, false, nil
+	}
+
+	result := certificates{modTimes: modTimes, loaded: true}
+	if p.cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(p.cfg.CertFile, p.cfg.KeyFile)
+		if err != nil {
+			return certificates{}, false, fmt.Errorf(
+				"unable to load tls certificate %s: %v", p.cfg.CertFile, err)
+		}
+		result.cert = &cert
+	}
+
+	if p.cfg.CAFile != "" {
+		caPEM, err := ioutil.ReadFile(p.cfg.CAFile)
+		if err != nil {
+			return certificates{}, false, err
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caPEM) {
+			return certificates{}, false, fmt.Errorf(
+				"unable to parse tls certificate authorities %s", p.cfg.CAFile)
+		}
+		result.certPool = certPool
+	}
+
+	return result, true, nil
+}
+
+func (p *configProvider) reloadEvery(interval time.Duration) {
+	defer close(p.doneCh)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-p.closeCh:
+			return
+		}
+
+		p.RLock()
+		prev := p.current
+		p.RUnlock()
+
+		current, reloaded, err := p.load(prev)
+		if err != nil {
+			// Keep serving with the previous certificates until the files
+			// on disk are valid again.
+			p.metrics.reloadErrors.Inc(1)
+			p.logger.Error("unable to reload tls certificates", zap.Error(err))
+			continue
+		}
+
+		if !reloaded {
+			continue
+		}
+
+		p.Lock()
+		p.current = current
+		p.Unlock()
+
+		p.metrics.reloads.Inc(1)
+		p.logger.Info("reloaded tls certificates")
+	}
+}
+
+func (p *configProvider) certificates() certificates {
+	p.RLock()
+	current := p.current
+	p.RUnlock()
+	return current
+}
+
+func (p *configProvider) ServerConfig() *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return p.serverConfig(), nil
+		},
+	}
+}
+
+func (p *configProvider) serverConfig() *tls.Config {
+	current := p.certificates()
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientCAs:  current.certPool,
+	}
+
+	if current.cert != nil {
+		cfg.Certificates = []tls.Certificate{*current.cert}
+	}
+
+	if p.cfg.MutualTLS {
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return cfg
+}
+
+func (p *configProvider) ClientConfig() *tls.Config {
+	current := p.certificates()
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		RootCAs:            current.certPool,
+		ServerName:         p.cfg.ServerName,
+		InsecureSkipVerify: p.cfg.InsecureSkipVerify,
+	}
+
+	if current.cert != nil {
+		cfg.Certificates = []tls.Certificate{*current.cert}
+	}
+
+	return cfg
+}
+
+func (p *configProvider) DialContext(
+	ctx context.Context,
+	network, address string,
+) (net.Conn, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := p.ClientConfig()
+	if cfg.ServerName == "" {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		cfg.ServerName = host
+	}
+
+	tlsConn := tls.Client(conn, cfg)
+	if deadline, ok := ctx.Deadline(); ok {
+		tlsConn.SetDeadline(deadline)
+	}
+
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	tlsConn.SetDeadline(time.Time{})
+	return tlsConn, nil
+}
+
+func (p *configProvider) Close() {
+	p.closeOnce.Do(func() {
+		close(p.closeCh)
+		<-p.doneCh
+	})
+}
+
+// NewListener returns a listener which accepts TLS connections using the
+// server config of the provider, or the listener itself if the provider
+// is nil.
+func NewListener(l net.Listener, provider ConfigProvider) net.Listener {
+	if provider == nil {
+		return l
+	}
+
+	return tls.NewListener(l, provider.ServerConfig())
+}
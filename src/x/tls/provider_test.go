@@ -0,0 +1,272 @@
+// Copyright (c) 2018 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package xtls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+func newTestCert(
+	t *testing.T,
+	serial int64,
+	parent *testCert,
+	isCA bool,
+) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage: []x509.ExtKeyUsage{
+			x509.ExtKeyUsageServerAuth,
+			x509.ExtKeyUsageClientAuth,
+		},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer,
+		&key.PublicKey, signerKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: key, der: der}
+}
+
+func (c *testCert) write(t *testing.T, dir, name string) (string, string) {
+	certFile := filepath.Join(dir, name+".crt")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.der})
+	require.NoError(t, ioutil.WriteFile(certFile, certPEM, 0600))
+
+	keyDER, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	keyFile := filepath.Join(dir, name+".key")
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	require.NoError(t, ioutil.WriteFile(keyFile, keyPEM, 0600))
+
+	return certFile, keyFile
+}
+
+type testCerts struct {
+	dir                        string
+	caFile                     string
+	serverCertFile, serverKey  string
+	clientCertFile, clientKey  string
+	ca, serverCert, clientCert *testCert
+}
+
+func newTestCerts(t *testing.T) testCerts {
+	dir, err := ioutil.TempDir("", "xtls")
+	require.NoError(t, err)
+
+	certs := testCerts{dir: dir}
+	certs.ca = newTestCert(t, 1, nil, true)
+	certs.caFile, _ = certs.ca.write(t, dir, "ca")
+	certs.serverCert = newTestCert(t, 2, certs.ca, false)
+	certs.serverCertFile, certs.serverKey = certs.serverCert.write(t, dir, "server")
+	certs.clientCert = newTestCert(t, 3, certs.ca, false)
+	certs.clientCertFile, certs.clientKey = certs.clientCert.write(t, dir, "client")
+	return certs
+}
+
+func serveEcho(t *testing.T, provider ConfigProvider) net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	l = NewListener(l, provider)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				buf := make([]byte, 4)
+				if _, err := conn.Read(buf); err == nil {
+					conn.Write(buf)
+				}
+			}()
+		}
+	}()
+
+	return l
+}
+
+func dialEcho(provider ConfigProvider, address string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := provider.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte("ping")); err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 4)
+	if _, err := conn.Read(buf); err != nil {
+		return "", err
+	}
+
+	return string(buf), nil
+}
+
+func TestConfigProviderMutualTLS(t *testing.T) {
+	certs := newTestCerts(t)
+	defer os.RemoveAll(certs.dir)
+
+	server, err := NewConfigProvider(Configuration{
+		Enabled:   true,
+		CertFile:  certs.serverCertFile,
+		KeyFile:   certs.serverKey,
+		CAFile:    certs.caFile,
+		MutualTLS: true,
+	}, nil)
+	require.NoError(t, err)
+	defer server.Close()
+
+	l := serveEcho(t, server)
+	defer l.Close()
+
+	client, err := NewConfigProvider(Configuration{
+		Enabled:  true,
+		CertFile: certs.clientCertFile,
+		KeyFile:  certs.clientKey,
+		CAFile:   certs.caFile,
+	}, nil)
+	require.NoError(t, err)
+	defer client.Close()
+
+	resp, err := dialEcho(client, l.Addr().String())
+	require.NoError(t, err)
+	assert.Equal(t, "ping", resp)
+
+	// Clients without a certificate are rejected.
+	anonymous, err := NewConfigProvider(Configuration{
+		Enabled: true,
+		CAFile:  certs.caFile,
+	}, nil)
+	require.NoError(t, err)
+	defer anonymous.Close()
+
+	_, err = dialEcho(anonymous, l.Addr().String())
+	require.Error(t, err)
+}
+
+func TestConfigProviderReloadsCertificates(t *testing.T) {
+	certs := newTestCerts(t)
+	defer os.RemoveAll(certs.dir)
+
+	provider, err := NewConfigProvider(Configuration{
+		Enabled:                    true,
+		CertFile:                   certs.serverCertFile,
+		KeyFile:                    certs.serverKey,
+		CertificatesReloadInterval: 10 * time.Millisecond,
+	}, nil)
+	require.NoError(t, err)
+	defer provider.Close()
+
+	serial := func() int64 {
+		cfg := provider.ClientConfig()
+		require.Len(t, cfg.Certificates, 1)
+		cert, err := x509.ParseCertificate(cfg.Certificates[0].Certificate[0])
+		require.NoError(t, err)
+		return cert.SerialNumber.Int64()
+	}
+	require.Equal(t, int64(2), serial())
+
+	renewed := newTestCert(t, 4, certs.ca, false)
+	renewed.write(t, certs.dir, "server")
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certs.serverCertFile, future, future))
+	require.NoError(t, os.Chtimes(certs.serverKey, future, future))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for serial() != 4 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, int64(4), serial())
+}
+
+func TestConfigurationValidate(t *testing.T) {
+	assert.NoError(t, Configuration{}.Validate())
+	assert.Equal(t, errCertAndKeyRequired,
+		Configuration{CertFile: "cert"}.Validate())
+	assert.Equal(t, errMutualTLSRequiresCA,
+		Configuration{MutualTLS: true}.Validate())
+
+	assert.NoError(t, Configuration{Enabled: true}.Validate())
+	assert.NoError(t, Configuration{}.ValidateServer())
+	assert.Equal(t, errServerCertRequired,
+		Configuration{Enabled: true}.ValidateServer())
+	assert.Equal(t, errCertAndKeyRequired,
+		Configuration{Enabled: true, KeyFile: "key"}.ValidateServer())
+	assert.NoError(t, Configuration{
+		Enabled:  true,
+		CertFile: "cert",
+		KeyFile:  "key",
+	}.ValidateServer())
+
+	var cfg *Configuration
+	provider, err := cfg.NewConfigProvider(nil)
+	require.NoError(t, err)
+	assert.Nil(t, provider)
+}